@@ -0,0 +1,32 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+)
+
+// PunchWireGuard 按 master 的协调向对端 NAT 映射地址打洞，对端会在同一时间收到相同的请求
+func PunchWireGuard(ctx *app.Context, req *pb.PunchWireGuardRequest) (*pb.PunchWireGuardResponse, error) {
+	var (
+		interfaceName = req.GetInterfaceName()
+		log           = ctx.Logger().WithField("op", "PunchWireGuard")
+	)
+
+	wgSvc, ok := ctx.GetApp().GetWireGuardManager().GetService(interfaceName)
+	if !ok {
+		log.Errorf("wireguard service not found, interface_name: %s", interfaceName)
+		return nil, fmt.Errorf("wireguard service not found, interface_name: %s", interfaceName)
+	}
+
+	results := wgSvc.Punch(req.GetTargets())
+	for _, r := range results {
+		log.Debugf("punch peer [%d] done, success: %v, rtt: %dms", r.GetPeerId(), r.GetSuccess(), r.GetRttMs())
+	}
+
+	return &pb.PunchWireGuardResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		Results: results,
+	}, nil
+}
@@ -81,4 +81,13 @@ func syncExistingWireGuard(log *logrus.Entry, wgSvc app.WireGuard, wgCfg *defs.W
 		log.WithError(err).Warn("patch peers failed while syncing existing wireguard")
 		return
 	}
+	if err := wgSvc.UpdateACL(wgCfg.GetAcl()); err != nil {
+		log.WithError(err).Warn("update acl failed while syncing existing wireguard")
+	}
+	if err := wgSvc.UpdateMagicDNS(wgCfg.GetNetworkName(), wgCfg.GetDnsRecords()); err != nil {
+		log.WithError(err).Warn("update magic dns failed while syncing existing wireguard")
+	}
+	if err := wgSvc.UpdateAdvertisedRoutes(wgCfg.GetAdvertisedRoutes(), wgCfg.GetExitNode(), wgCfg.GetAcceptRoutes()); err != nil {
+		log.WithError(err).Warn("update advertised routes failed while syncing existing wireguard")
+	}
 }
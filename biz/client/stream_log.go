@@ -8,6 +8,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
 )
 
 func StartSteamLogHandler(ctx *app.Context, req *pb.StartSteamLogRequest) (*pb.CommonResponse, error) {
@@ -28,9 +29,11 @@ func initStreamLog(ctx *app.Context, h app.StreamLogHookMgr) {
 		logger.Logger(ctx).Error(err)
 	}
 
-	h.AddStream(func(msg string) {
+	h.AddStream(func(line logger.StreamLogLine) {
 		handler.Send(&pb.PushClientStreamLogReq{
-			Log: []byte(utils.EncodeBase64(msg)),
+			Log:   []byte(utils.EncodeBase64(line.Msg)),
+			Pkg:   lo.ToPtr(line.Pkg),
+			Level: lo.ToPtr(line.Level),
 			Base: &pb.ClientBase{
 				ClientId:     clientID,
 				ClientSecret: clientSecret,
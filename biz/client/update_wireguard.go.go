@@ -103,12 +103,26 @@ func PatchPeers(ctx *app.Context, wgSvc app.WireGuard, req *pb.UpdateWireGuardRe
 
 	wgCfg := &defs.WireGuardConfig{WireGuardConfig: req.GetWireguardConfig()}
 
+	// patch peers 携带全量配置，顺带同步 ACL
+	if err := wgSvc.UpdateACL(wgCfg.GetAcl()); err != nil {
+		log.WithError(err).Errorf("update acl failed")
+		return nil, err
+	}
+
 	diffResp, err := wgSvc.PatchPeers(wgCfg.GetParsedPeers())
 	if err != nil {
 		log.WithError(err).Errorf("patch peers failed")
 		return nil, err
 	}
 
+	if err := wgSvc.UpdateMagicDNS(wgCfg.GetNetworkName(), wgCfg.GetDnsRecords()); err != nil {
+		log.WithError(err).Warn("update magic dns failed")
+	}
+
+	if err := wgSvc.UpdateAdvertisedRoutes(wgCfg.GetAdvertisedRoutes(), wgCfg.GetExitNode(), wgCfg.GetAcceptRoutes()); err != nil {
+		log.WithError(err).Warn("update advertised routes failed")
+	}
+
 	log.Debugf("patch peers done, add_peers: %+v, remove_peers: %+v",
 		lo.Map(diffResp.AddPeers, func(item *defs.WireGuardPeerConfig, _ int) string { return item.GetClientId() }),
 		lo.Map(diffResp.RemovePeers, func(item *defs.WireGuardPeerConfig, _ int) string { return item.GetClientId() }))
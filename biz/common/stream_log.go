@@ -28,7 +28,7 @@ func (h *HookMgr) Close() {
 	logger.Instance().ReplaceHooks(logrus.LevelHooks{})
 }
 
-func (h *HookMgr) AddStream(send func(msg string), closeSend func()) {
+func (h *HookMgr) AddStream(send func(line logger.StreamLogLine), closeSend func()) {
 	if h.Mutex == nil {
 		h.Mutex = &sync.Mutex{}
 	}
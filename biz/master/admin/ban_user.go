@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// BanUserHandler 封禁/解封用户，封禁时同时踢掉该用户在线的客户端与服务端
+func BanUserHandler(ctx *app.Context, req *pb.AdminBanUserRequest) (*pb.AdminBanUserResponse, error) {
+	logger.Logger(ctx).Infof("admin ban user, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.AdminBanUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	target, err := getTargetUser(ctx, userInfo, int(req.GetUserId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get target user, id: [%d]", req.GetUserId())
+		return nil, err
+	}
+
+	target.Status = models.STATUS_BANED
+	if req.GetUnban() {
+		target.Status = models.STATUS_NORMAL
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateUser(target, target); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update user status, id: [%d]", target.UserID)
+		return nil, err
+	}
+
+	if !req.GetUnban() {
+		if err := kickUser(ctx, target); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot kick user, id: [%d]", target.UserID)
+			return nil, err
+		}
+	}
+
+	logger.Logger(ctx).Infof("admin ban user success, id: [%d], unban: [%v]", target.UserID, req.GetUnban())
+	return &pb.AdminBanUserResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func ChangeRoleHandler(ctx *app.Context, req *pb.AdminChangeRoleRequest) (*pb.AdminChangeRoleResponse, error) {
+	logger.Logger(ctx).Infof("admin change role, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.AdminChangeRoleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if !validRole(userInfo, req.GetRole()) {
+		return &pb.AdminChangeRoleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid role"},
+		}, nil
+	}
+
+	target, err := getTargetUser(ctx, userInfo, int(req.GetUserId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get target user, id: [%d]", req.GetUserId())
+		return nil, err
+	}
+
+	target.Role = req.GetRole()
+	if err := dao.NewMutation(ctx).AdminUpdateUser(target, target); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot change role, id: [%d]", target.UserID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin change role success, id: [%d], role: [%s]", target.UserID, target.Role)
+	return &pb.AdminChangeRoleResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
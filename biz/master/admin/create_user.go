@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/master/tenant"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+)
+
+func CreateUserHandler(ctx *app.Context, req *pb.AdminCreateUserRequest) (*pb.AdminCreateUserResponse, error) {
+	logger.Logger(ctx).Infof("admin create user, username: [%s], email: [%s], role: [%s]",
+		req.GetUsername(), req.GetEmail(), req.GetRole())
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		username = req.GetUsername()
+		password = req.GetPassword()
+		email    = req.GetEmail()
+		role     = req.GetRole()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.AdminCreateUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if username == "" || password == "" || email == "" {
+		return &pb.AdminCreateUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid username or password or email"},
+		}, nil
+	}
+
+	if role == "" {
+		role = defs.UserRole_Normal
+	}
+	if !validRole(userInfo, role) {
+		return &pb.AdminCreateUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid role"},
+		}, nil
+	}
+
+	if err := tenant.CheckUserQuota(ctx, userInfo.GetTenantID()); err != nil {
+		return &pb.AdminCreateUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	if err := dao.NewQuery(ctx).CheckUserNameAndEmail(username, email); err == nil {
+		return &pb.AdminCreateUserResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_ALREADY_EXISTS, Message: "username or email already exists"},
+		}, nil
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	newUser := &models.UserEntity{
+		UserName: username,
+		Password: hashedPassword,
+		Email:    email,
+		Status:   models.STATUS_NORMAL,
+		Role:     role,
+		TenantID: userInfo.GetTenantID(),
+		Token:    uuid.New().String(),
+	}
+
+	if err := dao.NewMutation(ctx).CreateUser(newUser); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create user, username: [%s]", username)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin create user success, user id: [%d]", newUser.UserID)
+	return &pb.AdminCreateUserResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		User:   newUser.ToSafePB(),
+	}, nil
+}
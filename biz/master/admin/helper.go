@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// getTargetUser 获取被管理的用户，管理员不能操作自己，避免把自己锁在外面
+// 租户管理员只能操作本租户内的非管理员用户
+func getTargetUser(ctx *app.Context, operator models.UserInfo, userID int) (*models.UserEntity, error) {
+	if userID == operator.GetUserID() {
+		return nil, fmt.Errorf("can not operate on yourself")
+	}
+	target, err := dao.NewQuery(ctx).GetUserByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.IsAdmin() && (target.GetTenantID() != operator.GetTenantID() || target.IsAdmin()) {
+		return nil, fmt.Errorf(defs.ErrPermissionDenied)
+	}
+	return target, nil
+}
+
+// validRole 校验角色是否合法，租户管理员不能授予管理员角色
+func validRole(operator models.UserInfo, role string) bool {
+	switch role {
+	case defs.UserRole_Admin:
+		return operator.IsAdmin()
+	case defs.UserRole_TenantAdmin, defs.UserRole_Normal:
+		return true
+	}
+	return false
+}
+
+// kickUser 断开用户全部客户端/服务端与 master 的连接，并清理 frp 登录凭证缓存
+func kickUser(ctx *app.Context, u *models.UserEntity) error {
+	clients, err := dao.NewQuery(ctx).GetAllClients(u)
+	if err != nil {
+		return err
+	}
+	servers, err := dao.NewQuery(ctx).GetAllServers(u)
+	if err != nil {
+		return err
+	}
+
+	cliMgr := ctx.GetApp().GetClientsManager()
+	for _, c := range clients {
+		cliMgr.Kick(c.ClientID)
+	}
+	for _, s := range servers {
+		cliMgr.Kick(s.ServerID)
+	}
+
+	tokens, err := dao.NewQuery(ctx).AdminListUsableClientTokensByUserID(u.GetUserID())
+	if err != nil {
+		return err
+	}
+	client.InvalidateClientTokens(tokens)
+	client.InvalidateFRPTokenOwner(u.GetUserName())
+	cache.Get().Del([]byte(u.GetUserName()))
+
+	logger.Logger(ctx).Infof("kick user success, user: [%d], clients: [%d], servers: [%d]",
+		u.GetUserID(), len(clients), len(servers))
+	return nil
+}
@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListUsersHandler(ctx *app.Context, req *pb.AdminListUsersRequest) (*pb.AdminListUsersResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		keyword  = req.GetKeyword()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.AdminListUsersResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if page < 1 || pageSize < 1 {
+		return &pb.AdminListUsersResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid page or page size"},
+		}, nil
+	}
+
+	users, err := dao.NewQuery(ctx).ListUsers(userInfo, page, pageSize, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountUsers(userInfo, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminListUsersResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Users: lo.Map(users, func(u *models.UserEntity, _ int) *pb.User {
+			return u.ToSafePB()
+		}),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package admin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/biz/master/admin"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	root := apptest.CreateUser(t, ctx, "root", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	tadmin := apptest.CreateUser(t, ctx, "tadmin", func(u *models.UserEntity) {
+		u.Role = defs.UserRole_TenantAdmin
+		u.TenantID = 2
+	})
+	for i := 0; i < 5; i++ {
+		apptest.CreateUser(t, ctx, fmt.Sprintf("user%d", i), func(u *models.UserEntity) { u.TenantID = i % 2 * 2 })
+	}
+
+	resp, err := admin.ListUsersHandler(apptest.WithUser(ctx, root), &pb.AdminListUsersRequest{
+		Page: lo.ToPtr(int32(2)), PageSize: lo.ToPtr(int32(3)),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, resp.GetTotal())
+	assert.Len(t, resp.GetUsers(), 3)
+
+	resp, err = admin.ListUsersHandler(apptest.WithUser(ctx, root), &pb.AdminListUsersRequest{
+		Page: lo.ToPtr(int32(1)), PageSize: lo.ToPtr(int32(10)), Keyword: lo.ToPtr("user"),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, resp.GetTotal())
+
+	// 租户管理员只能看到本租户的用户
+	resp, err = admin.ListUsersHandler(apptest.WithUser(ctx, tadmin), &pb.AdminListUsersRequest{
+		Page: lo.ToPtr(int32(1)), PageSize: lo.ToPtr(int32(10)),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, resp.GetTotal())
+	for _, u := range resp.GetUsers() {
+		assert.EqualValues(t, 2, u.GetTenantID())
+	}
+}
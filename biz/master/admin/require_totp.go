@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// RequireTOTPHandler 要求/取消要求用户开启两步验证，未开启的用户登录后只能访问绑定相关接口
+func RequireTOTPHandler(ctx *app.Context, req *pb.AdminRequireTOTPRequest) (*pb.AdminRequireTOTPResponse, error) {
+	logger.Logger(ctx).Infof("admin require totp, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.AdminRequireTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	target, err := getTargetUser(ctx, userInfo, int(req.GetUserId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get target user, id: [%d]", req.GetUserId())
+		return nil, err
+	}
+
+	target.TOTPRequired = req.GetRequired()
+	if err := dao.NewMutation(ctx).AdminUpdateUser(target, target); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update user totp required, id: [%d]", target.UserID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin require totp success, id: [%d], required: [%v]", target.UserID, req.GetRequired())
+	return &pb.AdminRequireTOTPResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func ResetPasswordHandler(ctx *app.Context, req *pb.AdminResetPasswordRequest) (*pb.AdminResetPasswordResponse, error) {
+	logger.Logger(ctx).Infof("admin reset password, user id: [%d]", req.GetUserId())
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.AdminResetPasswordResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(req.GetPassword()) == 0 {
+		return &pb.AdminResetPasswordResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid password"},
+		}, nil
+	}
+
+	target, err := getTargetUser(ctx, userInfo, int(req.GetUserId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get target user, id: [%d]", req.GetUserId())
+		return nil, err
+	}
+
+	hashedPassword, err := utils.HashPassword(req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	target.Password = hashedPassword
+
+	if err := dao.NewMutation(ctx).AdminUpdateUser(target, target); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot reset password, id: [%d]", target.UserID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin reset password success, id: [%d]", target.UserID)
+	return &pb.AdminResetPasswordResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
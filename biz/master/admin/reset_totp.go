@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// ResetTOTPHandler 清除用户的两步验证密钥和恢复码，用于用户丢失设备时重新绑定
+func ResetTOTPHandler(ctx *app.Context, req *pb.AdminResetTOTPRequest) (*pb.AdminResetTOTPResponse, error) {
+	logger.Logger(ctx).Infof("admin reset totp, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.AdminResetTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	target, err := getTargetUser(ctx, userInfo, int(req.GetUserId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get target user, id: [%d]", req.GetUserId())
+		return nil, err
+	}
+
+	target.TOTPEnabled = false
+	target.TOTPSecret = ""
+	target.TOTPLastCounter = 0
+	target.RecoveryCodes = nil
+	if err := dao.NewMutation(ctx).AdminUpdateUser(target, target); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot reset user totp, id: [%d]", target.UserID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin reset totp success, id: [%d]", target.UserID)
+	return &pb.AdminResetTOTPResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
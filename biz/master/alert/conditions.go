@@ -0,0 +1,300 @@
+package alert
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+const (
+	// defaultHandshakeStaleSecond 未设置阈值时握手超过 3 分钟视为过期，wireguard 正常每 2 分钟重新握手
+	defaultHandshakeStaleSecond = 180
+	// trafficSpikeWindow 流量突增按最近 5 个完整分钟的平均速率计算
+	trafficSpikeWindow = 5 * time.Minute
+	// proxyStatsStaleTimes 隧道统计超过几个上报周期未更新视为隧道已停止
+	proxyStatsStaleTimes = 3
+)
+
+// observation 一次求值中满足告警条件的对象
+type observation struct {
+	name  string
+	value float64
+}
+
+// evalContext 一次求值中各规则共享的数据，按属主缓存避免重复查询
+type evalContext struct {
+	now        time.Time
+	tags       map[int]map[string][]string
+	proxyStats []*models.ProxyStatsEntity
+}
+
+func newEvalContext(now time.Time) *evalContext {
+	return &evalContext{now: now, tags: map[int]map[string][]string{}}
+}
+
+// clientTags 属主名下每个客户端的 wireguard tag
+func (c *evalContext) clientTags(ctx *app.Context, owner models.UserInfo) (map[string][]string, error) {
+	if tags, ok := c.tags[owner.GetUserID()]; ok {
+		return tags, nil
+	}
+	wgs, err := dao.NewQuery(ctx).GetAllWireGuards(owner)
+	if err != nil {
+		return nil, err
+	}
+	tags := map[string][]string{}
+	for _, wg := range wgs {
+		tags[wg.ClientID] = lo.Uniq(append(tags[wg.ClientID], wg.Tags...))
+	}
+	c.tags[owner.GetUserID()] = tags
+	return tags, nil
+}
+
+func (c *evalContext) allProxyStats(ctx *app.Context) ([]*models.ProxyStatsEntity, error) {
+	if c.proxyStats != nil {
+		return c.proxyStats, nil
+	}
+	stats, err := dao.NewQuery(ctx).AdminListProxyStats()
+	if err != nil {
+		return nil, err
+	}
+	c.proxyStats = stats
+	return stats, nil
+}
+
+// selectsClient 判断客户端上的对象是否被规则选中，子客户端同时匹配其原始客户端的 id 与 tag
+func selectsClient(rule *models.AlertRule, tags map[string][]string, clientID, originClientID string, ids ...string) bool {
+	ids = append(ids, clientID)
+	objTags := tags[clientID]
+	if len(originClientID) > 0 {
+		ids = append(ids, originClientID)
+		objTags = slices.Concat(objTags, tags[originClientID])
+	}
+	return rule.Selects(ids, objTags)
+}
+
+type collector func(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error)
+
+var collectors = map[string]collector{
+	models.AlertConditionClientOffline:  collectClientOffline,
+	models.AlertConditionProxyStopped:   collectProxyStopped,
+	models.AlertConditionWireGuardStale: collectWireGuardStale,
+	models.AlertConditionWorkerExited:   collectWorkerExited,
+	models.AlertConditionTrafficSpike:   collectTrafficSpike,
+}
+
+// collectClientOffline 连接过 master 但当前不在线的客户端，以及已配置但不在线的服务端，值为离线秒数
+func collectClientOffline(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error) {
+	q := dao.NewQuery(ctx)
+	mgr := ctx.GetApp().GetClientsManager()
+	tags, err := c.clientTags(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]observation{}
+	clients, err := q.GetAllClients(owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, cli := range clients {
+		if cli.Ephemeral || cli.LastSeenAt == nil || (len(cli.OriginClientID) > 0 && !cli.IsShadow) {
+			continue
+		}
+		if !selectsClient(rule, tags, cli.ClientID, "") || mgr.Get(cli.ClientID) != nil {
+			continue
+		}
+		result["client:"+cli.ClientID] = observation{
+			name:  fmt.Sprintf("client [%s]", cli.ClientID),
+			value: c.now.Sub(*cli.LastSeenAt).Seconds(),
+		}
+	}
+
+	servers, err := q.GetAllServers(owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, srv := range servers {
+		if len(srv.ConfigContent) == 0 || !rule.Selects([]string{srv.ServerID}, nil) || mgr.Get(srv.ServerID) != nil {
+			continue
+		}
+		result["server:"+srv.ServerID] = observation{name: fmt.Sprintf("server [%s]", srv.ServerID)}
+	}
+	return result, nil
+}
+
+// collectProxyStopped 未手动停止但服务端上不在线的隧道，统计长时间未更新也视为不在线
+func collectProxyStopped(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error) {
+	tags, err := c.clientTags(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	proxyCfgs, err := dao.NewQuery(ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{
+		UserID:   owner.GetUserID(),
+		TenantID: owner.GetTenantID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats, err := c.allProxyStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statsMap := lo.SliceToMap(stats, func(s *models.ProxyStatsEntity) (string, *models.ProxyStatsEntity) {
+		return s.ClientID + "/" + s.Name, s
+	})
+
+	staleBefore := c.now.Add(-proxyStatsStaleTimes * defs.PushProxyInfoDuration)
+	result := map[string]observation{}
+	for _, p := range proxyCfgs {
+		if p.Stopped {
+			continue
+		}
+		proxyID := strconv.FormatUint(uint64(p.ID), 10)
+		if !selectsClient(rule, tags, p.ClientID, p.OriginClientID, proxyID, p.ServerID) {
+			continue
+		}
+		s, ok := statsMap[p.ClientID+"/"+p.Name]
+		if ok && s.Online && s.UpdatedAt.After(staleBefore) {
+			continue
+		}
+		result["proxy:"+proxyID] = observation{name: fmt.Sprintf("proxy [%s] of client [%s]", p.Name, p.ClientID)}
+	}
+	return result, nil
+}
+
+// collectWireGuardStale 最近一次握手超过阈值秒数的 wireguard 对端，从未握手的对端不计入，值为距上次握手的秒数
+func collectWireGuardStale(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error) {
+	wgs, err := dao.NewQuery(ctx).GetAllWireGuards(owner)
+	if err != nil {
+		return nil, err
+	}
+	threshold := rule.Threshold
+	if threshold <= 0 {
+		threshold = defaultHandshakeStaleSecond
+	}
+
+	runtimeInfos := ctx.GetApp().GetNetworkTopologyCache().ListRuntimeInfo()
+	result := map[string]observation{}
+	for _, wg := range wgs {
+		if !rule.Selects([]string{strconv.FormatUint(uint64(wg.ID), 10), wg.ClientID}, wg.Tags) {
+			continue
+		}
+		info, ok := runtimeInfos[wg.ID]
+		if !ok {
+			continue
+		}
+		for _, peer := range info.GetPeers() {
+			if peer.GetLastHandshakeTimeSec() == 0 {
+				continue
+			}
+			age := c.now.Sub(time.Unix(int64(peer.GetLastHandshakeTimeSec()), 0)).Seconds()
+			if age < threshold {
+				continue
+			}
+			peerName := lo.CoalesceOrEmpty(peer.GetClientId(), peer.GetPublicKey())
+			result[fmt.Sprintf("wireguard:%d:%s", wg.ID, peer.GetPublicKey())] = observation{
+				name:  fmt.Sprintf("wireguard [%s] of client [%s], peer [%s]", wg.Name, wg.ClientID, peerName),
+				value: age,
+			}
+		}
+	}
+	return result, nil
+}
+
+// collectWorkerExited 部署在在线客户端上但未处于运行状态的 worker，离线客户端由 client_offline 覆盖
+func collectWorkerExited(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error) {
+	tags, err := c.clientTags(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	workers, err := dao.NewQuery(ctx).GetAllWorkers(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr := ctx.GetApp().GetClientsManager()
+	result := map[string]observation{}
+	for _, w := range workers {
+		for _, cli := range w.Clients {
+			if !selectsClient(rule, tags, cli.ClientID, cli.OriginClientID, w.ID) || mgr.Get(cli.ClientID) == nil {
+				continue
+			}
+			resp := &pb.GetWorkerStatusResponse{}
+			if err := rpc.CallClientWrapper(ctx, cli.ClientID, pb.Event_EVENT_GET_WORKER_STATUS,
+				&pb.GetWorkerStatusRequest{WorkerId: lo.ToPtr(w.ID)}, resp); err != nil {
+				logger.Logger(ctx).WithError(err).Warnf("cannot get worker status, worker: [%s], client: [%s]", w.ID, cli.ClientID)
+				continue
+			}
+			if resp.GetWorkerStatus()[cli.ClientID] == string(defs.WorkerStatus_Running) {
+				continue
+			}
+			result[fmt.Sprintf("worker:%s:%s", w.ID, cli.ClientID)] = observation{
+				name: fmt.Sprintf("worker [%s] on client [%s]", w.Name, cli.ClientID),
+			}
+		}
+	}
+	return result, nil
+}
+
+// collectTrafficSpike 最近窗口内平均速率超过阈值的隧道，值为 bytes/s
+func collectTrafficSpike(ctx *app.Context, c *evalContext, owner models.UserInfo, rule *models.AlertRule) (map[string]observation, error) {
+	tags, err := c.clientTags(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	q := dao.NewQuery(ctx)
+	end := c.now.Truncate(time.Minute)
+	points, err := q.ListTrafficPoints(owner, dao.TrafficPointFilter{
+		UserID: owner.GetUserID(),
+		Start:  end.Add(-trafficSpikeWindow),
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return map[string]observation{}, nil
+	}
+
+	proxyCfgs, err := q.AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{
+		UserID:   owner.GetUserID(),
+		TenantID: owner.GetTenantID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	proxyCfgMap := lo.SliceToMap(proxyCfgs, func(p *models.ProxyConfig) (string, *models.ProxyConfig) {
+		return p.ClientID + "/" + p.Name, p
+	})
+
+	bytes := map[*models.ProxyConfig]int64{}
+	for _, point := range points {
+		if p, ok := proxyCfgMap[point.ClientID+"/"+point.ProxyName]; ok {
+			bytes[p] += point.TrafficIn + point.TrafficOut
+		}
+	}
+
+	result := map[string]observation{}
+	for p, total := range bytes {
+		rate := float64(total) / trafficSpikeWindow.Seconds()
+		proxyID := strconv.FormatUint(uint64(p.ID), 10)
+		if rate < rule.Threshold || !selectsClient(rule, tags, p.ClientID, p.OriginClientID, proxyID, p.ServerID) {
+			continue
+		}
+		result["proxy:"+proxyID] = observation{
+			name:  fmt.Sprintf("proxy [%s] of client [%s]", p.Name, p.ClientID),
+			value: rate,
+		}
+	}
+	return result, nil
+}
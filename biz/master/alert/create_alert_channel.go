@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CreateAlertChannelHandler 创建通知渠道，保存前校验渠道配置
+func CreateAlertChannelHandler(ctx *app.Context, req *pb.CreateAlertChannelRequest) (*pb.CreateAlertChannelResponse, error) {
+	logger.Logger(ctx).Infof("create alert channel, name: [%s], type: [%s]", req.GetChannel().GetName(), req.GetChannel().GetType())
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.CreateAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	entity := &models.AlertChannelEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}
+	if err := channelFromPB(req.GetChannel(), entity); err != nil {
+		return &pb.CreateAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	ch, err := dao.NewMutation(ctx).AdminCreateAlertChannel(entity)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create alert channel, name: [%s]", entity.Name)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("create alert channel success, id: [%d]", ch.ID)
+	return &pb.CreateAlertChannelResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Channel: ch.ToPB(),
+	}, nil
+}
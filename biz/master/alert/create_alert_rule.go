@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CreateAlertRuleHandler 创建告警规则，规则只作用于创建者自己的资源
+func CreateAlertRuleHandler(ctx *app.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	logger.Logger(ctx).Infof("create alert rule, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.CreateAlertRuleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	entity := &models.AlertRuleEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}
+	if err := ruleFromPB(ctx, userInfo, req.GetRule(), entity); err != nil {
+		return &pb.CreateAlertRuleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	rule, err := dao.NewMutation(ctx).AdminCreateAlertRule(entity)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create alert rule, name: [%s]", entity.Name)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("create alert rule success, id: [%d]", rule.ID)
+	return &pb.CreateAlertRuleResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Rule:   rule.ToPB(),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DeleteAlertChannelHandler 删除通知渠道，引用它的规则在发送时会跳过该渠道
+func DeleteAlertChannelHandler(ctx *app.Context, req *pb.DeleteAlertChannelRequest) (*pb.DeleteAlertChannelResponse, error) {
+	logger.Logger(ctx).Infof("delete alert channel, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.DeleteAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	ch, err := dao.NewQuery(ctx).GetAlertChannel(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get alert channel, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	if err := dao.NewMutation(ctx).AdminDeleteAlertChannel(ch.ID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete alert channel, id: [%d]", ch.ID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("delete alert channel success, id: [%d]", ch.ID)
+	return &pb.DeleteAlertChannelResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
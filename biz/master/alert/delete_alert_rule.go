@@ -0,0 +1,37 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DeleteAlertRuleHandler 删除告警规则及其事件，未恢复的告警不再发送恢复通知
+func DeleteAlertRuleHandler(ctx *app.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	logger.Logger(ctx).Infof("delete alert rule, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.DeleteAlertRuleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	rule, err := dao.NewQuery(ctx).GetAlertRule(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get alert rule, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	if err := dao.NewMutation(ctx).AdminDeleteAlertRule(rule.ID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete alert rule, id: [%d]", rule.ID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("delete alert rule success, id: [%d]", rule.ID)
+	return &pb.DeleteAlertRuleResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+
+	alertsvc "github.com/VaalaCat/frp-panel/services/alert"
+)
+
+var conditions = []string{
+	models.AlertConditionClientOffline,
+	models.AlertConditionProxyStopped,
+	models.AlertConditionWireGuardStale,
+	models.AlertConditionWorkerExited,
+	models.AlertConditionTrafficSpike,
+}
+
+// ruleFromPB 校验请求中的规则并填充到 entity，通知渠道必须是当前用户可见的渠道
+func ruleFromPB(ctx *app.Context, userInfo models.UserInfo, r *pb.AlertRule, entity *models.AlertRuleEntity) error {
+	if len(r.GetName()) == 0 {
+		return fmt.Errorf("rule name is required")
+	}
+	if !slices.Contains(conditions, r.GetCondition()) {
+		return fmt.Errorf("invalid condition")
+	}
+	if r.GetDuration() < 0 || r.GetRepeatInterval() < 0 || r.GetThreshold() < 0 {
+		return fmt.Errorf("duration, repeat interval and threshold should not be negative")
+	}
+	if r.GetCondition() == models.AlertConditionTrafficSpike && r.GetThreshold() == 0 {
+		return fmt.Errorf("threshold is required for traffic spike")
+	}
+
+	channelIDs := lo.Uniq(lo.Map(r.GetChannelIds(), func(id uint32, _ int) uint { return uint(id) }))
+	for _, id := range channelIDs {
+		if _, err := dao.NewQuery(ctx).GetAlertChannel(userInfo, id); err != nil {
+			return fmt.Errorf("invalid channel id [%d]", id)
+		}
+	}
+
+	entity.Name = r.GetName()
+	entity.Condition = r.GetCondition()
+	entity.Threshold = r.GetThreshold()
+	entity.Duration = int(r.GetDuration())
+	entity.TargetIDs = lo.Uniq(r.GetTargetIds())
+	entity.TargetTags = lo.Uniq(r.GetTargetTags())
+	entity.ChannelIDs = channelIDs
+	entity.RepeatInterval = int(r.GetRepeatInterval())
+	entity.Enabled = r.GetEnabled()
+	return nil
+}
+
+// channelFromPB 校验渠道配置能否创建通知器并填充到 entity
+func channelFromPB(c *pb.AlertChannel, entity *models.AlertChannelEntity) error {
+	if len(c.GetName()) == 0 {
+		return fmt.Errorf("channel name is required")
+	}
+	if _, err := alertsvc.NewNotifier(c.GetType(), []byte(c.GetConfig())); err != nil {
+		return err
+	}
+	entity.Name = c.GetName()
+	entity.Type = c.GetType()
+	entity.Config = []byte(c.GetConfig())
+	return nil
+}
+
+func newNotification(rule *models.AlertRule, event *models.AlertEvent) *alertsvc.Notification {
+	return &alertsvc.Notification{
+		RuleID:     rule.ID,
+		RuleName:   rule.Name,
+		Condition:  rule.Condition,
+		Status:     event.Status,
+		ObjectKey:  event.ObjectKey,
+		ObjectName: event.ObjectName,
+		Value:      event.Value,
+		Threshold:  rule.Threshold,
+		StartedAt:  event.StartedAt,
+		ResolvedAt: event.ResolvedAt,
+	}
+}
+
+// notify 向规则的全部渠道发送通知，单个渠道失败不影响其他渠道
+func notify(ctx *app.Context, rule *models.AlertRule, n *alertsvc.Notification) {
+	channels, err := dao.NewQuery(ctx).AdminListAlertChannelsByIDs(rule.ChannelIDs)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list alert channels, rule: [%d]", rule.ID)
+		return
+	}
+	for _, ch := range channels {
+		if ch.TenantID != rule.TenantID {
+			continue
+		}
+		if err := send(ctx, ch, n); err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot send alert notification, rule: [%d], channel: [%d]", rule.ID, ch.ID)
+		}
+	}
+}
+
+func send(ctx context.Context, ch *models.AlertChannel, n *alertsvc.Notification) error {
+	notifier, err := alertsvc.NewNotifier(ch.Type, ch.Config)
+	if err != nil {
+		return err
+	}
+	return notifier.Notify(ctx, n)
+}
@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListAlertChannelsHandler(ctx *app.Context, req *pb.ListAlertChannelsRequest) (*pb.ListAlertChannelsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListAlertChannelsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	channels, err := dao.NewQuery(ctx).ListAlertChannels(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list alert channels")
+		return nil, err
+	}
+
+	return &pb.ListAlertChannelsResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Channels: lo.Map(channels, func(c *models.AlertChannel, _ int) *pb.AlertChannel { return c.ToPB() }),
+	}, nil
+}
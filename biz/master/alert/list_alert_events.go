@@ -0,0 +1,47 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListAlertEventsHandler 分页查询告警事件，按时间倒序
+func ListAlertEventsHandler(ctx *app.Context, req *pb.ListAlertEventsRequest) (*pb.ListAlertEventsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListAlertEventsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	page, pageSize := int(req.GetPage()), int(req.GetPageSize())
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	q := dao.NewQuery(ctx)
+	events, err := q.ListAlertEvents(userInfo, uint(req.GetRuleId()), req.GetStatus(), page, pageSize)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list alert events")
+		return nil, err
+	}
+	total, err := q.CountAlertEvents(userInfo, uint(req.GetRuleId()), req.GetStatus())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count alert events")
+		return nil, err
+	}
+
+	return &pb.ListAlertEventsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Events: lo.Map(events, func(e *models.AlertEvent, _ int) *pb.AlertEvent { return e.ToPB() }),
+	}, nil
+}
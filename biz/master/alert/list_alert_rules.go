@@ -0,0 +1,31 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListAlertRulesHandler(ctx *app.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListAlertRulesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	rules, err := dao.NewQuery(ctx).ListAlertRules(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list alert rules")
+		return nil, err
+	}
+
+	return &pb.ListAlertRulesResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Rules:  lo.Map(rules, func(r *models.AlertRule, _ int) *pb.AlertRule { return r.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// evaluating 求值可能因 rpc 调用超过任务间隔，避免上一轮未结束时重复执行
+var evaluating sync.Mutex
+
+// EvaluateAlertRules 对全部启用的规则求值，维护告警事件的 pending/firing/resolved 状态并发送通知
+func EvaluateAlertRules(appInstance app.Application) error {
+	if !evaluating.TryLock() {
+		return nil
+	}
+	defer evaluating.Unlock()
+
+	ctx := app.NewContext(context.Background(), appInstance)
+	rules, err := dao.NewQuery(ctx).AdminListEnabledAlertRules()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("EvaluateAlertRules cannot list rules")
+		return err
+	}
+
+	evalCtx := newEvalContext(time.Now())
+	for _, rule := range rules {
+		if err := evaluateRule(ctx, evalCtx, rule); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("EvaluateAlertRules cannot evaluate rule, id: [%d]", rule.ID)
+		}
+	}
+	return nil
+}
+
+func evaluateRule(ctx *app.Context, c *evalContext, rule *models.AlertRule) error {
+	collect, ok := collectors[rule.Condition]
+	if !ok {
+		return nil
+	}
+	owner, err := dao.NewQuery(ctx).GetUserByUserID(rule.UserID)
+	if err != nil {
+		return err
+	}
+	// rpc 调用等流程依赖当前用户，以规则属主身份执行
+	ownerCtx := app.NewContext(common.WithUserInfo(context.Background(), owner), ctx.GetApp())
+	observations, err := collect(ownerCtx, c, owner, rule)
+	if err != nil {
+		return err
+	}
+
+	openEvents, err := dao.NewQuery(ctx).AdminListOpenAlertEvents(rule.ID)
+	if err != nil {
+		return err
+	}
+	return reconcile(ctx, c.now, rule, openEvents, observations)
+}
+
+// reconcile 把本次观测结果与未恢复的事件对齐
+// 新出现的对象记为 pending，持续 Duration 秒后转为 firing 并通知，之后按 RepeatInterval 重复通知
+// 条件消失时 pending 事件直接删除，firing 事件转为 resolved 并发送恢复通知
+func reconcile(ctx *app.Context, now time.Time, rule *models.AlertRule, openEvents []*models.AlertEvent, observations map[string]observation) error {
+	m := dao.NewMutation(ctx)
+	duration := time.Duration(rule.Duration) * time.Second
+	repeat := time.Duration(rule.RepeatInterval) * time.Second
+
+	seen := map[string]bool{}
+	for _, event := range openEvents {
+		obs, ok := observations[event.ObjectKey]
+		if !ok || seen[event.ObjectKey] {
+			if event.Status == models.AlertEventStatusPending {
+				if err := m.AdminDeleteAlertEvent(event.ID); err != nil {
+					return err
+				}
+				continue
+			}
+			event.Status = models.AlertEventStatusResolved
+			event.ResolvedAt = now
+			if err := m.AdminUpdateAlertEvent(event); err != nil {
+				return err
+			}
+			logger.Logger(ctx).Infof("alert resolved, rule: [%d], object: [%s]", rule.ID, event.ObjectKey)
+			notify(ctx, rule, newNotification(rule, event))
+			continue
+		}
+		seen[event.ObjectKey] = true
+		event.Value = obs.value
+
+		shouldNotify := false
+		switch {
+		case event.Status == models.AlertEventStatusPending && now.Sub(event.StartedAt) >= duration:
+			event.Status = models.AlertEventStatusFiring
+			event.FiredAt = now
+			shouldNotify = true
+		case event.Status == models.AlertEventStatusFiring && repeat > 0 && now.Sub(event.LastNotifiedAt) >= repeat:
+			shouldNotify = true
+		}
+		if shouldNotify {
+			event.LastNotifiedAt = now
+		}
+		if err := m.AdminUpdateAlertEvent(event); err != nil {
+			return err
+		}
+		if shouldNotify {
+			logger.Logger(ctx).Infof("alert firing, rule: [%d], object: [%s]", rule.ID, event.ObjectKey)
+			notify(ctx, rule, newNotification(rule, event))
+		}
+	}
+
+	for key, obs := range observations {
+		if seen[key] {
+			continue
+		}
+		entity := &models.AlertEventEntity{
+			RuleID:     rule.ID,
+			UserID:     rule.UserID,
+			TenantID:   rule.TenantID,
+			ObjectKey:  key,
+			ObjectName: obs.name,
+			Status:     models.AlertEventStatusPending,
+			Value:      obs.value,
+			StartedAt:  now,
+		}
+		if duration == 0 {
+			entity.Status = models.AlertEventStatusFiring
+			entity.FiredAt = now
+			entity.LastNotifiedAt = now
+		}
+		event, err := m.AdminCreateAlertEvent(entity)
+		if err != nil {
+			return err
+		}
+		if event.Status == models.AlertEventStatusFiring {
+			logger.Logger(ctx).Infof("alert firing, rule: [%d], object: [%s]", rule.ID, event.ObjectKey)
+			notify(ctx, rule, newNotification(rule, event))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+
+	alertsvc "github.com/VaalaCat/frp-panel/services/alert"
+)
+
+// TestAlertChannelHandler 向渠道发送一条测试通知，发送失败时把错误返回给前端
+func TestAlertChannelHandler(ctx *app.Context, req *pb.TestAlertChannelRequest) (*pb.TestAlertChannelResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.TestAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	ch, err := dao.NewQuery(ctx).GetAlertChannel(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get alert channel, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	n := &alertsvc.Notification{
+		RuleName:   "test notification",
+		Status:     models.AlertEventStatusFiring,
+		ObjectKey:  "test",
+		ObjectName: ch.Name,
+		StartedAt:  time.Now(),
+	}
+	if err := send(ctx, ch, n); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("test alert channel failed, id: [%d]", ch.ID)
+		return &pb.TestAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	return &pb.TestAlertChannelResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
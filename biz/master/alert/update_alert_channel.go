@@ -0,0 +1,43 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func UpdateAlertChannelHandler(ctx *app.Context, req *pb.UpdateAlertChannelRequest) (*pb.UpdateAlertChannelResponse, error) {
+	logger.Logger(ctx).Infof("update alert channel, id: [%d]", req.GetChannel().GetId())
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.UpdateAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	ch, err := dao.NewQuery(ctx).GetAlertChannel(userInfo, uint(req.GetChannel().GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get alert channel, id: [%d]", req.GetChannel().GetId())
+		return nil, err
+	}
+
+	if err := channelFromPB(req.GetChannel(), ch.AlertChannelEntity); err != nil {
+		return &pb.UpdateAlertChannelResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateAlertChannel(ch); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update alert channel, id: [%d]", ch.ID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("update alert channel success, id: [%d]", ch.ID)
+	return &pb.UpdateAlertChannelResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Channel: ch.ToPB(),
+	}, nil
+}
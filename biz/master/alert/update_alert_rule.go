@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// UpdateAlertRuleHandler 修改告警规则，已有的事件在下一次求值时按新规则继续处理
+func UpdateAlertRuleHandler(ctx *app.Context, req *pb.UpdateAlertRuleRequest) (*pb.UpdateAlertRuleResponse, error) {
+	logger.Logger(ctx).Infof("update alert rule, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.UpdateAlertRuleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	rule, err := dao.NewQuery(ctx).GetAlertRule(userInfo, uint(req.GetRule().GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get alert rule, id: [%d]", req.GetRule().GetId())
+		return nil, err
+	}
+
+	if err := ruleFromPB(ctx, userInfo, req.GetRule(), rule.AlertRuleEntity); err != nil {
+		return &pb.UpdateAlertRuleResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateAlertRule(rule); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update alert rule, id: [%d]", rule.ID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("update alert rule success, id: [%d]", rule.ID)
+	return &pb.UpdateAlertRuleResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Rule:   rule.ToPB(),
+	}, nil
+}
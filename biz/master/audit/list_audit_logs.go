@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListAuditLogsHandler 分页查询审计日志，管理员可见全部，租户管理员可见本租户，普通用户只能看到自己的
+func ListAuditLogsHandler(ctx *app.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	logger.Logger(ctx).Infof("list audit logs, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListAuditLogsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	filter := dao.AuditLogFilter{
+		UserID:     int(req.GetUserId()),
+		Kind:       req.GetKind(),
+		Route:      req.GetRoute(),
+		TargetID:   req.GetTargetId(),
+		FailedOnly: req.GetFailedOnly(),
+	}
+	if req.GetStartTime() > 0 {
+		filter.StartTime = time.UnixMilli(req.GetStartTime())
+	}
+	if req.GetEndTime() > 0 {
+		filter.EndTime = time.UnixMilli(req.GetEndTime())
+	}
+
+	logs, err := dao.NewQuery(ctx).ListAuditLogs(userInfo, int(req.GetPage()), int(req.GetPageSize()), filter)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list audit logs")
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountAuditLogs(userInfo, filter)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count audit logs")
+		return nil, err
+	}
+
+	return &pb.ListAuditLogsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Logs:   lo.Map(logs, func(l *models.AuditLog, _ int) *pb.AuditLog { return l.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CleanExpiredAuditLogs 清理超过保留天数的审计日志
+func CleanExpiredAuditLogs(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	days := appInstance.GetConfig().Master.AuditRetentionDays
+	if days <= 0 {
+		return nil
+	}
+
+	deleted, err := dao.NewMutation(ctx).AdminDeleteAuditLogsBefore(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanExpiredAuditLogs cannot delete audit logs")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanExpiredAuditLogs success, deleted: [%d]", deleted)
+	return nil
+}
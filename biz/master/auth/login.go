@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/VaalaCat/frp-panel/biz/master/mfa"
 	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/middleware"
@@ -9,6 +10,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
 )
 
 func LoginHandler(ctx *app.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
@@ -48,6 +50,33 @@ func LoginHandler(ctx *app.Context, req *pb.LoginRequest) (*pb.LoginResponse, er
 
 	tokenStr := conf.GetJWTWithAllPermission(ctx.GetApp().GetConfig(), user.GetUserID())
 
+	// 开启两步验证的用户需要在密码正确后再提交验证码
+	if user.IsTOTPEnabled() {
+		if len(req.GetTotpCode()) == 0 {
+			return &pb.LoginResponse{
+				Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "totp code required"},
+				TotpRequired: lo.ToPtr(true),
+			}, nil
+		}
+
+		u, err := dao.NewQuery(ctx).GetUserByUserID(user.GetUserID())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := mfa.VerifyCode(ctx, u, req.GetTotpCode()); err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("login verify totp failed, user: [%d]", u.UserID)
+			return &pb.LoginResponse{
+				Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+				TotpRequired: lo.ToPtr(true),
+			}, nil
+		}
+
+		if tokenStr, err = mfa.SignMFAToken(ctx.GetApp().GetConfig(), u.UserID); err != nil {
+			return nil, err
+		}
+	}
+
 	ginCtx := ctx.GetGinCtx()
 	middleware.PushTokenStr(ginCtx, ctx.GetApp(), tokenStr)
 
@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/VaalaCat/frp-panel/biz/master/mfa"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/middleware"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/sso"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+)
+
+const oidcCookieAge = 300
+
+var (
+	oidcProviderMu sync.Mutex
+	oidcProvider   *sso.OIDCProvider
+)
+
+// getOIDCProvider 首次使用时做 discovery，失败不缓存，下次登录时重试
+func getOIDCProvider(ctx context.Context, cfg conf.Config) (*sso.OIDCProvider, error) {
+	oidcProviderMu.Lock()
+	defer oidcProviderMu.Unlock()
+
+	if oidcProvider != nil {
+		return oidcProvider, nil
+	}
+
+	p, err := sso.NewOIDCProvider(ctx, sso.NewOIDCConfig(cfg), nil)
+	if err != nil {
+		return nil, err
+	}
+	oidcProvider = p
+	return p, nil
+}
+
+// OIDCLoginHandler 跳转到 oidc provider 登录，state 与 nonce 暂存在 cookie 中
+func OIDCLoginHandler(appInstance app.Application) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		startOIDCFlow(c, appInstance, 0)
+	}
+}
+
+// OIDCLinkHandler 已登录用户主动绑定 oidc 账号，state 对应的用户记录在服务端，回调时据此绑定
+func OIDCLinkHandler(appInstance app.Application) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		userInfo := common.GetUserInfo(c)
+		if userInfo == nil || !userInfo.Valid() {
+			c.JSON(http.StatusUnauthorized, &Response{Msg: "invalid user"})
+			return
+		}
+		startOIDCFlow(c, appInstance, userInfo.GetUserID())
+	}
+}
+
+func startOIDCFlow(c *gin.Context, appInstance app.Application, linkUserID int) {
+	cfg := appInstance.GetConfig()
+	if !cfg.App.OIDC.Enable {
+		c.JSON(http.StatusNotFound, &Response{Msg: "oidc login is disabled"})
+		return
+	}
+
+	provider, err := getOIDCProvider(c.Request.Context(), cfg)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot init oidc provider")
+		oidcLoginFailed(c, "oidc provider unavailable")
+		return
+	}
+
+	state, nonce := uuid.New().String(), uuid.New().String()
+	if linkUserID > 0 {
+		if err := cache.Get().Set(oidcLinkCacheKey(state), []byte(cast.ToString(linkUserID)), oidcCookieAge); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot save oidc link state")
+			oidcLoginFailed(c, "oidc link failed")
+			return
+		}
+	}
+	setOIDCCookie(c, cfg, defs.OIDCStateCookie, state, oidcCookieAge)
+	setOIDCCookie(c, cfg, defs.OIDCNonceCookie, nonce, oidcCookieAge)
+
+	c.Redirect(http.StatusFound, provider.AuthCodeURL(state, nonce))
+}
+
+func oidcLinkCacheKey(state string) []byte {
+	return []byte("oidc-link:" + state)
+}
+
+// popOIDCLinkUser 取出 state 对应的待绑定用户，只能使用一次
+func popOIDCLinkUser(state string) int {
+	key := oidcLinkCacheKey(state)
+	val, err := cache.Get().Get(key)
+	if err != nil {
+		return 0
+	}
+	cache.Get().Del(key)
+	return cast.ToInt(string(val))
+}
+
+// OIDCCallbackHandler 校验 state 与 id token，登录成功后下发和密码登录相同的 jwt cookie
+func OIDCCallbackHandler(appInstance app.Application) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		cfg := appInstance.GetConfig()
+		if !cfg.App.OIDC.Enable {
+			c.JSON(http.StatusNotFound, &Response{Msg: "oidc login is disabled"})
+			return
+		}
+
+		state, _ := c.Cookie(defs.OIDCStateCookie)
+		nonce, _ := c.Cookie(defs.OIDCNonceCookie)
+		setOIDCCookie(c, cfg, defs.OIDCStateCookie, "", -1)
+		setOIDCCookie(c, cfg, defs.OIDCNonceCookie, "", -1)
+
+		if errMsg := c.Query("error"); len(errMsg) > 0 {
+			logger.Logger(c).Errorf("oidc provider returned error: [%s], desc: [%s]", errMsg, c.Query("error_description"))
+			oidcLoginFailed(c, errMsg)
+			return
+		}
+
+		if len(state) == 0 || len(nonce) == 0 || c.Query("state") != state {
+			logger.Logger(c).Errorf("oidc callback state mismatch")
+			oidcLoginFailed(c, "invalid state")
+			return
+		}
+
+		provider, err := getOIDCProvider(c.Request.Context(), cfg)
+		if err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot init oidc provider")
+			oidcLoginFailed(c, "oidc provider unavailable")
+			return
+		}
+
+		ident, err := provider.Exchange(c.Request.Context(), c.Query("code"), nonce)
+		if err != nil {
+			logger.Logger(c).WithError(err).Errorf("oidc exchange failed")
+			oidcLoginFailed(c, "oidc login failed")
+			return
+		}
+
+		appCtx := app.NewContext(c, appInstance)
+		if linkUserID := popOIDCLinkUser(state); linkUserID > 0 {
+			if _, err := linkOIDCUser(appCtx, linkUserID, ident); err != nil {
+				logger.Logger(c).WithError(err).Errorf("cannot link oidc user, user: [%d], sub: [%s]", linkUserID, ident.Subject)
+				oidcLoginFailed(c, "oidc link failed")
+				return
+			}
+			c.Redirect(http.StatusFound, "/")
+			return
+		}
+
+		user, err := provisionOIDCUser(appCtx, ident)
+		if err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot provision oidc user, sub: [%s]", ident.Subject)
+			oidcLoginFailed(c, "user not allowed")
+			return
+		}
+
+		if !user.Valid() {
+			logger.Logger(c).Errorf("oidc user is banned, id: [%d]", user.GetUserID())
+			oidcLoginFailed(c, "user not allowed")
+			return
+		}
+
+		if len(cfg.App.OIDC.GroupsClaim) > 0 {
+			if err := syncOIDCGroups(appCtx, user, ident.Groups); err != nil {
+				logger.Logger(c).WithError(err).Errorf("cannot sync oidc groups, user: [%d]", user.GetUserID())
+			}
+		}
+
+		// 开启两步验证的用户和密码登录一样需要再提交验证码，验证前不下发 token
+		if user.IsTOTPEnabled() {
+			challenge := uuid.New().String()
+			if err := cache.Get().Set(oidcMFACacheKey(challenge), []byte(user.GetUserIDStr()), oidcCookieAge); err != nil {
+				logger.Logger(c).WithError(err).Errorf("cannot save oidc mfa challenge")
+				oidcLoginFailed(c, "oidc login failed")
+				return
+			}
+			setOIDCCookie(c, cfg, defs.OIDCMFACookie, challenge, oidcCookieAge)
+			logger.Logger(c).Infof("oidc login need totp, user: [%d], sub: [%s]", user.GetUserID(), ident.Subject)
+			c.Redirect(http.StatusFound, "/login?totp_required=true")
+			return
+		}
+
+		tokenStr := conf.GetJWTWithAllPermission(cfg, user.GetUserID())
+		middleware.PushTokenStr(c, appInstance, tokenStr)
+
+		logger.Logger(c).Infof("oidc login success, user: [%d], sub: [%s]", user.GetUserID(), ident.Subject)
+		c.Redirect(http.StatusFound, "/")
+	}
+}
+
+func oidcMFACacheKey(challenge string) []byte {
+	return []byte("oidc-mfa:" + challenge)
+}
+
+// OIDCVerifyTOTPHandler 单点登录后提交两步验证码，通过后下发带两步验证时间的 token
+func OIDCVerifyTOTPHandler(ctx *app.Context, req *pb.VerifyTOTPRequest) (*pb.VerifyTOTPResponse, error) {
+	ginCtx := ctx.GetGinCtx()
+	challenge, _ := ginCtx.Cookie(defs.OIDCMFACookie)
+	if len(challenge) == 0 {
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "oidc login expired"},
+		}, nil
+	}
+
+	key := oidcMFACacheKey(challenge)
+	val, err := cache.Get().Get(key)
+	if err != nil {
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "oidc login expired"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(cast.ToInt(string(val)))
+	if err != nil {
+		return nil, err
+	}
+	if !u.Valid() {
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if err := mfa.VerifyCode(ctx, u, req.GetCode()); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("oidc login verify totp failed, user: [%d]", u.UserID)
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	cache.Get().Del(key)
+	setOIDCCookie(ginCtx, ctx.GetApp().GetConfig(), defs.OIDCMFACookie, "", -1)
+
+	tokenStr, err := mfa.SignMFAToken(ctx.GetApp().GetConfig(), u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	middleware.PushTokenStr(ginCtx, ctx.GetApp(), tokenStr)
+
+	logger.Logger(ctx).Infof("oidc login success, user: [%d]", u.UserID)
+	return &pb.VerifyTOTPResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Token:  &tokenStr,
+	}, nil
+}
+
+func setOIDCCookie(c *gin.Context, cfg conf.Config, name, value string, maxAge int) {
+	c.SetCookie(name, value, maxAge, defs.OIDCCookiePath, cfg.App.CookieDomain, cfg.App.CookieSecure, true)
+}
+
+func oidcLoginFailed(c *gin.Context, msg string) {
+	c.Redirect(http.StatusFound, "/login?error="+url.QueryEscape(msg))
+}
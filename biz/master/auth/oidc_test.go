@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func oidcVerify(t *testing.T, base *app.Context, challenge, code string) (*pb.VerifyTOTPResponse, *httptest.ResponseRecorder) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("POST", "/api/v1/auth/oidc/verify", nil)
+	c.Request.RemoteAddr = "10.0.9.1:1234"
+	if len(challenge) > 0 {
+		c.Request.AddCookie(&http.Cookie{Name: defs.OIDCMFACookie, Value: challenge})
+	}
+	resp, err := OIDCVerifyTOTPHandler(app.NewContext(c, base.GetApp()), &pb.VerifyTOTPRequest{Code: lo.ToPtr(code)})
+	require.NoError(t, err)
+	return resp, w
+}
+
+func TestOIDCVerifyTOTP(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	secret, err := utils.GenerateTOTPSecret()
+	require.NoError(t, err)
+	alice := apptest.CreateUser(t, ctx, "alice", func(u *models.UserEntity) {
+		u.TOTPEnabled = true
+		u.TOTPSecret = secret
+	})
+	code, err := utils.TOTPCode(secret, time.Now().Unix()/utils.TOTPPeriod)
+	require.NoError(t, err)
+
+	resp, _ := oidcVerify(t, ctx, "", code)
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+	resp, _ = oidcVerify(t, ctx, "unknown", code)
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+
+	challenge := "challenge-1"
+	require.NoError(t, cache.Get().Set(oidcMFACacheKey(challenge), []byte(alice.GetUserIDStr()), oidcCookieAge))
+
+	resp, _ = oidcVerify(t, ctx, challenge, "000000")
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+	assert.Empty(t, resp.GetToken())
+
+	resp, w := oidcVerify(t, ctx, challenge, code)
+	require.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+	assert.NotEmpty(t, resp.GetToken())
+	assert.NotEmpty(t, w.Result().Cookies())
+
+	// 挑战只能使用一次
+	resp, _ = oidcVerify(t, ctx, challenge, code)
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+}
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/sso"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// provisionOIDCUser 按 sub 查找单点登录用户，找不到时按配置自动创建，
+// 不会按邮箱绑定已有的本地用户，本地用户需要登录后主动绑定
+func provisionOIDCUser(ctx *app.Context, ident *sso.Identity) (*models.UserEntity, error) {
+	q := dao.NewQuery(ctx)
+
+	user, err := q.AdminGetUserBySSOSubject(ident.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if !ctx.GetApp().GetConfig().App.OIDC.AutoProvision {
+		return nil, fmt.Errorf("oidc user not provisioned")
+	}
+
+	userCount, err := q.AdminCountUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户名或邮箱与已有用户冲突时带上 sub 的摘要，避免接管本地账号
+	suffix := utils.SHA1(ident.Subject)[:8]
+	username := ident.Username
+	if _, err := q.GetUserByUserName(username); err == nil {
+		username = fmt.Sprintf("%s-%s", username, suffix)
+	}
+	email := ident.Email
+	if len(email) == 0 {
+		email = fmt.Sprintf("%s@oidc.invalid", suffix)
+	} else if _, err := q.AdminGetUserByEmail(email); err == nil {
+		email = fmt.Sprintf("%s+%s@oidc.invalid", strings.Split(email, "@")[0], suffix)
+	}
+
+	newUser := &models.UserEntity{
+		UserName:   username,
+		Email:      email,
+		Status:     models.STATUS_NORMAL,
+		Role:       defs.UserRole_Normal,
+		TenantID:   defs.DefaultTenantID,
+		Token:      uuid.New().String(),
+		SSOSubject: ident.Subject,
+	}
+	if userCount == 0 {
+		newUser.Role = defs.UserRole_Admin
+	}
+
+	if err := dao.NewMutation(ctx).CreateUser(newUser); err != nil {
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("auto provision oidc user, id: [%d], username: [%s]", newUser.UserID, newUser.UserName)
+	return newUser, nil
+}
+
+// linkOIDCUser 将 sub 绑定到当前登录的本地用户，sub 已被其他用户绑定时拒绝
+func linkOIDCUser(ctx *app.Context, userID int, ident *sso.Identity) (*models.UserEntity, error) {
+	q := dao.NewQuery(ctx)
+
+	bound, err := q.AdminGetUserBySSOSubject(ident.Subject)
+	if err == nil {
+		if bound.UserID != userID {
+			return nil, fmt.Errorf("oidc subject already linked to another user")
+		}
+		return bound, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	user, err := q.GetUserByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(user.SSOSubject) > 0 {
+		return nil, fmt.Errorf("user already linked to another oidc subject")
+	}
+
+	user.SSOSubject = ident.Subject
+	if err := dao.NewMutation(ctx).AdminUpdateUser(user, user); err != nil {
+		return nil, err
+	}
+	logger.Logger(ctx).Infof("link oidc subject to local user, id: [%d], sub: [%s]", user.UserID, ident.Subject)
+	return user, nil
+}
+
+// syncOIDCGroups 按 groups 声明同步用户组成员关系，不存在的组会自动创建，
+// 只会加入和移出由单点登录创建的组，同名的手动维护的组不受影响
+func syncOIDCGroups(ctx *app.Context, user *models.UserEntity, groups []string) error {
+	q := dao.NewQuery(ctx)
+	m := dao.NewMutation(ctx)
+	permMgr := ctx.GetApp().GetPermManager()
+
+	current, err := q.AdminListUserGroups(user.UserID)
+	if err != nil {
+		return err
+	}
+	current = lo.Filter(current, func(g *models.UserGroup, _ int) bool {
+		return g.TenantID == user.TenantID && strings.HasPrefix(g.GroupID, defs.OIDCGroupIDPrefix)
+	})
+	joined := lo.SliceToMap(current, func(g *models.UserGroup) (string, bool) { return g.GroupName, true })
+
+	for _, name := range groups {
+		if joined[name] {
+			continue
+		}
+
+		g, err := q.AdminGetGroupByName(user.TenantID, name)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			g = &models.UserGroup{
+				GroupID:   defs.OIDCGroupIDPrefix + uuid.New().String(),
+				GroupName: name,
+				TenantID:  user.TenantID,
+				Comment:   "synced from oidc groups claim",
+			}
+			err = m.AdminCreateGroup(g)
+		}
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(g.GroupID, defs.OIDCGroupIDPrefix) {
+			logger.Logger(ctx).Warnf("skip oidc group [%s], a manually managed group with the same name exists", name)
+			continue
+		}
+
+		if err := m.AdminAddGroupMember(g.GroupID, user.UserID); err != nil {
+			return err
+		}
+		if permMgr != nil {
+			if _, err := permMgr.AddUserToGroup(user.UserID, g.GroupID, user.TenantID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, g := range current {
+		if lo.Contains(groups, g.GroupName) {
+			continue
+		}
+		if err := m.AdminRemoveGroupMember(g.GroupID, user.UserID); err != nil {
+			return err
+		}
+		if permMgr != nil {
+			if _, err := permMgr.RemoveUserFromGroup(user.UserID, g.GroupID, user.TenantID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/sso"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvisionOIDCUser_NoEmailTakeover(t *testing.T) {
+	ctx := apptest.NewContext(t, func(c *conf.Config) { c.App.OIDC.AutoProvision = true })
+	alice := apptest.CreateUser(t, ctx, "alice")
+
+	ident := &sso.Identity{Subject: "sub-1", Username: "alice", Email: alice.Email, EmailVerified: true}
+	user, err := provisionOIDCUser(ctx, ident)
+	require.NoError(t, err)
+	assert.NotEqual(t, alice.UserID, user.UserID)
+	assert.NotEqual(t, alice.UserName, user.UserName)
+	assert.NotEqual(t, alice.Email, user.Email)
+
+	local, err := dao.NewQuery(ctx).GetUserByUserID(alice.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, local.SSOSubject)
+
+	again, err := provisionOIDCUser(ctx, ident)
+	require.NoError(t, err)
+	assert.Equal(t, user.UserID, again.UserID)
+}
+
+func TestLinkOIDCUser(t *testing.T) {
+	ctx := apptest.NewContext(t, func(c *conf.Config) { c.App.OIDC.AutoProvision = false })
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	ident := &sso.Identity{Subject: "sub-1", Email: alice.Email, EmailVerified: true}
+
+	_, err := provisionOIDCUser(ctx, ident)
+	assert.Error(t, err)
+
+	linked, err := linkOIDCUser(ctx, alice.UserID, ident)
+	require.NoError(t, err)
+	assert.Equal(t, alice.UserID, linked.UserID)
+
+	user, err := provisionOIDCUser(ctx, ident)
+	require.NoError(t, err)
+	assert.Equal(t, alice.UserID, user.UserID)
+
+	// 同一个 sub 不能再绑定到其他用户，已绑定的用户也不能换绑
+	_, err = linkOIDCUser(ctx, bob.UserID, ident)
+	assert.Error(t, err)
+	_, err = linkOIDCUser(ctx, alice.UserID, &sso.Identity{Subject: "sub-2"})
+	assert.Error(t, err)
+}
+
+func TestSyncOIDCGroups_SkipManualGroups(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	m := dao.NewMutation(ctx)
+	require.NoError(t, m.AdminCreateGroup(&models.UserGroup{GroupID: "manual-ops", GroupName: "ops"}))
+	require.NoError(t, m.AdminCreateGroup(&models.UserGroup{GroupID: "manual-qa", GroupName: "qa"}))
+	require.NoError(t, m.AdminAddGroupMember("manual-qa", alice.UserID))
+
+	groupNames := func() []string {
+		gs, err := dao.NewQuery(ctx).AdminListUserGroups(alice.UserID)
+		require.NoError(t, err)
+		return lo.Map(gs, func(g *models.UserGroup, _ int) string { return g.GroupName })
+	}
+
+	require.NoError(t, syncOIDCGroups(ctx, alice, []string{"ops", "dev"}))
+	assert.ElementsMatch(t, []string{"dev", "qa"}, groupNames())
+
+	// 声明中不再包含的组只会移出单点登录创建的组
+	require.NoError(t, syncOIDCGroups(ctx, alice, []string{}))
+	assert.ElementsMatch(t, []string{"qa"}, groupNames())
+}
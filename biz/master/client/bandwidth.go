@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/fatedier/frp/pkg/config/types"
+	"github.com/samber/lo"
+)
+
+// PatchClientConfigBandwidth 按流量配额给下发的 frpc 配置注入带宽上限，用户自己配置的更严格上限保持不变
+// 注入的上限使用 server 模式，frps 注册隧道时 master 会校验上报的上限，客户端改掉配置也无法绕过
+func PatchClientConfigBandwidth(ctx *app.Context, cli *models.ClientEntity, content []byte) ([]byte, error) {
+	quotas, err := dao.NewQuery(ctx).AdminListTrafficQuotasByUserID(cli.UserID)
+	if err != nil || len(quotas) == 0 {
+		return content, err
+	}
+
+	proxyCfgs, err := dao.NewQuery(ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{ClientID: cli.ClientID})
+	if err != nil {
+		return content, err
+	}
+	proxyCfgMap := lo.SliceToMap(proxyCfgs, func(p *models.ProxyConfig) (string, *models.ProxyConfig) { return p.Name, p })
+
+	cfg := map[string]any{}
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return nil, err
+	}
+	proxies, _ := cfg["proxies"].([]any)
+
+	patched := false
+	for _, item := range proxies {
+		proxy, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := proxy["name"].(string)
+		proxyCfg, ok := proxyCfgMap[name]
+		if !ok {
+			proxyCfg = &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
+				ClientID:       cli.ClientID,
+				OriginClientID: cli.OriginClientID,
+				UserID:         cli.UserID,
+				TenantID:       cli.TenantID,
+			}}
+		}
+
+		quotaLimit := QuotaBandwidthLimit(quotas, proxyCfg)
+		if len(quotaLimit) == 0 {
+			continue
+		}
+
+		transport, _ := proxy["transport"].(map[string]any)
+		if transport == nil {
+			transport = map[string]any{}
+		}
+		userLimit, _ := transport["bandwidthLimit"].(string)
+		if limit := models.MinBandwidthLimit(userLimit, quotaLimit); limit != models.MinBandwidthLimit(userLimit) {
+			transport["bandwidthLimit"] = limit
+			transport["bandwidthLimitMode"] = types.BandwidthLimitModeServer
+			proxy["transport"] = transport
+			patched = true
+		}
+	}
+
+	if !patched {
+		return content, nil
+	}
+	return json.Marshal(cfg)
+}
+
+// QuotaBandwidthLimit 作用于隧道的配额中最严格的带宽上限，空表示不限
+func QuotaBandwidthLimit(quotas []*models.TrafficQuota, proxyCfg *models.ProxyConfig) string {
+	return models.MinBandwidthLimit(lo.FilterMap(quotas, func(q *models.TrafficQuota, _ int) (string, bool) {
+		return q.EffectiveBandwidthLimit(), q.Covers(proxyCfg)
+	})...)
+}
+
+// PushClientConfig 把子客户端当前的配置重新下发给客户端，用于带宽上限变化后立即生效
+func PushClientConfig(ctx *app.Context, clientID string) error {
+	cli, err := dao.NewQuery(ctx).AdminGetClientByClientID(clientID)
+	if err != nil {
+		return err
+	}
+	if len(cli.ConfigContent) == 0 || len(cli.OriginClientID) == 0 {
+		return nil
+	}
+
+	origin, err := dao.NewQuery(ctx).AdminGetClientByClientID(cli.OriginClientID)
+	if err != nil {
+		return err
+	}
+	if origin.Stopped {
+		logger.Logger(ctx).Infof("client [%s] is stopped, do not push config", origin.ClientID)
+		return nil
+	}
+
+	frpToken, err := ClientFRPToken(ctx, cli.ClientEntity)
+	if err != nil {
+		return err
+	}
+	content, err := PatchClientConfigToken(cli.ConfigContent, cli.ClientID, frpToken)
+	if err != nil {
+		return err
+	}
+	if content, err = PatchClientConfigBandwidth(ctx, cli.ClientEntity, content); err != nil {
+		return err
+	}
+
+	_, err = rpc.CallClient(ctx, origin.ClientID, pb.Event_EVENT_UPDATE_FRPC, &pb.UpdateFRPCRequest{
+		ClientId: lo.ToPtr(cli.ClientID),
+		ServerId: lo.ToPtr(cli.ServerID),
+		Config:   content,
+	})
+	return err
+}
@@ -1,11 +1,14 @@
 package client
 
 import (
+	"github.com/VaalaCat/frp-panel/biz/master/tenant"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/google/uuid"
@@ -27,6 +30,12 @@ func InitClientHandler(c *app.Context, req *pb.InitClientRequest) (*pb.InitClien
 		}, nil
 	}
 
+	if err := tenant.CheckClientQuota(c, userInfo.GetTenantID()); err != nil {
+		return &pb.InitClientResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
 	globalClientID := app.GlobalClientID(userInfo.GetUserName(), "c", userClientID)
 
 	logger.Logger(c).Infof("start to init client, request:[%s], transformed global client id:[%s]", req.String(), globalClientID)
@@ -43,6 +52,10 @@ func InitClientHandler(c *app.Context, req *pb.InitClientRequest) (*pb.InitClien
 		return &pb.InitClientResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()}}, err
 	}
 
+	eventbus.Emit(c, defs.EventClientInitialized, defs.RBACObjClient, globalClientID, map[string]any{
+		"ephemeral": req.GetEphemeral(),
+	})
+
 	return &pb.InitClientResponse{
 		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		ClientId: &globalClientID,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
@@ -14,7 +15,7 @@ import (
 func DeleteClientHandler(ctx *app.Context, req *pb.DeleteClientRequest) (*pb.DeleteClientResponse, error) {
 	logger.Logger(ctx).Infof("delete client, req: [%+v]", req)
 
-	userInfo := common.GetUserInfo(ctx)
+	userInfo := common.GetObjectOwnerInfo(ctx)
 	clientID := req.GetClientId()
 
 	if !userInfo.Valid() {
@@ -37,6 +38,10 @@ func DeleteClientHandler(ctx *app.Context, req *pb.DeleteClientRequest) (*pb.Del
 		return nil, err
 	}
 
+	if _, err := ctx.GetApp().GetPermManager().RemoveObjectPolicies(defs.RBACObjClient, clientID, userInfo.GetTenantID()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot remove client policies, id: [%s]", clientID)
+	}
+
 	go func() {
 		resp, err := rpc.CallClient(app.NewContext(context.Background(), ctx.GetApp()), req.GetClientId(), pb.Event_EVENT_REMOVE_FRPC, req)
 		if err != nil {
@@ -17,7 +17,7 @@ func RemoveFrpcHandler(c *app.Context, req *pb.RemoveFRPCRequest) (*pb.RemoveFRP
 
 	var (
 		clientID = req.GetClientId()
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 	)
 
 	if len(clientID) == 0 {
@@ -16,7 +16,7 @@ func GetClientHandler(ctx *app.Context, req *pb.GetClientRequest) (*pb.GetClient
 	logger.Logger(ctx).Infof("get client, req: [%+v]", req)
 
 	var (
-		userInfo = common.GetUserInfo(ctx)
+		userInfo = common.GetObjectOwnerInfo(ctx)
 		clientID = req.GetClientId()
 		serverID = req.GetServerId()
 	)
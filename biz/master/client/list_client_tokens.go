@@ -0,0 +1,41 @@
+package client
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListClientTokensHandler(ctx *app.Context, req *pb.ListClientTokensRequest) (*pb.ListClientTokensResponse, error) {
+	var (
+		userInfo = common.GetObjectOwnerInfo(ctx)
+		clientID = req.GetClientId()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListClientTokensResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(clientID) == 0 {
+		return &pb.ListClientTokensResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid client id"},
+		}, nil
+	}
+
+	tokens, err := dao.NewQuery(ctx).ListClientTokens(userInfo, clientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list client tokens, id: [%s]", clientID)
+		return nil, err
+	}
+
+	return &pb.ListClientTokensResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Tokens: lo.Map(tokens, func(t *models.ClientToken, _ int) *pb.ClientToken { return t.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func RevokeClientTokenHandler(ctx *app.Context, req *pb.RevokeClientTokenRequest) (*pb.RevokeClientTokenResponse, error) {
+	logger.Logger(ctx).Infof("revoke client token, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetObjectOwnerInfo(ctx)
+		clientID = req.GetClientId()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.RevokeClientTokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(clientID) == 0 {
+		return &pb.RevokeClientTokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid client id"},
+		}, nil
+	}
+
+	revoked, err := dao.NewMutation(ctx).RevokeClientTokens(userInfo, clientID, uint(req.GetTokenId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot revoke client token, id: [%s]", clientID)
+		return nil, err
+	}
+
+	InvalidateClientTokens(revoked)
+
+	logger.Logger(ctx).Infof("revoke client token success, id: [%s], count: [%d]", clientID, len(revoked))
+	return &pb.RevokeClientTokenResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
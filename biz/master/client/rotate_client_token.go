@@ -0,0 +1,87 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// RotateClientTokenHandler 吊销客户端所有 token 并为每个子客户端签发新 token，
+// frpc 会在下一次拉取配置时使用新 token 重新登录
+func RotateClientTokenHandler(ctx *app.Context, req *pb.RotateClientTokenRequest) (*pb.RotateClientTokenResponse, error) {
+	logger.Logger(ctx).Infof("rotate client token, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetObjectOwnerInfo(ctx)
+		clientID = req.GetClientId()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.RotateClientTokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(clientID) == 0 || req.GetExpireSeconds() < 0 {
+		return &pb.RotateClientTokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid client id or expire seconds"},
+		}, nil
+	}
+
+	q := dao.NewQuery(ctx)
+	cli, err := q.GetClientByClientID(userInfo, clientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
+		return nil, fmt.Errorf("cannot get client")
+	}
+
+	clientsToIssue := []*models.ClientEntity{cli.ClientEntity}
+	if cli.IsShadow {
+		childIDs, err := q.GetClientIDsInShadowByClientID(userInfo, clientID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get child clients, id: [%s]", clientID)
+			return nil, err
+		}
+		clientsToIssue = []*models.ClientEntity{}
+		if len(childIDs) > 0 {
+			children, err := q.GetClientsByClientIDs(userInfo, childIDs)
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot get child clients, id: [%s]", clientID)
+				return nil, err
+			}
+			for _, child := range children {
+				clientsToIssue = append(clientsToIssue, child.ClientEntity)
+			}
+		}
+	}
+
+	revoked, err := dao.NewMutation(ctx).RevokeClientTokens(userInfo, clientID, 0)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot revoke client token, id: [%s]", clientID)
+		return nil, err
+	}
+	InvalidateClientTokens(revoked)
+
+	ttl := time.Duration(req.GetExpireSeconds()) * time.Second
+	if ttl == 0 {
+		ttl = time.Duration(ctx.GetApp().GetConfig().App.FRPTokenTTL) * time.Second
+	}
+
+	for _, c := range clientsToIssue {
+		if _, err := IssueClientFRPToken(ctx, c, ttl); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot issue client token, id: [%s]", c.ClientID)
+			return nil, err
+		}
+	}
+
+	logger.Logger(ctx).Infof("rotate client token success, id: [%s], revoked: [%d], issued: [%d]", clientID, len(revoked), len(clientsToIssue))
+	return &pb.RotateClientTokenResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
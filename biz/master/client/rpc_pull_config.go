@@ -48,11 +48,29 @@ func RPCPullConfig(ctx *app.Context, req *pb.PullClientConfigReq) (*pb.PullClien
 		}, nil
 	}
 
+	// 下发当前可用的客户端 token，token 轮换或吊销后 frpc 会在下一次拉取时重新登录
+	configContent := cli.ConfigContent
+	if len(configContent) > 0 && len(clientIDs) == 0 {
+		if frpToken, err := ClientFRPToken(ctx, cli); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get client frp token, id: [%s]", cli.ClientID)
+		} else if patched, err := PatchClientConfigToken(configContent, cli.ClientID, frpToken); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot patch client frp token, id: [%s]", cli.ClientID)
+		} else {
+			configContent = patched
+		}
+
+		if patched, err := PatchClientConfigBandwidth(ctx, cli, configContent); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot patch client bandwidth limit, id: [%s]", cli.ClientID)
+		} else {
+			configContent = patched
+		}
+	}
+
 	return &pb.PullClientConfigResp{
 		Client: &pb.Client{
 			Id:             lo.ToPtr(cli.ClientID),
 			ServerId:       lo.ToPtr(cli.ServerID),
-			Config:         lo.ToPtr(string(cli.ConfigContent)),
+			Config:         lo.ToPtr(string(configContent)),
 			OriginClientId: lo.ToPtr(cli.OriginClientID),
 			ClientIds:      clientIDs,
 		},
@@ -14,7 +14,7 @@ import (
 func StartFRPCHandler(ctx *app.Context, req *pb.StartFRPCRequest) (*pb.StartFRPCResponse, error) {
 	logger.Logger(ctx).Infof("master get a start client request, origin is: [%+v]", req)
 
-	userInfo := common.GetUserInfo(ctx)
+	userInfo := common.GetObjectOwnerInfo(ctx)
 	clientID := req.GetClientId()
 
 	if !userInfo.Valid() {
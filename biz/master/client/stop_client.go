@@ -14,7 +14,7 @@ import (
 func StopFRPCHandler(ctx *app.Context, req *pb.StopFRPCRequest) (*pb.StopFRPCResponse, error) {
 	logger.Logger(ctx).Infof("master get a stop client request, origin is: [%+v]", req)
 
-	userInfo := common.GetUserInfo(ctx)
+	userInfo := common.GetObjectOwnerInfo(ctx)
 	clientID := req.GetClientId()
 
 	if !userInfo.Valid() {
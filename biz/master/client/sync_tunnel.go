@@ -23,10 +23,18 @@ func SyncTunnel(ctx *app.Context, userInfo models.UserInfo) error {
 			return
 		}
 
+		frpToken, err := ClientFRPToken(ctx, cli)
+		if err != nil {
+			logger.Logger(context.Background()).WithError(err).Errorf("cannot get client frp token, id: [%s]", cli.ClientID)
+			return
+		}
+
 		cfg.User = userInfo.GetUserName()
-		cfg.Metadatas = map[string]string{
-			defs.FRPAuthTokenKey: userInfo.GetToken(),
+		if cfg.Metadatas == nil {
+			cfg.Metadatas = map[string]string{}
 		}
+		cfg.Metadatas[defs.FRPAuthTokenKey] = frpToken
+		cfg.Metadatas[defs.FRPClientIDKey] = cli.ClientID
 		if err := cli.SetConfigContent(*cfg); err != nil {
 			logger.Logger(context.Background()).WithError(err).Errorf("cannot set client config content, id: [%s]", cli.ClientID)
 			return
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+const (
+	clientTokenCachePrefix = "frp-client-token:"
+	clientTokenOwnerSep    = "\x00"
+	tokenOwnerCachePrefix  = "frp-token-owner:"
+	// tokenOwnerCacheTTL 属主状态缓存的秒数，封禁时会主动清理，过期只兜底未经 kickUser 的改动
+	tokenOwnerCacheTTL = 60
+)
+
+func clientTokenCacheKey(token string) []byte {
+	return []byte(clientTokenCachePrefix + token)
+}
+
+func tokenOwnerCacheKey(userName string) []byte {
+	return []byte(tokenOwnerCachePrefix + userName)
+}
+
+// IssueClientFRPToken 为子客户端签发一个新的 frp token，ttl 为 0 时永不过期
+func IssueClientFRPToken(ctx *app.Context, cli *models.ClientEntity, ttl time.Duration) (*models.ClientToken, error) {
+	if cli == nil || len(cli.ClientID) == 0 {
+		return nil, fmt.Errorf("invalid client")
+	}
+
+	t := &models.ClientToken{
+		ClientTokenEntity: &models.ClientTokenEntity{
+			ClientID:       cli.ClientID,
+			OriginClientID: cli.OriginClientID,
+			UserID:         cli.UserID,
+			TenantID:       cli.TenantID,
+			Token:          utils.GenerateUUIDWithoutSeperator(),
+			Status:         defs.TokenStatusActive,
+		},
+	}
+	if ttl > 0 {
+		expiresAt := time.Now().Add(ttl)
+		t.ExpiresAt = &expiresAt
+	}
+
+	if err := dao.NewMutation(ctx).AdminCreateClientToken(t); err != nil {
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("issue frp token for client [%s], expires at: [%v]", cli.ClientID, t.ExpiresAt)
+	return t, nil
+}
+
+// ClientFRPToken 获取子客户端当前可用的 frp token，不存在或剩余有效期不足一半时签发新的
+func ClientFRPToken(ctx *app.Context, cli *models.ClientEntity) (string, error) {
+	ttl := time.Duration(ctx.GetApp().GetConfig().App.FRPTokenTTL) * time.Second
+
+	tokens, err := dao.NewQuery(ctx).AdminGetUsableClientTokens(cli.ClientID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tokens) > 0 {
+		latest := tokens[0]
+		if latest.ExpiresAt == nil || ttl <= 0 || time.Until(*latest.ExpiresAt) > ttl/2 {
+			return latest.Token, nil
+		}
+	}
+
+	t, err := IssueClientFRPToken(ctx, cli, ttl)
+	if err != nil {
+		return "", err
+	}
+	return t.Token, nil
+}
+
+// PatchClientConfigToken 将客户端 token 和 client id 写入 frpc 配置的 metadatas
+func PatchClientConfigToken(content []byte, clientID, token string) ([]byte, error) {
+	cfg := map[string]any{}
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return nil, err
+	}
+
+	metas, ok := cfg["metadatas"].(map[string]any)
+	if !ok || metas == nil {
+		metas = map[string]any{}
+	}
+	metas[defs.FRPAuthTokenKey] = token
+	metas[defs.FRPClientIDKey] = clientID
+	cfg["metadatas"] = metas
+
+	return json.Marshal(cfg)
+}
+
+// ValidateClientFRPToken 校验 frpc 登录时携带的客户端 token，clientID 为空时只校验用户
+func ValidateClientFRPToken(ctx *app.Context, userName, clientID, token string) error {
+	if len(token) == 0 {
+		return fmt.Errorf("token can not be empty")
+	}
+
+	if cached, err := cache.Get().Get(clientTokenCacheKey(token)); err == nil {
+		if err := matchClientTokenOwner(string(cached), userName, clientID); err != nil {
+			return err
+		}
+		// 属主状态单独缓存，封禁时由 InvalidateFRPTokenOwner 清理
+		return ValidateFRPTokenOwner(ctx, userName)
+	}
+
+	t, err := dao.NewQuery(ctx).AdminGetClientTokenByToken(token)
+	if err != nil {
+		return fmt.Errorf("invalid token")
+	}
+
+	if !t.Usable(time.Now()) {
+		return fmt.Errorf("token is %s or expired", t.Status)
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(t.UserID)
+	if err != nil || !u.Valid() {
+		return fmt.Errorf("invalid token owner")
+	}
+
+	owner := u.GetUserName() + clientTokenOwnerSep + t.ClientID
+	expireSeconds := 0
+	if t.ExpiresAt != nil {
+		expireSeconds = int(time.Until(*t.ExpiresAt).Seconds()) + 1
+	}
+	if err := cache.Get().Set(clientTokenCacheKey(token), []byte(owner), expireSeconds); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot cache client token, client: [%s]", t.ClientID)
+	}
+	if err := cache.Get().Set(tokenOwnerCacheKey(u.GetUserName()), []byte{1}, tokenOwnerCacheTTL); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot cache token owner, user: [%s]", u.GetUserName())
+	}
+
+	return matchClientTokenOwner(owner, userName, clientID)
+}
+
+// ValidateFRPTokenOwner 校验 token 属主仍然可用，可用的结果会短暂缓存
+func ValidateFRPTokenOwner(ctx *app.Context, userName string) error {
+	if _, err := cache.Get().Get(tokenOwnerCacheKey(userName)); err == nil {
+		return nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserName(userName)
+	if err != nil || !u.Valid() {
+		return fmt.Errorf("invalid token owner")
+	}
+	if err := cache.Get().Set(tokenOwnerCacheKey(userName), []byte{1}, tokenOwnerCacheTTL); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot cache token owner, user: [%s]", userName)
+	}
+	return nil
+}
+
+// InvalidateFRPTokenOwner 用户被封禁或删除后清理属主状态缓存
+func InvalidateFRPTokenOwner(userName string) {
+	cache.Get().Del(tokenOwnerCacheKey(userName))
+}
+
+func matchClientTokenOwner(owner, userName, clientID string) error {
+	ownerUser, ownerClient, _ := strings.Cut(owner, clientTokenOwnerSep)
+	if ownerUser != userName {
+		return fmt.Errorf("token does not belong to user [%s]", userName)
+	}
+	if len(clientID) > 0 && ownerClient != clientID {
+		return fmt.Errorf("token does not belong to client [%s]", clientID)
+	}
+	return nil
+}
+
+// InvalidateClientTokens 吊销后清理缓存，下次登录会重新校验
+func InvalidateClientTokens(tokens []*models.ClientToken) {
+	for _, t := range tokens {
+		cache.Get().Del(clientTokenCacheKey(t.Token))
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFRPToken_Revoke(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	u := apptest.CreateUser(t, ctx, "alice")
+	cli := &models.ClientEntity{ClientID: "c1", UserID: u.UserID}
+
+	token, err := ClientFRPToken(ctx, cli)
+	require.NoError(t, err)
+	require.NoError(t, ValidateClientFRPToken(ctx, "alice", "c1", token))
+
+	// 复用未过期的 token
+	again, err := ClientFRPToken(ctx, cli)
+	require.NoError(t, err)
+	assert.Equal(t, token, again)
+
+	assert.Error(t, ValidateClientFRPToken(ctx, "alice", "c2", token))
+	assert.Error(t, ValidateClientFRPToken(ctx, "bob", "c1", token))
+
+	revoked, err := dao.NewMutation(ctx).RevokeClientTokens(u, "c1", 0)
+	require.NoError(t, err)
+	require.Len(t, revoked, 1)
+	InvalidateClientTokens(revoked)
+	assert.Error(t, ValidateClientFRPToken(ctx, "alice", "c1", token))
+
+	// 吊销后重新签发
+	rotated, err := ClientFRPToken(ctx, cli)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, rotated)
+}
+
+func TestClientFRPToken_Expired(t *testing.T) {
+	ctx := apptest.NewContext(t, func(c *conf.Config) { c.App.FRPTokenTTL = 60 })
+	u := apptest.CreateUser(t, ctx, "alice")
+	cli := &models.ClientEntity{ClientID: "c1", UserID: u.UserID}
+
+	issued, err := IssueClientFRPToken(ctx, cli, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Model(issued).
+		Update("expires_at", time.Now().Add(-time.Second)).Error)
+	assert.Error(t, ValidateClientFRPToken(ctx, "alice", "c1", issued.Token))
+
+	// 过期后 ClientFRPToken 会签发新的 token
+	token, err := ClientFRPToken(ctx, cli)
+	require.NoError(t, err)
+	assert.NotEqual(t, issued.Token, token)
+}
+
+func TestClientFRPToken_BannedOwnerWithWarmCache(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	u := apptest.CreateUser(t, ctx, "alice")
+
+	token, err := ClientFRPToken(ctx, &models.ClientEntity{ClientID: "c1", UserID: u.UserID})
+	require.NoError(t, err)
+	require.NoError(t, ValidateClientFRPToken(ctx, "alice", "c1", token))
+
+	// 属主状态缓存期内不再查库，封禁后清理缓存立即失效
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().
+		Model(&models.User{}).Where("user_id = ?", u.UserID).Update("status", models.STATUS_BANED).Error)
+	assert.NoError(t, ValidateClientFRPToken(ctx, "alice", "c1", token))
+	InvalidateFRPTokenOwner("alice")
+	assert.Error(t, ValidateClientFRPToken(ctx, "alice", "c1", token))
+}
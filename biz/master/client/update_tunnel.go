@@ -11,6 +11,7 @@ import (
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
@@ -25,7 +26,7 @@ func UpdateFrpcHander(c *app.Context, req *pb.UpdateFRPCRequest) (*pb.UpdateFRPC
 		content     = req.GetConfig()
 		serverID    = req.GetServerId()
 		reqClientID = req.GetClientId() // may be shadow or child
-		userInfo    = common.GetUserInfo(c)
+		userInfo    = common.GetObjectOwnerInfo(c)
 	)
 	q := dao.NewQuery(c)
 	m := dao.NewMutation(c)
@@ -146,8 +147,14 @@ func UpdateFrpcHander(c *app.Context, req *pb.UpdateFRPCRequest) (*pb.UpdateFRPC
 		cliCfg.Metadatas = make(map[string]string)
 	}
 
-	cliCfg.Metadatas[defs.FRPAuthTokenKey] = userInfo.GetToken()
-	cliCfg.Metadatas[defs.FRPClientIDKey] = reqClientID
+	frpToken, err := ClientFRPToken(c, cli)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client frp token, id: [%s]", cli.ClientID)
+		return nil, err
+	}
+
+	cliCfg.Metadatas[defs.FRPAuthTokenKey] = frpToken
+	cliCfg.Metadatas[defs.FRPClientIDKey] = cli.ClientID
 
 	newCfg := struct {
 		v1.ClientCommonConfig
@@ -200,6 +207,12 @@ func UpdateFrpcHander(c *app.Context, req *pb.UpdateFRPCRequest) (*pb.UpdateFRPC
 			return
 		}
 
+		if patched, err := PatchClientConfigBandwidth(childCtx, cli, cliReq.Config); err != nil {
+			logger.Logger(childCtx).WithError(err).Errorf("cannot patch client bandwidth limit, id: [%s]", cli.ClientID)
+		} else {
+			cliReq.Config = patched
+		}
+
 		resp, err := rpc.CallClient(childCtx, cliToUpdate.ClientID, pb.Event_EVENT_UPDATE_FRPC, cliReq)
 		if err != nil {
 			logger.Logger(childCtx).WithError(err).Errorf("update event send to client error, server: [%s], client: [%+v], updated client: [%+v]", serverID, cliToUpdate, cli)
@@ -210,6 +223,7 @@ func UpdateFrpcHander(c *app.Context, req *pb.UpdateFRPCRequest) (*pb.UpdateFRPC
 		}
 	}()
 
+	eventbus.Emit(c, defs.EventConfigUpdated, defs.RBACObjClient, reqClientID, nil)
 	logger.Logger(c).Infof("update frpc success, client id: [%s]", reqClientID)
 	return &pb.UpdateFRPCResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
@@ -4,9 +4,11 @@ import (
 	"sync"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 )
@@ -78,6 +80,11 @@ func UpgradeFrppHandler(ctx *app.Context, req *pb.UpgradeFrppRequest) (*pb.Upgra
 
 			resp := &pb.UpgradeFrppResponse{}
 			if err := rpc.CallClientWrapper(ctx, clientId, pb.Event_EVENT_UPGRADE_FRPP, reqForClient, resp); err != nil {
+				eventbus.Emit(ctx, defs.EventUpgradeProgress, defs.RBACObjClient, clientId, map[string]any{
+					"stage":   "failed",
+					"version": req.GetVersion(),
+					"error":   err.Error(),
+				})
 				mu.Lock()
 				if errOnce == nil {
 					errOnce = err
@@ -85,6 +92,10 @@ func UpgradeFrppHandler(ctx *app.Context, req *pb.UpgradeFrppRequest) (*pb.Upgra
 				mu.Unlock()
 				return
 			}
+			eventbus.Emit(ctx, defs.EventUpgradeProgress, defs.RBACObjClient, clientId, map[string]any{
+				"stage":   "dispatched",
+				"version": req.GetVersion(),
+			})
 		}()
 	}
 	wg.Wait()
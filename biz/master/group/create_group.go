@@ -0,0 +1,40 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func CreateGroupHandler(ctx *app.Context, req *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	logger.Logger(ctx).Infof("create group, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.CreateGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(req.GetGroupName()) == 0 {
+		return &pb.CreateGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid group name"},
+		}, nil
+	}
+
+	g, err := dao.NewMutation(ctx).CreateGroup(userInfo, utils.GenerateUUIDWithoutSeperator(), req.GetGroupName(), req.GetComment())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create group, name: [%s]", req.GetGroupName())
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("create group success, id: [%s], name: [%s]", g.GroupID, g.GroupName)
+	return &pb.CreateGroupResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Group:  g.ToPB(),
+	}, nil
+}
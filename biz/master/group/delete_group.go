@@ -0,0 +1,45 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func DeleteGroupHandler(ctx *app.Context, req *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	logger.Logger(ctx).Infof("delete group, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		groupID  = req.GetGroupId()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.DeleteGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(groupID) == 0 {
+		return &pb.DeleteGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid group id"},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).DeleteGroup(userInfo, groupID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete group, id: [%s]", groupID)
+		return nil, err
+	}
+
+	if _, err := ctx.GetApp().GetPermManager().RemoveGroup(groupID, userInfo.GetTenantID()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot remove group policies, id: [%s]", groupID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("delete group success, id: [%s]", groupID)
+	return &pb.DeleteGroupResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package group_test
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/biz/master/group"
+	"github.com/VaalaCat/frp-panel/biz/master/permission"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupSharing(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	admin := apptest.CreateUser(t, ctx, "admin", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+
+	created, err := group.CreateGroupHandler(apptest.WithUser(ctx, admin), &pb.CreateGroupRequest{GroupName: lo.ToPtr("ops")})
+	require.NoError(t, err)
+	groupID := created.GetGroup().GetGroupId()
+	require.NotEmpty(t, groupID)
+
+	// 属主把客户端只读共享给用户组
+	_, err = permission.GrantPermissionHandler(apptest.WithUser(ctx, alice), &pb.GrantPermissionRequest{
+		Permission: &pb.ObjectPermission{
+			ObjType:     lo.ToPtr(string(defs.RBACObjClient)),
+			ObjId:       lo.ToPtr("c1"),
+			SubjectType: lo.ToPtr(string(defs.RBACSubjectGroup)),
+			SubjectId:   lo.ToPtr(groupID),
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+
+	// 普通用户不能修改组成员
+	resp, err := group.AddGroupMemberHandler(apptest.WithUser(ctx, bob), &pb.AddGroupMemberRequest{
+		GroupId: lo.ToPtr(groupID), UserId: lo.ToPtr(int64(bob.UserID)),
+	})
+	assert.True(t, err != nil || resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS)
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+
+	_, err = group.AddGroupMemberHandler(apptest.WithUser(ctx, admin), &pb.AddGroupMemberRequest{
+		GroupId: lo.ToPtr(groupID), UserId: lo.ToPtr(int64(bob.UserID)),
+	})
+	require.NoError(t, err)
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.NoError(t, err)
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionUpdate)
+	assert.Error(t, err)
+
+	_, err = group.RemoveGroupMemberHandler(apptest.WithUser(ctx, admin), &pb.RemoveGroupMemberRequest{
+		GroupId: lo.ToPtr(groupID), UserId: lo.ToPtr(int64(bob.UserID)),
+	})
+	require.NoError(t, err)
+
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+}
+
+func TestGroupSharing_OnlyOwnerCanGrant(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+
+	_, err := permission.GrantPermissionHandler(apptest.WithUser(ctx, bob), &pb.GrantPermissionRequest{
+		Permission: &pb.ObjectPermission{
+			ObjType:     lo.ToPtr(string(defs.RBACObjClient)),
+			ObjId:       lo.ToPtr("c1"),
+			SubjectType: lo.ToPtr(string(defs.RBACSubjectUser)),
+			SubjectId:   lo.ToPtr("3"),
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListGroupMembersHandler(ctx *app.Context, req *pb.ListGroupMembersRequest) (*pb.ListGroupMembersResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.ListGroupMembersResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(req.GetGroupId()) == 0 {
+		return &pb.ListGroupMembersResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid group id"},
+		}, nil
+	}
+
+	users, err := dao.NewQuery(ctx).ListGroupMembers(userInfo, req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListGroupMembersResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Users: lo.Map(users, func(u *models.UserEntity, _ int) *pb.User {
+			return u.ToSafePB()
+		}),
+	}, nil
+}
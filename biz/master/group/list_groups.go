@@ -0,0 +1,43 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListGroupsHandler(ctx *app.Context, req *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		keyword  = req.GetKeyword()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListGroupsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	groups, err := dao.NewQuery(ctx).ListGroups(userInfo, page, pageSize, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountGroups(userInfo, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListGroupsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Groups: lo.Map(groups, func(g *models.UserGroup, _ int) *pb.UserGroup {
+			return g.ToPB()
+		}),
+	}, nil
+}
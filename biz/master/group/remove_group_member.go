@@ -0,0 +1,46 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func RemoveGroupMemberHandler(ctx *app.Context, req *pb.RemoveGroupMemberRequest) (*pb.RemoveGroupMemberResponse, error) {
+	logger.Logger(ctx).Infof("remove group member, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		groupID  = req.GetGroupId()
+		userID   = int(req.GetUserId())
+	)
+
+	if !userInfo.Valid() {
+		return &pb.RemoveGroupMemberResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(groupID) == 0 || userID == 0 {
+		return &pb.RemoveGroupMemberResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid group id or user id"},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).RemoveGroupMember(userInfo, groupID, userID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot remove group member, group: [%s], user: [%d]", groupID, userID)
+		return nil, err
+	}
+
+	if _, err := ctx.GetApp().GetPermManager().RemoveUserFromGroup(userID, groupID, userInfo.GetTenantID()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot remove user from casbin group, group: [%s], user: [%d]", groupID, userID)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("remove group member success, group: [%s], user: [%d]", groupID, userID)
+	return &pb.RemoveGroupMemberResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
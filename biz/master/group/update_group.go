@@ -0,0 +1,36 @@
+package group
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func UpdateGroupHandler(ctx *app.Context, req *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
+	logger.Logger(ctx).Infof("update group, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+
+	if !userInfo.Valid() {
+		return &pb.UpdateGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if len(req.GetGroupId()) == 0 || len(req.GetGroupName()) == 0 {
+		return &pb.UpdateGroupResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid group id or group name"},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).RenameGroup(userInfo, req.GetGroupId(), req.GetGroupName(), req.GetComment()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update group, id: [%s]", req.GetGroupId())
+		return nil, err
+	}
+
+	return &pb.UpdateGroupResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
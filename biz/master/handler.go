@@ -3,17 +3,29 @@ package master
 import (
 	"embed"
 
+	"github.com/VaalaCat/frp-panel/biz/master/admin"
+	"github.com/VaalaCat/frp-panel/biz/master/alert"
+	"github.com/VaalaCat/frp-panel/biz/master/audit"
 	"github.com/VaalaCat/frp-panel/biz/master/auth"
 	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/group"
+	"github.com/VaalaCat/frp-panel/biz/master/mfa"
+	"github.com/VaalaCat/frp-panel/biz/master/permission"
 	"github.com/VaalaCat/frp-panel/biz/master/platform"
 	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/biz/master/quota"
 	"github.com/VaalaCat/frp-panel/biz/master/server"
 	"github.com/VaalaCat/frp-panel/biz/master/shell"
 	"github.com/VaalaCat/frp-panel/biz/master/streamlog"
+	"github.com/VaalaCat/frp-panel/biz/master/tenant"
 	"github.com/VaalaCat/frp-panel/biz/master/user"
+	"github.com/VaalaCat/frp-panel/biz/master/webhook"
 	"github.com/VaalaCat/frp-panel/biz/master/worker"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/middleware"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/metrics"
+	"github.com/VaalaCat/frp-panel/services/rbac"
 	"github.com/gin-gonic/gin"
 
 	wgHandler "github.com/VaalaCat/frp-panel/biz/master/wg"
@@ -28,71 +40,154 @@ func NewRouter(fs embed.FS, appInstance app.Application) *gin.Engine {
 
 func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 	router.POST("/auth", auth.MakeGinHandlerFunc(appInstance, auth.HandleLogin))
+	if appInstance.GetConfig().App.MetricsEnable {
+		router.GET("/metrics", metrics.Handler(appInstance, metrics.NewMasterCollector(appInstance), metrics.RPCCallDuration))
+	}
 
 	api := router.Group("/api")
 	api.POST("/v1/auth/cert", app.Wrapper(appInstance, auth.GetClientCert))
 	api.POST("/v1/auth/login", app.Wrapper(appInstance, auth.LoginHandler))
 	api.POST("/v1/auth/register", app.Wrapper(appInstance, auth.RegisterHandler))
 	api.GET("/v1/auth/logout", auth.RemoveJWTHandler(appInstance))
+	api.GET("/v1/auth/oidc/login", auth.OIDCLoginHandler(appInstance))
+	api.GET("/v1/auth/oidc/callback", auth.OIDCCallbackHandler(appInstance))
+	api.POST("/v1/auth/oidc/verify", app.Wrapper(appInstance, auth.OIDCVerifyTOTPHandler))
+	api.GET("/v1/auth/oidc/link", middleware.JWTAuth(appInstance), middleware.AuthCtx(appInstance), auth.OIDCLinkHandler(appInstance))
 
-	v1 := api.Group("/v1", middleware.JWTAuth(appInstance), middleware.AuthCtx(appInstance), middleware.RBAC(appInstance))
+	v1 := api.Group("/v1", middleware.JWTAuth(appInstance), middleware.AuthCtx(appInstance), middleware.Audit(appInstance), middleware.RBAC(appInstance), middleware.MFAEnrollment(appInstance))
 	{
 		userRouter := v1.Group("/user")
 		{
 			userRouter.POST("/get", app.Wrapper(appInstance, user.GetUserInfoHandler))
 			userRouter.POST("/update", app.Wrapper(appInstance, user.UpdateUserInfoHander))
 			userRouter.POST("/sign-token", app.Wrapper(appInstance, user.SignTokenHandler))
+			userRouter.POST("/token/list", app.Wrapper(appInstance, user.ListAPITokensHandler))
+			userRouter.POST("/token/revoke", app.Wrapper(appInstance, user.RevokeAPITokenHandler))
+			userRouter.POST("/token/rotate", app.Wrapper(appInstance, user.RotateAPITokenHandler))
+			userRouter.POST("/2fa/setup", app.Wrapper(appInstance, mfa.SetupTOTPHandler))
+			userRouter.POST("/2fa/enable", app.Wrapper(appInstance, mfa.EnableTOTPHandler))
+			userRouter.POST("/2fa/disable", app.Wrapper(appInstance, mfa.DisableTOTPHandler))
+			userRouter.POST("/2fa/verify", app.Wrapper(appInstance, mfa.VerifyTOTPHandler))
+			userRouter.POST("/2fa/recovery_codes/regenerate", app.Wrapper(appInstance, mfa.RegenerateRecoveryCodesHandler))
 		}
 		platformRouter := v1.Group("/platform")
 		{
 			platformRouter.GET("/baseinfo", platform.GetPlatformInfo(appInstance))
 			platformRouter.POST("/clientsstatus", app.Wrapper(appInstance, platform.GetClientsStatus))
+			platformRouter.GET("/clientsstatus/stream", platform.StreamClientsStatusHandler(appInstance))
 		}
 		clientRouter := v1.Group("/client")
 		{
-			clientRouter.POST("/get", app.Wrapper(appInstance, client.GetClientHandler))
+			clientRouter.POST("/get", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, client.GetClientHandler)))
 			clientRouter.POST("/init", app.Wrapper(appInstance, client.InitClientHandler))
-			clientRouter.POST("/delete", app.Wrapper(appInstance, client.DeleteClientHandler))
+			clientRouter.POST("/delete", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionDelete, client.DeleteClientHandler)))
 			clientRouter.POST("/list", app.Wrapper(appInstance, client.ListClientsHandler))
-			clientRouter.POST("/install_workerd", app.Wrapper(appInstance, worker.InstallWorkerd))
-			clientRouter.POST("/upgrade", app.Wrapper(appInstance, client.UpgradeFrppHandler))
+			clientRouter.POST("/install_workerd", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, worker.InstallWorkerd)))
+			clientRouter.POST("/upgrade", middleware.RecentMFA(appInstance), app.Wrapper(appInstance, client.UpgradeFrppHandler))
+			clientRouter.POST("/token/list", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, client.ListClientTokensHandler)))
+			clientRouter.POST("/token/revoke", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, client.RevokeClientTokenHandler)))
+			clientRouter.POST("/token/rotate", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, client.RotateClientTokenHandler)))
 		}
 		serverRouter := v1.Group("/server")
 		{
-			serverRouter.POST("/get", app.Wrapper(appInstance, server.GetServerHandler))
+			serverRouter.POST("/get", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, server.GetServerHandler)))
 			serverRouter.POST("/init", app.Wrapper(appInstance, server.InitServerHandler))
-			serverRouter.POST("/delete", app.Wrapper(appInstance, server.DeleteServerHandler))
+			serverRouter.POST("/delete", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionDelete, server.DeleteServerHandler)))
 			serverRouter.POST("/list", app.Wrapper(appInstance, server.ListServersHandler))
 		}
 		frpcRouter := v1.Group("/frpc")
 		{
-			frpcRouter.POST("/update", app.Wrapper(appInstance, client.UpdateFrpcHander))
-			frpcRouter.POST("/delete", app.Wrapper(appInstance, client.RemoveFrpcHandler))
-			frpcRouter.POST("/stop", app.Wrapper(appInstance, client.StopFRPCHandler))
-			frpcRouter.POST("/start", app.Wrapper(appInstance, client.StartFRPCHandler))
+			frpcRouter.POST("/update", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, client.UpdateFrpcHander)))
+			frpcRouter.POST("/delete", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionDelete, client.RemoveFrpcHandler)))
+			frpcRouter.POST("/stop", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, client.StopFRPCHandler)))
+			frpcRouter.POST("/start", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, client.StartFRPCHandler)))
 		}
 		frpsRouter := v1.Group("/frps")
 		{
-			frpsRouter.POST("/update", app.Wrapper(appInstance, server.UpdateFrpsHander))
-			frpsRouter.POST("/delete", app.Wrapper(appInstance, server.RemoveFrpsHandler))
+			frpsRouter.POST("/update", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, server.UpdateFrpsHander)))
+			frpsRouter.POST("/delete", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionDelete, server.RemoveFrpsHandler)))
+		}
+		adminRouter := v1.Group("/admin", middleware.TenantAdminOnly(appInstance))
+		{
+			adminRouter.POST("/users/list", app.Wrapper(appInstance, admin.ListUsersHandler))
+			adminRouter.POST("/users/create", app.Wrapper(appInstance, admin.CreateUserHandler))
+			adminRouter.POST("/users/ban", app.Wrapper(appInstance, admin.BanUserHandler))
+			adminRouter.POST("/users/reset_password", app.Wrapper(appInstance, admin.ResetPasswordHandler))
+			adminRouter.POST("/users/change_role", app.Wrapper(appInstance, admin.ChangeRoleHandler))
+			adminRouter.POST("/users/require_2fa", app.Wrapper(appInstance, admin.RequireTOTPHandler))
+			adminRouter.POST("/users/reset_2fa", app.Wrapper(appInstance, admin.ResetTOTPHandler))
+			adminRouter.POST("/users/assign_tenant", middleware.AdminOnly(appInstance), app.Wrapper(appInstance, tenant.AssignUserTenantHandler))
+			tenantRouter := adminRouter.Group("/tenants", middleware.AdminOnly(appInstance))
+			{
+				tenantRouter.POST("/create", app.Wrapper(appInstance, tenant.CreateTenantHandler))
+				tenantRouter.POST("/list", app.Wrapper(appInstance, tenant.ListTenantsHandler))
+				tenantRouter.POST("/update", app.Wrapper(appInstance, tenant.UpdateTenantHandler))
+				tenantRouter.POST("/delete", app.Wrapper(appInstance, tenant.DeleteTenantHandler))
+			}
+		}
+		trafficQuotaRouter := v1.Group("/traffic_quota")
+		{
+			trafficQuotaRouter.POST("/list", app.Wrapper(appInstance, quota.ListTrafficQuotasHandler))
+			trafficQuotaRouter.POST("/create", middleware.TenantAdminOnly(appInstance), app.Wrapper(appInstance, quota.CreateTrafficQuotaHandler))
+			trafficQuotaRouter.POST("/update", middleware.TenantAdminOnly(appInstance), app.Wrapper(appInstance, quota.UpdateTrafficQuotaHandler))
+			trafficQuotaRouter.POST("/delete", middleware.TenantAdminOnly(appInstance), app.Wrapper(appInstance, quota.DeleteTrafficQuotaHandler))
+		}
+		alertRouter := v1.Group("/alert")
+		{
+			alertRouter.POST("/rule/create", app.Wrapper(appInstance, alert.CreateAlertRuleHandler))
+			alertRouter.POST("/rule/update", app.Wrapper(appInstance, alert.UpdateAlertRuleHandler))
+			alertRouter.POST("/rule/delete", app.Wrapper(appInstance, alert.DeleteAlertRuleHandler))
+			alertRouter.POST("/rule/list", app.Wrapper(appInstance, alert.ListAlertRulesHandler))
+			alertRouter.POST("/channel/create", app.Wrapper(appInstance, alert.CreateAlertChannelHandler))
+			alertRouter.POST("/channel/update", app.Wrapper(appInstance, alert.UpdateAlertChannelHandler))
+			alertRouter.POST("/channel/delete", app.Wrapper(appInstance, alert.DeleteAlertChannelHandler))
+			alertRouter.POST("/channel/list", app.Wrapper(appInstance, alert.ListAlertChannelsHandler))
+			alertRouter.POST("/channel/test", app.Wrapper(appInstance, alert.TestAlertChannelHandler))
+			alertRouter.POST("/event/list", app.Wrapper(appInstance, alert.ListAlertEventsHandler))
+		}
+		webhookRouter := v1.Group("/webhook")
+		{
+			webhookRouter.POST("/subscription/create", app.Wrapper(appInstance, webhook.CreateWebhookSubscriptionHandler))
+			webhookRouter.POST("/subscription/update", app.Wrapper(appInstance, webhook.UpdateWebhookSubscriptionHandler))
+			webhookRouter.POST("/subscription/delete", app.Wrapper(appInstance, webhook.DeleteWebhookSubscriptionHandler))
+			webhookRouter.POST("/subscription/list", app.Wrapper(appInstance, webhook.ListWebhookSubscriptionsHandler))
+			webhookRouter.POST("/delivery/list", app.Wrapper(appInstance, webhook.ListWebhookDeliveriesHandler))
+			webhookRouter.POST("/delivery/redeliver", app.Wrapper(appInstance, webhook.RedeliverWebhookHandler))
+		}
+		groupRouter := v1.Group("/group")
+		{
+			groupRouter.POST("/create", app.Wrapper(appInstance, group.CreateGroupHandler))
+			groupRouter.POST("/list", app.Wrapper(appInstance, group.ListGroupsHandler))
+			groupRouter.POST("/update", app.Wrapper(appInstance, group.UpdateGroupHandler))
+			groupRouter.POST("/delete", app.Wrapper(appInstance, group.DeleteGroupHandler))
+			groupRouter.POST("/member/add", app.Wrapper(appInstance, group.AddGroupMemberHandler))
+			groupRouter.POST("/member/remove", app.Wrapper(appInstance, group.RemoveGroupMemberHandler))
+			groupRouter.POST("/member/list", app.Wrapper(appInstance, group.ListGroupMembersHandler))
+		}
+		permissionRouter := v1.Group("/permission")
+		{
+			permissionRouter.POST("/grant", app.Wrapper(appInstance, permission.GrantPermissionHandler))
+			permissionRouter.POST("/revoke", app.Wrapper(appInstance, permission.RevokePermissionHandler))
+			permissionRouter.POST("/list", app.Wrapper(appInstance, permission.ListPermissionsHandler))
 		}
 		proxyRouter := v1.Group("/proxy")
 		{
-			proxyRouter.POST("/get_by_cid", app.Wrapper(appInstance, proxy.GetProxyStatsByClientID))
-			proxyRouter.POST("/get_by_sid", app.Wrapper(appInstance, proxy.GetProxyStatsByServerID))
-			proxyRouter.POST("/list_configs", app.Wrapper(appInstance, proxy.ListProxyConfigs))
-			proxyRouter.POST("/create_config", app.Wrapper(appInstance, proxy.CreateProxyConfig))
-			proxyRouter.POST("/update_config", app.Wrapper(appInstance, proxy.UpdateProxyConfig))
-			proxyRouter.POST("/delete_config", app.Wrapper(appInstance, proxy.DeleteProxyConfig))
-			proxyRouter.POST("/get_config", app.Wrapper(appInstance, proxy.GetProxyConfig))
-			proxyRouter.POST("/start_proxy", app.Wrapper(appInstance, proxy.StartProxy))
-			proxyRouter.POST("/stop_proxy", app.Wrapper(appInstance, proxy.StopProxy))
+			proxyRouter.POST("/get_by_cid", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, proxy.GetProxyStatsByClientID)))
+			proxyRouter.POST("/get_by_sid", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, proxy.GetProxyStatsByServerID)))
+			proxyRouter.POST("/list_configs", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, proxy.ListProxyConfigs)))
+			proxyRouter.POST("/create_config", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, proxy.CreateProxyConfig)))
+			proxyRouter.POST("/update_config", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, proxy.UpdateProxyConfig)))
+			proxyRouter.POST("/delete_config", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, proxy.DeleteProxyConfig)))
+			proxyRouter.POST("/get_config", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, proxy.GetProxyConfig)))
+			proxyRouter.POST("/start_proxy", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, proxy.StartProxy)))
+			proxyRouter.POST("/stop_proxy", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionUpdate, proxy.StopProxy)))
+			proxyRouter.POST("/traffic", app.Wrapper(appInstance, rbac.WithObjectPermission(defs.RBACActionRead, proxy.QueryTrafficSeries)))
 		}
 		workerHandler := v1.Group("/worker")
 		{
 			workerHandler.POST("/get", app.Wrapper(appInstance, worker.GetWorker))
 			workerHandler.POST("/status", app.Wrapper(appInstance, worker.GetWorkerStatus))
-			workerHandler.POST("/create", app.Wrapper(appInstance, worker.CreateWorker))
+			workerHandler.POST("/create", middleware.RecentMFA(appInstance), app.Wrapper(appInstance, worker.CreateWorker))
 			workerHandler.POST("/list", app.Wrapper(appInstance, worker.ListWorkers))
 			workerHandler.POST("/remove", app.Wrapper(appInstance, worker.RemoveWorker))
 			workerHandler.POST("/update", app.Wrapper(appInstance, worker.UpdateWorker))
@@ -133,9 +228,29 @@ func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 			wgRouter.POST("/get", app.Wrapper(appInstance, wgHandler.GetWireGuard))
 			wgRouter.POST("/list", app.Wrapper(appInstance, wgHandler.ListWireGuards))
 			wgRouter.POST("/runtime/get", app.Wrapper(appInstance, wgHandler.GetWireGuardRuntimeInfo))
+			wgRouter.POST("/config/gen", app.Wrapper(appInstance, wgHandler.GenWireGuardConfig))
+
+			// external peer
+			wgRouter.POST("/external_peer/create", app.Wrapper(appInstance, wgHandler.CreateWireGuardExternalPeer))
+			wgRouter.POST("/external_peer/delete", app.Wrapper(appInstance, wgHandler.DeleteWireGuardExternalPeer))
+			wgRouter.POST("/external_peer/list", app.Wrapper(appInstance, wgHandler.ListWireGuardExternalPeers))
+		}
+
+		auditRouter := v1.Group("/audit")
+		{
+			auditRouter.POST("/list", app.Wrapper(appInstance, audit.ListAuditLogsHandler))
+		}
+
+		recordingRouter := v1.Group("/recording")
+		{
+			recordingRouter.POST("/list", app.Wrapper(appInstance, shell.ListPTYRecordingsHandler))
+			recordingRouter.GET("/download/:sessionID", shell.DownloadPTYRecordingHandler(appInstance))
+			recordingRouter.GET("/replay/:sessionID", shell.ReplayPTYRecordingHandler(appInstance))
 		}
 
-		v1.GET("/pty/:clientID", shell.PTYHandler(appInstance))
+		v1.GET("/pty/:clientID", middleware.RecentMFA(appInstance), shell.PTYHandler(appInstance))
 		v1.GET("/log", streamlog.GetLogHandler(appInstance))
+		v1.GET("/events", platform.StreamEventsHandler(appInstance))
+		v1.POST("/log/search", app.Wrapper(appInstance, streamlog.SearchLogsHandler))
 	}
 }
@@ -0,0 +1,55 @@
+package mfa
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/middleware"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DisableTOTPHandler 关闭两步验证，被管理员要求或全局强制时不允许关闭
+func DisableTOTPHandler(ctx *app.Context, req *pb.DisableTOTPRequest) (*pb.DisableTOTPResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.DisableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if userInfo.IsTOTPRequired() || ctx.GetApp().GetConfig().App.Enforce2FA {
+		return &pb.DisableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "two-factor authentication is required by admin"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userInfo.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyCode(ctx, u, req.GetCode()); err != nil {
+		return &pb.DisableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	u.TOTPEnabled = false
+	u.TOTPSecret = ""
+	u.TOTPLastCounter = 0
+	u.RecoveryCodes = nil
+	if err := dao.NewMutation(ctx).AdminUpdateUser(u, u); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot disable totp, user: [%d]", u.UserID)
+		return nil, err
+	}
+
+	// 旧 token 中的两步验证时间不再有意义，换成普通会话
+	middleware.PushTokenStr(ctx.GetGinCtx(), ctx.GetApp(), conf.GetJWTWithAllPermission(ctx.GetApp().GetConfig(), u.UserID))
+
+	logger.Logger(ctx).Infof("user disable totp success, user: [%d]", u.UserID)
+	return &pb.DisableTOTPResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package mfa
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// EnableTOTPHandler 校验待确认密钥的验证码后开启两步验证，恢复码只在此处明文返回一次
+func EnableTOTPHandler(ctx *app.Context, req *pb.EnableTOTPRequest) (*pb.EnableTOTPResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.EnableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userInfo.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if u.TOTPEnabled || len(u.TOTPSecret) == 0 {
+		return &pb.EnableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "call setup first"},
+		}, nil
+	}
+
+	counter, ok := utils.VerifyTOTP(u.TOTPSecret, req.GetCode(), time.Now(), totpSkew)
+	if !ok {
+		return &pb.EnableTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid two-factor code"},
+		}, nil
+	}
+
+	codes, hashed, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	u.TOTPEnabled = true
+	u.TOTPLastCounter = counter
+	u.RecoveryCodes = hashed
+	if err := dao.NewMutation(ctx).AdminUpdateUser(u, u); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot enable totp, user: [%d]", u.UserID)
+		return nil, err
+	}
+
+	if _, err := pushMFAToken(ctx, u.UserID); err != nil {
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("user enable totp success, user: [%d]", u.UserID)
+	return &pb.EnableTOTPResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		RecoveryCodes: codes,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package mfa
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/middleware"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+const (
+	totpSkew           = 1
+	recoveryCodeCount  = 10
+	maxVerifyFailures  = 5
+	verifyFailureTTL   = 300
+	failureCachePrefix = "mfa-fail:"
+)
+
+// VerifyCode 校验 totp 验证码或一次性恢复码，同一个周期的验证码只能用一次，恢复码用后作废
+// 按来源地址与用户计数，连续失败过多会被临时锁定，防止暴力猜测，也避免他人锁定真实用户
+func VerifyCode(ctx *app.Context, u *models.UserEntity, code string) error {
+	if !u.TOTPEnabled || len(u.TOTPSecret) == 0 {
+		return fmt.Errorf("two-factor authentication is not enabled")
+	}
+
+	failKey := []byte(failureCachePrefix + u.GetUserIDStr() + ":" + requestSource(ctx))
+	attempts, err := takeVerifyAttempt(failKey)
+	if err != nil {
+		return err
+	}
+	if attempts > maxVerifyFailures {
+		return fmt.Errorf("too many failed attempts, try again later")
+	}
+
+	if counter, ok := utils.VerifyTOTP(u.TOTPSecret, code, time.Now(), totpSkew); ok && counter > u.TOTPLastCounter {
+		u.TOTPLastCounter = counter
+		cache.Get().Del(failKey)
+		return dao.NewMutation(ctx).AdminUpdateUser(u, u)
+	}
+
+	hashed := utils.HashRecoveryCode(code)
+	if lo.Contains(u.RecoveryCodes, hashed) {
+		u.RecoveryCodes = lo.Without(u.RecoveryCodes, hashed)
+		cache.Get().Del(failKey)
+		return dao.NewMutation(ctx).AdminUpdateUser(u, u)
+	}
+
+	return fmt.Errorf("invalid two-factor code")
+}
+
+// takeVerifyAttempt 先原子地占用一次尝试再校验，并发请求也不会超过失败上限
+func takeVerifyAttempt(key []byte) (int, error) {
+	attempts := 0
+	_, _, err := cache.Get().Update(key, func(value []byte, found bool) ([]byte, bool, int) {
+		if found {
+			attempts = cast.ToInt(string(value))
+		}
+		attempts++
+		return []byte(fmt.Sprint(attempts)), true, verifyFailureTTL
+	})
+	return attempts, err
+}
+
+// requestSource 请求的来源地址，非 http 请求时为空
+func requestSource(ctx *app.Context) string {
+	if ginCtx, ok := ctx.Context.(*gin.Context); ok && ginCtx.Request != nil {
+		return ginCtx.ClientIP()
+	}
+	return ""
+}
+
+// SignMFAToken 签发带两步验证时间的登录 token
+func SignMFAToken(cfg conf.Config, userID int) (string, error) {
+	return conf.GetJWTWithPayload(cfg, userID, map[string]interface{}{
+		defs.TokenPayloadKey_Permissions: conf.AllPermission(),
+		defs.TokenPayloadKey_MFAAt:       time.Now().Unix(),
+	})
+}
+
+func newRecoveryCodes() ([]string, []string, error) {
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return codes, lo.Map(codes, func(c string, _ int) string { return utils.HashRecoveryCode(c) }), nil
+}
+
+// pushMFAToken 两步验证通过后刷新当前会话的 token
+func pushMFAToken(ctx *app.Context, userID int) (string, error) {
+	tokenStr, err := SignMFAToken(ctx.GetApp().GetConfig(), userID)
+	if err != nil {
+		return "", err
+	}
+	middleware.PushTokenStr(ctx.GetGinCtx(), ctx.GetApp(), tokenStr)
+	return tokenStr, nil
+}
@@ -0,0 +1,132 @@
+package mfa
+
+import (
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ginContext 模拟来自 ip 的已登录请求
+func ginContext(t *testing.T, base *app.Context, userID int, ip string) *app.Context {
+	t.Helper()
+	u, err := dao.NewQuery(base).GetUserByUserID(userID)
+	require.NoError(t, err)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/api/v1/user/2fa/verify", nil)
+	c.Request.RemoteAddr = ip + ":12345"
+	c.Set(defs.UserInfoKey, u)
+	return app.NewContext(c, base.GetApp())
+}
+
+func currentCode(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+	code, err := utils.TOTPCode(secret, time.Now().Unix()/utils.TOTPPeriod+offset)
+	require.NoError(t, err)
+	return code
+}
+
+func enroll(t *testing.T, ctx *app.Context, u *models.UserEntity, ip string) (string, []string) {
+	t.Helper()
+	setup, err := SetupTOTPHandler(ginContext(t, ctx, u.UserID, ip), &pb.SetupTOTPRequest{})
+	require.NoError(t, err)
+	require.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, setup.GetStatus().GetCode())
+
+	resp, err := EnableTOTPHandler(ginContext(t, ctx, u.UserID, ip), &pb.EnableTOTPRequest{Code: lo.ToPtr("000000x")})
+	require.NoError(t, err)
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+
+	resp, err = EnableTOTPHandler(ginContext(t, ctx, u.UserID, ip), &pb.EnableTOTPRequest{Code: lo.ToPtr(currentCode(t, setup.GetSecret(), 0))})
+	require.NoError(t, err)
+	require.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+	return setup.GetSecret(), resp.GetRecoveryCodes()
+}
+
+func verify(t *testing.T, ctx *app.Context, userID int, ip, code string) pb.RespCode {
+	t.Helper()
+	resp, err := VerifyTOTPHandler(ginContext(t, ctx, userID, ip), &pb.VerifyTOTPRequest{Code: lo.ToPtr(code)})
+	require.NoError(t, err)
+	return resp.GetStatus().GetCode()
+}
+
+func TestEnrollAndVerify(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	ip := "10.0.1.1"
+
+	secret, recovery := enroll(t, ctx, alice, ip)
+	assert.Len(t, recovery, recoveryCodeCount)
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(alice.UserID)
+	require.NoError(t, err)
+	assert.True(t, u.TOTPEnabled)
+
+	// 开启时用过的验证码不能重放
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, ip, currentCode(t, secret, 0)))
+	assert.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, ip, currentCode(t, secret, 1)))
+
+	// 恢复码只能用一次
+	assert.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, ip, recovery[0]))
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, ip, recovery[0]))
+}
+
+func TestVerifyLockoutPerSource(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	_, recovery := enroll(t, ctx, alice, "10.0.2.1")
+
+	attacker, owner := "10.0.2.2", "10.0.2.3"
+	for i := 0; i < maxVerifyFailures; i++ {
+		assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, attacker, "123456"))
+	}
+
+	// 攻击者被锁定后即使猜中也不会通过
+	assert.NotEqual(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, attacker, recovery[0]))
+	// 真实用户从其他地址仍可验证
+	assert.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, verify(t, ctx, alice.UserID, owner, recovery[0]))
+}
+
+func TestVerifyCodeConcurrentAttempts(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	enroll(t, ctx, alice, "10.0.3.1")
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(alice.UserID)
+	require.NoError(t, err)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		checked int
+	)
+	for i := 0; i < 20; i++ {
+		reqCtx := ginContext(t, ctx, alice.UserID, "10.0.3.2")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := VerifyCode(reqCtx, u, "123456")
+			if err != nil && err.Error() == "invalid two-factor code" {
+				mu.Lock()
+				checked++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 并发请求中真正被校验的次数不会超过上限
+	assert.LessOrEqual(t, checked, maxVerifyFailures)
+}
@@ -0,0 +1,46 @@
+package mfa
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// RegenerateRecoveryCodesHandler 重新生成恢复码，旧的恢复码全部作废
+func RegenerateRecoveryCodesHandler(ctx *app.Context, req *pb.RegenerateRecoveryCodesRequest) (*pb.RegenerateRecoveryCodesResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.RegenerateRecoveryCodesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userInfo.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyCode(ctx, u, req.GetCode()); err != nil {
+		return &pb.RegenerateRecoveryCodesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	codes, hashed, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	u.RecoveryCodes = hashed
+	if err := dao.NewMutation(ctx).AdminUpdateUser(u, u); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save recovery codes, user: [%d]", u.UserID)
+		return nil, err
+	}
+
+	return &pb.RegenerateRecoveryCodesResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		RecoveryCodes: codes,
+	}, nil
+}
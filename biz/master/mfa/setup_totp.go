@@ -0,0 +1,50 @@
+package mfa
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// SetupTOTPHandler 生成待确认的 totp 密钥，需要调用 enable 校验一次验证码后才会生效
+func SetupTOTPHandler(ctx *app.Context, req *pb.SetupTOTPRequest) (*pb.SetupTOTPResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.SetupTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if userInfo.IsTOTPEnabled() {
+		return &pb.SetupTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_ALREADY_EXISTS, Message: "two-factor authentication already enabled"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userInfo.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	u.TOTPSecret = secret
+	if err := dao.NewMutation(ctx).AdminUpdateUser(u, u); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save totp secret, user: [%d]", u.UserID)
+		return nil, err
+	}
+
+	return &pb.SetupTOTPResponse{
+		Status:     &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Secret:     lo.ToPtr(secret),
+		OtpauthUrl: lo.ToPtr(utils.TOTPURL(defs.DefaultServiceName, u.UserName, secret)),
+	}, nil
+}
@@ -0,0 +1,50 @@
+package mfa
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/spf13/cast"
+)
+
+// VerifyTOTPHandler 重新进行两步验证，刷新会话中的验证时间，用于访问敏感接口
+func VerifyTOTPHandler(ctx *app.Context, req *pb.VerifyTOTPRequest) (*pb.VerifyTOTPResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	// API token 不能换取会话 token
+	if len(cast.ToString(ctx.Value(defs.TokenPayloadKey_JTI))) > 0 {
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "api token can not be verified"},
+		}, nil
+	}
+
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userInfo.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyCode(ctx, u, req.GetCode()); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("verify totp failed, user: [%d]", u.UserID)
+		return &pb.VerifyTOTPResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	tokenStr, err := pushMFAToken(ctx, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyTOTPResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Token:  &tokenStr,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package permission
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/spf13/cast"
+)
+
+// GrantPermissionHandler 将 client/server 共享给其他用户或用户组
+func GrantPermissionHandler(ctx *app.Context, req *pb.GrantPermissionRequest) (*pb.GrantPermissionResponse, error) {
+	logger.Logger(ctx).Infof("grant permission, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		perm     = req.GetPermission()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.GrantPermissionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	tenantID, err := checkObjectOwner(ctx, userInfo, perm.GetObjType(), perm.GetObjId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot grant permission, obj: [%s:%s]", perm.GetObjType(), perm.GetObjId())
+		return nil, err
+	}
+
+	actions, err := parseActions(perm.GetActions())
+	if err != nil {
+		return nil, err
+	}
+	if len(actions) == 0 {
+		actions = []defs.RBACAction{defs.RBACActionRead}
+	}
+
+	var (
+		permMgr = ctx.GetApp().GetPermManager()
+		objType = defs.RBACObj(perm.GetObjType())
+		objID   = perm.GetObjId()
+	)
+
+	switch defs.RBACSubject(perm.GetSubjectType()) {
+	case defs.RBACSubjectUser:
+		targetUserID, err := cast.ToIntE(perm.GetSubjectId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id: %s", perm.GetSubjectId())
+		}
+		if _, err := dao.NewQuery(ctx).GetUserByUserID(targetUserID); err != nil {
+			return nil, err
+		}
+		for _, action := range actions {
+			if _, err := permMgr.GrantUserPermission(targetUserID, objType, objID, action, tenantID); err != nil {
+				return nil, err
+			}
+		}
+	case defs.RBACSubjectGroup:
+		if _, err := dao.NewQuery(ctx).GetGroup(userInfo, perm.GetSubjectId()); err != nil {
+			return nil, err
+		}
+		for _, action := range actions {
+			if _, err := permMgr.GrantGroupPermission(perm.GetSubjectId(), objType, objID, action, tenantID); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid subject type: %s", perm.GetSubjectType())
+	}
+
+	logger.Logger(ctx).Infof("grant permission success, obj: [%s:%s], subject: [%s:%s], actions: %v",
+		objType, objID, perm.GetSubjectType(), perm.GetSubjectId(), actions)
+	return &pb.GrantPermissionResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package permission
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/samber/lo"
+)
+
+var (
+	shareableObjs    = []defs.RBACObj{defs.RBACObjClient, defs.RBACObjServer}
+	shareableActions = []defs.RBACAction{defs.RBACActionRead, defs.RBACActionUpdate, defs.RBACActionDelete}
+)
+
+// checkObjectOwner 只有资源属主可以管理资源的共享，返回资源所属租户
+func checkObjectOwner(ctx *app.Context, userInfo models.UserInfo, objType, objID string) (int, error) {
+	if !lo.Contains(shareableObjs, defs.RBACObj(objType)) {
+		return 0, fmt.Errorf("invalid obj type: %s", objType)
+	}
+	if len(objID) == 0 {
+		return 0, fmt.Errorf("invalid obj id")
+	}
+
+	ownerID, tenantID, err := rbac.GetObjectOwner(ctx, defs.RBACObj(objType), objID)
+	if err != nil {
+		return 0, err
+	}
+	if ownerID != userInfo.GetUserID() || tenantID != userInfo.GetTenantID() {
+		return 0, fmt.Errorf(defs.ErrPermissionDenied)
+	}
+	return tenantID, nil
+}
+
+func parseActions(actions []string) ([]defs.RBACAction, error) {
+	ret := []defs.RBACAction{}
+	for _, a := range actions {
+		if !lo.Contains(shareableActions, defs.RBACAction(a)) {
+			return nil, fmt.Errorf("invalid action: %s", a)
+		}
+		ret = append(ret, defs.RBACAction(a))
+	}
+	return lo.Uniq(ret), nil
+}
+
+// policiesToPB 将 [sub, obj, act, dom] 形式的策略按主体聚合
+func policiesToPB(objType, objID string, policies [][]string) []*pb.ObjectPermission {
+	ret := []*pb.ObjectPermission{}
+	index := map[string]*pb.ObjectPermission{}
+	for _, p := range policies {
+		if len(p) < 3 {
+			continue
+		}
+		perm, ok := index[p[0]]
+		if !ok {
+			subType, subID, _ := strings.Cut(p[0], ":")
+			perm = &pb.ObjectPermission{
+				ObjType:     lo.ToPtr(objType),
+				ObjId:       lo.ToPtr(objID),
+				SubjectType: lo.ToPtr(subType),
+				SubjectId:   lo.ToPtr(subID),
+			}
+			index[p[0]] = perm
+			ret = append(ret, perm)
+		}
+		perm.Actions = append(perm.Actions, p[2])
+	}
+	return ret
+}
@@ -0,0 +1,39 @@
+package permission
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func ListPermissionsHandler(ctx *app.Context, req *pb.ListPermissionsRequest) (*pb.ListPermissionsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		objType  = req.GetObjType()
+		objID    = req.GetObjId()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListPermissionsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	tenantID, err := checkObjectOwner(ctx, userInfo, objType, objID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list permissions, obj: [%s:%s]", objType, objID)
+		return nil, err
+	}
+
+	policies, err := ctx.GetApp().GetPermManager().ListObjectPolicies(defs.RBACObj(objType), objID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPermissionsResponse{
+		Status:      &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Permissions: policiesToPB(objType, objID, policies),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package platform
+
+import (
+	"sync"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+const statusSubscriberBufSize = 256
+
+// ClientStatusManager 缓存后台心跳探测到的客户端状态，状态变化时推送给订阅者
+type ClientStatusManager struct {
+	mu          sync.RWMutex
+	statuses    map[string]*pb.ClientStatus
+	subscribers map[string]chan *pb.ClientStatus
+}
+
+func NewClientStatusManager() app.ClientStatusManager {
+	return &ClientStatusManager{
+		statuses:    map[string]*pb.ClientStatus{},
+		subscribers: map[string]chan *pb.ClientStatus{},
+	}
+}
+
+func (m *ClientStatusManager) Get(clientID string) (*pb.ClientStatus, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.statuses[clientID]
+	return s, ok
+}
+
+func (m *ClientStatusManager) List() []*pb.ClientStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := make([]*pb.ClientStatus, 0, len(m.statuses))
+	for _, s := range m.statuses {
+		list = append(list, s)
+	}
+	return list
+}
+
+// Update 写入最新状态，在线状态、版本或连接时间变化时推送，仅延迟变化不推送
+// 离线的客户端推送后从缓存中移除
+func (m *ClientStatusManager) Update(status *pb.ClientStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	old, ok := m.statuses[status.GetClientId()]
+	if status.GetStatus() == pb.ClientStatus_STATUS_OFFLINE {
+		delete(m.statuses, status.GetClientId())
+	} else {
+		m.statuses[status.GetClientId()] = status
+	}
+
+	if ok && !statusChanged(old, status) {
+		return
+	}
+	if !ok && status.GetStatus() == pb.ClientStatus_STATUS_OFFLINE {
+		return
+	}
+	for _, ch := range m.subscribers {
+		select {
+		case ch <- status:
+		default:
+		}
+	}
+}
+
+func (m *ClientStatusManager) Subscribe() (string, <-chan *pb.ClientStatus) {
+	id := uuid.New().String()
+	ch := make(chan *pb.ClientStatus, statusSubscriberBufSize)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers[id] = ch
+	return id, ch
+}
+
+func (m *ClientStatusManager) Unsubscribe(subID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ch, ok := m.subscribers[subID]; ok {
+		delete(m.subscribers, subID)
+		close(ch)
+	}
+}
+
+func statusChanged(old, cur *pb.ClientStatus) bool {
+	return old.GetStatus() != cur.GetStatus() ||
+		old.GetConnectTime() != cur.GetConnectTime() ||
+		!proto.Equal(old.GetVersion(), cur.GetVersion())
+}
@@ -0,0 +1,102 @@
+package platform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recvStatus(ch <-chan *pb.ClientStatus) (*pb.ClientStatus, bool) {
+	select {
+	case s := <-ch:
+		return s, true
+	default:
+		return nil, false
+	}
+}
+
+func TestClientStatusManager(t *testing.T) {
+	mgr := NewClientStatusManager()
+	subID, ch := mgr.Subscribe()
+
+	online := &pb.ClientStatus{ClientId: "c1", Status: pb.ClientStatus_STATUS_ONLINE, Ping: 10}
+	mgr.Update(online)
+	got, ok := recvStatus(ch)
+	require.True(t, ok)
+	assert.Equal(t, "c1", got.GetClientId())
+
+	// 只有延迟变化时更新缓存但不推送
+	mgr.Update(&pb.ClientStatus{ClientId: "c1", Status: pb.ClientStatus_STATUS_ONLINE, Ping: 20})
+	_, ok = recvStatus(ch)
+	assert.False(t, ok)
+	cached, ok := mgr.Get("c1")
+	require.True(t, ok)
+	assert.EqualValues(t, 20, cached.GetPing())
+
+	mgr.Update(&pb.ClientStatus{ClientId: "c1", Status: pb.ClientStatus_STATUS_OFFLINE})
+	got, ok = recvStatus(ch)
+	require.True(t, ok)
+	assert.Equal(t, pb.ClientStatus_STATUS_OFFLINE, got.GetStatus())
+	_, ok = mgr.Get("c1")
+	assert.False(t, ok)
+
+	// 未知客户端离线不推送
+	mgr.Update(&pb.ClientStatus{ClientId: "c2", Status: pb.ClientStatus_STATUS_OFFLINE})
+	_, ok = recvStatus(ch)
+	assert.False(t, ok)
+	assert.Empty(t, mgr.List())
+
+	mgr.Unsubscribe(subID)
+	_, open := <-ch
+	assert.False(t, open)
+	mgr.Unsubscribe(subID)
+}
+
+func TestVisibleCacheExpires(t *testing.T) {
+	cache := visibleCache{}
+	calls := 0
+	allowed := true
+	authorize := func() bool {
+		calls++
+		return allowed
+	}
+
+	now := time.Now()
+	assert.True(t, cache.check("c1", now, authorize))
+	allowed = false
+	assert.True(t, cache.check("c1", now.Add(visibleTTL/2), authorize))
+	assert.Equal(t, 1, calls)
+
+	// 过期后重新鉴权，撤销的共享生效
+	assert.False(t, cache.check("c1", now.Add(visibleTTL), authorize))
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetClientsStatus_OnlyReadable(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	ctx.GetApp().SetClientsManager(rpc.NewClientsManager())
+	ctx.GetApp().SetClientStatusManager(NewClientStatusManager())
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+	ctx.GetApp().GetClientsManager().Set("c1", defs.CliTypeClient, &fakeStream{appInstance: ctx.GetApp()})
+
+	req := &pb.GetClientsStatusRequest{ClientIds: []string{"c1"}, ClientType: pb.ClientType_CLIENT_TYPE_FRPC}
+	resp, err := GetClientsStatus(apptest.WithUser(ctx, alice), req)
+	require.NoError(t, err)
+	assert.Equal(t, pb.ClientStatus_STATUS_ONLINE, resp.GetClients()["c1"].GetStatus())
+
+	// 没有读权限的客户端不返回状态与地址
+	resp, err = GetClientsStatus(apptest.WithUser(ctx, bob), req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetClients())
+}
@@ -1,18 +1,14 @@
 package platform
 
 import (
-	"context"
-	"time"
-
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
-	"github.com/VaalaCat/frp-panel/services/rpc"
-	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/proto"
 )
 
+// GetClientsStatus 从心跳缓存读取客户端状态，不再同步 ping 客户端，没有读权限的 id 不返回
 func GetClientsStatus(c *app.Context, req *pb.GetClientsStatusRequest) (*pb.GetClientsStatusResponse, error) {
 	userInfo := common.GetUserInfo(c)
 	if !userInfo.Valid() || req == nil || len(req.GetClientIds()) == 0 || req.GetClientType() == pb.ClientType_CLIENT_TYPE_UNSPECIFIED {
@@ -22,53 +18,39 @@ func GetClientsStatus(c *app.Context, req *pb.GetClientsStatusRequest) (*pb.GetC
 	}
 
 	var (
-		clientIDs = req.GetClientIds()
+		mgr       = c.GetApp().GetClientsManager()
+		statusMgr = c.GetApp().GetClientStatusManager()
 		resps     = map[string]*pb.ClientStatus{}
 	)
 
-	for _, clientID := range clientIDs {
-		mgr := c.GetApp().GetClientsManager()
-		conn := mgr.Get(clientID)
-		if conn == nil {
-			resps[clientID] = &pb.ClientStatus{
-				ClientType: req.GetClientType(),
-				ClientId:   clientID,
-				Status:     pb.ClientStatus_STATUS_OFFLINE,
-				Ping:       -1,
-			}
+	for _, clientID := range req.GetClientIds() {
+		if !statusVisible(c, userInfo, req.GetClientType(), clientID) {
 			continue
 		}
-		startTime := time.Now()
-		tresp, err := rpc.CallClient(c, clientID, pb.Event_EVENT_PING, &pb.CommonRequest{})
-		endTime := time.Now()
-		pingTime := endTime.Sub(startTime).Milliseconds()
-		if err != nil || tresp == nil {
-			logger.Logger(context.Background()).WithError(err).Errorf("get client status error, client id: [%s]", clientID)
-			resps[clientID] = &pb.ClientStatus{
-				ClientType: req.GetClientType(),
-				ClientId:   clientID,
-				Status:     pb.ClientStatus_STATUS_ERROR,
-				Ping:       int32(pingTime),
-			}
+
+		if mgr.Get(clientID) == nil {
+			resps[clientID] = offlineStatus(req.GetClientType(), clientID)
 			continue
 		}
 
-		clientVersion := &pb.ClientVersion{}
-		proto.Unmarshal(tresp.GetData(), clientVersion)
-		connectTime, ok := mgr.ConnectTime(clientID)
-		if !ok {
-			connectTime = time.Time{}
+		if cached, ok := statusMgr.Get(clientID); ok {
+			status := proto.Clone(cached).(*pb.ClientStatus)
+			status.ClientType = req.GetClientType()
+			resps[clientID] = status
+			continue
 		}
 
-		resps[clientID] = &pb.ClientStatus{
-			ClientType:  req.GetClientType(),
-			ClientId:    clientID,
-			Status:      pb.ClientStatus_STATUS_ONLINE,
-			Ping:        int32(pingTime),
-			Version:     clientVersion,
-			Addr:        lo.ToPtr(mgr.ClientAddr(clientID)),
-			ConnectTime: lo.ToPtr(connectTime.UnixMilli()),
+		// 刚连接还未探测过的客户端，先按在线返回
+		status := &pb.ClientStatus{
+			ClientType: req.GetClientType(),
+			ClientId:   clientID,
+			Status:     pb.ClientStatus_STATUS_ONLINE,
+			Addr:       lo.ToPtr(mgr.ClientAddr(clientID)),
+		}
+		if connectTime, ok := mgr.ConnectTime(clientID); ok {
+			status.ConnectTime = lo.ToPtr(connectTime.UnixMilli())
 		}
+		resps[clientID] = status
 	}
 
 	return &pb.GetClientsStatusResponse{
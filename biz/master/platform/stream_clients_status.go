@@ -0,0 +1,117 @@
+package platform
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// visibleTTL SSE 连接上可见性缓存时间，共享被撤销后最多延迟这么久生效
+const visibleTTL = time.Minute
+
+type visibleEntry struct {
+	ok       bool
+	expireAt time.Time
+}
+
+// visibleCache 缓存单个 SSE 连接上的鉴权结果，过期后重新鉴权
+type visibleCache map[string]visibleEntry
+
+func (c visibleCache) check(key string, now time.Time, authorize func() bool) bool {
+	if v, ok := c[key]; ok && now.Before(v.expireAt) {
+		return v.ok
+	}
+	ok := authorize()
+	c[key] = visibleEntry{ok: ok, expireAt: now.Add(visibleTTL)}
+	return ok
+}
+
+func StreamClientsStatusHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		streamClientsStatus(c, appInstance)
+	}
+}
+
+// streamClientsStatus 推送客户端状态变化，先发送当前缓存的状态
+// client_ids 为空时推送当前用户可见的全部客户端与服务端
+func streamClientsStatus(c *gin.Context, appInstance app.Application) {
+	userInfo := common.GetUserInfo(c)
+	if !userInfo.Valid() {
+		c.JSON(http.StatusUnauthorized, common.UnAuth("invalid user"))
+		return
+	}
+
+	ids := lo.Compact(strings.Split(c.Query("client_ids"), ","))
+	filter := lo.SliceToMap(ids, func(id string) (string, bool) { return id, true })
+	ctx := app.NewContext(c, appInstance)
+	visible := visibleCache{}
+	canSee := func(s *pb.ClientStatus) bool {
+		if len(filter) > 0 && !filter[s.GetClientId()] {
+			return false
+		}
+		return visible.check(s.GetClientId(), time.Now(), func() bool {
+			return statusVisible(ctx, userInfo, s.GetClientType(), s.GetClientId())
+		})
+	}
+
+	statusMgr := appInstance.GetClientStatusManager()
+	subID, ch := statusMgr.Subscribe()
+	defer statusMgr.Unsubscribe(subID)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("Content-Encoding", "none")
+	c.Writer.Flush()
+
+	writeStatus := func(s *pb.ClientStatus) error {
+		k, _ := json.Marshal(s)
+		if _, err := c.Writer.WriteString(string(k) + "\r\n"); err != nil {
+			logger.Logger(c).Errorf("write client status error: %v", err)
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	for _, s := range statusMgr.List() {
+		if canSee(s) && writeStatus(s) != nil {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case s, ok := <-ch:
+			if !ok {
+				return
+			}
+			if canSee(s) && writeStatus(s) != nil {
+				return
+			}
+		}
+	}
+}
+
+// statusVisible 与其他接口一致按 rbac 鉴权，被共享的客户端与服务端也能看到状态
+func statusVisible(ctx *app.Context, userInfo models.UserInfo, clientType pb.ClientType, clientID string) bool {
+	objType := defs.RBACObjClient
+	if clientType == pb.ClientType_CLIENT_TYPE_FRPS {
+		objType = defs.RBACObjServer
+	}
+	_, err := rbac.Authorize(ctx, userInfo, objType, clientID, defs.RBACActionRead)
+	return err == nil
+}
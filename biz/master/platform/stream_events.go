@@ -0,0 +1,100 @@
+package platform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const eventKeepaliveInterval = 30 * time.Second
+
+func StreamEventsHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		streamEvents(c, appInstance)
+	}
+}
+
+// streamEvents 以 SSE 推送当前用户有读权限的资源上的事件，types 可按逗号分隔过滤事件类型
+func streamEvents(c *gin.Context, appInstance app.Application) {
+	userInfo := common.GetUserInfo(c)
+	if !userInfo.Valid() {
+		c.JSON(http.StatusUnauthorized, common.UnAuth("invalid user"))
+		return
+	}
+
+	bus := appInstance.GetEventBus()
+	if bus == nil {
+		c.JSON(http.StatusOK, common.Err("event bus is not enabled"))
+		return
+	}
+
+	types := lo.SliceToMap(lo.Compact(strings.Split(c.Query("types"), ",")), func(t string) (string, bool) { return t, true })
+	ctx := app.NewContext(c, appInstance)
+	visible := visibleCache{}
+	canSee := func(e *defs.PanelEvent) bool {
+		if len(types) > 0 && !types[e.Type] {
+			return false
+		}
+		return visible.check(string(e.ObjType)+":"+e.ObjID, time.Now(), func() bool {
+			_, err := rbac.Authorize(ctx, userInfo, e.ObjType, e.ObjID, defs.RBACActionRead)
+			return err == nil
+		})
+	}
+
+	subID, ch := bus.Subscribe()
+	defer bus.Unsubscribe(subID)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("Content-Encoding", "none")
+	c.Writer.Flush()
+
+	write := func(s string) error {
+		if _, err := c.Writer.WriteString(s); err != nil {
+			logger.Logger(c).Errorf("write panel event error: %v", err)
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	ticker := time.NewTicker(eventKeepaliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-ticker.C:
+			if write(": keepalive\n\n") != nil {
+				return
+			}
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+			if !canSee(e) {
+				continue
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				logger.Logger(c).WithError(err).Errorf("marshal panel event error, type: [%s]", e.Type)
+				continue
+			}
+			if write(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)) != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package platform
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"google.golang.org/protobuf/proto"
+)
+
+// probing 探测耗时可能超过任务间隔，避免上一轮未结束时重复执行
+var probing sync.Mutex
+
+// ProbeClientsStatus 并发 ping 全部已连接的客户端与服务端并更新状态缓存，单个客户端最多等待 ClientHeartbeatTimeout
+func ProbeClientsStatus(appInstance app.Application) error {
+	if !probing.TryLock() {
+		return nil
+	}
+	defer probing.Unlock()
+
+	statusMgr := appInstance.GetClientStatusManager()
+	if statusMgr == nil {
+		return nil
+	}
+
+	connectors := appInstance.GetClientsManager().List()
+	p := pool.New().WithMaxGoroutines(defs.ClientHeartbeatConcurrency)
+	for _, conn := range connectors {
+		p.Go(func() {
+			statusMgr.Update(probeClient(appInstance, conn))
+		})
+	}
+	p.Wait()
+
+	connected := lo.SliceToMap(connectors, func(c *defs.Connector) (string, bool) { return c.CliID, true })
+	for _, s := range statusMgr.List() {
+		if !connected[s.GetClientId()] {
+			statusMgr.Update(offlineStatus(s.GetClientType(), s.GetClientId()))
+		}
+	}
+	return nil
+}
+
+func probeClient(appInstance app.Application, conn *defs.Connector) *pb.ClientStatus {
+	mgr := appInstance.GetClientsManager()
+	clientType := pb.ClientType_CLIENT_TYPE_FRPC
+	if conn.CliType == defs.CliTypeServer {
+		clientType = pb.ClientType_CLIENT_TYPE_FRPS
+	}
+
+	status := &pb.ClientStatus{
+		ClientType: clientType,
+		ClientId:   conn.CliID,
+		Addr:       lo.ToPtr(mgr.ClientAddr(conn.CliID)),
+	}
+	if connectTime, ok := mgr.ConnectTime(conn.CliID); ok {
+		status.ConnectTime = lo.ToPtr(connectTime.UnixMilli())
+	}
+	if old, ok := appInstance.GetClientStatusManager().Get(conn.CliID); ok {
+		status.Version = old.GetVersion()
+		status.LastSuccessTime = old.LastSuccessTime
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), defs.ClientHeartbeatTimeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := rpc.CallClient(app.NewContext(timeoutCtx, appInstance), conn.CliID, pb.Event_EVENT_PING, &pb.CommonRequest{})
+	status.Ping = int32(time.Since(start).Milliseconds())
+	if err != nil || resp == nil {
+		logger.Logger(timeoutCtx).WithError(err).Warnf("ping client failed, client id: [%s]", conn.CliID)
+		status.Status = pb.ClientStatus_STATUS_ERROR
+		return status
+	}
+
+	clientVersion := &pb.ClientVersion{}
+	if err := proto.Unmarshal(resp.GetData(), clientVersion); err == nil {
+		status.Version = clientVersion
+	}
+	status.Status = pb.ClientStatus_STATUS_ONLINE
+	status.LastSuccessTime = lo.ToPtr(time.Now().UnixMilli())
+	return status
+}
+
+func offlineStatus(clientType pb.ClientType, clientID string) *pb.ClientStatus {
+	return &pb.ClientStatus{
+		ClientType: clientType,
+		ClientId:   clientID,
+		Status:     pb.ClientStatus_STATUS_OFFLINE,
+		Ping:       -1,
+	}
+}
@@ -0,0 +1,75 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeStream 收到 ping 后立即回复版本信息，broken 时模拟连接已断开
+type fakeStream struct {
+	pb.Master_ServerSendServer
+	appInstance app.Application
+	broken      bool
+}
+
+func (s *fakeStream) Context() context.Context { return context.Background() }
+
+func (s *fakeStream) Send(req *pb.ServerMessage) error {
+	if s.broken {
+		return errors.New("broken pipe")
+	}
+	data, _ := proto.Marshal(&pb.ClientVersion{GitVersion: "v1.0.0"})
+	ch, _ := s.appInstance.GetClientRecvMap().Load(req.GetSessionId())
+	ch.(chan *pb.ClientMessage) <- &pb.ClientMessage{Event: req.GetEvent(), SessionId: req.GetSessionId(), Data: data}
+	return nil
+}
+
+func TestProbeClientsStatus(t *testing.T) {
+	appInstance := app.NewApp()
+	appInstance.SetClientRecvMap(&sync.Map{})
+	appInstance.SetClientsManager(rpc.NewClientsManager())
+	appInstance.SetClientStatusManager(NewClientStatusManager())
+
+	cliMgr := appInstance.GetClientsManager()
+	cliMgr.Set("c1", defs.CliTypeClient, &fakeStream{appInstance: appInstance})
+	cliMgr.Set("s1", defs.CliTypeServer, &fakeStream{appInstance: appInstance, broken: true})
+
+	require.NoError(t, ProbeClientsStatus(appInstance))
+
+	statusMgr := appInstance.GetClientStatusManager()
+	c1, ok := statusMgr.Get("c1")
+	require.True(t, ok)
+	assert.Equal(t, pb.ClientStatus_STATUS_ONLINE, c1.GetStatus())
+	assert.Equal(t, pb.ClientType_CLIENT_TYPE_FRPC, c1.GetClientType())
+	assert.Equal(t, "v1.0.0", c1.GetVersion().GetGitVersion())
+	assert.NotZero(t, c1.GetLastSuccessTime())
+
+	s1, ok := statusMgr.Get("s1")
+	require.True(t, ok)
+	assert.Equal(t, pb.ClientStatus_STATUS_ERROR, s1.GetStatus())
+	assert.Equal(t, pb.ClientType_CLIENT_TYPE_FRPS, s1.GetClientType())
+
+	// 发送失败的连接已被移除，下一轮标记为离线并移出缓存
+	subID, ch := statusMgr.Subscribe()
+	defer statusMgr.Unsubscribe(subID)
+	require.NoError(t, ProbeClientsStatus(appInstance))
+
+	_, ok = statusMgr.Get("s1")
+	assert.False(t, ok)
+	got, ok := recvStatus(ch)
+	require.True(t, ok)
+	assert.Equal(t, "s1", got.GetClientId())
+	assert.Equal(t, pb.ClientStatus_STATUS_OFFLINE, got.GetStatus())
+	assert.Equal(t, []string{"c1"}, lo.Map(statusMgr.List(), func(s *pb.ClientStatus, _ int) string { return s.GetClientId() }))
+}
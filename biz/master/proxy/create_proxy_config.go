@@ -6,10 +6,12 @@ import (
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
@@ -25,7 +27,7 @@ func CreateProxyConfig(c *app.Context, req *pb.CreateProxyConfigRequest) (*pb.Cr
 	}
 
 	var (
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 		clientID = req.GetClientId()
 		serverID = req.GetServerId()
 	)
@@ -79,7 +81,7 @@ type CreateProxyConfigWithTypedConfigParam struct {
 
 func CreateProxyConfigWithTypedConfig(c *app.Context, param CreateProxyConfigWithTypedConfigParam) error {
 	var (
-		userInfo      = common.GetUserInfo(c)
+		userInfo      = common.GetObjectOwnerInfo(c)
 		clientID      = param.ClientID
 		serverID      = param.ServerID
 		clientEntity  = param.ClientEntity
@@ -157,5 +159,11 @@ func CreateProxyConfigWithTypedConfig(c *app.Context, param CreateProxyConfigWit
 		}
 	}
 
+	eventbus.Emit(c, defs.EventProxyConfigCreated, defs.RBACObjClient, clientEntity.ClientID, map[string]any{
+		"server_id":   serverID,
+		"proxy_name":  proxyCfg.Name,
+		"proxy_type":  proxyCfg.Type,
+		"overwritten": existedProxyCfg != nil,
+	})
 	return nil
 }
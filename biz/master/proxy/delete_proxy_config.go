@@ -5,9 +5,11 @@ import (
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
@@ -15,7 +17,7 @@ import (
 
 func DeleteProxyConfig(c *app.Context, req *pb.DeleteProxyConfigRequest) (*pb.DeleteProxyConfigResponse, error) {
 	var (
-		userInfo  = common.GetUserInfo(c)
+		userInfo  = common.GetObjectOwnerInfo(c)
 		clientID  = req.GetClientId()
 		serverID  = req.GetServerId()
 		proxyName = req.GetName()
@@ -80,6 +82,10 @@ func DeleteProxyConfig(c *app.Context, req *pb.DeleteProxyConfigRequest) (*pb.De
 	}
 
 	logger.Logger(c).Infof("delete proxy config, id: [%s], name: [%s]", clientID, proxyName)
+	eventbus.Emit(c, defs.EventProxyConfigDeleted, defs.RBACObjClient, clientID, map[string]any{
+		"server_id":  serverID,
+		"proxy_name": proxyName,
+	})
 
 	return &pb.DeleteProxyConfigResponse{}, nil
 }
@@ -15,7 +15,7 @@ import (
 
 func GetProxyConfig(c *app.Context, req *pb.GetProxyConfigRequest) (*pb.GetProxyConfigResponse, error) {
 	var (
-		userInfo  = common.GetUserInfo(c)
+		userInfo  = common.GetObjectOwnerInfo(c)
 		clientID  = req.GetClientId()
 		serverID  = req.GetServerId()
 		proxyName = req.GetName()
@@ -16,7 +16,7 @@ func GetProxyStatsByClientID(c *app.Context, req *pb.GetProxyStatsByClientIDRequ
 	logger.Logger(c).Infof("get proxy by client id, req: [%+v]", req)
 	var (
 		clientID = req.GetClientId()
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 	)
 
 	if len(clientID) == 0 {
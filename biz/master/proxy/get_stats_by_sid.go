@@ -16,7 +16,7 @@ func GetProxyStatsByServerID(c *app.Context, req *pb.GetProxyStatsByServerIDRequ
 	logger.Logger(c).Infof("get proxy by server id, req: [%+v]", req)
 	var (
 		serverID = req.GetServerId()
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 	)
 
 	if len(serverID) == 0 {
@@ -14,7 +14,7 @@ func ListProxyConfigs(ctx *app.Context, req *pb.ListProxyConfigsRequest) (*pb.Li
 	logger.Logger(ctx).Infof("list proxy configs, req: [%+v]", req)
 
 	var (
-		userInfo = common.GetUserInfo(ctx)
+		userInfo = common.GetObjectOwnerInfo(ctx)
 	)
 
 	if !userInfo.Valid() {
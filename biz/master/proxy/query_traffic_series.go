@@ -0,0 +1,125 @@
+package proxy
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultTrafficSeriesRange = 24 * time.Hour
+	maxTrafficSeriesRange     = 366 * 24 * time.Hour
+	// targetTrafficSeriesPoints 自动选择 step 时期望返回的点数
+	targetTrafficSeriesPoints = 300
+	maxTrafficSeriesPoints    = 10000
+)
+
+// QueryTrafficSeries 按 step 聚合隧道流量增量，返回每个时间桶的流量与速率
+func QueryTrafficSeries(ctx *app.Context, req *pb.QueryTrafficSeriesRequest) (*pb.QueryTrafficSeriesResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.QueryTrafficSeriesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	end := time.Now()
+	if req.GetEndTime() > 0 {
+		end = time.UnixMilli(req.GetEndTime())
+	}
+	start := end.Add(-defaultTrafficSeriesRange)
+	if req.GetStartTime() > 0 {
+		start = time.UnixMilli(req.GetStartTime())
+	}
+	if !start.Before(end) || end.Sub(start) > maxTrafficSeriesRange {
+		return &pb.QueryTrafficSeriesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid time range"},
+		}, nil
+	}
+
+	step := trafficSeriesStep(ctx, start, end, req.GetStep())
+	start = start.Truncate(step)
+
+	filter := dao.TrafficPointFilter{
+		ProxyID:  int(req.GetProxyId()),
+		ClientID: req.GetClientId(),
+		ServerID: req.GetServerId(),
+		Start:    start,
+		End:      end,
+	}
+	if userInfo.IsAdmin() || userInfo.IsTenantAdmin() {
+		filter.UserID = int(req.GetUserId())
+	}
+
+	points, err := dao.NewQuery(ctx).ListTrafficPoints(common.GetObjectOwnerInfo(ctx), filter)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list traffic points, filter: [%+v]", filter)
+		return nil, err
+	}
+	truncated := len(points) >= dao.MaxTrafficPoints
+	if truncated {
+		logger.Logger(ctx).Warnf("traffic points truncated to the latest [%d], filter: [%+v]", len(points), filter)
+	}
+
+	samples, totalIn, totalOut := bucketTrafficPoints(points, start, end, step)
+	return &pb.QueryTrafficSeriesResponse{
+		Status:    &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Samples:   samples,
+		Step:      lo.ToPtr(int64(step.Seconds())),
+		TotalIn:   lo.ToPtr(totalIn),
+		TotalOut:  lo.ToPtr(totalOut),
+		Truncated: lo.ToPtr(truncated),
+	}, nil
+}
+
+// trafficSeriesStep 未指定时按期望点数选择 step，分钟数据已过期的范围至少按小时聚合
+func trafficSeriesStep(ctx *app.Context, start, end time.Time, reqStep int64) time.Duration {
+	minStep := time.Duration(models.TrafficResolutionMinute) * time.Second
+	retention := time.Duration(ctx.GetApp().GetConfig().Master.TrafficMinuteRetentionHours) * time.Hour
+	if start.Before(time.Now().Add(-retention)) {
+		minStep = time.Duration(models.TrafficResolutionHour) * time.Second
+	}
+
+	step := time.Duration(reqStep) * time.Second
+	if step <= 0 {
+		step = end.Sub(start) / targetTrafficSeriesPoints
+	}
+	step = max(step.Truncate(time.Minute), minStep)
+	if end.Sub(start)/step > maxTrafficSeriesPoints {
+		step = (end.Sub(start) / maxTrafficSeriesPoints).Truncate(time.Minute) + time.Minute
+	}
+	return step
+}
+
+func bucketTrafficPoints(points []*models.TrafficPoint, start, end time.Time, step time.Duration) ([]*pb.TrafficSample, int64, int64) {
+	count := int((end.Sub(start) + step - 1) / step)
+	samples := make([]*pb.TrafficSample, count)
+	for i := range samples {
+		samples[i] = &pb.TrafficSample{Timestamp: lo.ToPtr(start.Add(time.Duration(i) * step).UnixMilli())}
+	}
+
+	var totalIn, totalOut int64
+	for _, p := range points {
+		idx := int(p.BucketTime.Sub(start) / step)
+		if idx < 0 || idx >= count {
+			continue
+		}
+		samples[idx].TrafficIn = lo.ToPtr(samples[idx].GetTrafficIn() + p.TrafficIn)
+		samples[idx].TrafficOut = lo.ToPtr(samples[idx].GetTrafficOut() + p.TrafficOut)
+		totalIn += p.TrafficIn
+		totalOut += p.TrafficOut
+	}
+
+	seconds := step.Seconds()
+	for _, s := range samples {
+		s.InRate = lo.ToPtr(float64(s.GetTrafficIn()) / seconds)
+		s.OutRate = lo.ToPtr(float64(s.GetTrafficOut()) / seconds)
+	}
+	return samples, totalIn, totalOut
+}
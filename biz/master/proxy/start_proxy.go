@@ -1,36 +1,48 @@
 package proxy
 
 import (
+	"fmt"
+
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
 )
 
 func StartProxy(ctx *app.Context, req *pb.StartProxyRequest) (*pb.StartProxyResponse, error) {
+	if err := StartProxyConfig(ctx, req.GetClientId(), req.GetServerId(), req.GetName()); err != nil {
+		return nil, err
+	}
 
-	var (
-		userInfo  = common.GetUserInfo(ctx)
-		clientID  = req.GetClientId()
-		serverID  = req.GetServerId()
-		proxyName = req.GetName()
-	)
+	return &pb.StartProxyResponse{
+		Status: &pb.Status{
+			Code:    pb.RespCode_RESP_CODE_SUCCESS,
+			Message: "start proxy success",
+		},
+	}, nil
+}
+
+// StartProxyConfig 启动隧道并下发新的 frpc 配置，流量配额超额停止的隧道在周期重置前不能启动
+func StartProxyConfig(ctx *app.Context, clientID, serverID, proxyName string) error {
+	userInfo := common.GetObjectOwnerInfo(ctx)
 
 	clientEntity, err := GetClientWithMakeShadow(ctx, clientID, serverID)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
-		return nil, err
+		return err
 	}
 
 	_, err = dao.NewQuery(ctx).GetServerByServerID(userInfo, serverID)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
-		return nil, err
+		return err
 	}
 
 	proxyConfig, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
@@ -40,27 +52,37 @@ func StartProxy(ctx *app.Context, req *pb.StartProxyRequest) (*pb.StartProxyResp
 	})
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
-		return nil, err
+		return err
+	}
+
+	quotas, err := dao.NewQuery(ctx).AdminListTrafficQuotasByUserID(proxyConfig.UserID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list traffic quotas, user: [%d]", proxyConfig.UserID)
+		return err
+	}
+	if lo.ContainsBy(quotas, func(q *models.TrafficQuota) bool { return q.Blocking() && q.Covers(proxyConfig) }) {
+		return fmt.Errorf("proxy is stopped by traffic quota until next billing cycle")
 	}
 
 	// 1. 更新proxy状态
 	proxyConfig.Stopped = false
+	proxyConfig.StoppedByQuota = false
 	err = dao.NewMutation(ctx).UpdateProxyConfig(userInfo, proxyConfig)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot update proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
-		return nil, err
+		return err
 	}
 
 	typedProxyConfig, err := proxyConfig.GetTypedProxyConfig()
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get typed proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
-		return nil, err
+		return err
 	}
 
 	// 2. 添加 proxy到client
 	if oldCfg, err := clientEntity.GetConfigContent(); err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get client config, id: [%s]", clientID)
-		return nil, err
+		return err
 	} else {
 		oldCfg.Proxies = lo.Filter(oldCfg.Proxies, func(proxy v1.TypedProxyConfig, _ int) bool {
 			return proxy.GetBaseConfig().Name != proxyName
@@ -69,7 +91,7 @@ func StartProxy(ctx *app.Context, req *pb.StartProxyRequest) (*pb.StartProxyResp
 
 		if err := clientEntity.SetConfigContent(*oldCfg); err != nil {
 			logger.Logger(ctx).WithError(err).Errorf("cannot set client config, id: [%s]", clientID)
-			return nil, err
+			return err
 		}
 	}
 
@@ -77,7 +99,7 @@ func StartProxy(ctx *app.Context, req *pb.StartProxyRequest) (*pb.StartProxyResp
 	rawCfg, err := clientEntity.MarshalJSONConfig()
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot marshal client config, id: [%s]", clientID)
-		return nil, err
+		return err
 	}
 
 	_, err = client.UpdateFrpcHander(ctx, &pb.UpdateFRPCRequest{
@@ -91,10 +113,9 @@ func StartProxy(ctx *app.Context, req *pb.StartProxyRequest) (*pb.StartProxyResp
 		logger.Logger(ctx).WithError(err).Warnf("cannot update frpc, id: [%s]", clientID)
 	}
 
-	return &pb.StartProxyResponse{
-		Status: &pb.Status{
-			Code:    pb.RespCode_RESP_CODE_SUCCESS,
-			Message: "start proxy success",
-		},
-	}, nil
+	eventbus.Emit(ctx, defs.EventProxyStarted, defs.RBACObjClient, clientID, map[string]any{
+		"server_id":  serverID,
+		"proxy_name": proxyName,
+	})
+	return nil
 }
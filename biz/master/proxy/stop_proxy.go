@@ -3,33 +3,44 @@ package proxy
 import (
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
 )
 
 func StopProxy(ctx *app.Context, req *pb.StopProxyRequest) (*pb.StopProxyResponse, error) {
-	var (
-		userInfo  = common.GetUserInfo(ctx)
-		clientID  = req.GetClientId()
-		serverID  = req.GetServerId()
-		proxyName = req.GetName()
-	)
+	if err := StopProxyConfig(ctx, req.GetClientId(), req.GetServerId(), req.GetName(), false); err != nil {
+		return nil, err
+	}
+
+	return &pb.StopProxyResponse{
+		Status: &pb.Status{
+			Code:    pb.RespCode_RESP_CODE_SUCCESS,
+			Message: "stop proxy success",
+		},
+	}, nil
+}
+
+// StopProxyConfig 停止隧道并下发新的 frpc 配置，byQuota 表示由流量配额触发
+func StopProxyConfig(ctx *app.Context, clientID, serverID, proxyName string, byQuota bool) error {
+	userInfo := common.GetObjectOwnerInfo(ctx)
 
 	clientEntity, err := GetClientWithMakeShadow(ctx, clientID, serverID)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
-		return nil, err
+		return err
 	}
 
 	_, err = dao.NewQuery(ctx).GetServerByServerID(userInfo, serverID)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
-		return nil, err
+		return err
 	}
 
 	proxyConfig, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
@@ -39,21 +50,22 @@ func StopProxy(ctx *app.Context, req *pb.StopProxyRequest) (*pb.StopProxyRespons
 	})
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
-		return nil, err
+		return err
 	}
 
 	// 1. 更新proxy状态
 	proxyConfig.Stopped = true
+	proxyConfig.StoppedByQuota = byQuota
 	err = dao.NewMutation(ctx).UpdateProxyConfig(userInfo, proxyConfig)
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot update proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
-		return nil, err
+		return err
 	}
 
 	// 2. 从client移除proxy
 	if oldCfg, err := clientEntity.GetConfigContent(); err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot get client config, id: [%s]", clientID)
-		return nil, err
+		return err
 	} else {
 		oldCfg.Proxies = lo.Filter(oldCfg.Proxies, func(proxy v1.TypedProxyConfig, _ int) bool {
 			return proxy.GetBaseConfig().Name != proxyName
@@ -61,7 +73,7 @@ func StopProxy(ctx *app.Context, req *pb.StopProxyRequest) (*pb.StopProxyRespons
 
 		if err := clientEntity.SetConfigContent(*oldCfg); err != nil {
 			logger.Logger(ctx).WithError(err).Errorf("cannot set client config, id: [%s]", clientID)
-			return nil, err
+			return err
 		}
 	}
 
@@ -69,7 +81,7 @@ func StopProxy(ctx *app.Context, req *pb.StopProxyRequest) (*pb.StopProxyRespons
 	rawCfg, err := clientEntity.MarshalJSONConfig()
 	if err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot marshal client config, id: [%s]", clientID)
-		return nil, err
+		return err
 	}
 
 	_, err = client.UpdateFrpcHander(ctx, &pb.UpdateFRPCRequest{
@@ -83,10 +95,10 @@ func StopProxy(ctx *app.Context, req *pb.StopProxyRequest) (*pb.StopProxyRespons
 		logger.Logger(ctx).WithError(err).Warnf("cannot update frpc, id: [%s]", clientID)
 	}
 
-	return &pb.StopProxyResponse{
-		Status: &pb.Status{
-			Code:    pb.RespCode_RESP_CODE_SUCCESS,
-			Message: "stop proxy success",
-		},
-	}, nil
+	eventbus.Emit(ctx, defs.EventProxyStopped, defs.RBACObjClient, clientID, map[string]any{
+		"server_id":  serverID,
+		"proxy_name": proxyName,
+		"by_quota":   byQuota,
+	})
+	return nil
 }
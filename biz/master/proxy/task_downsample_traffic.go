@@ -0,0 +1,38 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DownsampleTrafficPoints 把过期的分钟流量合并为小时流量，并清理过期的小时流量
+func DownsampleTrafficPoints(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+	cfg := appInstance.GetConfig().Master
+	now := time.Now()
+
+	minuteBefore := now.Add(-time.Duration(cfg.TrafficMinuteRetentionHours) * time.Hour).Truncate(time.Hour)
+	merged, err := dao.NewMutation(ctx).AdminDownsampleTrafficPoints(minuteBefore)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("DownsampleTrafficPoints cannot merge minute points")
+		return err
+	}
+
+	var deleted int64
+	if cfg.TrafficHourRetentionDays > 0 {
+		hourBefore := now.AddDate(0, 0, -cfg.TrafficHourRetentionDays)
+		deleted, err = dao.NewMutation(ctx).AdminDeleteTrafficPointsBefore(models.TrafficResolutionHour, hourBefore)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Error("DownsampleTrafficPoints cannot delete expired hour points")
+			return err
+		}
+	}
+
+	logger.Logger(ctx).Infof("DownsampleTrafficPoints success, merged: %d, deleted: %d", merged, deleted)
+	return nil
+}
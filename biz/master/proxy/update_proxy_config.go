@@ -23,7 +23,7 @@ func UpdateProxyConfig(c *app.Context, req *pb.UpdateProxyConfigRequest) (*pb.Up
 	}
 
 	var (
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 		clientID = req.GetClientId()
 		serverID = req.GetServerId()
 	)
@@ -0,0 +1,69 @@
+package quota
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// AccountTraffic 把服务端上报的流量增量计入流量配额，超额时异步执行超额动作
+func AccountTraffic(ctx *app.Context, points []*models.TrafficPoint) {
+	byUser := lo.GroupBy(points, func(p *models.TrafficPoint) int { return p.UserID })
+	for userID, userPoints := range byUser {
+		quotas, err := dao.NewQuery(ctx).AdminListTrafficQuotasByUserID(userID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot list traffic quotas, user: [%d]", userID)
+			continue
+		}
+		quotas = lo.Filter(quotas, func(q *models.TrafficQuota, _ int) bool { return q.MonthlyBytes > 0 })
+		if len(quotas) == 0 {
+			continue
+		}
+
+		proxyCfgs, err := dao.NewQuery(ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{UserID: userID})
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot list proxy configs, user: [%d]", userID)
+			continue
+		}
+		proxyCfgMap := lo.SliceToMap(proxyCfgs, func(p *models.ProxyConfig) (string, *models.ProxyConfig) {
+			return p.ClientID + "/" + p.Name, p
+		})
+
+		usage := map[uint]int64{}
+		for _, point := range userPoints {
+			proxyCfg, ok := proxyCfgMap[point.ClientID+"/"+point.ProxyName]
+			if !ok {
+				proxyCfg = &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
+					ClientID:       point.ClientID,
+					OriginClientID: point.OriginClientID,
+					UserID:         point.UserID,
+					TenantID:       point.TenantID,
+				}}
+			}
+			for _, q := range quotas {
+				if q.Covers(proxyCfg) {
+					usage[q.ID] += point.TrafficIn + point.TrafficOut
+				}
+			}
+		}
+
+		for id, bytes := range usage {
+			q, err := dao.NewMutation(ctx).AdminAddTrafficQuotaUsage(id, bytes)
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot add traffic quota usage, id: [%d]", id)
+				continue
+			}
+			if q.Exceeded || q.UsedBytes < q.MonthlyBytes {
+				continue
+			}
+			if marked, err := dao.NewMutation(ctx).AdminMarkTrafficQuotaExceeded(id); err != nil || !marked {
+				continue
+			}
+			q.Exceeded = true
+			logger.Logger(ctx).Infof("traffic quota exceeded, id: [%d], used: [%d], monthly: [%d]", q.ID, q.UsedBytes, q.MonthlyBytes)
+			go enforce(ctx.Background(), q)
+		}
+	}
+}
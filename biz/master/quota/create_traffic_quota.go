@@ -0,0 +1,68 @@
+package quota
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CreateTrafficQuotaHandler 给用户、客户端或隧道创建流量配额，从当前计费周期开始计量
+func CreateTrafficQuotaHandler(ctx *app.Context, req *pb.CreateTrafficQuotaRequest) (*pb.CreateTrafficQuotaResponse, error) {
+	logger.Logger(ctx).Infof("create traffic quota, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.CreateTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	reqQuota := req.GetQuota()
+	owner, err := resolveTarget(ctx, userInfo, reqQuota.GetTargetType(), reqQuota.GetTargetId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot resolve traffic quota target, type: [%s], id: [%s]", reqQuota.GetTargetType(), reqQuota.GetTargetId())
+		return &pb.CreateTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	entity := &models.TrafficQuotaEntity{
+		Name:                   reqQuota.GetName(),
+		UserID:                 owner.GetUserID(),
+		TenantID:               owner.GetTenantID(),
+		TargetType:             reqQuota.GetTargetType(),
+		TargetID:               reqQuota.GetTargetId(),
+		MonthlyBytes:           reqQuota.GetMonthlyBytes(),
+		BandwidthLimit:         reqQuota.GetBandwidthLimit(),
+		ExceededAction:         reqQuota.GetExceededAction(),
+		ExceededBandwidthLimit: reqQuota.GetExceededBandwidthLimit(),
+		CycleDay:               int(reqQuota.GetCycleDay()),
+	}
+	if err := validateQuota(entity); err != nil {
+		return &pb.CreateTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+	entity.CycleStart = entity.CycleStartAt(time.Now())
+
+	q, err := dao.NewMutation(ctx).AdminCreateTrafficQuota(entity)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create traffic quota, target: [%s:%s]", entity.TargetType, entity.TargetID)
+		return nil, err
+	}
+
+	if len(q.BandwidthLimit) > 0 {
+		go syncBandwidth(ctx.Background(), q)
+	}
+
+	logger.Logger(ctx).Infof("create traffic quota success, id: [%d]", q.ID)
+	return &pb.CreateTrafficQuotaResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Quota:  q.ToPB(),
+	}, nil
+}
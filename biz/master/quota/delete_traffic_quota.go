@@ -0,0 +1,44 @@
+package quota
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DeleteTrafficQuotaHandler 删除配额，并恢复因该配额被停止或限速的隧道
+func DeleteTrafficQuotaHandler(ctx *app.Context, req *pb.DeleteTrafficQuotaRequest) (*pb.DeleteTrafficQuotaResponse, error) {
+	logger.Logger(ctx).Infof("delete traffic quota, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.DeleteTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	q, err := dao.NewQuery(ctx).GetTrafficQuota(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get traffic quota, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	if err := dao.NewMutation(ctx).AdminDeleteTrafficQuota(q.ID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete traffic quota, id: [%d]", q.ID)
+		return nil, err
+	}
+
+	bgCtx := ctx.Background()
+	if q.Exceeded {
+		go restore(bgCtx, q)
+	} else if len(q.BandwidthLimit) > 0 {
+		go syncBandwidth(bgCtx, q)
+	}
+
+	logger.Logger(ctx).Infof("delete traffic quota success, id: [%d]", q.ID)
+	return &pb.DeleteTrafficQuotaResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,195 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/fatedier/frp/pkg/config/types"
+	"github.com/samber/lo"
+)
+
+// resolveTarget 校验配额目标并返回资源属主，租户管理员只能给本租户的资源配置配额
+func resolveTarget(ctx *app.Context, operator models.UserInfo, targetType, targetID string) (*models.UserEntity, error) {
+	var ownerID int
+	switch targetType {
+	case models.TrafficQuotaTargetUser:
+		id, err := strconv.Atoi(targetID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user id")
+		}
+		ownerID = id
+	case models.TrafficQuotaTargetClient:
+		cli, err := dao.NewQuery(ctx).AdminGetClientByClientID(targetID)
+		if err != nil {
+			return nil, err
+		}
+		ownerID = cli.UserID
+	case models.TrafficQuotaTargetProxy:
+		id, err := strconv.ParseUint(targetID, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy config id")
+		}
+		proxyCfg, err := dao.NewQuery(ctx).AdminGetProxyConfigByID(uint(id))
+		if err != nil {
+			return nil, err
+		}
+		ownerID = proxyCfg.UserID
+	default:
+		return nil, fmt.Errorf("invalid target type")
+	}
+
+	owner, err := dao.NewQuery(ctx).GetUserByUserID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.IsAdmin() && owner.GetTenantID() != operator.GetTenantID() {
+		return nil, fmt.Errorf(defs.ErrPermissionDenied)
+	}
+	return owner, nil
+}
+
+// validateQuota 校验配额参数并补齐默认值
+func validateQuota(q *models.TrafficQuotaEntity) error {
+	if q.MonthlyBytes < 0 {
+		return fmt.Errorf("invalid monthly bytes")
+	}
+	if q.CycleDay == 0 {
+		q.CycleDay = 1
+	}
+	if q.CycleDay < 1 || q.CycleDay > 28 {
+		return fmt.Errorf("cycle day should be between 1 and 28")
+	}
+	if len(q.ExceededAction) == 0 {
+		q.ExceededAction = models.TrafficQuotaActionStop
+	}
+	switch q.ExceededAction {
+	case models.TrafficQuotaActionStop:
+	case models.TrafficQuotaActionLimit:
+		if len(q.ExceededBandwidthLimit) == 0 {
+			return fmt.Errorf("exceeded bandwidth limit is required for limit action")
+		}
+	default:
+		return fmt.Errorf("invalid exceeded action")
+	}
+	for _, l := range []string{q.BandwidthLimit, q.ExceededBandwidthLimit} {
+		if len(l) == 0 {
+			continue
+		}
+		if _, err := types.NewBandwidthQuantity(l); err != nil {
+			return fmt.Errorf("invalid bandwidth limit [%s]: %v", l, err)
+		}
+	}
+	return nil
+}
+
+// ownerContext 以资源属主身份构造 context，停止/启动隧道的流程依赖当前用户
+func ownerContext(ctx *app.Context, userID int) (*app.Context, error) {
+	owner, err := dao.NewQuery(ctx).GetUserByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return app.NewContext(common.WithUserInfo(context.Background(), owner), ctx.GetApp()), nil
+}
+
+// coveredProxies 返回配额作用的全部隧道配置
+func coveredProxies(ctx *app.Context, q *models.TrafficQuota) ([]*models.ProxyConfig, error) {
+	proxyCfgs, err := dao.NewQuery(ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{
+		UserID:   q.UserID,
+		TenantID: q.TenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(proxyCfgs, func(p *models.ProxyConfig, _ int) bool { return q.Covers(p) }), nil
+}
+
+// pushBandwidth 重新下发配额涉及的客户端配置，使新的带宽上限生效
+func pushBandwidth(ctx *app.Context, proxyCfgs []*models.ProxyConfig) {
+	clientIDs := lo.Uniq(lo.FilterMap(proxyCfgs, func(p *models.ProxyConfig, _ int) (string, bool) {
+		return p.ClientID, !p.Stopped
+	}))
+	for _, clientID := range clientIDs {
+		if err := client.PushClientConfig(ctx, clientID); err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot push client config, id: [%s]", clientID)
+		}
+	}
+}
+
+// syncBandwidth 配额的带宽上限变化后重新下发涉及的客户端配置
+func syncBandwidth(ctx *app.Context, q *models.TrafficQuota) {
+	proxyCfgs, err := coveredProxies(ctx, q)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxies of traffic quota, id: [%d]", q.ID)
+		return
+	}
+	pushBandwidth(ctx, proxyCfgs)
+}
+
+// enforce 执行超额动作，停止隧道或下发超额后的限速
+func enforce(ctx *app.Context, q *models.TrafficQuota) {
+	proxyCfgs, err := coveredProxies(ctx, q)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxies of traffic quota, id: [%d]", q.ID)
+		return
+	}
+
+	if q.ExceededAction == models.TrafficQuotaActionLimit {
+		pushBandwidth(ctx, proxyCfgs)
+		return
+	}
+
+	ownerCtx, err := ownerContext(ctx, q.UserID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get owner of traffic quota, id: [%d]", q.ID)
+		return
+	}
+	for _, p := range proxyCfgs {
+		if p.Stopped {
+			continue
+		}
+		if err := proxy.StopProxyConfig(ownerCtx, p.ClientID, p.ServerID, p.Name, true); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot stop proxy exceeded traffic quota, client: [%s], proxy: [%s]", p.ClientID, p.Name)
+			continue
+		}
+		logger.Logger(ctx).Infof("proxy stopped by traffic quota [%d], client: [%s], proxy: [%s]", q.ID, p.ClientID, p.Name)
+	}
+}
+
+// restore 配额不再超额后恢复被停止的隧道或取消超额限速
+func restore(ctx *app.Context, q *models.TrafficQuota) {
+	proxyCfgs, err := coveredProxies(ctx, q)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxies of traffic quota, id: [%d]", q.ID)
+		return
+	}
+
+	if q.ExceededAction == models.TrafficQuotaActionLimit {
+		pushBandwidth(ctx, proxyCfgs)
+		return
+	}
+
+	ownerCtx, err := ownerContext(ctx, q.UserID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get owner of traffic quota, id: [%d]", q.ID)
+		return
+	}
+	for _, p := range proxyCfgs {
+		if !p.StoppedByQuota {
+			continue
+		}
+		if err := proxy.StartProxyConfig(ownerCtx, p.ClientID, p.ServerID, p.Name); err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot restart proxy stopped by traffic quota, client: [%s], proxy: [%s]", p.ClientID, p.Name)
+			continue
+		}
+		logger.Logger(ctx).Infof("proxy restarted by traffic quota [%d], client: [%s], proxy: [%s]", q.ID, p.ClientID, p.Name)
+	}
+}
@@ -0,0 +1,32 @@
+package quota
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListTrafficQuotasHandler 列出当前用户可见的流量配额与本周期用量
+func ListTrafficQuotasHandler(ctx *app.Context, req *pb.ListTrafficQuotasRequest) (*pb.ListTrafficQuotasResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListTrafficQuotasResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	quotas, err := dao.NewQuery(ctx).ListTrafficQuotas(userInfo, req.GetTargetType(), req.GetTargetId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list traffic quotas")
+		return nil, err
+	}
+
+	return &pb.ListTrafficQuotasResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Quotas: lo.Map(quotas, func(q *models.TrafficQuota, _ int) *pb.TrafficQuota { return q.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// ResetTrafficQuotas 进入新计费周期的配额清零用量，并恢复超额时停止或限速的隧道
+func ResetTrafficQuotas(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+	now := time.Now()
+
+	quotas, err := dao.NewQuery(ctx).AdminListTrafficQuotas()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("ResetTrafficQuotas cannot list quotas")
+		return err
+	}
+
+	reset := 0
+	for _, q := range quotas {
+		cycleStart := q.CycleStartAt(now)
+		if !q.CycleStart.Before(cycleStart) {
+			continue
+		}
+		if err := dao.NewMutation(ctx).AdminResetTrafficQuota(q.ID, cycleStart); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("ResetTrafficQuotas cannot reset quota, id: [%d]", q.ID)
+			continue
+		}
+		reset++
+		if q.Exceeded {
+			restore(ctx, q)
+		}
+	}
+
+	logger.Logger(ctx).Infof("ResetTrafficQuotas success, reset: %d", reset)
+	return nil
+}
@@ -0,0 +1,68 @@
+package quota
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// UpdateTrafficQuotaHandler 修改配额限额与超额动作，目标不可修改，调高限额后立即恢复被停止的隧道
+func UpdateTrafficQuotaHandler(ctx *app.Context, req *pb.UpdateTrafficQuotaRequest) (*pb.UpdateTrafficQuotaResponse, error) {
+	logger.Logger(ctx).Infof("update traffic quota, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.UpdateTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	reqQuota := req.GetQuota()
+	q, err := dao.NewQuery(ctx).GetTrafficQuota(userInfo, uint(reqQuota.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get traffic quota, id: [%d]", reqQuota.GetId())
+		return nil, err
+	}
+	before := *q.TrafficQuotaEntity
+
+	q.Name = reqQuota.GetName()
+	q.MonthlyBytes = reqQuota.GetMonthlyBytes()
+	q.BandwidthLimit = reqQuota.GetBandwidthLimit()
+	q.ExceededAction = reqQuota.GetExceededAction()
+	q.ExceededBandwidthLimit = reqQuota.GetExceededBandwidthLimit()
+	q.CycleDay = int(reqQuota.GetCycleDay())
+	if err := validateQuota(q.TrafficQuotaEntity); err != nil {
+		return &pb.UpdateTrafficQuotaResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+	q.Exceeded = q.MonthlyBytes > 0 && q.UsedBytes >= q.MonthlyBytes
+
+	if err := dao.NewMutation(ctx).AdminUpdateTrafficQuota(q); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update traffic quota, id: [%d]", q.ID)
+		return nil, err
+	}
+
+	bgCtx := ctx.Background()
+	switch {
+	case before.Exceeded && !q.Exceeded:
+		beforeQuota := *q
+		beforeQuota.TrafficQuotaEntity = &before
+		go func() {
+			restore(bgCtx, &beforeQuota)
+			syncBandwidth(bgCtx, q)
+		}()
+	case !before.Exceeded && q.Exceeded:
+		go enforce(bgCtx, q)
+	default:
+		go syncBandwidth(bgCtx, q)
+	}
+
+	logger.Logger(ctx).Infof("update traffic quota success, id: [%d]", q.ID)
+	return &pb.UpdateTrafficQuotaResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Quota:  q.ToPB(),
+	}, nil
+}
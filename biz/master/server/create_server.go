@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/VaalaCat/frp-panel/biz/master/tenant"
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
@@ -29,6 +30,12 @@ func InitServerHandler(c *app.Context, req *pb.InitServerRequest) (*pb.InitServe
 		}, nil
 	}
 
+	if err := tenant.CheckServerQuota(c, userInfo.GetTenantID()); err != nil {
+		return &pb.InitServerResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
 	globalServerID := app.GlobalClientID(userInfo.GetUserName(), "s", userServerID)
 
 	if err := dao.NewMutation(c).CreateServer(userInfo,
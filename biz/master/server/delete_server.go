@@ -2,15 +2,17 @@ package server
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
 )
 
 func DeleteServerHandler(c *app.Context, req *pb.DeleteServerRequest) (*pb.DeleteServerResponse, error) {
 	var (
 		userServerID = req.GetServerId()
-		userInfo     = common.GetUserInfo(c)
+		userInfo     = common.GetObjectOwnerInfo(c)
 	)
 
 	if !userInfo.Valid() {
@@ -29,6 +31,10 @@ func DeleteServerHandler(c *app.Context, req *pb.DeleteServerRequest) (*pb.Delet
 		return nil, err
 	}
 
+	if _, err := c.GetApp().GetPermManager().RemoveObjectPolicies(defs.RBACObjServer, userServerID, userInfo.GetTenantID()); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot remove server policies, id: [%s]", userServerID)
+	}
+
 	return &pb.DeleteServerResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 	}, nil
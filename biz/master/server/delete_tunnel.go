@@ -16,7 +16,7 @@ func RemoveFrpsHandler(c *app.Context, req *pb.RemoveFRPSRequest) (*pb.RemoveFRP
 
 	var (
 		serverID = req.GetServerId()
-		userInfo = common.GetUserInfo(c)
+		userInfo = common.GetObjectOwnerInfo(c)
 	)
 
 	srv, err := dao.NewQuery(c).GetServerByServerID(userInfo, serverID)
@@ -11,7 +11,7 @@ import (
 func GetServerHandler(c *app.Context, req *pb.GetServerRequest) (*pb.GetServerResponse, error) {
 	var (
 		userServerID = req.GetServerId()
-		userInfo     = common.GetUserInfo(c)
+		userInfo     = common.GetObjectOwnerInfo(c)
 	)
 
 	if !userInfo.Valid() {
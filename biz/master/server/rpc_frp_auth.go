@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/cache"
@@ -13,30 +14,13 @@ import (
 
 func FRPAuth(ctx *app.Context, req *pb.FRPAuthRequest) (*pb.FRPAuthResponse, error) {
 	logger.Logger(ctx).Infof("frpc auth, req: [%+v]", req)
-	var (
-		err error
-	)
 
-	userToken, err := cache.Get().Get([]byte(req.User))
-	if err != nil {
-		u, err := dao.NewQuery(ctx).GetUserByUserName(req.User)
-		if err != nil || u == nil {
-			logger.Logger(context.Background()).WithError(err).Errorf("invalid user: %s", req.User)
-			return &pb.FRPAuthResponse{
-				Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
-				Ok:     false,
-			}, fmt.Errorf("invalid user: %s", req.User)
-		}
-		cache.Get().Set([]byte(u.GetUserName()), []byte(u.GetToken()), 0)
-		userToken = []byte(u.GetToken())
-	}
-
-	if string(userToken) != req.GetToken() {
-		logger.Logger(ctx).Error("invalid token")
+	if err := validateFRPToken(ctx, req.GetUser(), req.GetClientId(), req.GetToken()); err != nil {
+		logger.Logger(ctx).WithError(err).Error("invalid frp user token")
 		return &pb.FRPAuthResponse{
-			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid token"},
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
 			Ok:     false,
-		}, fmt.Errorf("invalid token")
+		}, err
 	}
 
 	return &pb.FRPAuthResponse{
@@ -44,3 +28,37 @@ func FRPAuth(ctx *app.Context, req *pb.FRPAuthRequest) (*pb.FRPAuthResponse, err
 		Ok:     true,
 	}, nil
 }
+
+// validateFRPToken 优先校验客户端级别 token，允许时回退到用户 token
+func validateFRPToken(ctx *app.Context, userName, clientID, token string) error {
+	err := client.ValidateClientFRPToken(ctx, userName, clientID, token)
+	if err == nil {
+		return nil
+	}
+
+	if !ctx.GetApp().GetConfig().App.AllowUserToken {
+		return err
+	}
+
+	return validateFRPUserToken(ctx, userName, token)
+}
+
+func validateFRPUserToken(ctx *app.Context, userName, token string) error {
+	userToken, err := cache.Get().Get([]byte(userName))
+	if err != nil {
+		u, err := dao.NewQuery(ctx).GetUserByUserName(userName)
+		if err != nil || !u.Valid() {
+			logger.Logger(context.Background()).WithError(err).Errorf("invalid user: %s", userName)
+			return fmt.Errorf("invalid user: %s", userName)
+		}
+		cache.Get().Set([]byte(u.GetUserName()), []byte(u.GetToken()), 0)
+		userToken = []byte(u.GetToken())
+	} else if err := client.ValidateFRPTokenOwner(ctx, userName); err != nil {
+		return err
+	}
+
+	if string(userToken) != token {
+		return fmt.Errorf("invalid token")
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/fatedier/frp/pkg/config/types"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
+	"github.com/samber/lo"
+)
+
+// FRPPluginOp 处理 frps 转发过来的 plugin 操作，Login 走 FRPAuth
+func FRPPluginOp(ctx *app.Context, req *pb.FRPPluginOpRequest) (*pb.FRPPluginOpResponse, error) {
+	logger.Logger(ctx).Debugf("frp plugin op, op: [%s], user: [%s], proxy: [%+v]", req.GetOp(), req.GetUser(), req.GetProxy())
+
+	srv, err := ValidateServerRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate server request")
+		return nil, err
+	}
+
+	// client id 为空时 token 只校验到用户，查 ProxyConfig 时也会匹配该用户的任意客户端
+	if len(req.GetClientId()) == 0 {
+		return rejectPluginOp(ctx, req, fmt.Errorf("client id can not be empty")), nil
+	}
+
+	if err := validateFRPToken(ctx, req.GetUser(), req.GetClientId(), req.GetToken()); err != nil {
+		return rejectPluginOp(ctx, req, err), nil
+	}
+
+	switch req.GetOp() {
+	case plugin.OpNewProxy:
+		err = checkNewProxy(ctx, srv, req)
+	case plugin.OpNewUserConn:
+		err = checkNewUserConn(ctx, srv, req)
+	case plugin.OpCloseProxy, plugin.OpPing, plugin.OpNewWorkConn:
+	default:
+		err = fmt.Errorf("unsupported plugin op: [%s]", req.GetOp())
+	}
+
+	if err != nil {
+		return rejectPluginOp(ctx, req, err), nil
+	}
+
+	return &pb.FRPPluginOpResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func rejectPluginOp(ctx *app.Context, req *pb.FRPPluginOpRequest, err error) *pb.FRPPluginOpResponse {
+	logger.Logger(ctx).WithError(err).Warnf("reject frp plugin op, op: [%s], user: [%s], client: [%s]", req.GetOp(), req.GetUser(), req.GetClientId())
+	return &pb.FRPPluginOpResponse{
+		Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: err.Error()},
+		Reject:       true,
+		RejectReason: err.Error(),
+	}
+}
+
+// getPluginProxyConfig 根据 frps 上报的代理名查找用户在该 server 上保存的 ProxyConfig
+func getPluginProxyConfig(ctx *app.Context, srv *models.ServerEntity, req *pb.FRPPluginOpRequest) (*models.ProxyConfig, error) {
+	u, err := dao.NewQuery(ctx).GetUserByUserName(req.GetUser())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user: %s", req.GetUser())
+	}
+
+	// frpc 会给代理名加上 user. 前缀
+	proxyName := strings.TrimPrefix(req.GetProxy().GetName(), req.GetUser()+".")
+	if len(proxyName) == 0 {
+		return nil, fmt.Errorf("proxy name can not be empty")
+	}
+
+	proxyCfgs, err := dao.NewQuery(ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{
+		ServerID: srv.ServerID,
+		ClientID: req.GetClientId(),
+		UserID:   u.UserID,
+		Name:     proxyName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proxyCfgs) == 0 {
+		return nil, fmt.Errorf("proxy [%s] is not configured for user [%s] on server [%s]", proxyName, req.GetUser(), srv.ServerID)
+	}
+
+	proxyCfg, ok := lo.Find(proxyCfgs, func(p *models.ProxyConfig) bool { return !p.Stopped })
+	if !ok {
+		return nil, fmt.Errorf("proxy [%s] is stopped", proxyName)
+	}
+
+	return proxyCfg, nil
+}
+
+func checkNewProxy(ctx *app.Context, srv *models.ServerEntity, req *pb.FRPPluginOpRequest) error {
+	proxyCfg, err := getPluginProxyConfig(ctx, srv, req)
+	if err != nil {
+		return err
+	}
+
+	typedCfg, err := proxyCfg.GetTypedProxyConfig()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot parse proxy config, name: [%s]", proxyCfg.Name)
+		return fmt.Errorf("invalid proxy config [%s]", proxyCfg.Name)
+	}
+
+	quotas, err := dao.NewQuery(ctx).AdminListTrafficQuotasByUserID(proxyCfg.UserID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list traffic quotas, user: [%d]", proxyCfg.UserID)
+		return fmt.Errorf("cannot check bandwidth limit of proxy [%s]", proxyCfg.Name)
+	}
+
+	return matchPluginProxy(typedCfg, req.GetProxy(), client.QuotaBandwidthLimit(quotas, proxyCfg))
+}
+
+func checkNewUserConn(ctx *app.Context, srv *models.ServerEntity, req *pb.FRPPluginOpRequest) error {
+	proxyCfg, err := getPluginProxyConfig(ctx, srv, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.GetProxy().GetType()) > 0 && proxyCfg.Type != req.GetProxy().GetType() {
+		return fmt.Errorf("proxy [%s] type mismatch", proxyCfg.Name)
+	}
+	return nil
+}
+
+// matchPluginProxy 检查 frpc 注册的代理是否和 master 保存的配置一致，quotaLimit 非空时要求由 frps 按不超过它的上限限速
+func matchPluginProxy(cfg v1.TypedProxyConfig, proxy *pb.FRPPluginProxy, quotaLimit string) error {
+	base := cfg.GetBaseConfig()
+	if base.Type != proxy.GetType() {
+		return fmt.Errorf("proxy [%s] type mismatch, expect [%s], got [%s]", base.Name, base.Type, proxy.GetType())
+	}
+
+	if err := matchPluginBandwidth(base.Name, proxy, quotaLimit); err != nil {
+		return err
+	}
+
+	var (
+		remotePort int
+		domainCfg  *v1.DomainConfig
+	)
+
+	switch c := cfg.ProxyConfigurer.(type) {
+	case *v1.TCPProxyConfig:
+		remotePort = c.RemotePort
+	case *v1.UDPProxyConfig:
+		remotePort = c.RemotePort
+	case *v1.HTTPProxyConfig:
+		domainCfg = &c.DomainConfig
+	case *v1.HTTPSProxyConfig:
+		domainCfg = &c.DomainConfig
+	case *v1.TCPMuxProxyConfig:
+		domainCfg = &c.DomainConfig
+	}
+
+	if remotePort != int(proxy.GetRemotePort()) {
+		return fmt.Errorf("proxy [%s] remote port mismatch, expect [%d], got [%d]", base.Name, remotePort, proxy.GetRemotePort())
+	}
+
+	if domainCfg == nil {
+		if len(proxy.GetSubdomain()) > 0 || len(proxy.GetCustomDomains()) > 0 {
+			return fmt.Errorf("proxy [%s] does not allow domains", base.Name)
+		}
+		return nil
+	}
+
+	if domainCfg.SubDomain != proxy.GetSubdomain() {
+		return fmt.Errorf("proxy [%s] subdomain mismatch, expect [%s], got [%s]", base.Name, domainCfg.SubDomain, proxy.GetSubdomain())
+	}
+
+	if extra, _ := lo.Difference(proxy.GetCustomDomains(), domainCfg.CustomDomains); len(extra) > 0 {
+		return fmt.Errorf("proxy [%s] custom domains %v are not allowed", base.Name, extra)
+	}
+
+	return nil
+}
+
+func matchPluginBandwidth(name string, proxy *pb.FRPPluginProxy, quotaLimit string) error {
+	if len(quotaLimit) == 0 {
+		return nil
+	}
+	want, err := types.NewBandwidthQuantity(quotaLimit)
+	if err != nil {
+		return fmt.Errorf("proxy [%s] has invalid quota bandwidth limit [%s]", name, quotaLimit)
+	}
+
+	if proxy.GetBandwidthLimitMode() != types.BandwidthLimitModeServer {
+		return fmt.Errorf("proxy [%s] bandwidth limit mode must be [%s], got [%s]", name, types.BandwidthLimitModeServer, proxy.GetBandwidthLimitMode())
+	}
+	got, err := types.NewBandwidthQuantity(proxy.GetBandwidthLimit())
+	if err != nil || got.Bytes() <= 0 || got.Bytes() > want.Bytes() {
+		return fmt.Errorf("proxy [%s] bandwidth limit [%s] exceeds quota limit [%s]", name, proxy.GetBandwidthLimit(), quotaLimit)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/fatedier/frp/pkg/config/types"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPluginTest alice 在 s1 上有子客户端 c1，c1 配置了 tcp 代理 web（remote port 6000）
+func setupPluginTest(t *testing.T) (*app.Context, string) {
+	ctx := apptest.NewContext(t)
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	u := apptest.CreateUser(t, ctx, "alice")
+
+	require.NoError(t, db.Create(&models.Server{ServerEntity: &models.ServerEntity{
+		ServerID: "s1", UserID: u.UserID, ConnectSecret: "s1-secret",
+	}}).Error)
+
+	proxy := &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
+		ServerID: "s1", ClientID: "c1", UserID: u.UserID,
+	}}
+	require.NoError(t, proxy.FillTypedProxyConfig(v1.TypedProxyConfig{ProxyConfigurer: &v1.TCPProxyConfig{
+		ProxyBaseConfig: v1.ProxyBaseConfig{Name: "web", Type: "tcp"},
+		RemotePort:      6000,
+	}}))
+	require.NoError(t, db.Create(proxy).Error)
+
+	require.NoError(t, db.Create(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		ClientID: "c1", UserID: u.UserID, Token: "c1-frp-token", Status: defs.TokenStatusActive,
+	}}).Error)
+	return ctx, "c1-frp-token"
+}
+
+func newProxyReq(clientID, token string, port int32) *pb.FRPPluginOpRequest {
+	return &pb.FRPPluginOpRequest{
+		Base:     &pb.ServerBase{ServerId: "s1", ServerSecret: "s1-secret"},
+		Op:       plugin.OpNewProxy,
+		User:     "alice",
+		ClientId: clientID,
+		Token:    token,
+		Proxy:    &pb.FRPPluginProxy{Name: "alice.web", Type: "tcp", RemotePort: port},
+	}
+}
+
+func TestFRPPluginOp_NewProxy(t *testing.T) {
+	ctx, token := setupPluginTest(t)
+
+	resp, err := FRPPluginOp(ctx, newProxyReq("c1", token, 6000))
+	require.NoError(t, err)
+	assert.False(t, resp.GetReject(), resp.GetRejectReason())
+
+	// 端口与 master 保存的配置不一致
+	resp, err = FRPPluginOp(ctx, newProxyReq("c1", token, 7000))
+	require.NoError(t, err)
+	assert.True(t, resp.GetReject())
+}
+
+func TestFRPPluginOp_RejectEmptyOrForeignClientID(t *testing.T) {
+	ctx, token := setupPluginTest(t)
+
+	resp, err := FRPPluginOp(ctx, newProxyReq("", token, 6000))
+	require.NoError(t, err)
+	assert.True(t, resp.GetReject())
+
+	// token 属于 c1，不能冒用其他客户端
+	resp, err = FRPPluginOp(ctx, newProxyReq("c2", token, 6000))
+	require.NoError(t, err)
+	assert.True(t, resp.GetReject())
+}
+
+func TestFRPPluginOp_RejectInvalidServerSecret(t *testing.T) {
+	ctx, token := setupPluginTest(t)
+
+	req := newProxyReq("c1", token, 6000)
+	req.Base.ServerSecret = "wrong"
+	_, err := FRPPluginOp(ctx, req)
+	assert.Error(t, err)
+}
+
+func TestFRPPluginOp_NewProxyBandwidthQuota(t *testing.T) {
+	ctx, token := setupPluginTest(t)
+	u, err := dao.NewQuery(ctx).GetUserByUserName("alice")
+	require.NoError(t, err)
+
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	require.NoError(t, db.Create(&models.TrafficQuota{TrafficQuotaEntity: &models.TrafficQuotaEntity{
+		UserID:         u.UserID,
+		TargetType:     models.TrafficQuotaTargetUser,
+		TargetID:       strconv.Itoa(u.UserID),
+		BandwidthLimit: "1MB",
+	}}).Error)
+
+	newProxy := func(limit, mode string) *pb.FRPPluginOpResponse {
+		req := newProxyReq("c1", token, 6000)
+		req.Proxy.BandwidthLimit, req.Proxy.BandwidthLimitMode = limit, mode
+		resp, err := FRPPluginOp(ctx, req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	assert.False(t, newProxy("1MB", types.BandwidthLimitModeServer).GetReject())
+	assert.False(t, newProxy("512KB", types.BandwidthLimitModeServer).GetReject())
+	// 去掉或放宽上限、改成客户端限速都会被拒绝
+	assert.True(t, newProxy("", "").GetReject())
+	assert.True(t, newProxy("2MB", types.BandwidthLimitModeServer).GetReject())
+	assert.True(t, newProxy("1MB", types.BandwidthLimitModeClient).GetReject())
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/VaalaCat/frp-panel/biz/master/quota"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -15,9 +16,14 @@ func PushProxyInfo(ctx *app.Context, req *pb.PushProxyInfoReq) (*pb.PushProxyInf
 		return nil, err
 	}
 
-	if err = dao.NewMutation(ctx).AdminUpdateProxyStats(srv, req.GetProxyInfos()); err != nil {
+	points, err := dao.NewMutation(ctx).AdminUpdateProxyStats(srv, req.GetProxyInfos())
+	if err != nil {
 		return nil, err
 	}
+	// 配额计费要查多张表，不阻塞服务端的上报
+	if len(points) > 0 {
+		go quota.AccountTraffic(ctx.Background(), points)
+	}
 	return &pb.PushProxyInfoResp{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 	}, nil
@@ -10,6 +10,7 @@ import (
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
@@ -22,7 +23,7 @@ func UpdateFrpsHander(c *app.Context, req *pb.UpdateFRPSRequest) (*pb.UpdateFRPS
 	var (
 		serverID  = req.GetServerId()
 		configStr = req.GetConfig()
-		userInfo  = common.GetUserInfo(c)
+		userInfo  = common.GetObjectOwnerInfo(c)
 	)
 
 	if len(configStr) == 0 || len(serverID) == 0 {
@@ -76,6 +77,7 @@ func UpdateFrpsHander(c *app.Context, req *pb.UpdateFRPSRequest) (*pb.UpdateFRPS
 		}
 	}()
 
+	eventbus.Emit(c, defs.EventConfigUpdated, defs.RBACObjServer, serverID, nil)
 	logger.Logger(c).Infof("update frps success, id: [%s]", serverID)
 	return &pb.UpdateFRPSResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
@@ -8,8 +8,12 @@ import (
 	"time"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/audit"
+	"github.com/VaalaCat/frp-panel/services/rbac"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/fatedier/golib/log"
@@ -30,6 +34,20 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 	connectionErrorLimit := 10
 	keepalivePingTimeout := 10 * time.Second
 
+	clientID := c.Param("clientID")
+	if len(clientID) == 0 {
+		logger.Logger(c).Errorf("invalid client id")
+		c.JSON(http.StatusBadRequest, common.Err("invalid client id"))
+		return
+	}
+
+	// 终端等同于客户端上的 root 权限，需要对客户端有写权限
+	if _, err := rbac.Authorize(app.NewContext(c, appInstance), common.GetUserInfo(c), defs.RBACObjClient, clientID, defs.RBACActionUpdate); err != nil {
+		logger.Logger(c).WithError(err).Warnf("open pty denied, client id: [%s]", clientID)
+		c.JSON(http.StatusForbidden, common.Err(defs.ErrPermissionDenied))
+		return
+	}
+
 	upgrader := getUpgrader(c)
 	webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
@@ -38,13 +56,6 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 		return
 	}
 
-	clientID := c.Param("clientID")
-	if len(clientID) == 0 {
-		logger.Logger(c).Errorf("invalid client id")
-		webConn.Close()
-		return
-	}
-
 	var (
 		initHeight    = c.Query("height")
 		initWidth     = c.Query("width")
@@ -70,9 +81,11 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 		}
 	}
 
-	cliMsg, err := rpc.CallClient(app.NewContext(c, appInstance), clientID, pb.Event_EVENT_START_PTY_CONNECT, &pb.CommonRequest{})
+	appCtx := app.NewContext(c, appInstance)
+	cliMsg, err := rpc.CallClient(appCtx, clientID, pb.Event_EVENT_START_PTY_CONNECT, &pb.CommonRequest{})
 	if err != nil {
 		logger.Logger(c).WithError(err).Errorf("start pty connect error")
+		recordPTY(appCtx, defs.AuditRoutePTYOpen, clientID, "", err, 0)
 		webConn.Close()
 		return
 	}
@@ -85,6 +98,11 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 	}
 
 	sessionID := string(commonResp.GetData())
+	sessionStart := time.Now()
+	recordPTY(appCtx, defs.AuditRoutePTYOpen, clientID, sessionID, nil, 0)
+	defer func() {
+		recordPTY(appCtx, defs.AuditRoutePTYClose, clientID, sessionID, nil, time.Since(sessionStart))
+	}()
 
 	cliConn, ok := appInstance.GetShellPTYMgr().Load(sessionID)
 	if !ok {
@@ -93,6 +111,23 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 		return
 	}
 
+	var recorder *sessionRecorder
+	if appInstance.GetConfig().Master.PTYRecordMandatory || c.Query("record") == "true" {
+		recorder, err = newSessionRecorder(appCtx, clientID, sessionID, initWidthInt, initHeightInt)
+		if err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot start pty recording, session id: [%s]", sessionID)
+			// 强制录像时无法录像就不允许打开终端
+			if appInstance.GetConfig().Master.PTYRecordMandatory {
+				cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true})
+				appInstance.GetShellPTYMgr().SetSessionDone(sessionID)
+				webConn.WriteMessage(websocket.BinaryMessage, []byte("session recording is required but failed to start"))
+				webConn.Close()
+				return
+			}
+		}
+	}
+	defer recorder.Close()
+
 	cliConn.Send(&pb.PTYServerMessage{
 		Height: lo.ToPtr(int32(initHeightInt)),
 		Width:  lo.ToPtr(int32(initWidthInt)),
@@ -171,6 +206,7 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 			}
 
 			readLength := len(cliMsg.GetData())
+			recorder.Output(cliMsg.GetData())
 
 			if err := webConn.WriteMessage(websocket.BinaryMessage, []byte(cliMsg.GetData())); err != nil {
 				logger.Logger(c).Warnf("failed to send %v bytes from client sender to xterm.js", readLength)
@@ -216,6 +252,7 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 			if payload.Width != nil {
 				msg.Width = lo.ToPtr(int32(*payload.Width))
 			}
+			recorder.Resize(payload.Width, payload.Height)
 
 			err = cliConn.Send(msg)
 			if err != nil {
@@ -231,6 +268,24 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 	connectionClosed = true
 }
 
+// recordPTY 记录终端会话的打开与关闭
+func recordPTY(ctx *app.Context, route, clientID, sessionID string, err error, duration time.Duration) {
+	entry := &models.AuditLogEntity{
+		Kind:       defs.AuditKindPTY,
+		Method:     http.MethodGet,
+		Route:      route,
+		TargetID:   clientID,
+		Summary:    fmt.Sprintf("session: %s", sessionID),
+		ResultCode: int(pb.RespCode_RESP_CODE_SUCCESS),
+		DurationMs: duration.Milliseconds(),
+	}
+	if err != nil {
+		entry.ResultCode = int(pb.RespCode_RESP_CODE_INVALID)
+		entry.ResultMessage = err.Error()
+	}
+	audit.Record(ctx, entry)
+}
+
 func getUpgrader(c *gin.Context) websocket.Upgrader {
 	return websocket.Upgrader{
 		// cross origin domain
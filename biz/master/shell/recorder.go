@@ -0,0 +1,133 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultTermWidth  = 80
+	defaultTermHeight = 24
+)
+
+// sessionRecorder 把一次终端会话录制为 asciicast v2 文件，nil 表示不录制，方法均可安全调用
+type sessionRecorder struct {
+	ctx       *app.Context
+	sessionID string
+	file      *os.File
+	writer    *utils.AsciicastWriter
+
+	mu     sync.Mutex
+	width  int
+	height int
+}
+
+func newSessionRecorder(ctx *app.Context, clientID, sessionID string, width, height int) (*sessionRecorder, error) {
+	dir := ctx.GetApp().GetConfig().Master.PTYRecordDir
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	width, height = max(width, 0), max(height, 0)
+	if width == 0 || height == 0 {
+		width, height = defaultTermWidth, defaultTermHeight
+	}
+
+	fileName := uuid.New().String() + ".cast"
+	f, err := os.OpenFile(filepath.Join(dir, fileName), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	w, err := utils.NewAsciicastWriter(f, utils.AsciicastHeader{
+		Width:  width,
+		Height: height,
+		Title:  clientID,
+		Env:    map[string]string{"TERM": "xterm-256color"},
+	}, start)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	record := &models.PTYRecording{PTYRecordingEntity: &models.PTYRecordingEntity{
+		SessionID: sessionID,
+		ClientID:  clientID,
+		FileName:  fileName,
+		StartedAt: start,
+	}}
+	if operator := common.GetUserInfo(ctx); operator != nil {
+		record.UserID = operator.GetUserID()
+		record.TenantID = operator.GetTenantID()
+		record.UserName = operator.GetUserName()
+	}
+	if err := dao.NewMutation(ctx).AdminCreatePTYRecording(record); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	return &sessionRecorder{
+		ctx:       ctx,
+		sessionID: sessionID,
+		file:      f,
+		writer:    w,
+		width:     width,
+		height:    height,
+	}, nil
+}
+
+func (r *sessionRecorder) Output(data []byte) {
+	if r == nil || len(data) == 0 {
+		return
+	}
+	if err := r.writer.WriteOutput(time.Now(), data); err != nil {
+		logger.Logger(r.ctx).WithError(err).Warnf("write pty recording failed, session: [%s]", r.sessionID)
+	}
+}
+
+// Resize 记录窗口大小变化，只传了宽或高时沿用另一边的旧值
+func (r *sessionRecorder) Resize(width, height *uint16) {
+	if r == nil || (width == nil && height == nil) {
+		return
+	}
+	r.mu.Lock()
+	if width != nil {
+		r.width = int(*width)
+	}
+	if height != nil {
+		r.height = int(*height)
+	}
+	w, h := r.width, r.height
+	r.mu.Unlock()
+
+	if err := r.writer.WriteResize(time.Now(), w, h); err != nil {
+		logger.Logger(r.ctx).WithError(err).Warnf("write pty recording failed, session: [%s]", r.sessionID)
+	}
+}
+
+func (r *sessionRecorder) Close() {
+	if r == nil {
+		return
+	}
+	if err := r.writer.Flush(time.Now()); err != nil {
+		logger.Logger(r.ctx).WithError(err).Warnf("flush pty recording failed, session: [%s]", r.sessionID)
+	}
+	if err := r.file.Close(); err != nil {
+		logger.Logger(r.ctx).WithError(err).Warnf("close pty recording failed, session: [%s]", r.sessionID)
+	}
+	if err := dao.NewMutation(r.ctx).AdminFinishPTYRecording(r.sessionID, time.Now(), r.writer.Size()); err != nil {
+		logger.Logger(r.ctx).WithError(err).Errorf("cannot finish pty recording, session: [%s]", r.sessionID)
+	}
+}
@@ -0,0 +1,92 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRecorderFlushOnClose(t *testing.T) {
+	dir := t.TempDir()
+	ctx := apptest.NewContext(t, func(c *conf.Config) { c.Master.PTYRecordDir = dir })
+	alice := apptest.CreateUser(t, ctx, "alice")
+
+	r, err := newSessionRecorder(apptest.WithUser(ctx, alice), "c1", "s1", 80, 24)
+	require.NoError(t, err)
+	r.Output([]byte("ok"))
+	r.Output([]byte("你")[:2])
+	r.Close()
+
+	record, err := dao.NewQuery(ctx).GetPTYRecording(alice, "s1")
+	require.NoError(t, err)
+	require.NotNil(t, record.EndedAt)
+
+	content, err := os.ReadFile(filepath.Join(dir, record.FileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	assert.Len(t, lines, 3)
+	assert.EqualValues(t, len(content), record.Size)
+}
+
+func TestGetPTYRecording_AdminReadsOthers(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	root := apptest.CreateUser(t, ctx, "root", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	require.NoError(t, dao.NewMutation(ctx).AdminCreatePTYRecording(&models.PTYRecording{PTYRecordingEntity: &models.PTYRecordingEntity{
+		SessionID: "s1", ClientID: "c1", FileName: "s1.cast", UserID: alice.UserID, StartedAt: time.Now(),
+	}}))
+
+	// 管理员可以查看其他用户的录像，普通用户只能看到自己的
+	_, err := dao.NewQuery(ctx).GetPTYRecording(root, "s1")
+	assert.NoError(t, err)
+	n, err := dao.NewQuery(ctx).CountPTYRecordings(root, "c1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+	list, err := dao.NewQuery(ctx).ListPTYRecordings(root, 1, 10, "")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	_, err = dao.NewQuery(ctx).GetPTYRecording(bob, "s1")
+	assert.Error(t, err)
+}
+
+func TestCleanExpiredPTYRecordings(t *testing.T) {
+	dir := t.TempDir()
+	ctx := apptest.NewContext(t, func(c *conf.Config) {
+		c.Master.PTYRecordDir = dir
+		c.Master.PTYRecordRetentionDays = 7
+	})
+	alice := apptest.CreateUser(t, ctx, "alice")
+
+	for _, s := range []struct {
+		session string
+		age     time.Duration
+	}{{"old", 8 * 24 * time.Hour}, {"new", time.Hour}} {
+		fileName := s.session + ".cast"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), []byte("{}"), 0600))
+		require.NoError(t, dao.NewMutation(ctx).AdminCreatePTYRecording(&models.PTYRecording{PTYRecordingEntity: &models.PTYRecordingEntity{
+			SessionID: s.session, ClientID: "c1", FileName: fileName, UserID: alice.UserID, StartedAt: time.Now().Add(-s.age),
+		}}))
+	}
+
+	require.NoError(t, CleanExpiredPTYRecordings(ctx.GetApp()))
+
+	_, err := dao.NewQuery(ctx).GetPTYRecording(alice, "old")
+	assert.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "old.cast"))
+
+	_, err = dao.NewQuery(ctx).GetPTYRecording(alice, "new")
+	assert.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "new.cast"))
+}
@@ -0,0 +1,164 @@
+package shell
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+	"github.com/spf13/cast"
+)
+
+// replayMaxIdle 回放时两帧之间最长等待时间，避免长时间无输出的会话回放卡住
+const replayMaxIdle = 2 * time.Second
+
+func ListPTYRecordingsHandler(ctx *app.Context, req *pb.ListPTYRecordingsRequest) (*pb.ListPTYRecordingsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListPTYRecordingsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	recordings, err := dao.NewQuery(ctx).ListPTYRecordings(userInfo, int(req.GetPage()), int(req.GetPageSize()), req.GetClientId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list pty recordings")
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountPTYRecordings(userInfo, req.GetClientId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count pty recordings")
+		return nil, err
+	}
+
+	return &pb.ListPTYRecordingsResponse{
+		Status:     &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:      lo.ToPtr(int32(total)),
+		Recordings: lo.Map(recordings, func(r *models.PTYRecording, _ int) *pb.PTYRecording { return r.ToPB() }),
+	}, nil
+}
+
+// DownloadPTYRecordingHandler 下载 asciicast 格式的录像文件
+func DownloadPTYRecordingHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		f, err := openRecording(c, appInstance)
+		if err != nil {
+			c.JSON(http.StatusNotFound, common.Err(err.Error()))
+			return
+		}
+		defer f.Close()
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", c.Param("sessionID")+".cast"))
+		c.Header("Content-Type", "application/x-asciicast")
+		if _, err := io.Copy(c.Writer, f); err != nil {
+			logger.Logger(c).WithError(err).Warnf("download pty recording failed")
+		}
+	}
+}
+
+// ReplayPTYRecordingHandler 通过 websocket 按原始时间间隔回放录像，
+// 第一条消息是 asciicast 头，之后每条是一个 [time, type, data] 事件，speed 参数控制倍速
+func ReplayPTYRecordingHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		f, err := openRecording(c, appInstance)
+		if err != nil {
+			c.JSON(http.StatusNotFound, common.Err(err.Error()))
+			return
+		}
+		defer f.Close()
+
+		speed := cast.ToFloat64(c.Query("speed"))
+		if speed <= 0 {
+			speed = 1
+		}
+
+		upgrader := getUpgrader(c)
+		webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logger.Logger(c).WithError(err).Infof("websocket connect error")
+			return
+		}
+		defer webConn.Close()
+
+		// 浏览器断开后停止回放
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := webConn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		reader, err := utils.NewAsciicastReader(f)
+		if err != nil {
+			logger.Logger(c).WithError(err).Errorf("invalid pty recording")
+			return
+		}
+
+		header, _ := json.Marshal(reader.Header)
+		if err := webConn.WriteMessage(websocket.TextMessage, header); err != nil {
+			return
+		}
+
+		last := 0.0
+		for {
+			event, err := reader.Next()
+			if err != nil {
+				if err != io.EOF {
+					logger.Logger(c).WithError(err).Warnf("read pty recording failed")
+				}
+				return
+			}
+
+			wait := min(time.Duration((event.Time-last)/speed*float64(time.Second)), replayMaxIdle)
+			last = event.Time
+			select {
+			case <-closed:
+				return
+			case <-time.After(wait):
+			}
+
+			line, _ := json.Marshal(event)
+			if err := webConn.WriteMessage(websocket.TextMessage, line); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func openRecording(c *gin.Context, appInstance app.Application) (*os.File, error) {
+	ctx := app.NewContext(c, appInstance)
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	record, err := dao.NewQuery(ctx).GetPTYRecording(userInfo, c.Param("sessionID"))
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get pty recording, session id: [%s]", c.Param("sessionID"))
+		return nil, fmt.Errorf("recording not found")
+	}
+
+	f, err := os.Open(filepath.Join(appInstance.GetConfig().Master.PTYRecordDir, filepath.Base(record.FileName)))
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot open pty recording file, session id: [%s]", record.SessionID)
+		return nil, fmt.Errorf("recording file not found")
+	}
+	return f, nil
+}
@@ -0,0 +1,47 @@
+package shell
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CleanExpiredPTYRecordings 清理超过保留天数的终端会话录像与对应文件
+func CleanExpiredPTYRecordings(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	cfg := appInstance.GetConfig().Master
+	if cfg.PTYRecordRetentionDays <= 0 {
+		return nil
+	}
+
+	records, err := dao.NewQuery(ctx).AdminListPTYRecordingsBefore(time.Now().AddDate(0, 0, -cfg.PTYRecordRetentionDays))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanExpiredPTYRecordings cannot list recordings")
+		return err
+	}
+
+	deleted := 0
+	for _, record := range records {
+		path := filepath.Join(cfg.PTYRecordDir, filepath.Base(record.FileName))
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logger.Logger(ctx).WithError(err).Warnf("CleanExpiredPTYRecordings cannot remove file: [%s]", path)
+			continue
+		}
+		if err := dao.NewMutation(ctx).AdminDeletePTYRecording(record.SessionID); err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("CleanExpiredPTYRecordings cannot delete recording: [%s]", record.SessionID)
+			continue
+		}
+		deleted++
+	}
+
+	logger.Logger(ctx).Infof("CleanExpiredPTYRecordings success, deleted: [%d]", deleted)
+	return nil
+}
@@ -0,0 +1,164 @@
+package streamlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/logarchive"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+const (
+	DefaultSearchLimit = 1000
+	MaxSearchLimit     = 10000
+)
+
+// archiveLine 开启归档时把日志落盘，客户端推送的内容是 base64 编码的
+func archiveLine(ctx *app.Context, clientID string, line logger.StreamLogLine) {
+	archive := ctx.GetApp().GetLogArchive()
+	if archive == nil {
+		return
+	}
+
+	msg, err := utils.DecodeBase64(line.Msg)
+	if err != nil {
+		msg = line.Msg
+	}
+
+	if err := archive.Append(clientID, logarchive.Entry{
+		Time:  time.Now().UnixMilli(),
+		Pkg:   line.Pkg,
+		Level: line.Level,
+		Msg:   msg,
+	}); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot archive stream log, id: [%s]", clientID)
+	}
+}
+
+// StartArchiveStream 开启归档时，客户端/服务端连上 master 后就让它持续推送全部日志
+func StartArchiveStream(ctx *app.Context, clientID string) {
+	if ctx.GetApp().GetLogArchive() == nil {
+		return
+	}
+
+	lock := ctx.GetApp().GetClientLogManager().GetClientLock(clientID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, err := rpc.CallClient(ctx, clientID, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{}); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot start archive stream log, id: [%s]", clientID)
+	}
+}
+
+func SearchLogsHandler(ctx *app.Context, req *pb.SearchLogsRequest) (*pb.SearchLogsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.SearchLogsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	archive := ctx.GetApp().GetLogArchive()
+	if archive == nil {
+		return &pb.SearchLogsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "log archive is not enabled"},
+		}, nil
+	}
+
+	clientID := req.GetClientId()
+	if len(clientID) == 0 {
+		return nil, fmt.Errorf("invalid client id")
+	}
+
+	// 日志可能来自客户端也可能来自服务端，任一有读权限即可查看
+	if _, err := rbac.AuthorizeClientOrServer(ctx, userInfo, clientID, defs.RBACActionRead); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot search logs, id: [%s]", clientID)
+		return nil, fmt.Errorf("client or server not found")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	query := logarchive.Query{
+		ClientID: clientID,
+		Pkgs:     req.GetPkgs(),
+		Keyword:  req.GetKeyword(),
+		Regex:    req.GetRegex(),
+		Limit:    min(limit, MaxSearchLimit),
+	}
+	if req.GetStartTime() > 0 {
+		query.Start = time.UnixMilli(req.GetStartTime())
+	}
+	if req.GetEndTime() > 0 {
+		query.End = time.UnixMilli(req.GetEndTime())
+	}
+
+	entries, truncated, err := archive.Search(query)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot search archived logs, id: [%s]", clientID)
+		return nil, err
+	}
+
+	return &pb.SearchLogsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Logs: lo.Map(entries, func(e logarchive.Entry, _ int) *pb.ArchivedLog {
+			return &pb.ArchivedLog{
+				Timestamp: lo.ToPtr(e.Time),
+				Pkg:       lo.ToPtr(e.Pkg),
+				Level:     lo.ToPtr(e.Level),
+				Msg:       lo.ToPtr(e.Msg),
+			}
+		}),
+		Truncated: lo.ToPtr(truncated),
+	}, nil
+}
+
+// CleanExpiredArchivedLogs 关闭过期的分段并删除超过保留天数的归档日志
+func CleanExpiredArchivedLogs(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	archive := appInstance.GetLogArchive()
+	if archive == nil {
+		return nil
+	}
+
+	now := time.Now()
+	before := time.Time{}
+	if days := appInstance.GetConfig().Master.LogArchiveRetentionDays; days > 0 {
+		before = now.AddDate(0, 0, -days)
+	}
+
+	removed, err := archive.Purge(now, before)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanExpiredArchivedLogs cannot purge archived logs")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanExpiredArchivedLogs success, removed: [%d]", removed)
+	return nil
+}
+
+// FlushArchivedLogs 定时把归档的压缩缓冲写到磁盘，进程崩溃时最多丢失一个周期的日志
+func FlushArchivedLogs(appInstance app.Application) error {
+	archive := appInstance.GetLogArchive()
+	if archive == nil {
+		return nil
+	}
+
+	if err := archive.Flush(); err != nil {
+		logger.Logger(context.Background()).WithError(err).Warn("FlushArchivedLogs cannot flush archived logs")
+		return err
+	}
+	return nil
+}
@@ -2,9 +2,10 @@ package streamlog
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/biz/master/server"
@@ -12,14 +13,52 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
 const (
 	CacheBufSize = 4096
+	// HistoryBufSize 每个客户端在 master 上保留的最近日志行数
+	HistoryBufSize = 1000
+	// HubIdleTimeout 没有订阅者且超过该时间没有新日志的客户端会释放历史缓冲
+	HubIdleTimeout = 30 * time.Minute
 )
 
+type logSubscriber struct {
+	ch    chan logger.StreamLogLine
+	pkgs  map[string]bool
+	level logrus.Level
+}
+
+// match 包过滤为空表示全部，级别为最低要显示的级别，老版本客户端不带包名和级别时不过滤
+func (s *logSubscriber) match(line logger.StreamLogLine) bool {
+	if len(s.pkgs) > 0 && len(line.Pkg) > 0 && !s.pkgs[line.Pkg] {
+		return false
+	}
+	if lvl, err := logrus.ParseLevel(line.Level); err == nil && lvl > s.level {
+		return false
+	}
+	return true
+}
+
+type clientLogHub struct {
+	mu          sync.RWMutex
+	history     *utils.RingBuffer[logger.StreamLogLine]
+	subscribers map[string]*logSubscriber
+	// activeAt 最近一次推送或订阅的时间，evicted 表示已被释放，持有旧引用的调用方需要重新获取
+	activeAt atomic.Int64
+	evicted  bool
+}
+
+func (h *clientLogHub) touch() {
+	h.activeAt.Store(time.Now().UnixNano())
+}
+
+// ClientLogManager 按客户端分发实时日志，支持多个订阅者同时查看，并保留最近的日志供新订阅者回看
 type ClientLogManager struct {
-	*utils.SyncMap[string, chan string]
+	hubs           *utils.SyncMap[string, *clientLogHub]
 	clientLocksMap *utils.SyncMap[string, *sync.Mutex]
 }
 
@@ -30,11 +69,129 @@ func (c *ClientLogManager) GetClientLock(clientId string) *sync.Mutex {
 
 func NewClientLogManager() app.ClientLogManager {
 	return &ClientLogManager{
-		SyncMap:        &utils.SyncMap[string, chan string]{},
+		hubs:           &utils.SyncMap[string, *clientLogHub]{},
 		clientLocksMap: &utils.SyncMap[string, *sync.Mutex]{},
 	}
 }
 
+func (c *ClientLogManager) getHub(clientID string) *clientLogHub {
+	hub, loaded := c.hubs.LoadOrStore(clientID, &clientLogHub{
+		history:     utils.NewRingBuffer[logger.StreamLogLine](HistoryBufSize),
+		subscribers: map[string]*logSubscriber{},
+	})
+	if !loaded {
+		hub.touch()
+	}
+	return hub
+}
+
+// Publish 写入历史缓冲并分发给匹配的订阅者，订阅者处理不过来时丢弃，避免阻塞客户端推送
+func (c *ClientLogManager) Publish(clientID string, line logger.StreamLogLine) {
+	hub := c.getHub(clientID)
+	hub.touch()
+	hub.history.Push(line)
+
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	for _, sub := range hub.subscribers {
+		if !sub.match(line) {
+			continue
+		}
+		select {
+		case sub.ch <- line:
+		default:
+		}
+	}
+}
+
+// Subscribe 订阅客户端日志，pkgs 为空表示全部包，level 为空表示全部级别，返回的历史日志已按条件过滤
+func (c *ClientLogManager) Subscribe(clientID string, pkgs []string, level string) (string, <-chan logger.StreamLogLine, []logger.StreamLogLine) {
+	sub := &logSubscriber{
+		ch:    make(chan logger.StreamLogLine, CacheBufSize),
+		pkgs:  lo.SliceToMap(lo.Compact(pkgs), func(p string) (string, bool) { return p, true }),
+		level: logrus.TraceLevel,
+	}
+	if lvl, err := logrus.ParseLevel(level); err == nil {
+		sub.level = lvl
+	}
+
+	id := uuid.New().String()
+
+	var hub *clientLogHub
+	for {
+		hub = c.getHub(clientID)
+		hub.mu.Lock()
+		if !hub.evicted {
+			break
+		}
+		hub.mu.Unlock()
+	}
+	hub.subscribers[id] = sub
+	hub.touch()
+	hub.mu.Unlock()
+
+	return id, sub.ch, lo.Filter(hub.history.Snapshot(), func(l logger.StreamLogLine, _ int) bool { return sub.match(l) })
+}
+
+// Unsubscribe 取消订阅并返回剩余的订阅者数量
+func (c *ClientLogManager) Unsubscribe(clientID string, subID string) int {
+	hub, ok := c.hubs.Load(clientID)
+	if !ok {
+		return 0
+	}
+	hub.touch()
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if sub, ok := hub.subscribers[subID]; ok {
+		delete(hub.subscribers, subID)
+		close(sub.ch)
+	}
+	return len(hub.subscribers)
+}
+
+func (c *ClientLogManager) SubscriberCount(clientID string) int {
+	hub, ok := c.hubs.Load(clientID)
+	if !ok {
+		return 0
+	}
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	return len(hub.subscribers)
+}
+
+// EvictIdle 释放没有订阅者且超过 idle 没有活动的客户端，返回释放的数量
+func (c *ClientLogManager) EvictIdle(idle time.Duration) int {
+	deadline := time.Now().Add(-idle).UnixNano()
+	evicted := 0
+	for _, clientID := range c.hubs.Keys() {
+		hub, ok := c.hubs.Load(clientID)
+		if !ok || hub.activeAt.Load() > deadline {
+			continue
+		}
+
+		hub.mu.Lock()
+		if len(hub.subscribers) == 0 && hub.activeAt.Load() <= deadline {
+			hub.evicted = true
+			c.hubs.Delete(clientID)
+			evicted++
+		}
+		hub.mu.Unlock()
+	}
+	return evicted
+}
+
+// EvictIdleLogHubs 定时释放空闲客户端的日志缓冲，避免已删除或长期离线的客户端一直占用内存
+func EvictIdleLogHubs(appInstance app.Application) error {
+	logMgr := appInstance.GetClientLogManager()
+	if logMgr == nil {
+		return nil
+	}
+	if n := logMgr.EvictIdle(HubIdleTimeout); n > 0 {
+		logger.Logger(context.Background()).Infof("evict idle stream log hubs, count: [%d]", n)
+	}
+	return nil
+}
+
 func PushClientStreamLog(ctx *app.Context, sender pb.Master_PushClientStreamLogServer) error {
 	for {
 		req, err := sender.Recv()
@@ -52,12 +209,13 @@ func PushClientStreamLog(ctx *app.Context, sender pb.Master_PushClientStreamLogS
 			return err
 		}
 
-		ch, ok := ctx.GetApp().GetClientLogManager().Load(req.GetBase().GetClientId())
-		if !ok {
-			return fmt.Errorf("push client stream log cannot find client, id: [%s]", req.GetBase().GetClientId())
+		line := logger.StreamLogLine{
+			Msg:   string(req.GetLog()),
+			Pkg:   req.GetPkg(),
+			Level: req.GetLevel(),
 		}
-
-		ch <- string(req.GetLog())
+		ctx.GetApp().GetClientLogManager().Publish(req.GetBase().GetClientId(), line)
+		archiveLine(ctx, req.GetBase().GetClientId(), line)
 	}
 	return nil
 }
@@ -79,11 +237,13 @@ func PushServerStreamLog(ctx *app.Context, sender pb.Master_PushServerStreamLogS
 			return err
 		}
 
-		ch, ok := ctx.GetApp().GetClientLogManager().Load(req.GetBase().GetServerId())
-		if !ok {
-			return fmt.Errorf("push server stream log cannot find server, id: [%s]", req.GetBase().GetServerId())
+		line := logger.StreamLogLine{
+			Msg:   string(req.GetLog()),
+			Pkg:   req.GetPkg(),
+			Level: req.GetLevel(),
 		}
-		ch <- string(req.GetLog())
+		ctx.GetApp().GetClientLogManager().Publish(req.GetBase().GetServerId(), line)
+		archiveLine(ctx, req.GetBase().GetServerId(), line)
 	}
 	return nil
 }
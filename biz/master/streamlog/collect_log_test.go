@@ -0,0 +1,50 @@
+package streamlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictIdleKeepsSubscribedHubs(t *testing.T) {
+	mgr := NewClientLogManager().(*ClientLogManager)
+	mgr.Publish("idle", logger.StreamLogLine{Msg: "a"})
+	mgr.Publish("watched", logger.StreamLogLine{Msg: "b"})
+	subID, _, _ := mgr.Subscribe("watched", nil, "")
+
+	assert.Equal(t, 0, mgr.EvictIdle(time.Hour))
+	assert.Equal(t, 1, mgr.EvictIdle(0))
+
+	_, ok := mgr.hubs.Load("idle")
+	assert.False(t, ok)
+	_, ok = mgr.hubs.Load("watched")
+	assert.True(t, ok)
+
+	assert.Equal(t, 0, mgr.Unsubscribe("watched", subID))
+	assert.Equal(t, 1, mgr.EvictIdle(0))
+	assert.Empty(t, mgr.hubs.Keys())
+}
+
+func TestSubscribeAfterEvictStartsFreshHub(t *testing.T) {
+	mgr := NewClientLogManager().(*ClientLogManager)
+	mgr.Publish("c1", logger.StreamLogLine{Msg: "old"})
+	require.Equal(t, 1, mgr.EvictIdle(0))
+
+	subID, ch, history := mgr.Subscribe("c1", nil, "")
+	assert.Empty(t, history)
+	assert.Equal(t, 1, mgr.SubscriberCount("c1"))
+
+	mgr.Publish("c1", logger.StreamLogLine{Msg: "new"})
+	assert.Equal(t, "new", (<-ch).Msg)
+	mgr.Unsubscribe("c1", subID)
+}
+
+func TestUnsubscribeUnknownClientDoesNotCreateHub(t *testing.T) {
+	mgr := NewClientLogManager().(*ClientLogManager)
+	assert.Equal(t, 0, mgr.Unsubscribe("missing", "x"))
+	assert.Equal(t, 0, mgr.SubscriberCount("missing"))
+	assert.Empty(t, mgr.hubs.Keys())
+}
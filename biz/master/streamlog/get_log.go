@@ -7,12 +7,14 @@ import (
 	"strings"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rbac"
 	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/gin-gonic/gin"
-	"github.com/sourcegraph/conc"
 )
 
 func GetLogHandler(appInstance app.Application) func(*gin.Context) {
@@ -21,42 +23,49 @@ func GetLogHandler(appInstance app.Application) func(*gin.Context) {
 	}
 }
 
+// getLogHander 订阅客户端实时日志，多个人可以同时查看同一个客户端，
+// 客户端会推送全部包的日志，由 master 按每个订阅者的 pkgs/level 过滤
 func getLogHander(c *gin.Context, appInstance app.Application) {
 	id := c.Query("id")
 	pkgsQuery := c.Query("pkgs")
+	level := c.Query("level")
 	pkgs := strings.Split(pkgsQuery, ",")
-	logger.Logger(c).Infof("user try to get stream log, id: [%s], pkgs: [%s]", id, pkgsQuery)
+	logger.Logger(c).Infof("user try to get stream log, id: [%s], pkgs: [%s], level: [%s]", id, pkgsQuery, level)
 
 	if id == "" {
 		c.JSON(http.StatusBadRequest, common.Err("id is empty"))
 		return
 	}
 
+	if _, err := rbac.AuthorizeClientOrServer(app.NewContext(c, appInstance), common.GetUserInfo(c), id, defs.RBACActionRead); err != nil {
+		logger.Logger(c).WithError(err).Warnf("get stream log denied, id: [%s]", id)
+		c.JSON(http.StatusForbidden, common.Err(defs.ErrPermissionDenied))
+		return
+	}
+
 	if len(pkgs) != 0 {
 		if pkgs[0] == "all" {
 			pkgs = make([]string, 0)
 		}
 	}
 
-	appInstance.GetClientLogManager().GetClientLock(id).Lock()
-	defer appInstance.GetClientLogManager().GetClientLock(id).Unlock()
-
-	ch := make(chan string, CacheBufSize)
-	if oldCh, ok := appInstance.GetClientLogManager().LoadAndDelete(id); ok {
-		close(oldCh)
-	}
-	appInstance.GetClientLogManager().Store(id, ch)
+	logMgr := appInstance.GetClientLogManager()
 
-	_, err := rpc.CallClient(app.NewContext(c, appInstance), id, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{Pkgs: pkgs})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Err(err.Error()))
-		return
+	logMgr.GetClientLock(id).Lock()
+	subID, ch, history := logMgr.Subscribe(id, pkgs, level)
+	var startErr error
+	if logMgr.SubscriberCount(id) == 1 {
+		_, startErr = rpc.CallClient(app.NewContext(c, appInstance), id, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{})
 	}
+	logMgr.GetClientLock(id).Unlock()
 
 	defer func() {
-		appInstance.GetClientLogManager().Delete(id)
-		close(ch)
-		rpc.CallClient(app.NewContext(context.Background(), appInstance), id, pb.Event_EVENT_STOP_STREAM_LOG, &pb.CommonRequest{})
+		logMgr.GetClientLock(id).Lock()
+		defer logMgr.GetClientLock(id).Unlock()
+		// 开启归档时客户端需要一直推送日志，不能停止
+		if logMgr.Unsubscribe(id, subID) == 0 && appInstance.GetLogArchive() == nil {
+			rpc.CallClient(app.NewContext(context.Background(), appInstance), id, pb.Event_EVENT_STOP_STREAM_LOG, &pb.CommonRequest{})
+		}
 	}()
 
 	c.Writer.Header().Set("Content-Type", "text/event-stream")
@@ -66,24 +75,39 @@ func getLogHander(c *gin.Context, appInstance app.Application) {
 	c.Writer.Header().Set("Content-Encoding", "none")
 	c.Writer.Flush()
 
-	var wg conc.WaitGroup
+	writeLine := func(l string) error {
+		k, _ := json.Marshal(l)
+		if _, err := c.Writer.WriteString(string(k) + "\r\n"); err != nil {
+			logger.Logger(c).Errorf("write log error: %v", err)
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	}
 
-	wg.Go(func() {
-		for l := range ch {
-			k, _ := json.Marshal(l)
-			_, err := c.Writer.WriteString(string(k) + "\r\n")
-			if err != nil {
-				logger.Logger(c).Errorf("write log error: %v", err)
-				break
-			}
-			c.Writer.Flush()
+	// 先把缓存的历史日志发给新的订阅者，客户端离线时也能看到
+	for _, l := range history {
+		if writeLine(l.Msg) != nil {
+			return
 		}
-	})
+	}
 
-	select {
-	case <-c.Request.Context().Done():
-		return
-	case <-c.Writer.CloseNotify():
+	if startErr != nil {
+		logger.Logger(c).WithError(startErr).Warnf("cannot start stream log, id: [%s]", id)
+		writeLine(utils.EncodeBase64("[frp-panel] cannot start stream log: " + startErr.Error() + "\n"))
 		return
 	}
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-c.Writer.CloseNotify():
+			return
+		case l, ok := <-ch:
+			if !ok || writeLine(l.Msg) != nil {
+				return
+			}
+		}
+	}
 }
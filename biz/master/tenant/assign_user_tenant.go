@@ -0,0 +1,93 @@
+package tenant
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// AssignUserTenantHandler 将用户连同其名下资源迁移到目标租户，tenant_id 为 0 表示迁回默认租户
+func AssignUserTenantHandler(ctx *app.Context, req *pb.AdminAssignUserTenantRequest) (*pb.AdminAssignUserTenantResponse, error) {
+	logger.Logger(ctx).Infof("admin assign user tenant, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		userID   = int(req.GetUserId())
+		tenantID = int(req.GetTenantId())
+	)
+
+	if userID == userInfo.GetUserID() {
+		return &pb.AdminAssignUserTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "can not operate on yourself"},
+		}, nil
+	}
+
+	target, err := dao.NewQuery(ctx).GetUserByUserID(userID)
+	if err != nil {
+		return &pb.AdminAssignUserTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_NOT_FOUND, Message: "user not found"},
+		}, nil
+	}
+
+	if target.GetTenantID() == tenantID {
+		return &pb.AdminAssignUserTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		}, nil
+	}
+
+	if tenantID != defs.DefaultTenantID {
+		if _, err := dao.NewQuery(ctx).AdminGetTenant(tenantID); err != nil {
+			return &pb.AdminAssignUserTenantResponse{
+				Status: &pb.Status{Code: pb.RespCode_RESP_CODE_NOT_FOUND, Message: "tenant not found"},
+			}, nil
+		}
+	}
+
+	if err := CheckUserQuota(ctx, tenantID); err != nil {
+		return &pb.AdminAssignUserTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	// 迁移前记下用户的资源，原租户下对这些资源的共享授权随之失效
+	clients, err := dao.NewQuery(ctx).GetAllClients(target)
+	if err != nil {
+		return nil, err
+	}
+	servers, err := dao.NewQuery(ctx).GetAllServers(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewMutation(ctx).AdminAssignUserTenant(userID, tenantID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot assign user tenant, user: [%d], tenant: [%d]", userID, tenantID)
+		return nil, err
+	}
+
+	if permMgr := ctx.GetApp().GetPermManager(); permMgr != nil {
+		if _, err := permMgr.RemoveUserFromTenant(userID, target.GetTenantID()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot remove user policies in old tenant, user: [%d], tenant: [%d]",
+				userID, target.GetTenantID())
+			return nil, err
+		}
+		for _, c := range clients {
+			if _, err := permMgr.RemoveObjectPolicies(defs.RBACObjClient, c.ClientID, target.GetTenantID()); err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot remove client policies, id: [%s]", c.ClientID)
+			}
+		}
+		for _, s := range servers {
+			if _, err := permMgr.RemoveObjectPolicies(defs.RBACObjServer, s.ServerID, target.GetTenantID()); err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot remove server policies, id: [%s]", s.ServerID)
+			}
+		}
+	}
+
+	logger.Logger(ctx).Infof("admin assign user tenant success, user: [%d], tenant: [%d] -> [%d]",
+		userID, target.GetTenantID(), tenantID)
+	return &pb.AdminAssignUserTenantResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
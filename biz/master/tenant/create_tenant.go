@@ -0,0 +1,37 @@
+package tenant
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func CreateTenantHandler(ctx *app.Context, req *pb.AdminCreateTenantRequest) (*pb.AdminCreateTenantResponse, error) {
+	logger.Logger(ctx).Infof("admin create tenant, req: [%+v]", req)
+
+	if len(req.GetName()) == 0 || req.GetMaxUsers() < 0 || req.GetMaxClients() < 0 || req.GetMaxServers() < 0 {
+		return &pb.AdminCreateTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid tenant name or quota"},
+		}, nil
+	}
+
+	t, err := dao.NewMutation(ctx).AdminCreateTenant(&models.TenantEntity{
+		Name:       req.GetName(),
+		Comment:    req.GetComment(),
+		MaxUsers:   int(req.GetMaxUsers()),
+		MaxClients: int(req.GetMaxClients()),
+		MaxServers: int(req.GetMaxServers()),
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create tenant, name: [%s]", req.GetName())
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("admin create tenant success, tenant id: [%d]", t.ID)
+	return &pb.AdminCreateTenantResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Tenant: t.ToPB(),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package tenant
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DeleteTenantHandler 删除空租户，同时清理租户下的用户组与 casbin 策略
+func DeleteTenantHandler(ctx *app.Context, req *pb.AdminDeleteTenantRequest) (*pb.AdminDeleteTenantResponse, error) {
+	logger.Logger(ctx).Infof("admin delete tenant, req: [%+v]", req)
+
+	tenantID := int(req.GetTenantId())
+	if tenantID <= 0 {
+		return &pb.AdminDeleteTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid tenant id"},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).AdminDeleteTenant(tenantID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete tenant, id: [%d]", tenantID)
+		return &pb.AdminDeleteTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	if permMgr := ctx.GetApp().GetPermManager(); permMgr != nil {
+		if _, err := permMgr.RemoveTenant(tenantID); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot remove tenant policies, id: [%d]", tenantID)
+			return nil, err
+		}
+	}
+
+	return &pb.AdminDeleteTenantResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListTenantsHandler(ctx *app.Context, req *pb.AdminListTenantsRequest) (*pb.AdminListTenantsResponse, error) {
+	var (
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		keyword  = req.GetKeyword()
+	)
+
+	if page < 1 || pageSize < 1 {
+		return &pb.AdminListTenantsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid page or page size"},
+		}, nil
+	}
+
+	tenants, err := dao.NewQuery(ctx).AdminListTenants(page, pageSize, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).AdminCountTenants(keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AdminListTenantsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Tenants: lo.Map(tenants, func(t *models.Tenant, _ int) *pb.Tenant {
+			return t.ToPB()
+		}),
+	}, nil
+}
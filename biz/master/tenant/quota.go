@@ -0,0 +1,60 @@
+package tenant
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+)
+
+// CheckUserQuota 校验租户用户数配额，默认租户不限制
+func CheckUserQuota(ctx *app.Context, tenantID int) error {
+	return checkQuota(ctx, tenantID, "users", func(q dao.Query) (int64, int, error) {
+		t, err := q.AdminGetTenant(tenantID)
+		if err != nil {
+			return 0, 0, err
+		}
+		count, err := q.AdminCountTenantUsers(tenantID)
+		return count, t.MaxUsers, err
+	})
+}
+
+// CheckClientQuota 校验租户客户端数配额，影子客户端不计入
+func CheckClientQuota(ctx *app.Context, tenantID int) error {
+	return checkQuota(ctx, tenantID, "clients", func(q dao.Query) (int64, int, error) {
+		t, err := q.AdminGetTenant(tenantID)
+		if err != nil {
+			return 0, 0, err
+		}
+		count, err := q.AdminCountTenantClients(tenantID)
+		return count, t.MaxClients, err
+	})
+}
+
+// CheckServerQuota 校验租户服务端数配额
+func CheckServerQuota(ctx *app.Context, tenantID int) error {
+	return checkQuota(ctx, tenantID, "servers", func(q dao.Query) (int64, int, error) {
+		t, err := q.AdminGetTenant(tenantID)
+		if err != nil {
+			return 0, 0, err
+		}
+		count, err := q.AdminCountTenantServers(tenantID)
+		return count, t.MaxServers, err
+	})
+}
+
+func checkQuota(ctx *app.Context, tenantID int, name string, usage func(q dao.Query) (int64, int, error)) error {
+	if tenantID == defs.DefaultTenantID {
+		return nil
+	}
+
+	count, limit, err := usage(dao.NewQuery(ctx))
+	if err != nil {
+		return err
+	}
+	if limit > 0 && count >= int64(limit) {
+		return fmt.Errorf("tenant %s quota exceeded, limit: %d", name, limit)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func UpdateTenantHandler(ctx *app.Context, req *pb.AdminUpdateTenantRequest) (*pb.AdminUpdateTenantResponse, error) {
+	logger.Logger(ctx).Infof("admin update tenant, req: [%+v]", req)
+
+	if req.GetTenantId() <= 0 || len(req.GetName()) == 0 ||
+		req.GetMaxUsers() < 0 || req.GetMaxClients() < 0 || req.GetMaxServers() < 0 {
+		return &pb.AdminUpdateTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid tenant id, name or quota"},
+		}, nil
+	}
+
+	if _, err := dao.NewQuery(ctx).AdminGetTenant(int(req.GetTenantId())); err != nil {
+		return &pb.AdminUpdateTenantResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_NOT_FOUND, Message: "tenant not found"},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateTenant(int(req.GetTenantId()), &models.TenantEntity{
+		Name:       req.GetName(),
+		Comment:    req.GetComment(),
+		MaxUsers:   int(req.GetMaxUsers()),
+		MaxClients: int(req.GetMaxClients()),
+		MaxServers: int(req.GetMaxServers()),
+	}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update tenant, id: [%d]", req.GetTenantId())
+		return nil, err
+	}
+
+	return &pb.AdminUpdateTenantResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/samber/lo"
+)
+
+// signAPIToken 签发带 jti 的 JWT 并落库，之后可通过 jti 列出、吊销
+func signAPIToken(ctx *app.Context, userInfo models.UserInfo, name string,
+	permissions []defs.APIPermission, expiresIn int64) (string, *models.APIToken, error) {
+	if expiresIn <= 0 {
+		return "", nil, fmt.Errorf("invalid expires_in: %d", expiresIn)
+	}
+
+	var (
+		now = time.Now()
+		jti = utils.GenerateUUIDWithoutSeperator()
+	)
+
+	payload := map[string]interface{}{
+		defs.UserIDKey:                   userInfo.GetUserID(),
+		defs.TokenPayloadKey_Permissions: permissions,
+		defs.TokenPayloadKey_JTI:         jti,
+	}
+
+	token, err := utils.GetJwtTokenFromMap(conf.JWTSecret(ctx.GetApp().GetConfig()),
+		now.Unix(),
+		expiresIn,
+		payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	apiToken := &models.APIToken{APITokenEntity: &models.APITokenEntity{
+		JTI:         jti,
+		Name:        name,
+		Permissions: permissions,
+		Status:      defs.TokenStatusActive,
+		ExpiresAt:   now.Add(time.Duration(expiresIn) * time.Second),
+	}}
+	if err := dao.NewMutation(ctx).CreateAPIToken(userInfo, apiToken); err != nil {
+		return "", nil, err
+	}
+
+	return token, apiToken, nil
+}
+
+func permissionsFromPB(perms []*pb.APIPermission) []defs.APIPermission {
+	return lo.Map(perms, func(p *pb.APIPermission, _ int) defs.APIPermission {
+		return defs.APIPermission{Method: p.GetMethod(), Path: p.GetPath()}
+	})
+}
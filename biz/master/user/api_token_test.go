@@ -0,0 +1,65 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeAPITokenHandler_OnlyOwner(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+
+	_, token, err := signAPIToken(ctx, alice, "ci", nil, 3600)
+	require.NoError(t, err)
+
+	_, err = RevokeAPITokenHandler(apptest.WithUser(ctx, bob), &pb.RevokeAPITokenRequest{TokenId: lo.ToPtr(uint32(token.ID))})
+	assert.Error(t, err)
+
+	resp, err := RevokeAPITokenHandler(apptest.WithUser(ctx, alice), &pb.RevokeAPITokenRequest{TokenId: lo.ToPtr(uint32(token.ID))})
+	require.NoError(t, err)
+	assert.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+
+	got, err := dao.NewQuery(ctx).AdminGetAPITokenByJTI(token.JTI)
+	require.NoError(t, err)
+	assert.Equal(t, defs.TokenStatusRevoked, got.Status)
+}
+
+func TestRotateAPITokenHandler_RequiresUsableToken(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	rotate := func(id uint, expiresIn int64) *pb.RotateAPITokenResponse {
+		resp, err := RotateAPITokenHandler(apptest.WithUser(ctx, alice), &pb.RotateAPITokenRequest{
+			TokenId: lo.ToPtr(uint32(id)), ExpiresIn: lo.ToPtr(expiresIn),
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// 已吊销与已过期的 token 不能轮换
+	_, revoked, err := signAPIToken(ctx, alice, "revoked", nil, 3600)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(revoked).Update("status", defs.TokenStatusRevoked).Error)
+	assert.Equal(t, pb.RespCode_RESP_CODE_INVALID, rotate(revoked.ID, 0).GetStatus().GetCode())
+
+	_, expired, err := signAPIToken(ctx, alice, "expired", nil, 3600)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(expired).Update("expires_at", time.Now().Add(-time.Minute)).Error)
+	assert.Equal(t, pb.RespCode_RESP_CODE_INVALID, rotate(expired.ID, 0).GetStatus().GetCode())
+
+	// 未指定有效期时沿用旧 token 的有效期
+	_, active, err := signAPIToken(ctx, alice, "active", nil, 3600)
+	require.NoError(t, err)
+	resp := rotate(active.ID, 0)
+	require.Equal(t, pb.RespCode_RESP_CODE_SUCCESS, resp.GetStatus().GetCode())
+	assert.InDelta(t, time.Now().Add(time.Hour).UnixMilli(), resp.GetApiToken().GetExpiresAt(), float64(time.Minute.Milliseconds()))
+}
@@ -21,6 +21,9 @@ func GetUserInfoHandler(c *app.Context, req *pb.GetUserInfoRequest) (*pb.GetUser
 			Status:   lo.ToPtr(fmt.Sprint(userInfo.GetStatus())),
 			Role:     lo.ToPtr(userInfo.GetRole()),
 			Token:    lo.ToPtr(userInfo.GetToken()),
+
+			TOTPEnabled:  lo.ToPtr(userInfo.IsTOTPEnabled()),
+			TOTPRequired: lo.ToPtr(userInfo.IsTOTPRequired()),
 		},
 	}, nil
 }
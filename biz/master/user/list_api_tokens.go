@@ -0,0 +1,46 @@
+package user
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListAPITokensHandler(ctx *app.Context, req *pb.ListAPITokensRequest) (*pb.ListAPITokensResponse, error) {
+	logger.Logger(ctx).Infof("list api tokens, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		keyword  = req.GetKeyword()
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListAPITokensResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	tokens, err := dao.NewQuery(ctx).ListAPITokensWithKeyword(userInfo, page, pageSize, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountAPITokensWithKeyword(userInfo, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAPITokensResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		ApiTokens: lo.Map(tokens, func(t *models.APIToken, _ int) *pb.APIToken {
+			return t.ToPB()
+		}),
+	}, nil
+}
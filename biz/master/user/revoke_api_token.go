@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/middleware"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func RevokeAPITokenHandler(ctx *app.Context, req *pb.RevokeAPITokenRequest) (*pb.RevokeAPITokenResponse, error) {
+	logger.Logger(ctx).Infof("revoke api token, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		tokenID  = uint(req.GetTokenId())
+	)
+
+	if !userInfo.Valid() {
+		return &pb.RevokeAPITokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if tokenID == 0 {
+		return &pb.RevokeAPITokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid token id"},
+		}, nil
+	}
+
+	revoked, err := dao.NewMutation(ctx).RevokeAPIToken(userInfo, tokenID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot revoke api token, id: [%d]", tokenID)
+		return nil, err
+	}
+
+	middleware.InvalidateAPIToken(revoked.JTI)
+
+	logger.Logger(ctx).Infof("revoke api token success, id: [%d]", tokenID)
+	return &pb.RevokeAPITokenResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
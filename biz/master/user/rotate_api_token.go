@@ -0,0 +1,78 @@
+package user
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/middleware"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// RotateAPITokenHandler 吊销旧 token 并以相同的名称与权限签发新 token
+func RotateAPITokenHandler(ctx *app.Context, req *pb.RotateAPITokenRequest) (*pb.RotateAPITokenResponse, error) {
+	logger.Logger(ctx).Infof("rotate api token, req: [%+v]", req)
+
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		tokenID  = uint(req.GetTokenId())
+	)
+
+	if !userInfo.Valid() {
+		return &pb.RotateAPITokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	if tokenID == 0 {
+		return &pb.RotateAPITokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid token id"},
+		}, nil
+	}
+
+	old, err := dao.NewQuery(ctx).GetAPITokenByID(userInfo, tokenID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get api token, id: [%d]", tokenID)
+		return nil, err
+	}
+
+	// 已吊销或已过期的 token 不能轮换，避免借此恢复失效的凭证
+	if !old.Usable(time.Now()) {
+		return &pb.RotateAPITokenResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "token is revoked or expired"},
+		}, nil
+	}
+
+	// 未指定有效期时沿用旧 token 的有效期，旧 token 没有记录过期时间时需要显式指定
+	expiresIn := req.GetExpiresIn()
+	if expiresIn <= 0 {
+		if old.ExpiresAt.IsZero() || !old.ExpiresAt.After(old.CreatedAt) {
+			return &pb.RotateAPITokenResponse{
+				Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "expires_in is required"},
+			}, nil
+		}
+		expiresIn = int64(old.ExpiresAt.Sub(old.CreatedAt).Seconds())
+	}
+
+	token, apiToken, err := signAPIToken(ctx, userInfo, old.Name, old.Permissions, expiresIn)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot sign api token, old id: [%d]", tokenID)
+		return nil, err
+	}
+
+	if _, err := dao.NewMutation(ctx).RevokeAPIToken(userInfo, tokenID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot revoke api token, id: [%d]", tokenID)
+		return nil, err
+	}
+	middleware.InvalidateAPIToken(old.JTI)
+
+	logger.Logger(ctx).Infof("rotate api token success, old id: [%d], new id: [%d]", tokenID, apiToken.ID)
+	return &pb.RotateAPITokenResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Token:    lo.ToPtr(token),
+		ApiToken: apiToken.ToPB(),
+	}, nil
+}
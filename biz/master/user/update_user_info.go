@@ -8,6 +8,7 @@ import (
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
@@ -23,6 +24,7 @@ func UpdateUserInfoHander(c *app.Context, req *pb.UpdateUserInfoRequest) (*pb.Up
 			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
 		}, nil
 	}
+	oldUserName := userInfo.GetUserName()
 	newUserEntity := userInfo.(*models.UserEntity)
 	newUserInfo := req.GetUserInfo()
 
@@ -53,6 +55,9 @@ func UpdateUserInfoHander(c *app.Context, req *pb.UpdateUserInfoRequest) (*pb.Up
 		}, err
 	}
 
+	// 用户名或 token 变更后旧的 frp 认证缓存失效
+	cache.Get().Del([]byte(oldUserName))
+
 	go func() {
 		newUser, err := dao.NewQuery(app.NewContext(context.Background(), c.GetApp())).GetUserByUserID(userInfo.GetUserID())
 		if err != nil {
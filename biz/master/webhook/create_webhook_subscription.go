@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func CreateWebhookSubscriptionHandler(ctx *app.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	logger.Logger(ctx).Infof("create webhook subscription, name: [%s], url: [%s]", req.GetSubscription().GetName(), req.GetSubscription().GetUrl())
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.CreateWebhookSubscriptionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	entity := &models.WebhookSubscriptionEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}
+	if err := subscriptionFromPB(req.GetSubscription(), entity); err != nil {
+		return &pb.CreateWebhookSubscriptionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	sub, err := dao.NewMutation(ctx).AdminCreateWebhookSubscription(entity)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create webhook subscription, name: [%s]", entity.Name)
+		return nil, err
+	}
+	invalidateSubscriptions()
+
+	logger.Logger(ctx).Infof("create webhook subscription success, id: [%d]", sub.ID)
+	return &pb.CreateWebhookSubscriptionResponse{
+		Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Subscription: sub.ToPB(),
+	}, nil
+}
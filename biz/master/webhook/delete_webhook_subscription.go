@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// DeleteWebhookSubscriptionHandler 删除订阅及其推送记录
+func DeleteWebhookSubscriptionHandler(ctx *app.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	logger.Logger(ctx).Infof("delete webhook subscription, req: [%+v]", req)
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.DeleteWebhookSubscriptionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	sub, err := dao.NewQuery(ctx).GetWebhookSubscription(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get webhook subscription, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	if err := dao.NewMutation(ctx).AdminDeleteWebhookSubscription(sub.ID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete webhook subscription, id: [%d]", sub.ID)
+		return nil, err
+	}
+	invalidateSubscriptions()
+
+	logger.Logger(ctx).Infof("delete webhook subscription success, id: [%d]", sub.ID)
+	return &pb.DeleteWebhookSubscriptionResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+const (
+	// subscriptionCacheTTL 订阅与属主缓存时间，增删改订阅时会立即失效
+	subscriptionCacheTTL = 30 * time.Second
+	deliveryQueueSize    = 1024
+	deliveryWorkers      = 8
+)
+
+// subscriptionsStale 订阅变更后置为 true，分发时重新加载
+var subscriptionsStale atomic.Bool
+
+// invalidateSubscriptions 订阅增删改后调用，让分发立即使用新的订阅
+func invalidateSubscriptions() {
+	subscriptionsStale.Store(true)
+}
+
+type subscriber struct {
+	sub   *models.WebhookSubscription
+	owner models.UserInfo
+}
+
+type deliveryJob struct {
+	sub *models.WebhookSubscription
+	d   *models.WebhookDelivery
+}
+
+// dispatcher 事件总线只有一个消费者，这里只做内存匹配与落库，推送交给固定数量的 worker
+// 队列满时跳过立即推送，已落库的 pending 推送由重试任务补发
+type dispatcher struct {
+	ctx      *app.Context
+	subs     []*subscriber
+	loadedAt time.Time
+	// allowed 订阅属主对事件对象的读权限，随订阅缓存一起过期
+	allowed map[string]bool
+	queue   chan deliveryJob
+}
+
+func newDispatcher(ctx *app.Context) *dispatcher {
+	return &dispatcher{
+		ctx:     ctx,
+		allowed: map[string]bool{},
+		queue:   make(chan deliveryJob, deliveryQueueSize),
+	}
+}
+
+// StartDispatcher 订阅事件总线，为匹配的 webhook 订阅创建推送记录并推送
+func StartDispatcher(appInstance app.Application) {
+	bus := appInstance.GetEventBus()
+	if bus == nil {
+		return
+	}
+	d := newDispatcher(app.NewContext(context.Background(), appInstance))
+	_, ch := bus.Subscribe()
+	for i := 0; i < deliveryWorkers; i++ {
+		go d.work()
+	}
+	go func() {
+		for e := range ch {
+			d.dispatch(e)
+		}
+	}()
+}
+
+func (w *dispatcher) work() {
+	for job := range w.queue {
+		deliver(w.ctx, job.sub, job.d)
+	}
+}
+
+// subscribers 返回缓存的启用中的订阅，过期或订阅变更时重新加载
+func (w *dispatcher) subscribers(now time.Time) []*subscriber {
+	if !subscriptionsStale.Swap(false) && !w.loadedAt.IsZero() && now.Sub(w.loadedAt) < subscriptionCacheTTL {
+		return w.subs
+	}
+
+	subs, err := dao.NewQuery(w.ctx).AdminListEnabledWebhookSubscriptions()
+	if err != nil {
+		logger.Logger(w.ctx).WithError(err).Error("cannot list webhook subscriptions")
+		return w.subs
+	}
+
+	owners := map[int]models.UserInfo{}
+	result := make([]*subscriber, 0, len(subs))
+	for _, sub := range subs {
+		owner, ok := owners[sub.UserID]
+		if !ok {
+			u, err := dao.NewQuery(w.ctx).GetUserByUserID(sub.UserID)
+			if err != nil {
+				logger.Logger(w.ctx).WithError(err).Warnf("cannot get webhook subscription owner, id: [%d]", sub.ID)
+				continue
+			}
+			owner = u
+			owners[sub.UserID] = owner
+		}
+		result = append(result, &subscriber{sub: sub, owner: owner})
+	}
+
+	w.subs, w.loadedAt, w.allowed = result, now, map[string]bool{}
+	return w.subs
+}
+
+// canRead 订阅者只会收到自己有读权限的资源上的事件
+func (w *dispatcher) canRead(s *subscriber, e *defs.PanelEvent) bool {
+	key := fmt.Sprintf("%d:%s:%s", s.owner.GetUserID(), e.ObjType, e.ObjID)
+	if ok, cached := w.allowed[key]; cached {
+		return ok
+	}
+	_, err := rbac.Authorize(w.ctx, s.owner, e.ObjType, e.ObjID, defs.RBACActionRead)
+	w.allowed[key] = err == nil
+	return err == nil
+}
+
+func (w *dispatcher) dispatch(e *defs.PanelEvent) {
+	var payload []byte
+	for _, s := range w.subscribers(time.Now()) {
+		if !s.sub.Subscribes(e.Type) || !w.canRead(s, e) {
+			continue
+		}
+		if payload == nil {
+			var err error
+			if payload, err = json.Marshal(e); err != nil {
+				logger.Logger(w.ctx).WithError(err).Errorf("cannot marshal event, type: [%s]", e.Type)
+				return
+			}
+		}
+
+		d, err := newDelivery(w.ctx, s.sub, e.ID, e.Type, payload)
+		if err != nil {
+			logger.Logger(w.ctx).WithError(err).Errorf("cannot create webhook delivery, subscription: [%d]", s.sub.ID)
+			continue
+		}
+		select {
+		case w.queue <- deliveryJob{sub: s.sub, d: d}:
+		default:
+			logger.Logger(w.ctx).Warnf("webhook delivery queue is full, leave delivery [%d] to retry task", d.ID)
+		}
+	}
+}
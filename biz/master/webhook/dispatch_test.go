@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSubscription(t *testing.T, ctx *app.Context, u *models.UserEntity, name string) *models.WebhookSubscription {
+	sub := &models.WebhookSubscription{WebhookSubscriptionEntity: &models.WebhookSubscriptionEntity{
+		Name: name, UserID: u.UserID, TenantID: u.TenantID, URL: "https://example.com/" + name,
+		EventTypes: []string{defs.EventClientConnected}, Enabled: true,
+	}}
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(sub).Error)
+	return sub
+}
+
+func listDeliveries(t *testing.T, ctx *app.Context) []*models.WebhookDelivery {
+	var ds []*models.WebhookDelivery
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Find(&ds).Error)
+	return ds
+}
+
+func newClientEvent(id string) *defs.PanelEvent {
+	return &defs.PanelEvent{
+		ID: id, Type: defs.EventClientConnected, Time: time.Now().UnixMilli(),
+		ObjType: defs.RBACObjClient, ObjID: "c1",
+	}
+}
+
+func TestDispatch_OnlyReadableSubscriptions(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+	aliceSub := createSubscription(t, ctx, alice, "alice")
+	createSubscription(t, ctx, bob, "bob")
+
+	d := newDispatcher(ctx)
+	d.dispatch(newClientEvent("e1"))
+
+	// bob 对 c1 没有读权限，不会收到推送
+	ds := listDeliveries(t, ctx)
+	require.Len(t, ds, 1)
+	assert.Equal(t, aliceSub.ID, ds[0].SubscriptionID)
+	assert.Equal(t, models.WebhookDeliveryStatusPending, ds[0].Status)
+	require.Len(t, d.queue, 1)
+	assert.Equal(t, ds[0].ID, (<-d.queue).d.ID)
+
+	// 缓存期内新建的订阅在失效后立即生效
+	createSubscription(t, ctx, alice, "alice2")
+	invalidateSubscriptions()
+	d.dispatch(newClientEvent("e2"))
+	assert.Len(t, listDeliveries(t, ctx), 3)
+	assert.Len(t, d.queue, 2)
+}
+
+func TestDispatch_QueueFullKeepsPendingDelivery(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+	createSubscription(t, ctx, alice, "alice")
+
+	d := newDispatcher(ctx)
+	d.queue = make(chan deliveryJob)
+	d.dispatch(newClientEvent("e1"))
+
+	// 队列满时不阻塞事件消费，推送记录留给重试任务
+	ds := listDeliveries(t, ctx)
+	require.Len(t, ds, 1)
+	assert.Equal(t, models.WebhookDeliveryStatusPending, ds[0].Status)
+}
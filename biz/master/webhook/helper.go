@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+
+	webhooksvc "github.com/VaalaCat/frp-panel/services/webhook"
+)
+
+// subscriptionFromPB 校验请求中的订阅并填充到 entity，secret 为空时保留原值
+func subscriptionFromPB(s *pb.WebhookSubscription, entity *models.WebhookSubscriptionEntity) error {
+	if len(s.GetName()) == 0 {
+		return fmt.Errorf("subscription name is required")
+	}
+	u, err := url.ParseRequestURI(s.GetUrl())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid webhook url")
+	}
+	eventTypes := lo.Uniq(s.GetEventTypes())
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+	for _, t := range eventTypes {
+		if !slices.Contains(defs.EventTypes, t) {
+			return fmt.Errorf("invalid event type [%s]", t)
+		}
+	}
+	if len(s.GetSecret()) > 0 {
+		entity.Secret = s.GetSecret()
+	}
+	if len(entity.Secret) == 0 {
+		return fmt.Errorf("secret is required")
+	}
+
+	entity.Name = s.GetName()
+	entity.URL = s.GetUrl()
+	entity.EventTypes = eventTypes
+	entity.Enabled = s.GetEnabled()
+	return nil
+}
+
+// deliver 推送一次并记录结果，失败时按退避时间安排重试，超过最大次数后标记为失败
+func deliver(ctx *app.Context, sub *models.WebhookSubscription, d *models.WebhookDelivery) {
+	code, err := webhooksvc.Send(ctx, &webhooksvc.Request{
+		URL:        sub.URL,
+		Secret:     sub.Secret,
+		EventType:  d.EventType,
+		DeliveryID: strconv.FormatUint(uint64(d.ID), 10),
+		Body:       d.Payload,
+	})
+
+	now := time.Now()
+	d.Attempts++
+	d.StatusCode = code
+	switch {
+	case err == nil:
+		d.Status = models.WebhookDeliveryStatusSuccess
+		d.Error = ""
+		d.DeliveredAt = now
+		d.NextRetryAt = time.Time{}
+	case d.Attempts >= webhooksvc.MaxAttempts:
+		d.Status = models.WebhookDeliveryStatusFailed
+		d.Error = err.Error()
+		d.NextRetryAt = time.Time{}
+	default:
+		d.Error = err.Error()
+		d.NextRetryAt = now.Add(webhooksvc.Backoff(d.Attempts))
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("webhook delivery failed, id: [%d], subscription: [%d], attempts: [%d]", d.ID, sub.ID, d.Attempts)
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateWebhookDelivery(d); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update webhook delivery, id: [%d]", d.ID)
+	}
+}
+
+// newDelivery 创建 pending 推送，首次推送由调用方立即发起，
+// NextRetryAt 预留一个退避间隔，避免重试任务与首次推送并发
+func newDelivery(ctx *app.Context, sub *models.WebhookSubscription, eventID, eventType string, payload []byte) (*models.WebhookDelivery, error) {
+	return dao.NewMutation(ctx).AdminCreateWebhookDelivery(&models.WebhookDeliveryEntity{
+		SubscriptionID: sub.ID,
+		UserID:         sub.UserID,
+		TenantID:       sub.TenantID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         models.WebhookDeliveryStatusPending,
+		NextRetryAt:    time.Now().Add(webhooksvc.Backoff(1)),
+	})
+}
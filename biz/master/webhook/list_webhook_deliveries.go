@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListWebhookDeliveriesHandler 分页查询推送记录，按时间倒序
+func ListWebhookDeliveriesHandler(ctx *app.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListWebhookDeliveriesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	page, pageSize := int(req.GetPage()), int(req.GetPageSize())
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	q := dao.NewQuery(ctx)
+	deliveries, err := q.ListWebhookDeliveries(userInfo, uint(req.GetSubscriptionId()), req.GetStatus(), page, pageSize)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list webhook deliveries")
+		return nil, err
+	}
+	total, err := q.CountWebhookDeliveries(userInfo, uint(req.GetSubscriptionId()), req.GetStatus())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count webhook deliveries")
+		return nil, err
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{
+		Status:     &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:      lo.ToPtr(int32(total)),
+		Deliveries: lo.Map(deliveries, func(d *models.WebhookDelivery, _ int) *pb.WebhookDelivery { return d.ToPB() }),
+	}, nil
+}
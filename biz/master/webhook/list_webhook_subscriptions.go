@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListWebhookSubscriptionsHandler(ctx *app.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListWebhookSubscriptionsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	subs, err := dao.NewQuery(ctx).ListWebhookSubscriptions(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list webhook subscriptions")
+		return nil, err
+	}
+
+	return &pb.ListWebhookSubscriptionsResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Subscriptions: lo.Map(subs, func(s *models.WebhookSubscription, _ int) *pb.WebhookSubscription { return s.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// RedeliverWebhookHandler 以原推送的内容新建一次推送并同步发送，返回新的推送记录
+func RedeliverWebhookHandler(ctx *app.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	logger.Logger(ctx).Infof("redeliver webhook, id: [%d]", req.GetId())
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.RedeliverWebhookResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	q := dao.NewQuery(ctx)
+	origin, err := q.GetWebhookDelivery(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get webhook delivery, id: [%d]", req.GetId())
+		return nil, err
+	}
+	sub, err := q.GetWebhookSubscription(userInfo, origin.SubscriptionID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get webhook subscription, id: [%d]", origin.SubscriptionID)
+		return nil, err
+	}
+
+	d, err := newDelivery(ctx, sub, origin.EventID, origin.EventType, origin.Payload)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create webhook delivery, subscription: [%d]", sub.ID)
+		return nil, err
+	}
+	deliver(ctx, sub, d)
+
+	return &pb.RedeliverWebhookResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Delivery: d.ToPB(),
+	}, nil
+}
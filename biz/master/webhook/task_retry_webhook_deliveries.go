@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/sourcegraph/conc/pool"
+)
+
+const (
+	retryBatchSize   = 100
+	retryConcurrency = 8
+)
+
+// retrying 推送可能超过任务间隔，避免上一轮未结束时重复执行
+var retrying sync.Mutex
+
+// RetryWebhookDeliveries 重试到期的 pending 推送，订阅已删除或停用时直接标记为失败
+func RetryWebhookDeliveries(appInstance app.Application) error {
+	if !retrying.TryLock() {
+		return nil
+	}
+	defer retrying.Unlock()
+
+	ctx := app.NewContext(context.Background(), appInstance)
+	deliveries, err := dao.NewQuery(ctx).AdminListDueWebhookDeliveries(time.Now(), retryBatchSize)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("RetryWebhookDeliveries cannot list deliveries")
+		return err
+	}
+
+	subs := map[uint]*models.WebhookSubscription{}
+	p := pool.New().WithMaxGoroutines(retryConcurrency)
+	for _, d := range deliveries {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			sub, _ = dao.NewQuery(ctx).AdminGetWebhookSubscription(d.SubscriptionID)
+			subs[d.SubscriptionID] = sub
+		}
+		if sub == nil || !sub.Enabled {
+			d.Status = models.WebhookDeliveryStatusFailed
+			d.Error = "subscription is deleted or disabled"
+			if err := dao.NewMutation(ctx).AdminUpdateWebhookDelivery(d); err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot update webhook delivery, id: [%d]", d.ID)
+			}
+			continue
+		}
+		p.Go(func() { deliver(ctx, sub, d) })
+	}
+	p.Wait()
+	return nil
+}
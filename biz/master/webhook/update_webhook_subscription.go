@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func UpdateWebhookSubscriptionHandler(ctx *app.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	logger.Logger(ctx).Infof("update webhook subscription, id: [%d]", req.GetSubscription().GetId())
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.UpdateWebhookSubscriptionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	sub, err := dao.NewQuery(ctx).GetWebhookSubscription(userInfo, uint(req.GetSubscription().GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get webhook subscription, id: [%d]", req.GetSubscription().GetId())
+		return nil, err
+	}
+
+	if err := subscriptionFromPB(req.GetSubscription(), sub.WebhookSubscriptionEntity); err != nil {
+		return &pb.UpdateWebhookSubscriptionResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()},
+		}, nil
+	}
+
+	if err := dao.NewMutation(ctx).AdminUpdateWebhookSubscription(sub); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update webhook subscription, id: [%d]", sub.ID)
+		return nil, err
+	}
+	invalidateSubscriptions()
+
+	logger.Logger(ctx).Infof("update webhook subscription success, id: [%d]", sub.ID)
+	return &pb.UpdateWebhookSubscriptionResponse{
+		Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Subscription: sub.ToPB(),
+	}, nil
+}
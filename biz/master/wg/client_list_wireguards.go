@@ -55,6 +55,7 @@ func ListClientWireGuards(ctx *app.Context, req *pb.ListClientWireGuardsRequest)
 
 	networkPeerConfigsMap := make(map[uint]map[uint][]*pb.WireGuardPeerConfig)
 	networkAllEdgesMap := make(map[uint]map[uint][]wgsvc.Edge)
+	networkPushCfgMap := make(map[uint]*networkPushConfig)
 
 	for _, networkID := range networkIDs {
 		peerConfigs, allEdges, err := wgsvc.PlanAllowedIPs(
@@ -69,9 +70,15 @@ func ListClientWireGuards(ctx *app.Context, req *pb.ListClientWireGuardsRequest)
 			log.WithError(err).Errorf("failed to plan allowed ips for wireguard configs: %v", wgCfgs)
 			return nil, err
 		}
+		if err := attachExternalPeers(ctx, networkID, networkPeers[networkID], peerConfigs); err != nil {
+			log.WithError(err).Errorf("failed to attach external peers, network id: %d", networkID)
+			return nil, err
+		}
 
 		networkPeerConfigsMap[networkID] = peerConfigs
 		networkAllEdgesMap[networkID] = allEdges
+		networkPushCfgMap[networkID] = newNetworkPushConfig(ctx, networkID,
+			networkPeers[networkID][0].Network.NetworkEntity, networkPeers[networkID])
 	}
 
 	resp := &pb.ListClientWireGuardsResponse{
@@ -98,6 +105,7 @@ func ListClientWireGuards(ctx *app.Context, req *pb.ListClientWireGuardsRequest)
 				})
 
 			r.Adjs = adjsToPB(networkAllEdgesMap[wgCfg.NetworkID])
+			networkPushCfgMap[wgCfg.NetworkID].applyTo(r)
 
 			fillConnectablePeersAsPreconnect(r, uint32(wgCfg.ID), idToWg, log)
 			sortPeersStable(r)
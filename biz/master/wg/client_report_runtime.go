@@ -3,9 +3,11 @@ package wg
 import (
 	"errors"
 
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 )
 
 func ReportWireGuardRuntimeInfo(ctx *app.Context, req *pb.ReportWireGuardRuntimeInfoReq) (*pb.ReportWireGuardRuntimeInfoResp, error) {
@@ -22,8 +24,22 @@ func ReportWireGuardRuntimeInfo(ctx *app.Context, req *pb.ReportWireGuardRuntime
 	}
 
 	networkTopologyCache := ctx.GetApp().GetNetworkTopologyCache()
+	var oldReflexive string
+	if old, ok := networkTopologyCache.GetRuntimeInfo(uint(wgIfce.ID)); ok {
+		oldReflexive = old.GetReflexiveEndpoint()
+	}
 	networkTopologyCache.SetRuntimeInfo(uint(wgIfce.ID), req.GetRuntimeInfo())
 
+	// NAT 映射地址变化后旧的打洞结果失效，重新协调打洞
+	if newReflexive := req.GetRuntimeInfo().GetReflexiveEndpoint(); newReflexive != "" && newReflexive != oldReflexive {
+		go coordinatePunch(ctx.Background(), wgIfce)
+	}
+	eventbus.Emit(ctx, defs.EventWireGuardRuntimeReported, defs.RBACObjClient, clientId, map[string]any{
+		"wireguard_id":   wgIfce.ID,
+		"interface_name": interfaceName,
+		"peer_count":     len(req.GetRuntimeInfo().GetPeers()),
+	})
+
 	return &pb.ReportWireGuardRuntimeInfoResp{
 		Status: &pb.Status{
 			Code:    pb.RespCode_RESP_CODE_SUCCESS,
@@ -0,0 +1,122 @@
+package wg
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
+	"github.com/VaalaCat/frp-panel/utils"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+)
+
+// CreateWireGuardExternalPeer 添加不运行 frpp 的外部设备（手机、笔记本等），经由中继节点接入网络
+func CreateWireGuardExternalPeer(ctx *app.Context, req *pb.CreateWireGuardExternalPeerRequest) (*pb.CreateWireGuardExternalPeerResponse, error) {
+	log := ctx.Logger().WithField("op", "CreateWireGuardExternalPeer")
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, errors.New("invalid user")
+	}
+	ext := req.GetExternalPeer()
+	if ext == nil || len(ext.GetName()) == 0 || ext.GetNetworkId() == 0 || ext.GetRelayWireguardId() == 0 {
+		return nil, errors.New("invalid external peer params")
+	}
+	for _, cidr := range ext.GetAllowedIps() {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return nil, errors.Join(errors.New("invalid allowed ips"), err)
+		}
+	}
+
+	q := dao.NewQuery(ctx)
+	m := dao.NewMutation(ctx)
+
+	network, err := q.GetNetworkByID(userInfo, uint(ext.GetNetworkId()))
+	if err != nil {
+		log.WithError(err).Errorf("get network by id failed")
+		return nil, err
+	}
+	relay, err := q.GetWireGuardByID(userInfo, uint(ext.GetRelayWireguardId()))
+	if err != nil {
+		log.WithError(err).Errorf("get relay wireguard by id failed")
+		return nil, err
+	}
+	if relay.NetworkID != network.ID {
+		return nil, errors.New("relay wireguard not in network")
+	}
+
+	ips, err := q.GetWireGuardLocalAddressesByNetworkID(userInfo, network.ID)
+	if err != nil {
+		log.WithError(err).Errorf("get wireguard local addresses by network id failed")
+		return nil, err
+	}
+	externalIps, err := q.GetWireGuardExternalPeerAddressesByNetworkID(userInfo, network.ID)
+	if err != nil {
+		log.WithError(err).Errorf("get wireguard external peer addresses by network id failed")
+		return nil, err
+	}
+	ips = append(ips, externalIps...)
+
+	desired := ""
+	if len(ext.GetLocalAddress()) > 0 {
+		addr, _, err := models.ParseIPOrCIDRWithNetip(ext.GetLocalAddress())
+		if err != nil {
+			return nil, errors.Join(errors.New("invalid local address"), err)
+		}
+		desired = addr.String()
+	}
+
+	newIpStr, err := utils.AllocateIP(network.CIDR, ips, desired)
+	if err != nil {
+		log.WithError(err).Errorf("allocate ip failed")
+		return nil, err
+	}
+	newIp, err := netip.ParseAddr(newIpStr)
+	if err != nil {
+		log.WithError(err).Errorf("parse ip failed")
+		return nil, err
+	}
+	networkCidr, err := netip.ParsePrefix(network.CIDR)
+	if err != nil {
+		log.WithError(err).Errorf("parse network cidr failed")
+		return nil, err
+	}
+
+	peer := &models.WireGuardExternalPeer{WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+		Name:             ext.GetName(),
+		NetworkID:        network.ID,
+		RelayWireGuardID: relay.ID,
+		PublicKey:        ext.GetPublicKey(),
+		LocalAddress:     netip.PrefixFrom(newIp, networkCidr.Bits()).String(),
+		DnsServers:       ext.GetDnsServers(),
+		AllowedIPs:       ext.GetAllowedIps(),
+	}}
+	if len(peer.PublicKey) == 0 {
+		keys := wgsvc.GenerateKeys()
+		peer.PrivateKey = keys.PrivateKeyBase64
+		peer.PublicKey = keys.PublicKeyBase64
+	} else if _, err := wgtypes.ParseKey(peer.PublicKey); err != nil {
+		return nil, errors.Join(errors.New("invalid public key"), err)
+	}
+
+	if err := m.CreateWireGuardExternalPeer(userInfo, peer); err != nil {
+		log.WithError(err).Errorf("create wireguard external peer failed")
+		return nil, err
+	}
+
+	ctxBg := ctx.Background()
+	go func() {
+		if err := emitPatchNetworkEvent(ctxBg, userInfo, network.ID); err != nil {
+			log.WithError(err).Errorf("emit patch network event failed")
+		}
+	}()
+
+	return &pb.CreateWireGuardExternalPeerResponse{
+		Status:       &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		ExternalPeer: peer.ToPB(),
+	}, nil
+}
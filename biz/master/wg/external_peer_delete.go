@@ -0,0 +1,41 @@
+package wg
+
+import (
+	"errors"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+)
+
+func DeleteWireGuardExternalPeer(ctx *app.Context, req *pb.DeleteWireGuardExternalPeerRequest) (*pb.DeleteWireGuardExternalPeerResponse, error) {
+	log := ctx.Logger().WithField("op", "DeleteWireGuardExternalPeer")
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, errors.New("invalid user")
+	}
+	id := uint(req.GetId())
+	if id == 0 {
+		return nil, errors.New("invalid id")
+	}
+
+	peer, err := dao.NewQuery(ctx).GetWireGuardExternalPeerByID(userInfo, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := dao.NewMutation(ctx).DeleteWireGuardExternalPeer(userInfo, id); err != nil {
+		log.WithError(err).Errorf("delete wireguard external peer failed")
+		return nil, err
+	}
+
+	ctxBg := ctx.Background()
+	go func() {
+		if err := emitPatchNetworkEvent(ctxBg, userInfo, peer.NetworkID); err != nil {
+			log.WithError(err).Errorf("emit patch network event failed")
+		}
+	}()
+
+	return &pb.DeleteWireGuardExternalPeerResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"}}, nil
+}
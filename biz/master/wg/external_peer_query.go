@@ -0,0 +1,38 @@
+package wg
+
+import (
+	"errors"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListWireGuardExternalPeers(ctx *app.Context, req *pb.ListWireGuardExternalPeersRequest) (*pb.ListWireGuardExternalPeersResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, errors.New("invalid user")
+	}
+	page, pageSize := int(req.GetPage()), int(req.GetPageSize())
+	keyword := req.GetKeyword()
+	networkID := uint(req.GetNetworkId())
+	list, err := dao.NewQuery(ctx).ListWireGuardExternalPeersWithFilters(userInfo, page, pageSize, networkID, keyword)
+	if err != nil {
+		return nil, err
+	}
+	count, err := dao.NewQuery(ctx).CountWireGuardExternalPeersWithFilters(userInfo, networkID, keyword)
+	if err != nil {
+		return nil, err
+	}
+	resp := lo.Map(list, func(item *models.WireGuardExternalPeer, _ int) *pb.WireGuardExternalPeer {
+		return item.ToPB()
+	})
+	return &pb.ListWireGuardExternalPeersResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		Total:         lo.ToPtr(int32(count)),
+		ExternalPeers: resp,
+	}, nil
+}
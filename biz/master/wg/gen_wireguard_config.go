@@ -0,0 +1,102 @@
+package wg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
+	"github.com/samber/lo"
+)
+
+// GenWireGuardConfig 导出 wg-quick 格式的配置，可导出网络内节点或外部设备
+func GenWireGuardConfig(ctx *app.Context, req *pb.GenWireGuardConfigRequest) (*pb.GenWireGuardConfigResponse, error) {
+	log := ctx.Logger().WithField("op", "GenWireGuardConfig")
+
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, errors.New("invalid user")
+	}
+
+	var (
+		cfg *pb.WireGuardConfig
+		err error
+	)
+	switch {
+	case req.GetWireguardId() > 0:
+		cfg, err = buildWireGuardExportConfig(ctx, userInfo, uint(req.GetWireguardId()))
+	case req.GetExternalPeerId() > 0:
+		cfg, err = buildExternalPeerExportConfig(ctx, userInfo, uint(req.GetExternalPeerId()))
+	default:
+		return nil, errors.New("invalid id")
+	}
+	if err != nil {
+		log.WithError(err).Errorf("build wireguard config failed")
+		return nil, err
+	}
+
+	conf := wgsvc.RenderWGQuickConfig(cfg)
+	return &pb.GenWireGuardConfigResponse{
+		Status:    &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		Config:    lo.ToPtr(conf),
+		QrContent: lo.ToPtr(wgsvc.QRContent(conf)),
+		FileName:  lo.ToPtr(fmt.Sprintf("%s.conf", cfg.GetInterfaceName())),
+	}, nil
+}
+
+func buildWireGuardExportConfig(ctx *app.Context, userInfo models.UserInfo, id uint) (*pb.WireGuardConfig, error) {
+	wgCfg, err := dao.NewQuery(ctx).GetWireGuardByID(userInfo, id)
+	if err != nil {
+		return nil, err
+	}
+	if wgCfg.Network == nil {
+		return nil, errors.New("wireguard network not found")
+	}
+
+	peers, err := dao.NewQuery(ctx).GetWireGuardsByNetworkID(userInfo, wgCfg.NetworkID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := dao.NewQuery(ctx).ListWireGuardLinksByNetwork(userInfo, wgCfg.NetworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	peerConfigs, adjs, err := wgsvc.PlanAllowedIPs(peers, links,
+		wgsvc.DefaultRoutingPolicy(
+			wgsvc.NewACL().LoadFromPB(wgCfg.Network.ACL.Data),
+			ctx.GetApp().GetNetworkTopologyCache(),
+			ctx.GetApp().GetClientsManager(),
+		))
+	if err != nil {
+		return nil, err
+	}
+	if err := attachExternalPeers(ctx, wgCfg.NetworkID, peers, peerConfigs); err != nil {
+		return nil, err
+	}
+
+	idToWg := lo.SliceToMap(peers, func(p *models.WireGuard) (uint32, *models.WireGuard) { return uint32(p.ID), p })
+
+	r := wgCfg.ToPB()
+	r.Peers = peerConfigs[wgCfg.ID]
+	r.Adjs = adjsToPB(adjs)
+	fillConnectablePeersAsPreconnect(r, uint32(wgCfg.ID), idToWg, ctx.Logger())
+	sortPeersStable(r)
+
+	return r, nil
+}
+
+func buildExternalPeerExportConfig(ctx *app.Context, userInfo models.UserInfo, id uint) (*pb.WireGuardConfig, error) {
+	ext, err := dao.NewQuery(ctx).GetWireGuardExternalPeerByID(userInfo, id)
+	if err != nil {
+		return nil, err
+	}
+	if ext.Network == nil || ext.Relay == nil {
+		return nil, errors.New("external peer network or relay not found")
+	}
+	return wgsvc.ExternalPeerConfig(ext, ext.Relay, ext.Network.CIDR)
+}
@@ -55,6 +55,10 @@ func GetNetworkTopology(ctx *app.Context, req *pb.GetNetworkTopologyRequest) (*p
 			log.WithError(err).Errorf("failed to plan allowed ips")
 			return nil, err
 		}
+		if err := attachExternalPeers(ctx, networkID, peers, peerCfgs); err != nil {
+			log.WithError(err).Errorf("failed to attach external peers")
+			return nil, err
+		}
 		adjs := peerConfigsToPBAdjs(peerCfgs, allEdges)
 
 		return &pb.GetNetworkTopologyResponse{
@@ -1,7 +1,12 @@
 package wg
 
 import (
+	"errors"
+
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/services/wg"
 	"github.com/samber/lo"
 )
@@ -96,3 +101,103 @@ func peerConfigsToPBAdjs(peerCfgs map[uint][]*pb.WireGuardPeerConfig, allEdges m
 
 	return adjs
 }
+
+// attachExternalPeers 加载网络内的外部设备并挂到规划结果上
+func attachExternalPeers(ctx *app.Context, networkID uint, peers []*models.WireGuard, peerConfigs map[uint][]*pb.WireGuardPeerConfig) error {
+	externals, err := dao.NewQuery(ctx).AdminListWireGuardExternalPeersWithNetworkIDs([]uint{networkID})
+	if err != nil {
+		return err
+	}
+	return wg.AttachExternalPeers(peerConfigs, peers, externals)
+}
+
+// emitPatchNetworkEvent 重新规划整个网络并向所有节点下发 peers
+// 通常在后台 goroutine 中调用，ctx 不带用户信息，userInfo 需由调用方在请求内取出后传入
+func emitPatchNetworkEvent(ctx *app.Context, userInfo models.UserInfo, networkID uint) error {
+	log := ctx.Logger().WithField("op", "emitPatchNetworkEvent")
+	if userInfo == nil || !userInfo.Valid() {
+		return errors.New("invalid user")
+	}
+	q := dao.NewQuery(ctx)
+
+	peers, err := q.GetWireGuardsByNetworkID(userInfo, networkID)
+	if err != nil {
+		return err
+	}
+	if len(peers) == 0 {
+		return nil
+	}
+	links, err := q.ListWireGuardLinksByNetwork(userInfo, networkID)
+	if err != nil {
+		return err
+	}
+
+	peerConfigs, adjs, err := wg.PlanAllowedIPs(peers, links,
+		wg.DefaultRoutingPolicy(
+			wg.NewACL().LoadFromPB(peers[0].Network.ACL.Data),
+			ctx.GetApp().GetNetworkTopologyCache(),
+			ctx.GetApp().GetClientsManager(),
+		))
+	if err != nil {
+		return err
+	}
+	if err := attachExternalPeers(ctx, networkID, peers, peerConfigs); err != nil {
+		return err
+	}
+
+	pushCfg := newNetworkPushConfig(ctx, networkID, peers[0].Network.NetworkEntity, peers)
+
+	for _, peer := range peers {
+		if err := emitPatchWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs, pushCfg); err != nil {
+			log.WithError(err).Errorf("patch wireguard event send to client error")
+		}
+	}
+	return nil
+}
+
+// networkPushConfig 网络内所有节点相同的下发内容：数据面 ACL 与 MagicDNS 记录
+type networkPushConfig struct {
+	acl        *pb.AclConfig
+	name       string
+	dnsRecords []*pb.WireGuardDNSRecord
+}
+
+func newNetworkPushConfig(ctx *app.Context, networkID uint, network *models.NetworkEntity, peers []*models.WireGuard) *networkPushConfig {
+	ret := &networkPushConfig{}
+	externals, err := dao.NewQuery(ctx).AdminListWireGuardExternalPeersWithNetworkIDs([]uint{networkID})
+	if err != nil {
+		ctx.Logger().WithError(err).Warnf("list external peers failed, network id: %d", networkID)
+	}
+	if network != nil {
+		ret.acl = wg.CompileACL(network.ACL.Data, peers, externals)
+		ret.name = network.Name
+	}
+
+	for _, p := range peers {
+		if p == nil || p.WireGuardEntity == nil {
+			continue
+		}
+		addr, _, err := models.ParseIPOrCIDRWithNetip(p.LocalAddress)
+		if err != nil {
+			continue
+		}
+		ret.dnsRecords = append(ret.dnsRecords, &pb.WireGuardDNSRecord{Name: p.Name, ClientId: p.ClientID, VirtualIp: addr.String()})
+	}
+	for _, ext := range externals {
+		addr, _, err := models.ParseIPOrCIDRWithNetip(ext.LocalAddress)
+		if err != nil {
+			continue
+		}
+		ret.dnsRecords = append(ret.dnsRecords, &pb.WireGuardDNSRecord{Name: ext.Name, VirtualIp: addr.String()})
+	}
+	return ret
+}
+
+func (n *networkPushConfig) applyTo(cfg *pb.WireGuardConfig) {
+	if n == nil || cfg == nil {
+		return
+	}
+	cfg.Acl = n.acl
+	cfg.NetworkName = n.name
+	cfg.DnsRecords = n.dnsRecords
+}
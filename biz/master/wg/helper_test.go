@@ -0,0 +1,18 @@
+package wg
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmitPatchNetworkEvent_BackgroundContext(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+
+	// 后台 context 不带用户信息，不能 panic
+	ctxBg := apptest.WithUser(ctx, alice).Background()
+	assert.NoError(t, emitPatchNetworkEvent(ctxBg, alice, 1))
+	assert.Error(t, emitPatchNetworkEvent(ctxBg, nil, 1))
+}
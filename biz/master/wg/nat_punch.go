@@ -0,0 +1,129 @@
+package wg
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+// punchCallTimeout 客户端打洞最多持续 5s，留出 rpc 往返的余量
+const punchCallTimeout = 15 * time.Second
+
+// coordinatePunch 节点 NAT 映射地址变化后，通知它与网络内其他 NAT 节点同时向对方映射地址打洞。
+// 成功的结果立即写入拓扑缓存，客户端下次拉取配置时即按直连重新选路；失败则继续经由中继节点转发
+func coordinatePunch(ctx *app.Context, self *models.WireGuard) {
+	log := ctx.Logger().WithField("op", "coordinatePunch")
+	cache := ctx.GetApp().GetNetworkTopologyCache()
+
+	selfEndpoint, ok := reflexiveEndpoint(cache, self)
+	if !ok || len(self.AdvertisedEndpoints) > 0 {
+		return
+	}
+
+	peers, err := dao.NewQuery(ctx).AdminListWireGuardsWithNetworkIDs([]uint{self.NetworkID})
+	if err != nil {
+		log.WithError(err).Errorf("failed to list wireguards of network %d", self.NetworkID)
+		return
+	}
+	if len(peers) == 0 {
+		return
+	}
+	acl := wgsvc.NewACL().LoadFromPB(peers[0].Network.ACL.Data)
+
+	selfReq := &pb.PunchWireGuardRequest{InterfaceName: lo.ToPtr(self.Name)}
+	peerReqs := map[*models.WireGuard]*pb.PunchWireGuardRequest{}
+	for _, p := range peers {
+		if p.ID == self.ID || len(p.AdvertisedEndpoints) > 0 {
+			continue
+		}
+		if !acl.CanConnect(self, p) && !acl.CanConnect(p, self) {
+			continue
+		}
+		if ctx.GetApp().GetClientsManager().Get(p.ClientID) == nil {
+			continue
+		}
+		peerEndpoint, ok := reflexiveEndpoint(cache, p)
+		if !ok {
+			continue
+		}
+		selfReq.Targets = append(selfReq.Targets, &pb.WireGuardPunchTarget{
+			PeerId: lo.ToPtr(uint32(p.ID)), Endpoint: lo.ToPtr(peerEndpoint),
+		})
+		peerReqs[p] = &pb.PunchWireGuardRequest{
+			InterfaceName: lo.ToPtr(p.Name),
+			Targets: []*pb.WireGuardPunchTarget{{
+				PeerId: lo.ToPtr(uint32(self.ID)), Endpoint: lo.ToPtr(selfEndpoint),
+			}},
+		}
+	}
+	if len(selfReq.Targets) == 0 {
+		return
+	}
+
+	log.Infof("coordinate punching between wireguard [%d] and %d nat peers", self.ID, len(peerReqs))
+
+	// 双方需要同时发包，才能在各自的 NAT 上建立映射
+	var waitGroup sync.WaitGroup
+	punch := func(wgIfce *models.WireGuard, req *pb.PunchWireGuardRequest) {
+		defer waitGroup.Done()
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), punchCallTimeout)
+		defer cancel()
+
+		resp := &pb.PunchWireGuardResponse{}
+		if err := rpc.CallClientWrapper(app.NewContext(timeoutCtx, ctx.GetApp()), wgIfce.ClientID, pb.Event_EVENT_PUNCH_WIREGUARD, req, resp); err != nil {
+			log.WithError(err).Warnf("punch wireguard event send to client [%s] failed", wgIfce.ClientID)
+			return
+		}
+		mergePunchResults(cache, wgIfce.ID, resp.GetResults())
+	}
+
+	waitGroup.Add(1 + len(peerReqs))
+	go punch(self, selfReq)
+	for p, req := range peerReqs {
+		go punch(p, req)
+	}
+	waitGroup.Wait()
+}
+
+func reflexiveEndpoint(cache app.NetworkTopologyCache, w *models.WireGuard) (string, bool) {
+	runtimeInfo, ok := cache.GetRuntimeInfo(w.ID)
+	if !ok || runtimeInfo == nil {
+		return "", false
+	}
+	ap, err := netip.ParseAddrPort(runtimeInfo.GetReflexiveEndpoint())
+	if err != nil || ap.Port() == 0 {
+		return "", false
+	}
+	return ap.String(), true
+}
+
+// mergePunchResults 打洞成功的时延写入 ping map，与客户端下次上报的 endpoint ping 一致
+func mergePunchResults(cache app.NetworkTopologyCache, wireguardID uint, results []*pb.WireGuardPunchResult) {
+	runtimeInfo, ok := cache.GetRuntimeInfo(wireguardID)
+	if !ok || runtimeInfo == nil {
+		return
+	}
+	succeeded := lo.Filter(results, func(r *pb.WireGuardPunchResult, _ int) bool { return r.GetSuccess() })
+	if len(succeeded) == 0 {
+		return
+	}
+
+	runtimeInfo = proto.Clone(runtimeInfo).(*pb.WGDeviceRuntimeInfo)
+	if runtimeInfo.PingMap == nil {
+		runtimeInfo.PingMap = map[uint32]uint32{}
+	}
+	for _, r := range succeeded {
+		runtimeInfo.PingMap[r.GetPeerId()] = r.GetRttMs()
+	}
+	cache.SetRuntimeInfo(wireguardID, runtimeInfo)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
 )
 
 func CreateNetwork(ctx *app.Context, req *pb.CreateNetworkRequest) (*pb.CreateNetworkResponse, error) {
@@ -28,6 +29,11 @@ func CreateNetwork(ctx *app.Context, req *pb.CreateNetworkRequest) (*pb.CreateNe
 		return nil, errors.New("invalid cidr")
 	}
 
+	if err := wgsvc.ValidateACL(req.GetNetwork().GetAcl()); err != nil {
+		log.WithError(err).Errorf("invalid acl")
+		return nil, err
+	}
+
 	entity := &models.NetworkEntity{
 		Name:     req.GetNetwork().GetName(),
 		CIDR:     req.GetNetwork().GetCidr(),
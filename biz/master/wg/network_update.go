@@ -8,6 +8,7 @@ import (
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
 )
 
 func UpdateNetwork(ctx *app.Context, req *pb.UpdateNetworkRequest) (*pb.UpdateNetworkResponse, error) {
@@ -19,11 +20,22 @@ func UpdateNetwork(ctx *app.Context, req *pb.UpdateNetworkRequest) (*pb.UpdateNe
 	if n == nil || n.GetId() == 0 || len(n.GetName()) == 0 || len(n.GetCidr()) == 0 {
 		return nil, errors.New("invalid network")
 	}
+	if err := wgsvc.ValidateACL(n.GetAcl()); err != nil {
+		return nil, err
+	}
 	entity := &models.NetworkEntity{Name: n.GetName(), CIDR: n.GetCidr(), ACL: models.JSON[*pb.AclConfig]{Data: n.GetAcl()}}
 	if err := dao.NewMutation(ctx).UpdateNetwork(userInfo, uint(n.GetId()), entity); err != nil {
 		return nil, err
 	}
 
+	// ACL 可能变化，重新下发给网络内所有节点
+	ctxBg := ctx.Background()
+	go func() {
+		if err := emitPatchNetworkEvent(ctxBg, userInfo, uint(n.GetId())); err != nil {
+			ctxBg.Logger().WithError(err).Errorf("emit patch network event failed")
+		}
+	}()
+
 	e := &models.Network{NetworkEntity: entity}
 	return &pb.UpdateNetworkResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
 		Network: e.ToPB(),
@@ -36,12 +36,23 @@ func CreateWireGuard(ctx *app.Context, req *pb.CreateWireGuardRequest) (*pb.Crea
 		return nil, err
 	}
 
+	externalIps, err := q.GetWireGuardExternalPeerAddressesByNetworkID(userInfo, uint(cfg.GetNetworkId()))
+	if err != nil {
+		log.WithError(err).Errorf("get wireguard external peer addresses by network id failed")
+		return nil, err
+	}
+	ips = append(ips, externalIps...)
+
 	network, err := q.GetNetworkByID(userInfo, uint(cfg.GetNetworkId()))
 	if err != nil {
 		log.WithError(err).Errorf("get network by id failed")
 		return nil, err
 	}
 
+	if err := wgsvc.ValidateAdvertisedRoutes(cfg.GetAdvertisedRoutes(), network.CIDR); err != nil {
+		return nil, err
+	}
+
 	newIpStr, err := utils.AllocateIP(network.CIDR, ips, cfg.GetLocalAddress())
 	if err != nil {
 		log.WithError(err).Errorf("allocate ip failed")
@@ -151,16 +162,22 @@ func emitCreateWireGuardEvent(ctx *app.Context, cfg *pb.WireGuardConfig, network
 		log.WithError(err).Errorf("build peer configs for network failed")
 		return err
 	}
+	if err := attachExternalPeers(ctx, uint(cfg.GetNetworkId()), peers, peerConfigs); err != nil {
+		log.WithError(err).Errorf("attach external peers failed")
+		return err
+	}
+
+	pushCfg := newNetworkPushConfig(ctx, uint(cfg.GetNetworkId()), network, peers)
 
 	for _, peer := range peers {
 		if peer.ClientID == cfg.GetClientId() {
-			if err := emitCreateWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs); err != nil {
+			if err := emitCreateWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs, pushCfg); err != nil {
 				log.WithError(err).Errorf("update config to client failed")
 			}
 			continue
 		}
 
-		if err := emitPatchWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs); err != nil {
+		if err := emitPatchWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs, pushCfg); err != nil {
 			log.WithError(err).Errorf("add wireguard event send to client error")
 			continue
 		}
@@ -171,7 +188,7 @@ func emitCreateWireGuardEvent(ctx *app.Context, cfg *pb.WireGuardConfig, network
 	return nil
 }
 
-func emitCreateWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard, peerConfigs []*pb.WireGuardPeerConfig, adjs map[uint][]wgsvc.Edge) error {
+func emitCreateWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard, peerConfigs []*pb.WireGuardPeerConfig, adjs map[uint][]wgsvc.Edge, pushCfg *networkPushConfig) error {
 	log := ctx.Logger().WithField("op", "updateConfigToClient")
 	userInfo := common.GetUserInfo(ctx)
 	if !userInfo.Valid() {
@@ -181,6 +198,7 @@ func emitCreateWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard,
 	cfg := peer.ToPB()
 	cfg.Peers = peerConfigs
 	cfg.Adjs = adjsToPB(adjs)
+	pushCfg.applyTo(cfg)
 	resp := &pb.CreateWireGuardResponse{}
 
 	req := &pb.CreateWireGuardRequest{
@@ -198,7 +216,7 @@ func emitCreateWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard,
 	return nil
 }
 
-func emitPatchWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard, peerConfigs []*pb.WireGuardPeerConfig, adjs map[uint][]wgsvc.Edge) error {
+func emitPatchWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard, peerConfigs []*pb.WireGuardPeerConfig, adjs map[uint][]wgsvc.Edge, pushCfg *networkPushConfig) error {
 	log := ctx.Logger().WithField("op", "patchWireGuardToClient")
 	userInfo := common.GetUserInfo(ctx)
 	if !userInfo.Valid() {
@@ -208,6 +226,7 @@ func emitPatchWireGuardEventToClient(ctx *app.Context, peer *models.WireGuard, p
 	cfg := peer.ToPB()
 	cfg.Peers = peerConfigs
 	cfg.Adjs = adjsToPB(adjs)
+	pushCfg.applyTo(cfg)
 
 	resp := &pb.UpdateWireGuardResponse{}
 	req := &pb.UpdateWireGuardRequest{
@@ -37,6 +37,11 @@ func DeleteWireGuard(ctx *app.Context, req *pb.DeleteWireGuardRequest) (*pb.Dele
 		return nil, err
 	}
 
+	if err := m.DeleteWireGuardExternalPeersByRelayID(userInfo, id); err != nil {
+		log.WithError(err).Errorf("delete wireguard external peers by relay id failed")
+		return nil, err
+	}
+
 	log.Debugf("delete wireguard success, id: %d", id)
 
 	ctxBg := ctx.Background()
@@ -98,9 +103,15 @@ func emitDeleteWireGuardEvent(ctx *app.Context, wgToDelete *models.WireGuard) er
 		log.WithError(err).Errorf("build peer configs for network failed")
 		return err
 	}
+	if err := attachExternalPeers(ctx, wgToDelete.NetworkID, peers, peerConfigs); err != nil {
+		log.WithError(err).Errorf("attach external peers failed")
+		return err
+	}
+
+	pushCfg := newNetworkPushConfig(ctx, wgToDelete.NetworkID, wgToDelete.Network.NetworkEntity, peers)
 
 	for _, peer := range peers {
-		if err := emitPatchWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs); err != nil {
+		if err := emitPatchWireGuardEventToClient(ctx, peer, peerConfigs[peer.ID], adjs, pushCfg); err != nil {
 			log.WithError(err).Errorf("patch wireguard event send to client error")
 			continue
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	wgsvc "github.com/VaalaCat/frp-panel/services/wg"
 	"github.com/samber/lo"
 )
 
@@ -23,6 +24,14 @@ func UpdateWireGuard(ctx *app.Context, req *pb.UpdateWireGuardRequest) (*pb.Upda
 	q := dao.NewQuery(ctx)
 	m := dao.NewMutation(ctx)
 
+	network, err := q.GetNetworkByID(userInfo, uint(cfg.GetNetworkId()))
+	if err != nil {
+		return nil, err
+	}
+	if err := wgsvc.ValidateAdvertisedRoutes(cfg.GetAdvertisedRoutes(), network.CIDR); err != nil {
+		return nil, err
+	}
+
 	model := &models.WireGuard{}
 	model.FromPB(cfg)
 	model.UserId = uint32(userInfo.GetUserID())
@@ -90,5 +99,14 @@ func UpdateWireGuard(ctx *app.Context, req *pb.UpdateWireGuardRequest) (*pb.Upda
 			return nil, err
 		}
 	}
+
+	// 通告网段、出口节点与标签会影响全网路由，重新下发给网络内所有节点
+	ctxBg := ctx.Background()
+	go func() {
+		if err := emitPatchNetworkEvent(ctxBg, userInfo, network.ID); err != nil {
+			ctxBg.Logger().WithError(err).Errorf("emit patch network event failed")
+		}
+	}()
+
 	return &pb.UpdateWireGuardResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"}, WireguardConfig: cfg}, nil
 }
@@ -11,7 +11,7 @@ import (
 
 func InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest) (*pb.InstallWorkerdResponse, error) {
 	var (
-		userInfo = common.GetUserInfo(ctx)
+		userInfo = common.GetObjectOwnerInfo(ctx)
 		clientId = req.GetClientId()
 	)
 	logger.Logger(ctx).Infof("installw orkerd called with userInfo: %v, clientId: %s", userInfo, clientId)
@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
@@ -78,7 +80,9 @@ func RedeployWorker(ctx *app.Context, req *pb.RedeployWorkerRequest) (*pb.Redepl
 			}, createResp)
 			if err != nil {
 				logger.Logger(bgCtx).WithError(err).Errorf("update new worker event send to client error, client id: [%s], worker name: [%s]", cliId, workerToUpdate.Name)
+				continue
 			}
+			emitWorkerDeployed(bgCtx, cliId, workerToUpdate, "redeploy")
 		}
 
 		logger.Logger(ctx).Infof("redeploy worker event send to client success, clients: [%s], worker name: [%s], remove old worker send to those clients: %s",
@@ -94,3 +98,12 @@ func RedeployWorker(ctx *app.Context, req *pb.RedeployWorkerRequest) (*pb.Redepl
 		},
 	}, nil
 }
+
+// emitWorkerDeployed worker 下发到客户端成功后发布事件，action 为 create、update 或 redeploy
+func emitWorkerDeployed(ctx *app.Context, clientID string, worker *models.Worker, action string) {
+	eventbus.Emit(ctx, defs.EventWorkerDeployed, defs.RBACObjClient, clientID, map[string]any{
+		"worker_id":   worker.ID,
+		"worker_name": worker.Name,
+		"action":      action,
+	})
+}
@@ -94,7 +94,9 @@ func UpdateWorker(ctx *app.Context, req *pb.UpdateWorkerRequest) (*pb.UpdateWork
 			}, createResp)
 			if err != nil {
 				logger.Logger(bgCtx).WithError(err).Errorf("update new worker event send to client error, client id: [%s], worker name: [%s]", newClient.ClientID, workerToUpdate.Name)
+				continue
 			}
+			emitWorkerDeployed(bgCtx, newClient.ClientID, workerToUpdate, "update")
 		}
 
 		logger.Logger(ctx).Infof("update worker event send to client success, clients: [%s], worker name: [%s], remove old worker send to those clients: %s",
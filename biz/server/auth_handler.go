@@ -6,6 +6,7 @@ import (
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/metrics"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	plugin "github.com/fatedier/frp/pkg/plugin/server"
 	"github.com/gin-gonic/gin"
@@ -13,7 +14,10 @@ import (
 
 func NewRouter(appInstance app.Application) *gin.Engine {
 	router := gin.Default()
-	router.POST("/auth", MakeGinHandlerFunc(appInstance, HandleLogin))
+	router.POST("/auth", MakeGinHandlerFunc(appInstance, HandlePlugin))
+	if appInstance.GetConfig().App.MetricsEnable {
+		router.GET("/metrics", metrics.Handler(appInstance, metrics.NewServerCollector(appInstance)))
+	}
 	return router
 }
 
@@ -68,7 +72,12 @@ func HandleLogin(ctx *app.Context) (interface{}, error) {
 		return res, nil
 	}
 	cli := ctx.GetApp().GetMasterCli()
-	authResponse, err := cli.Call().FRPCAuth(ctx, &pb.FRPAuthRequest{User: content.User, Token: token})
+	authResponse, err := cli.Call().FRPCAuth(ctx, &pb.FRPAuthRequest{
+		User:     content.User,
+		Token:    token,
+		ClientId: content.Metas[defs.FRPClientIDKey],
+		Base:     ctx.GetApp().GetServerBase(),
+	})
 	if err != nil {
 		res.Reject = true
 		res.RejectReason = "invalid meta token"
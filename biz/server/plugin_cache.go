@@ -0,0 +1,60 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
+)
+
+// pluginOpCacheTTL 高频 plugin 操作放行结果的缓存时间，吊销 token 或停用代理后最多延迟这么久生效
+const pluginOpCacheTTL = 30 * time.Second
+
+var pluginOpAllowed = &utils.SyncMap[string, time.Time]{}
+
+// cacheablePluginOp 只缓存 Ping/NewWorkConn/NewUserConn，NewProxy/CloseProxy 每次都交给 master 校验
+func cacheablePluginOp(op string) bool {
+	return op == plugin.OpPing || op == plugin.OpNewWorkConn || op == plugin.OpNewUserConn
+}
+
+func pluginOpCacheKey(req *pb.FRPPluginOpRequest) string {
+	return strings.Join([]string{
+		req.GetOp(), req.GetUser(), req.GetClientId(), req.GetToken(),
+		req.GetProxy().GetName(), req.GetProxy().GetType(),
+	}, "\x00")
+}
+
+func pluginOpCached(req *pb.FRPPluginOpRequest) bool {
+	if !cacheablePluginOp(req.GetOp()) {
+		return false
+	}
+	key := pluginOpCacheKey(req)
+	expireAt, ok := pluginOpAllowed.Load(key)
+	if !ok {
+		return false
+	}
+	if time.Now().After(expireAt) {
+		pluginOpAllowed.Delete(key)
+		return false
+	}
+	return true
+}
+
+func cachePluginOpAllowed(req *pb.FRPPluginOpRequest) {
+	if !cacheablePluginOp(req.GetOp()) {
+		return
+	}
+	now := time.Now()
+	// 顺带清理过期项，避免 run id / 代理变化后旧 key 一直累积
+	if pluginOpAllowed.Len() > 1024 {
+		pluginOpAllowed.Range(func(k string, v time.Time) bool {
+			if now.After(v) {
+				pluginOpAllowed.Delete(k)
+			}
+			return true
+		})
+	}
+	pluginOpAllowed.Store(pluginOpCacheKey(req), now.Add(pluginOpCacheTTL))
+}
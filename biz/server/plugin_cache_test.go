@@ -0,0 +1,25 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginOpCache(t *testing.T) {
+	ping := &pb.FRPPluginOpRequest{Op: plugin.OpPing, User: "alice", ClientId: "c1", Token: "t"}
+	assert.False(t, pluginOpCached(ping))
+	cachePluginOpAllowed(ping)
+	assert.True(t, pluginOpCached(ping))
+
+	// token 变化后不能命中
+	other := &pb.FRPPluginOpRequest{Op: plugin.OpPing, User: "alice", ClientId: "c1", Token: "t2"}
+	assert.False(t, pluginOpCached(other))
+
+	// NewProxy 每次都要交给 master 校验
+	newProxy := &pb.FRPPluginOpRequest{Op: plugin.OpNewProxy, User: "alice", ClientId: "c1", Token: "t"}
+	cachePluginOpAllowed(newProxy)
+	assert.False(t, pluginOpCached(newProxy))
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
+)
+
+// HandlePlugin dispatch frp server plugin request by op, see https://github.com/fatedier/frp/blob/dev/doc/server_plugin.md
+func HandlePlugin(ctx *app.Context) (interface{}, error) {
+	op := ctx.GetGinCtx().Query("op")
+	switch op {
+	case plugin.OpLogin:
+		return HandleLogin(ctx)
+	case plugin.OpNewProxy:
+		return HandleNewProxy(ctx)
+	case plugin.OpCloseProxy:
+		return HandleCloseProxy(ctx)
+	case plugin.OpPing:
+		return HandlePing(ctx)
+	case plugin.OpNewWorkConn:
+		return HandleNewWorkConn(ctx)
+	case plugin.OpNewUserConn:
+		return HandleNewUserConn(ctx)
+	default:
+		return nil, &HTTPError{
+			Code: http.StatusBadRequest,
+			Err:  fmt.Errorf("unsupported plugin op: [%s]", op),
+		}
+	}
+}
+
+func HandleNewProxy(ctx *app.Context) (interface{}, error) {
+	var content plugin.NewProxyContent
+	if err := bindPluginContent(ctx, &content); err != nil {
+		return nil, err
+	}
+
+	return callMasterPluginOp(ctx, newPluginOpRequest(plugin.OpNewProxy, content.User, &pb.FRPPluginProxy{
+		Name:               content.ProxyName,
+		Type:               content.ProxyType,
+		RemotePort:         int32(content.RemotePort),
+		Subdomain:          content.SubDomain,
+		CustomDomains:      content.CustomDomains,
+		BandwidthLimit:     content.BandwidthLimit,
+		BandwidthLimitMode: content.BandwidthLimitMode,
+	})), nil
+}
+
+func HandleCloseProxy(ctx *app.Context) (interface{}, error) {
+	var content plugin.CloseProxyContent
+	if err := bindPluginContent(ctx, &content); err != nil {
+		return nil, err
+	}
+
+	return callMasterPluginOp(ctx, newPluginOpRequest(plugin.OpCloseProxy, content.User, &pb.FRPPluginProxy{
+		Name: content.ProxyName,
+	})), nil
+}
+
+func HandlePing(ctx *app.Context) (interface{}, error) {
+	var content plugin.PingContent
+	if err := bindPluginContent(ctx, &content); err != nil {
+		return nil, err
+	}
+
+	return callMasterPluginOp(ctx, newPluginOpRequest(plugin.OpPing, content.User, nil)), nil
+}
+
+func HandleNewWorkConn(ctx *app.Context) (interface{}, error) {
+	var content plugin.NewWorkConnContent
+	if err := bindPluginContent(ctx, &content); err != nil {
+		return nil, err
+	}
+
+	return callMasterPluginOp(ctx, newPluginOpRequest(plugin.OpNewWorkConn, content.User, nil)), nil
+}
+
+func HandleNewUserConn(ctx *app.Context) (interface{}, error) {
+	var content plugin.NewUserConnContent
+	if err := bindPluginContent(ctx, &content); err != nil {
+		return nil, err
+	}
+
+	req := newPluginOpRequest(plugin.OpNewUserConn, content.User, &pb.FRPPluginProxy{
+		Name: content.ProxyName,
+		Type: content.ProxyType,
+	})
+	req.RemoteAddr = content.RemoteAddr
+	return callMasterPluginOp(ctx, req), nil
+}
+
+func bindPluginContent(ctx *app.Context, content any) error {
+	r := plugin.Request{Content: content}
+	if err := ctx.GetGinCtx().BindJSON(&r); err != nil {
+		return &HTTPError{
+			Code: http.StatusBadRequest,
+			Err:  err,
+		}
+	}
+	return nil
+}
+
+func newPluginOpRequest(op string, user plugin.UserInfo, proxy *pb.FRPPluginProxy) *pb.FRPPluginOpRequest {
+	return &pb.FRPPluginOpRequest{
+		Op:       op,
+		User:     user.User,
+		Token:    user.Metas[defs.FRPAuthTokenKey],
+		ClientId: user.Metas[defs.FRPClientIDKey],
+		RunId:    user.RunID,
+		Proxy:    proxy,
+	}
+}
+
+func callMasterPluginOp(ctx *app.Context, req *pb.FRPPluginOpRequest) plugin.Response {
+	var res plugin.Response
+	if len(req.GetUser()) == 0 || len(req.GetToken()) == 0 || len(req.GetClientId()) == 0 {
+		res.Reject = true
+		res.RejectReason = "user, meta token or client id can not be empty"
+		return res
+	}
+
+	if pluginOpCached(req) {
+		res.Unchange = true
+		return res
+	}
+
+	req.Base = ctx.GetApp().GetServerBase()
+	cli := ctx.GetApp().GetMasterCli()
+	opResp, err := cli.Call().FRPPluginOp(ctx, req)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("call master plugin op error, op: [%s], user: [%s]", req.GetOp(), req.GetUser())
+		res.Reject = true
+		res.RejectReason = "master rejected this operation"
+		return res
+	}
+
+	if opResp.GetReject() {
+		logger.Logger(ctx).Infof("master reject plugin op, op: [%s], user: [%s], reason: [%s]", req.GetOp(), req.GetUser(), opResp.GetRejectReason())
+		res.Reject = true
+		res.RejectReason = opResp.GetRejectReason()
+		return res
+	}
+
+	cachePluginOpAllowed(req)
+	res.Unchange = true
+	return res
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/fatedier/frp/pkg/metrics/mem"
 	"github.com/samber/lo"
 )
 
@@ -38,6 +39,7 @@ func PushProxyInfo(appInstance app.Application, serverID, serverSecret string) e
 					TodayTrafficIn:  lo.ToPtr(proxyStats.TodayTrafficIn),
 					TodayTrafficOut: lo.ToPtr(proxyStats.TodayTrafficOut),
 					FirstSync:       lo.ToPtr(firstSync),
+					Online:          lo.ToPtr(isProxyOnline(proxyStats)),
 				})
 			}
 		}
@@ -60,3 +62,8 @@ func PushProxyInfo(appInstance app.Application, serverID, serverSecret string) e
 	}
 	return nil
 }
+
+// isProxyOnline frps 只保留 "01-02 15:04:05" 格式的启停时间，同格式下按字符串比较即可
+func isProxyOnline(ps *mem.ProxyStats) bool {
+	return len(ps.LastCloseTime) == 0 || ps.LastStartTime >= ps.LastCloseTime
+}
@@ -2,17 +2,21 @@ package shared
 
 import (
 	"context"
+	"net"
 
 	bizclient "github.com/VaalaCat/frp-panel/biz/client"
 	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/api"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/clientrpc"
+	"github.com/VaalaCat/frp-panel/services/metrics"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/services/tunnel"
 	"github.com/VaalaCat/frp-panel/services/watcher"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
 	"github.com/sourcegraph/conc"
 	"go.uber.org/fx"
 )
@@ -56,7 +60,10 @@ func runClient(param runClientParam) {
 	param.TaskManager.AddDurationTask(defs.ReportWireGuardRuntimeInfoDuration,
 		bizclient.ReportWireGuardRuntimeInfo, appInstance, clientID, clientSecret)
 
-	var wg conc.WaitGroup
+	var (
+		wg         conc.WaitGroup
+		metricsSrv app.Service
+	)
 	param.Lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			appInstance.SetRPCCred(NewClientCred(appInstance))
@@ -81,11 +88,17 @@ func runClient(param runClientParam) {
 
 			wg.Go(cliRpcHandler.Run)
 			wg.Go(param.TaskManager.Run)
+			if metricsSrv = newClientMetricsService(appInstance); metricsSrv != nil {
+				wg.Go(metricsSrv.Run)
+			}
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
 			param.TaskManager.Stop()
 			appInstance.GetClientRPCHandler().Stop()
+			if metricsSrv != nil {
+				metricsSrv.Stop()
+			}
 
 			wg.Wait()
 			return nil
@@ -93,6 +106,24 @@ func runClient(param runClientParam) {
 	})
 }
 
+// newClientMetricsService 客户端没有 api 服务，开启指标时单独监听一个端口
+func newClientMetricsService(appInstance app.Application) app.Service {
+	cfg := appInstance.GetConfig()
+	if !cfg.App.MetricsEnable {
+		return nil
+	}
+
+	l, err := net.Listen("tcp", conf.ClientMetricsListenAddr(cfg))
+	if err != nil {
+		logger.Logger(context.Background()).WithError(err).Errorf("failed to listen metrics addr: %v", conf.ClientMetricsListenAddr(cfg))
+		return nil
+	}
+
+	router := gin.New()
+	router.GET("/metrics", metrics.Handler(appInstance, metrics.NewClientCollector(appInstance)))
+	return api.NewApiService(l, router, true)
+}
+
 func initClientOnce(appInstance app.Application, clientID, clientSecret string) {
 	err := bizclient.PullConfig(appInstance, clientID, clientSecret)
 	if err != nil {
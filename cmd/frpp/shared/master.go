@@ -3,14 +3,25 @@ package shared
 import (
 	"context"
 
+	"github.com/VaalaCat/frp-panel/biz/master/alert"
+	"github.com/VaalaCat/frp-panel/biz/master/audit"
 	"github.com/VaalaCat/frp-panel/biz/master/auth"
+	"github.com/VaalaCat/frp-panel/biz/master/platform"
 	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/biz/master/quota"
+	"github.com/VaalaCat/frp-panel/biz/master/shell"
+	"github.com/VaalaCat/frp-panel/biz/master/streamlog"
+	"github.com/VaalaCat/frp-panel/biz/master/webhook"
 	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/eventbus"
+	"github.com/VaalaCat/frp-panel/services/logarchive"
 	"github.com/VaalaCat/frp-panel/services/master"
 	"github.com/VaalaCat/frp-panel/services/mux"
 	"github.com/VaalaCat/frp-panel/services/watcher"
+	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/VaalaCat/frp-panel/utils/wsgrpc"
 	"github.com/gin-gonic/gin"
@@ -41,12 +52,30 @@ type runMasterParam struct {
 func runMaster(param runMasterParam) {
 
 	param.AppInstance.SetClientLogManager(param.ClientLogManager)
+	param.AppInstance.SetClientStatusManager(platform.NewClientStatusManager())
+	param.AppInstance.SetEventBus(eventbus.NewBus())
+	eventbus.WatchClients(param.AppInstance)
+	webhook.StartDispatcher(param.AppInstance)
+	if cfg := param.AppInstance.GetConfig(); cfg.Master.LogArchiveEnable {
+		param.AppInstance.SetLogArchive(logarchive.NewStore(cfg.Master.LogArchiveDir))
+	}
 	param.MasterRouter.GET("/wsgrpc", param.WsGrpcHandler)
 
+	utils.SetOutboundAllowPrivate(param.AppInstance.GetConfig().Master.OutboundAllowPrivate)
 	cache.InitCache(param.AppInstance.GetConfig())
 	auth.InitAuth(param.AppInstance)
 
 	param.TaskManager.AddCronTask("0 0 3 * * *", proxy.CollectDailyStats, param.AppInstance)
+	param.TaskManager.AddCronTask("0 30 3 * * *", audit.CleanExpiredAuditLogs, param.AppInstance)
+	param.TaskManager.AddCronTask("0 40 3 * * *", shell.CleanExpiredPTYRecordings, param.AppInstance)
+	param.TaskManager.AddCronTask("0 5 * * * *", streamlog.CleanExpiredArchivedLogs, param.AppInstance)
+	param.TaskManager.AddCronTask("0 10 * * * *", proxy.DownsampleTrafficPoints, param.AppInstance)
+	param.TaskManager.AddCronTask("0 1 0 * * *", quota.ResetTrafficQuotas, param.AppInstance)
+	param.TaskManager.AddDurationTask(defs.AlertEvaluateDuration, alert.EvaluateAlertRules, param.AppInstance)
+	param.TaskManager.AddDurationTask(defs.ClientHeartbeatDuration, platform.ProbeClientsStatus, param.AppInstance)
+	param.TaskManager.AddDurationTask(defs.WebhookRetryDuration, webhook.RetryWebhookDeliveries, param.AppInstance)
+	param.TaskManager.AddDurationTask(defs.EvictIdleLogHubsDuration, streamlog.EvictIdleLogHubs, param.AppInstance)
+	param.TaskManager.AddDurationTask(defs.FlushArchivedLogsDuration, streamlog.FlushArchivedLogs, param.AppInstance)
 	defer param.TaskManager.Stop()
 
 	logger.Logger(param.Ctx).Infof("start to run master")
@@ -70,6 +99,9 @@ func runMaster(param runMasterParam) {
 			param.HTTPMuxServer.Stop()
 			param.TaskManager.Stop()
 			wg.Wait()
+			if archive := param.AppInstance.GetLogArchive(); archive != nil {
+				archive.Close()
+			}
 			return nil
 		},
 	})
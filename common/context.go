@@ -22,6 +22,20 @@ func GetUserInfo(c context.Context) models.UserInfo {
 	return u
 }
 
+// GetObjectOwnerInfo 返回请求中资源的属主，只用于 dao 按属主查询与拼接属主的 frp 用户名，
+// 鉴权与审计仍使用 GetUserInfo。访问自己的资源或未经过对象鉴权时返回当前用户
+func GetObjectOwnerInfo(c context.Context) models.UserInfo {
+	if u, ok := c.Value(defs.ObjectOwnerKey).(*models.UserEntity); ok {
+		return u
+	}
+	return GetUserInfo(c)
+}
+
+// WithUserInfo 以指定用户身份构造 context，供后台任务复用依赖登录用户的业务流程
+func WithUserInfo(c context.Context, u *models.UserEntity) context.Context {
+	return context.WithValue(c, defs.UserInfoKey, u)
+}
+
 func GetTokenPermission(c context.Context) ([]defs.APIPermission, error) {
 	val := c.Value(defs.TokenPayloadKey_Permissions)
 	if val == nil {
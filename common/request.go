@@ -3,40 +3,15 @@ package common
 import (
 	"fmt"
 
-	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-type ReqType interface {
-	pb.UpdateFRPCRequest | pb.RemoveFRPCRequest |
-		pb.UpdateFRPSRequest | pb.RemoveFRPSRequest |
-		pb.CommonRequest | pb.RegisterRequest | pb.LoginRequest |
-		pb.InitClientRequest | pb.ListClientsRequest | pb.GetClientRequest |
-		pb.DeleteClientRequest |
-		pb.InitServerRequest | pb.ListServersRequest | pb.GetServerRequest |
-		pb.DeleteServerRequest |
-		pb.GetUserInfoRequest | pb.UpdateUserInfoRequest |
-		pb.GetPlatformInfoRequest | pb.GetClientsStatusRequest |
-		pb.GetClientCertRequest |
-		pb.StartFRPCRequest | pb.StopFRPCRequest | pb.StartFRPSRequest | pb.StopFRPSRequest |
-		pb.GetProxyStatsByClientIDRequest | pb.GetProxyStatsByServerIDRequest |
-		pb.CreateProxyConfigRequest | pb.ListProxyConfigsRequest | pb.UpdateProxyConfigRequest |
-		pb.DeleteProxyConfigRequest | pb.GetProxyConfigRequest | pb.SignTokenRequest |
-		pb.StartProxyRequest | pb.StopProxyRequest |
-		pb.CreateWorkerRequest | pb.RemoveWorkerRequest | pb.RunWorkerRequest | pb.StopWorkerRequest | pb.UpdateWorkerRequest | pb.GetWorkerRequest |
-		pb.ListWorkersRequest | pb.CreateWorkerIngressRequest | pb.GetWorkerIngressRequest |
-		pb.GetWorkerStatusRequest | pb.InstallWorkerdRequest | pb.RedeployWorkerRequest | pb.UpgradeFrppRequest |
-		pb.StartSteamLogRequest |
-		// wireguard api
-		pb.CreateNetworkRequest | pb.DeleteNetworkRequest | pb.UpdateNetworkRequest | pb.GetNetworkRequest | pb.ListNetworksRequest | pb.RestartWireGuardRequest |
-		pb.CreateEndpointRequest | pb.DeleteEndpointRequest | pb.UpdateEndpointRequest | pb.GetEndpointRequest | pb.ListEndpointsRequest |
-		pb.CreateWireGuardRequest | pb.DeleteWireGuardRequest | pb.UpdateWireGuardRequest | pb.GetWireGuardRequest | pb.ListWireGuardsRequest |
-		pb.CreateWireGuardLinkRequest | pb.DeleteWireGuardLinkRequest | pb.UpdateWireGuardLinkRequest | pb.GetWireGuardLinkRequest | pb.ListWireGuardLinksRequest |
-		pb.GetWireGuardRuntimeInfoRequest | pb.GetNetworkTopologyRequest
-}
+// ReqType 接口请求类型，均为 pb 中的 message，数量超过了编译器 union 的 100 项限制，
+// 因此不再用 union 约束，在序列化时通过 protoreflect.ProtoMessage 校验
+type ReqType = any
 
 func GetProtoRequest[T ReqType](c *gin.Context) (r *T, err error) {
 	r = new(T)
@@ -11,33 +11,9 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-type RespType interface {
-	pb.UpdateFRPCResponse | pb.RemoveFRPCResponse |
-		pb.UpdateFRPSResponse | pb.RemoveFRPSResponse |
-		pb.CommonResponse | pb.RegisterResponse | pb.LoginResponse |
-		pb.InitClientResponse | pb.ListClientsResponse | pb.GetClientResponse |
-		pb.DeleteClientResponse |
-		pb.InitServerResponse | pb.ListServersResponse | pb.GetServerResponse |
-		pb.DeleteServerResponse |
-		pb.GetUserInfoResponse | pb.UpdateUserInfoResponse |
-		pb.GetPlatformInfoResponse | pb.GetClientsStatusResponse |
-		pb.GetClientCertResponse |
-		pb.StartFRPCResponse | pb.StopFRPCResponse | pb.StartFRPSResponse | pb.StopFRPSResponse |
-		pb.GetProxyStatsByClientIDResponse | pb.GetProxyStatsByServerIDResponse |
-		pb.CreateProxyConfigResponse | pb.ListProxyConfigsResponse | pb.UpdateProxyConfigResponse |
-		pb.DeleteProxyConfigResponse | pb.GetProxyConfigResponse | pb.SignTokenResponse |
-		pb.StartProxyResponse | pb.StopProxyResponse |
-		pb.CreateWorkerResponse | pb.RemoveWorkerResponse | pb.RunWorkerResponse | pb.StopWorkerResponse | pb.UpdateWorkerResponse | pb.GetWorkerResponse |
-		pb.ListWorkersResponse | pb.CreateWorkerIngressResponse | pb.GetWorkerIngressResponse |
-		pb.GetWorkerStatusResponse | pb.InstallWorkerdResponse | pb.RedeployWorkerResponse | pb.UpgradeFrppResponse |
-		pb.StartSteamLogResponse |
-		// wireguard api
-		pb.CreateNetworkResponse | pb.DeleteNetworkResponse | pb.UpdateNetworkResponse | pb.GetNetworkResponse | pb.ListNetworksResponse | pb.RestartWireGuardResponse |
-		pb.CreateEndpointResponse | pb.DeleteEndpointResponse | pb.UpdateEndpointResponse | pb.GetEndpointResponse | pb.ListEndpointsResponse |
-		pb.CreateWireGuardResponse | pb.DeleteWireGuardResponse | pb.UpdateWireGuardResponse | pb.GetWireGuardResponse | pb.ListWireGuardsResponse |
-		pb.CreateWireGuardLinkResponse | pb.DeleteWireGuardLinkResponse | pb.UpdateWireGuardLinkResponse | pb.GetWireGuardLinkResponse | pb.ListWireGuardLinksResponse |
-		pb.GetWireGuardRuntimeInfoResponse | pb.GetNetworkTopologyResponse
-}
+// RespType 接口响应类型，均为 pb 中的 message，数量超过了编译器 union 的 100 项限制，
+// 因此不再用 union 约束，在序列化时通过 protoreflect.ProtoMessage 校验
+type RespType = any
 
 func OKResp[T RespType](c *gin.Context, origin *T) {
 	c.Header(defs.TraceIDKey, c.GetString(defs.TraceIDKey))
@@ -126,6 +102,8 @@ func getEvent(origin interface{}) (pb.Event, protoreflect.ProtoMessage, error) {
 		return pb.Event_EVENT_RESTART_WIREGUARD, ptr, nil
 	case *pb.GetWireGuardRuntimeInfoResponse:
 		return pb.Event_EVENT_GET_WIREGUARD_RUNTIME_INFO, ptr, nil
+	case *pb.PunchWireGuardResponse:
+		return pb.Event_EVENT_PUNCH_WIREGUARD, ptr, nil
 	default:
 		return 0, nil, fmt.Errorf("cannot unmarshal unknown type: %T", origin)
 	}
@@ -8,12 +8,14 @@ import (
 	"math/big"
 	"net"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
+	plugin "github.com/fatedier/frp/pkg/plugin/server"
 )
 
 func RPCListenAddr(cfg Config) string {
@@ -36,6 +38,10 @@ func ServerAPIListenAddr(cfg Config) string {
 	return fmt.Sprintf(":%d", cfg.Server.APIPort)
 }
 
+func ClientMetricsListenAddr(cfg Config) string {
+	return fmt.Sprintf(":%d", cfg.Client.MetricsPort)
+}
+
 func FRPsAuthOption(cfg Config) v1.HTTPPluginOptions {
 	authUrl := fmt.Sprintf("http://%s:%d/auth", defs.LocalHost, cfg.Server.APIPort)
 	parsedUrl, err := url.Parse(authUrl)
@@ -45,7 +51,14 @@ func FRPsAuthOption(cfg Config) v1.HTTPPluginOptions {
 
 	return v1.HTTPPluginOptions{
 		Name: defs.FRP_Plugin_Multiuser,
-		Ops:  []string{"Login"},
+		Ops: []string{
+			plugin.OpLogin,
+			plugin.OpNewProxy,
+			plugin.OpCloseProxy,
+			plugin.OpPing,
+			plugin.OpNewWorkConn,
+			plugin.OpNewUserConn,
+		},
 		Addr: parsedUrl.Host,
 		Path: parsedUrl.Path,
 	}
@@ -187,3 +200,21 @@ func GetRPCConnInfo(cfg Config) ConnInfo {
 		Scheme: Scheme(parsedUrl.Scheme),
 	}
 }
+
+// OIDCRedirectURL 未配置时使用 master api 地址拼接回调路径
+func OIDCRedirectURL(cfg Config) string {
+	if len(cfg.App.OIDC.RedirectURL) != 0 {
+		return cfg.App.OIDC.RedirectURL
+	}
+	return fmt.Sprintf("%s://%s:%d/api/v1/auth/oidc/callback", cfg.Master.APIScheme, cfg.Master.APIHost, cfg.Master.APIPort)
+}
+
+func OIDCScopes(cfg Config) []string {
+	scopes := []string{}
+	for _, s := range strings.Split(cfg.App.OIDC.Scopes, ",") {
+		if s = strings.TrimSpace(s); len(s) > 0 {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
@@ -28,15 +28,43 @@ type Config struct {
 		CookieHTTPOnly bool   `env:"COOKIE_HTTP_ONLY" env-default:"true" env-description:"cookie http only"`
 		EnableRegister bool   `env:"ENABLE_REGISTER" env-default:"false" env-description:"enable register, only allow the first admin to register"`
 		GithubProxyUrl string `env:"GITHUB_PROXY_URL" env-default:"https://ghfast.top/" env-description:"github proxy url"`
+		FRPTokenTTL    int    `env:"FRP_TOKEN_TTL" env-default:"0" env-description:"frpc client scoped token ttl in second, 0 means never expire"`
+		AllowUserToken bool   `env:"ALLOW_USER_FRP_TOKEN" env-default:"true" env-description:"allow frpc to login with the legacy per user token"`
+		Enforce2FA     bool   `env:"ENFORCE_2FA" env-default:"false" env-description:"require all users to enable totp two-factor authentication"`
+		MFARecentTTL   int    `env:"MFA_RECENT_TTL" env-default:"900" env-description:"seconds a second-factor verification stays valid for sensitive routes"`
+		MetricsEnable  bool   `env:"METRICS_ENABLE" env-default:"false" env-description:"expose prometheus metrics at /metrics"`
+		MetricsToken   string `env:"METRICS_TOKEN" env-description:"bearer token required to scrape /metrics, empty means no auth"`
+		OIDC           struct {
+			Enable        bool   `env:"ENABLE" env-default:"false" env-description:"enable oidc single sign-on"`
+			Issuer        string `env:"ISSUER" env-description:"oidc issuer url, eg: https://accounts.google.com"`
+			ClientID      string `env:"CLIENT_ID" env-description:"oidc client id"`
+			ClientSecret  string `env:"CLIENT_SECRET" env-description:"oidc client secret"`
+			RedirectURL   string `env:"REDIRECT_URL" env-description:"oidc redirect url, default is {master api}/api/v1/auth/oidc/callback"`
+			Scopes        string `env:"SCOPES" env-default:"openid,profile,email" env-description:"oidc scopes, split by comma"`
+			UsernameClaim string `env:"USERNAME_CLAIM" env-default:"preferred_username" env-description:"claim used as username"`
+			EmailClaim    string `env:"EMAIL_CLAIM" env-default:"email" env-description:"claim used as email"`
+			GroupsClaim   string `env:"GROUPS_CLAIM" env-default:"groups" env-description:"claim mapped to user groups, empty to disable group sync"`
+			AutoProvision bool   `env:"AUTO_PROVISION" env-default:"true" env-description:"create user on first sso login"`
+		} `env-prefix:"OIDC_"`
 	} `env-prefix:"APP_"`
 	Master struct {
-		APIPort               int    `env:"API_PORT" env-default:"9000" env-description:"master api port"`
-		APIHost               string `env:"API_HOST" env-description:"master host, can behind proxy like cdn"`
-		APIScheme             string `env:"API_SCHEME" env-default:"http" env-description:"master api scheme"`
-		CacheSize             int    `env:"CACHE_SIZE" env-default:"10" env-description:"cache size in MB"`
-		RPCHost               string `env:"RPC_HOST" env-default:"127.0.0.1" env-description:"master host, is a public ip or domain"`
-		RPCPort               int    `env:"RPC_PORT" env-default:"9001" env-description:"master rpc port"`
-		InternalFRPServerHost string `env:"INTERNAL_FRP_SERVER_HOST" env-description:"internal frp server host, used for client connection"`
+		APIPort                     int    `env:"API_PORT" env-default:"9000" env-description:"master api port"`
+		APIHost                     string `env:"API_HOST" env-description:"master host, can behind proxy like cdn"`
+		APIScheme                   string `env:"API_SCHEME" env-default:"http" env-description:"master api scheme"`
+		CacheSize                   int    `env:"CACHE_SIZE" env-default:"10" env-description:"cache size in MB"`
+		RPCHost                     string `env:"RPC_HOST" env-default:"127.0.0.1" env-description:"master host, is a public ip or domain"`
+		RPCPort                     int    `env:"RPC_PORT" env-default:"9001" env-description:"master rpc port"`
+		InternalFRPServerHost       string `env:"INTERNAL_FRP_SERVER_HOST" env-description:"internal frp server host, used for client connection"`
+		AuditRetentionDays          int    `env:"AUDIT_RETENTION_DAYS" env-default:"90" env-description:"days to keep audit logs, 0 means keep forever"`
+		PTYRecordDir                string `env:"PTY_RECORD_DIR" env-default:"/data/recordings" env-description:"dir to store pty session recordings"`
+		PTYRecordMandatory          bool   `env:"PTY_RECORD_MANDATORY" env-default:"false" env-description:"record every pty session, otherwise only when requested"`
+		PTYRecordRetentionDays      int    `env:"PTY_RECORD_RETENTION_DAYS" env-default:"30" env-description:"days to keep pty session recordings, 0 means keep forever"`
+		LogArchiveEnable            bool   `env:"LOG_ARCHIVE_ENABLE" env-default:"false" env-description:"keep clients and servers shipping stream logs and archive them on master"`
+		LogArchiveDir               string `env:"LOG_ARCHIVE_DIR" env-default:"/data/logs" env-description:"dir to store archived stream logs"`
+		LogArchiveRetentionDays     int    `env:"LOG_ARCHIVE_RETENTION_DAYS" env-default:"7" env-description:"days to keep archived stream logs, 0 means keep forever"`
+		TrafficMinuteRetentionHours int    `env:"TRAFFIC_MINUTE_RETENTION_HOURS" env-default:"48" env-description:"hours to keep per-minute traffic points before merging them into hourly points"`
+		TrafficHourRetentionDays    int    `env:"TRAFFIC_HOUR_RETENTION_DAYS" env-default:"90" env-description:"days to keep hourly traffic points, 0 means keep forever"`
+		OutboundAllowPrivate        bool   `env:"OUTBOUND_ALLOW_PRIVATE" env-default:"false" env-description:"allow alert channels and webhooks to reach private, loopback and link-local addresses"`
 	} `env-prefix:"MASTER_"`
 	Server struct {
 		APIPort int `env:"API_PORT" env-default:"8999" env-description:"server api port"`
@@ -46,12 +74,15 @@ type Config struct {
 		DSN  string `env:"DSN" env-default:"/data/data.db?_pragma=journal_mode(WAL)" env-description:"db dsn, for sqlite is path, other is dsn, look at https://github.com/go-sql-driver/mysql#dsn-data-source-name"`
 	} `env-prefix:"DB_"`
 	Client struct {
-		ID                    string `env:"ID" env-description:"client id"`
-		Secret                string `env:"SECRET" env-description:"client secret"`
-		TLSRpc                bool   `env:"TLS_RPC" env-default:"true" env-description:"use tls for rpc connection"`
-		RPCUrl                string `env:"RPC_URL" env-description:"rpc url, support ws or wss or grpc scheme, eg: ws://127.0.0.1:9000"`
-		APIUrl                string `env:"API_URL" env-description:"api url, support http or https scheme, eg: http://127.0.0.1:9000"`
-		TLSInsecureSkipVerify bool   `env:"TLS_INSECURE_SKIP_VERIFY" env-default:"true" env-description:"skip tls verify"`
+		ID                    string   `env:"ID" env-description:"client id"`
+		Secret                string   `env:"SECRET" env-description:"client secret"`
+		TLSRpc                bool     `env:"TLS_RPC" env-default:"true" env-description:"use tls for rpc connection"`
+		RPCUrl                string   `env:"RPC_URL" env-description:"rpc url, support ws or wss or grpc scheme, eg: ws://127.0.0.1:9000"`
+		APIUrl                string   `env:"API_URL" env-description:"api url, support http or https scheme, eg: http://127.0.0.1:9000"`
+		TLSInsecureSkipVerify bool     `env:"TLS_INSECURE_SKIP_VERIFY" env-default:"true" env-description:"skip tls verify"`
+		MetricsPort           int      `env:"METRICS_PORT" env-default:"8998" env-description:"client metrics port, only listen when metrics is enabled"`
+		MagicDNSUpstream      string   `env:"MAGIC_DNS_UPSTREAM" env-description:"upstream dns server for names outside wireguard networks, eg: 1.1.1.1:53, default is the first non-loopback nameserver in system resolv.conf"`
+		STUNServers           []string `env:"STUN_SERVERS" env-description:"extra stun servers used to discover nat mapped address, comma separated, eg: stun.l.google.com:19302, nodes with public udp endpoint in the same network that enable nat traversal are also used"`
 		Worker                struct {
 			WorkerdBinaryPath  string `env:"WORKERD_BINARY_PATH" env-description:"workerd binary path"`
 			WorkerdWorkDir     string `env:"WORKERD_WORK_DIR" env-default:"/tmp/frpp/workerd" env-description:"workerd work dir"`
@@ -62,8 +93,10 @@ type Config struct {
 			} `env-prefix:"WORKERD_DOWNLOAD_URL_" env-description:"workerd download url"`
 		} `env-prefix:"WORKER_" env-description:"worker's config"`
 		Features struct {
-			EnableFunctions   bool `env:"ENABLE_FUNCTIONS" env-default:"true" env-description:"enable functions"`
-			EnableRemoteShell bool `env:"ENABLE_REMOTE_SHELL" env-default:"true" env-description:"enable remote shell"`
+			EnableFunctions    bool `env:"ENABLE_FUNCTIONS" env-default:"true" env-description:"enable functions"`
+			EnableRemoteShell  bool `env:"ENABLE_REMOTE_SHELL" env-default:"true" env-description:"enable remote shell"`
+			EnableMagicDNS     bool `env:"ENABLE_MAGIC_DNS" env-default:"false" env-description:"serve <name>.<network>.internal for wireguard network on wireguard address"`
+			EnableNATTraversal bool `env:"ENABLE_NAT_TRAVERSAL" env-default:"false" env-description:"discover nat mapped address and punch holes between wireguard peers without public endpoint"`
 		} `env-prefix:"FEATURES_" env-description:"features config"`
 	} `env-prefix:"CLIENT_"`
 	IsDebug bool `env:"IS_DEBUG" env-default:"false" env-description:"is debug mode"`
@@ -20,6 +20,7 @@ const (
 	FRPAuthTokenKey     = "token"
 	ErrKey              = "err"
 	UserInfoKey         = "x-vaala-userinfo"
+	ObjectOwnerKey      = "x-vaala-object-owner"
 	FRPClientIDKey      = "x-vaala-frp-client-id"
 )
 
@@ -30,8 +31,11 @@ const (
 	ErrParamNotValid    = "param not valid"
 	ErrDB               = "database error"
 	ErrNotFound         = "data not found"
+	ErrPermissionDenied = "permission denied"
 	ErrCodeNotFound     = "code not found"
 	ErrCodeAlreadyUsed  = "code already used"
+	ErrMFARequired      = "two-factor verification required"
+	ErrMFANotEnrolled   = "two-factor enrollment required"
 )
 
 const (
@@ -65,9 +69,23 @@ const (
 const (
 	DefaultServerID    = "default"
 	DefaultAdminUserID = 1
+	DefaultTenantID    = 0
 	DefaultServiceName = "frpp"
 )
 
+// 审计日志类型
+const (
+	AuditKindAPI = "api"
+	AuditKindPTY = "pty"
+	AuditKindRPC = "rpc"
+
+	AuditRoutePTYOpen  = "open"
+	AuditRoutePTYClose = "close"
+
+	// AuditSummaryMaxLen 请求摘要最多保留的字节数
+	AuditSummaryMaxLen = 2048
+)
+
 const (
 	LocalHost            = "127.0.0.1"
 	FRP_Plugin_Multiuser = "multiuser"
@@ -80,6 +98,13 @@ const (
 	PullClientWireGuardsDuration = 30 * time.Second
 
 	ReportWireGuardRuntimeInfoDuration = 60 * time.Second
+	AlertEvaluateDuration              = 30 * time.Second
+	ClientHeartbeatDuration            = 10 * time.Second
+	ClientHeartbeatTimeout             = 5 * time.Second
+	ClientHeartbeatConcurrency         = 64
+	WebhookRetryDuration               = 30 * time.Second
+	EvictIdleLogHubsDuration           = 5 * time.Minute
+	FlushArchivedLogsDuration          = 10 * time.Second
 
 	AppStartTimeout = 5 * time.Minute
 )
@@ -110,12 +135,13 @@ const (
 )
 
 const (
-	UserRole_Admin  = "admin"
-	UserRole_Normal = "normal"
-	CapFileName     = "workerd.capnp"
-	WorkerInfoPath  = "workers"
-	WorkerCodePath  = "src"
-	DBTypeSqlite    = "sqlite"
+	UserRole_Admin       = "admin"
+	UserRole_TenantAdmin = "tenant_admin"
+	UserRole_Normal      = "normal"
+	CapFileName          = "workerd.capnp"
+	WorkerInfoPath       = "workers"
+	WorkerCodePath       = "src"
+	DBTypeSqlite         = "sqlite"
 
 	DefaultHostName       = "127.0.0.1"
 	DefaultNodeName       = "default"
@@ -203,4 +229,14 @@ const VaalaMagicBytesCookie = uint32(1630367849)
 
 const (
 	DefaultWSHandlerPath = "/api/x-vaala-transport/ws"
+	// EndpointTypeSTUN STUN 探测得到的 NAT 映射地址，打洞成功后才会参与选路
+	EndpointTypeSTUN = "stun"
+)
+
+const (
+	OIDCCookiePath    = "/api/v1/auth/oidc"
+	OIDCStateCookie   = "frpp-oidc-state"
+	OIDCNonceCookie   = "frpp-oidc-nonce"
+	OIDCMFACookie     = "frpp-oidc-mfa"
+	OIDCGroupIDPrefix = "oidc-"
 )
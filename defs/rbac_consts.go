@@ -40,4 +40,7 @@ type APIPermission struct {
 
 const (
 	TokenPayloadKey_Permissions = "permissions"
+	TokenPayloadKey_JTI         = "jti"
+	// TokenPayloadKey_MFAAt 最近一次两步验证通过的时间戳
+	TokenPayloadKey_MFAAt = "mfa_at"
 )
@@ -0,0 +1,40 @@
+package defs
+
+const (
+	EventClientInitialized        = "client.initialized"
+	EventClientConnected          = "client.connected"
+	EventClientDisconnected       = "client.disconnected"
+	EventConfigUpdated            = "config.updated"
+	EventProxyConfigCreated       = "proxy_config.created"
+	EventProxyConfigDeleted       = "proxy_config.deleted"
+	EventProxyStarted             = "proxy.started"
+	EventProxyStopped             = "proxy.stopped"
+	EventWorkerDeployed           = "worker.deployed"
+	EventWireGuardRuntimeReported = "wireguard.runtime_reported"
+	EventUpgradeProgress          = "upgrade.progress"
+)
+
+// EventTypes 全部事件类型，webhook 订阅时只能选择其中的类型
+var EventTypes = []string{
+	EventClientInitialized,
+	EventClientConnected,
+	EventClientDisconnected,
+	EventConfigUpdated,
+	EventProxyConfigCreated,
+	EventProxyConfigDeleted,
+	EventProxyStarted,
+	EventProxyStopped,
+	EventWorkerDeployed,
+	EventWireGuardRuntimeReported,
+	EventUpgradeProgress,
+}
+
+// PanelEvent 面板内的状态变化事件，ObjType/ObjID 为事件所属的客户端或服务端，用于按 RBAC 过滤
+type PanelEvent struct {
+	ID      string  `json:"id"`
+	Type    string  `json:"type"`
+	Time    int64   `json:"time"` // unix milli
+	ObjType RBACObj `json:"object_type"`
+	ObjID   string  `json:"object_id"`
+	Data    any     `json:"data,omitempty"`
+}
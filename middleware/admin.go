@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminOnly 仅允许管理员访问，需要在 AuthCtx 之后使用
+func AdminOnly(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		userInfo := common.GetUserInfo(c)
+		if userInfo == nil || !userInfo.Valid() || !userInfo.IsAdmin() {
+			logger.Logger(c).Errorf("user is not admin, path: [%s]", c.Request.URL.Path)
+			common.ErrResp(c, &pb.CommonResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: "admin only"}}, "admin only")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// TenantAdminOnly 允许管理员与租户管理员访问，租户范围的限制由 handler 自行处理
+func TenantAdminOnly(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		userInfo := common.GetUserInfo(c)
+		if userInfo == nil || !userInfo.Valid() || !(userInfo.IsAdmin() || userInfo.IsTenantAdmin()) {
+			logger.Logger(c).Errorf("user is not admin or tenant admin, path: [%s]", c.Request.URL.Path)
+			common.ErrResp(c, &pb.CommonResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: "admin only"}}, "admin only")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
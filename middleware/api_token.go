@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/cast"
+)
+
+const (
+	apiTokenCacheKeyPrefix = "api-token:"
+	// apiTokenCacheSeconds 校验结果的缓存时间，同时也是 last used 的刷新粒度
+	apiTokenCacheSeconds = 60
+)
+
+// IsAPIToken 带有 jti 的 token 为通过 SignToken 签发的持久化 API token
+func IsAPIToken(t jwt.MapClaims) bool {
+	return len(cast.ToString(t[defs.TokenPayloadKey_JTI])) > 0
+}
+
+// InvalidateAPIToken 清理 token 的校验缓存，吊销后立即生效
+func InvalidateAPIToken(jti string) {
+	cache.Get().Del([]byte(apiTokenCacheKeyPrefix + jti))
+}
+
+// checkAPIToken 校验 API token 是否仍然有效，并记录最后使用时间，登录签发的 token 直接放行
+func checkAPIToken(c *gin.Context, appInstance app.Application, t jwt.MapClaims) error {
+	if !IsAPIToken(t) {
+		return nil
+	}
+	jti := cast.ToString(t[defs.TokenPayloadKey_JTI])
+	cacheKey := []byte(apiTokenCacheKeyPrefix + jti)
+
+	if status, err := cache.Get().Get(cacheKey); err == nil {
+		if defs.TokenStatus(status) != defs.TokenStatusActive {
+			return errors.New("api token is " + string(status))
+		}
+		return nil
+	}
+
+	appCtx := app.NewContext(c, appInstance)
+	token, err := dao.NewQuery(appCtx).AdminGetAPITokenByJTI(jti)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if token.UserID != cast.ToInt(t[defs.UserIDKey]) {
+		return errors.New("api token user mismatch")
+	}
+	if !token.Usable(now) {
+		status := token.Status
+		if status == defs.TokenStatusActive {
+			status = defs.TokenStatusInactive
+		}
+		cache.Get().Set(cacheKey, []byte(status), apiTokenCacheSeconds)
+		return errors.New("api token is " + string(status))
+	}
+
+	if err := dao.NewMutation(appCtx).AdminUpdateAPITokenLastUsed(jti, now); err != nil {
+		return err
+	}
+
+	expire := min(apiTokenCacheSeconds, int(token.ExpiresAt.Sub(now).Seconds())+1)
+	cache.Get().Set(cacheKey, []byte(defs.TokenStatusActive), expire)
+	return nil
+}
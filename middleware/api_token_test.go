@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAPIToken_Revoke(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	u := apptest.CreateUser(t, ctx, "alice")
+	token := &models.APIToken{APITokenEntity: &models.APITokenEntity{
+		JTI: "jti-alice", Name: "ci", Status: defs.TokenStatusActive, ExpiresAt: time.Now().Add(time.Hour),
+	}}
+	require.NoError(t, dao.NewMutation(ctx).CreateAPIToken(u, token))
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	claims := jwt.MapClaims{defs.UserIDKey: float64(u.UserID), defs.TokenPayloadKey_JTI: "jti-alice"}
+
+	require.NoError(t, checkAPIToken(c, ctx.GetApp(), claims))
+
+	// jti 被其他用户冒用
+	assert.Error(t, checkAPIToken(c, ctx.GetApp(), jwt.MapClaims{defs.UserIDKey: float64(u.UserID + 1), defs.TokenPayloadKey_JTI: "jti-other"}))
+
+	_, err := dao.NewMutation(ctx).RevokeAPIToken(u, token.ID)
+	require.NoError(t, err)
+	// 缓存未清理前仍然放行，吊销接口会调用 InvalidateAPIToken
+	InvalidateAPIToken("jti-alice")
+	assert.Error(t, checkAPIToken(c, ctx.GetApp(), claims))
+
+	// 登录签发的 token 没有 jti，不受影响
+	assert.NoError(t, checkAPIToken(c, ctx.GetApp(), jwt.MapClaims{defs.UserIDKey: float64(u.UserID)}))
+}
+
+func TestCheckAPIToken_Expired(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	u := apptest.CreateUser(t, ctx, "alice")
+	require.NoError(t, dao.NewMutation(ctx).CreateAPIToken(u, &models.APIToken{APITokenEntity: &models.APITokenEntity{
+		JTI: "jti-expired", Status: defs.TokenStatusActive, ExpiresAt: time.Now().Add(-time.Minute),
+	}}))
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	assert.Error(t, checkAPIToken(c, ctx.GetApp(), jwt.MapClaims{defs.UserIDKey: float64(u.UserID), defs.TokenPayloadKey_JTI: "jti-expired"}))
+}
@@ -0,0 +1,181 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// auditReadOnlyRoutes 不修改数据的 POST 接口，不记录审计日志，未列出的接口默认都会记录
+var auditReadOnlyRoutes = map[string]bool{
+	"/api/v1/user/get":                  true,
+	"/api/v1/user/token/list":           true,
+	"/api/v1/platform/clientsstatus":    true,
+	"/api/v1/client/get":                true,
+	"/api/v1/client/list":               true,
+	"/api/v1/client/token/list":         true,
+	"/api/v1/server/get":                true,
+	"/api/v1/server/list":               true,
+	"/api/v1/admin/users/list":          true,
+	"/api/v1/admin/tenants/list":        true,
+	"/api/v1/traffic_quota/list":        true,
+	"/api/v1/alert/rule/list":           true,
+	"/api/v1/alert/channel/list":        true,
+	"/api/v1/alert/event/list":          true,
+	"/api/v1/webhook/subscription/list": true,
+	"/api/v1/webhook/delivery/list":     true,
+	"/api/v1/group/list":                true,
+	"/api/v1/group/member/list":         true,
+	"/api/v1/permission/list":           true,
+	"/api/v1/proxy/get_by_cid":          true,
+	"/api/v1/proxy/get_by_sid":          true,
+	"/api/v1/proxy/list_configs":        true,
+	"/api/v1/proxy/get_config":          true,
+	"/api/v1/proxy/traffic":             true,
+	"/api/v1/worker/get":                true,
+	"/api/v1/worker/status":             true,
+	"/api/v1/worker/list":               true,
+	"/api/v1/worker/get_ingress":        true,
+	"/api/v1/wg/network/get":            true,
+	"/api/v1/wg/network/list":           true,
+	"/api/v1/wg/network/topology":       true,
+	"/api/v1/wg/endpoint/get":           true,
+	"/api/v1/wg/endpoint/list":          true,
+	"/api/v1/wg/link/get":               true,
+	"/api/v1/wg/link/list":              true,
+	"/api/v1/wg/get":                    true,
+	"/api/v1/wg/list":                   true,
+	"/api/v1/wg/runtime/get":            true,
+	"/api/v1/wg/external_peer/list":     true,
+	"/api/v1/audit/list":                true,
+	"/api/v1/recording/list":            true,
+	"/api/v1/log/search":                true,
+}
+
+const (
+	// auditMaxCapture 最多缓存的响应字节数，只用于解析结果码
+	auditMaxCapture = 64 * 1024
+	// auditMaxBody 最多读取的请求字节数，超出部分不进入摘要，原样交给后续 handler
+	auditMaxBody = 64 * 1024
+)
+
+type auditBodyWriter struct {
+	gin.ResponseWriter
+	buf *bytes.Buffer
+}
+
+func (w *auditBodyWriter) Write(b []byte) (int, error) {
+	if w.buf.Len() < auditMaxCapture {
+		w.buf.Write(b[:min(len(b), auditMaxCapture-w.buf.Len())])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Audit 记录修改类接口的调用者、路由、请求摘要、结果和来源 IP，需要在 AuthCtx 之后使用
+func Audit(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		if !isMutatingRequest(c) {
+			c.Next()
+			return
+		}
+
+		var (
+			body      []byte
+			truncated bool
+		)
+		if c.Request.Body != nil {
+			body, _ = io.ReadAll(io.LimitReader(c.Request.Body, auditMaxBody+1))
+			c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(body), c.Request.Body), c.Request.Body}
+			if len(body) > auditMaxBody {
+				body, truncated = body[:auditMaxBody], true
+			}
+		}
+
+		writer := &auditBodyWriter{ResponseWriter: c.Writer, buf: &bytes.Buffer{}}
+		c.Writer = writer
+
+		start := time.Now()
+		c.Next()
+
+		summary, targetID := audit.Summarize(c.ContentType(), body)
+		if truncated {
+			summary = fmt.Sprintf("<%s more than %d bytes>", c.ContentType(), auditMaxBody)
+		}
+		code, msg := auditResult(c.Writer.Status(), writer.buf.Bytes())
+
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = c.Request.URL.Path
+		}
+
+		audit.Record(app.NewContext(c, appInstance), &models.AuditLogEntity{
+			Kind:          defs.AuditKindAPI,
+			Method:        c.Request.Method,
+			Route:         route,
+			TargetID:      targetID,
+			Summary:       summary,
+			ResultCode:    int(code),
+			ResultMessage: msg,
+			DurationMs:    time.Since(start).Milliseconds(),
+		})
+	}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func isMutatingRequest(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	route := c.FullPath()
+	if len(route) == 0 {
+		route = c.Request.URL.Path
+	}
+	return !auditReadOnlyRoutes[route]
+}
+
+// auditResult 从统一的响应结构中解析结果码，解析不了时按 http 状态码判断
+func auditResult(httpStatus int, resp []byte) (pb.RespCode, string) {
+	result := struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+		Body struct {
+			Status *struct {
+				Code    pb.RespCode `json:"code"`
+				Message string      `json:"message"`
+			} `json:"status"`
+		} `json:"body"`
+	}{}
+
+	if err := json.Unmarshal(resp, &result); err != nil {
+		result.Code = httpStatus
+	}
+
+	switch result.Code {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return pb.RespCode_RESP_CODE_UNAUTHORIZED, result.Msg
+	default:
+		return pb.RespCode_RESP_CODE_INVALID, result.Msg
+	}
+
+	if status := result.Body.Status; status != nil && status.Code != pb.RespCode_RESP_CODE_UNSPECIFIED {
+		return status.Code, status.Message
+	}
+	return pb.RespCode_RESP_CODE_SUCCESS, result.Msg
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	u := apptest.CreateUser(t, ctx, "alice")
+
+	received := 0
+	router := gin.New()
+	v1 := router.Group("/api/v1", func(c *gin.Context) { c.Set(defs.UserInfoKey, u) }, Audit(ctx.GetApp()))
+	handler := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		received = len(body)
+		c.JSON(http.StatusOK, gin.H{"code": http.StatusOK})
+	}
+	v1.POST("/client/list", handler)
+	v1.POST("/client/delete", handler)
+
+	logs := func() []*models.AuditLog {
+		list := []*models.AuditLog{}
+		require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Order("id asc").Find(&list).Error)
+		return list
+	}
+	post := func(path, body string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	post("/api/v1/client/list", `{"page":1}`)
+	assert.Empty(t, logs())
+
+	post("/api/v1/client/delete", `{"client_id":"c1"}`)
+	require.Len(t, logs(), 1)
+	assert.Equal(t, "c1", logs()[0].TargetID)
+
+	// 超出上限的请求体不进入摘要，handler 仍能读到完整内容
+	big := `{"client_id":"c2","comment":"` + strings.Repeat("a", 2*auditMaxBody) + `"}`
+	post("/api/v1/client/delete", big)
+	assert.Equal(t, len(big), received)
+	require.Len(t, logs(), 2)
+	assert.Contains(t, logs()[1].Summary, "more than")
+}
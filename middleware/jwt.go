@@ -31,6 +31,12 @@ func JWTAuth(appInstance app.Application) func(c *gin.Context) {
 					c.Set(k, v)
 				}
 				logger.Logger(c).Debugf("query auth success")
+				if err = checkAPIToken(c, appInstance, t); err != nil {
+					logger.Logger(c).WithError(err).Errorf("api token invalid")
+					common.ErrUnAuthorized(c, "invalid authorization")
+					c.Abort()
+					return
+				}
 				if err = resignAndPatchCtxJWT(c, appInstance, cast.ToInt(t[defs.UserIDKey]), t, tokenStr); err != nil {
 					logger.Logger(c).WithError(err).Errorf("resign jwt error")
 					common.ErrUnAuthorized(c, "resign jwt error")
@@ -51,6 +57,12 @@ func JWTAuth(appInstance app.Application) func(c *gin.Context) {
 					c.Set(k, v)
 				}
 				logger.Logger(c).Debugf("cookie auth success")
+				if err = checkAPIToken(c, appInstance, t); err != nil {
+					logger.Logger(c).WithError(err).Errorf("api token invalid")
+					common.ErrUnAuthorized(c, "invalid authorization")
+					c.Abort()
+					return
+				}
 				if err = resignAndPatchCtxJWT(c, appInstance, cast.ToInt(t[defs.UserIDKey]), t, cookieToken); err != nil {
 					logger.Logger(c).WithError(err).Errorf("resign jwt error")
 					common.ErrUnAuthorized(c, "resign jwt error")
@@ -81,6 +93,12 @@ func JWTAuth(appInstance app.Application) func(c *gin.Context) {
 				c.Set(k, v)
 			}
 			logger.Logger(c).Debugf("header auth success")
+			if err = checkAPIToken(c, appInstance, t); err != nil {
+				logger.Logger(c).WithError(err).Errorf("api token invalid")
+				common.ErrUnAuthorized(c, "invalid authorization")
+				c.Abort()
+				return
+			}
 			if err = resignAndPatchCtxJWT(c, appInstance, cast.ToInt(t[defs.UserIDKey]), t, tokenStr); err != nil {
 				logger.Logger(c).WithError(err).Errorf("resign jwt error")
 				common.ErrUnAuthorized(c, "resign jwt error")
@@ -96,6 +114,12 @@ func JWTAuth(appInstance app.Application) func(c *gin.Context) {
 }
 
 func resignAndPatchCtxJWT(c *gin.Context, appInstance app.Application, userID int, t jwt.MapClaims, tokenStr string) error {
+	if IsAPIToken(t) {
+		// API token 的有效期由签发时决定，不做续签
+		c.Set(defs.TokenKey, tokenStr)
+		return nil
+	}
+
 	tokenExpire, _ := t.GetExpirationTime()
 	now := time.Now().Add(time.Duration(appInstance.GetConfig().App.CookieAge/2) * time.Second)
 	if now.Before(tokenExpire.Time) {
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+// mfaEnrollmentPaths 尚未开启两步验证的用户在被强制要求时仍可访问的接口
+var mfaEnrollmentPaths = map[string]bool{
+	"/api/v1/user/get":        true,
+	"/api/v1/user/2fa/setup":  true,
+	"/api/v1/user/2fa/enable": true,
+}
+
+// MFARequired 用户是否必须使用两步验证：自己开启、被管理员要求或全局强制
+func MFARequired(cfg conf.Config, u models.UserInfo) bool {
+	return u.IsTOTPEnabled() || u.IsTOTPRequired() || cfg.App.Enforce2FA
+}
+
+// MFAEnrollment 被要求开启两步验证但还没有绑定的用户，只放行绑定相关接口，需要在 AuthCtx 之后使用
+func MFAEnrollment(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		userInfo := common.GetUserInfo(c)
+		if userInfo == nil || userInfo.IsTOTPEnabled() || !MFARequired(appInstance.GetConfig(), userInfo) ||
+			mfaEnrollmentPaths[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
+		logger.Logger(c).Warnf("user [%d] must enroll 2fa first, path: [%s]", userInfo.GetUserID(), c.Request.URL.Path)
+		common.ErrResp(c, &pb.CommonResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: defs.ErrMFANotEnrolled}}, defs.ErrMFANotEnrolled)
+		c.Abort()
+	}
+}
+
+// RecentMFA 敏感操作要求在 MFARecentTTL 内通过过两步验证，API token 不能访问
+func RecentMFA(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		cfg := appInstance.GetConfig()
+		userInfo := common.GetUserInfo(c)
+		if userInfo == nil || !MFARequired(cfg, userInfo) {
+			c.Next()
+			return
+		}
+
+		if MFAVerified(c, cfg) {
+			c.Next()
+			return
+		}
+
+		msg := defs.ErrMFARequired
+		if !userInfo.IsTOTPEnabled() {
+			msg = defs.ErrMFANotEnrolled
+		}
+		logger.Logger(c).Warnf("user [%d] need recent 2fa, path: [%s]", userInfo.GetUserID(), c.Request.URL.Path)
+		common.ErrResp(c, &pb.CommonResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: msg}}, msg)
+		c.Abort()
+	}
+}
+
+// MFAVerified 当前请求的 token 是否处于两步验证有效期内，API token 不带两步验证时间
+func MFAVerified(c *gin.Context, cfg conf.Config) bool {
+	if len(cast.ToString(c.Value(defs.TokenPayloadKey_JTI))) > 0 {
+		return false
+	}
+
+	mfaAt := cast.ToInt64(c.Value(defs.TokenPayloadKey_MFAAt))
+	if mfaAt <= 0 {
+		return false
+	}
+	return time.Since(time.Unix(mfaAt, 0)) <= time.Duration(cfg.App.MFARecentTTL)*time.Second
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	AlertConditionClientOffline  = "client_offline"
+	AlertConditionProxyStopped   = "proxy_stopped"
+	AlertConditionWireGuardStale = "wireguard_handshake_stale"
+	AlertConditionWorkerExited   = "worker_exited"
+	AlertConditionTrafficSpike   = "traffic_spike"
+)
+
+const (
+	AlertEventStatusPending  = "pending"
+	AlertEventStatusFiring   = "firing"
+	AlertEventStatusResolved = "resolved"
+)
+
+const (
+	AlertChannelTypeWebhook  = "webhook"
+	AlertChannelTypeEmail    = "email"
+	AlertChannelTypeTelegram = "telegram"
+	AlertChannelTypeDingTalk = "dingtalk"
+)
+
+// AlertRule 告警规则，条件持续 Duration 秒后触发，恢复后发送恢复通知
+type AlertRule struct {
+	*gorm.Model
+	*AlertRuleEntity
+}
+
+type AlertRuleEntity struct {
+	Name      string `json:"name"`
+	UserID    int    `json:"user_id" gorm:"index"`
+	TenantID  int    `json:"tenant_id" gorm:"index"`
+	Condition string `json:"condition"`
+	// Threshold 握手过期条件为秒数，流量突增条件为 bytes/s，其他条件不使用
+	Threshold float64 `json:"threshold"`
+	Duration  int     `json:"duration"`
+	// TargetIDs 客户端、服务端、隧道配置或 worker 的 id，与 TargetTags 都为空时匹配用户全部资源
+	TargetIDs GormArray[string] `json:"target_ids" gorm:"type:text"`
+	// TargetTags 按客户端 wireguard 的 tag 选择
+	TargetTags GormArray[string] `json:"target_tags" gorm:"type:text"`
+	ChannelIDs GormArray[uint]   `json:"channel_ids" gorm:"type:text"`
+	// RepeatInterval 持续告警时重复通知的间隔秒数，0 表示只通知一次
+	RepeatInterval int  `json:"repeat_interval"`
+	Enabled        bool `json:"enabled"`
+}
+
+func (*AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// Selects 判断对象是否被规则选中，ids 为对象本身及其所属资源的 id，tags 为所属客户端的 tag
+func (r *AlertRuleEntity) Selects(ids []string, tags []string) bool {
+	if len(r.TargetIDs) == 0 && len(r.TargetTags) == 0 {
+		return true
+	}
+	return lo.SomeBy(ids, func(id string) bool { return slices.Contains(r.TargetIDs, id) }) ||
+		lo.SomeBy(tags, func(tag string) bool { return slices.Contains(r.TargetTags, tag) })
+}
+
+func (r *AlertRule) ToPB() *pb.AlertRule {
+	return &pb.AlertRule{
+		Id:             lo.ToPtr(uint32(r.ID)),
+		Name:           lo.ToPtr(r.Name),
+		Condition:      lo.ToPtr(r.Condition),
+		Threshold:      lo.ToPtr(r.Threshold),
+		Duration:       lo.ToPtr(int32(r.Duration)),
+		TargetIds:      r.TargetIDs,
+		TargetTags:     r.TargetTags,
+		ChannelIds:     lo.Map(r.ChannelIDs, func(id uint, _ int) uint32 { return uint32(id) }),
+		RepeatInterval: lo.ToPtr(int32(r.RepeatInterval)),
+		Enabled:        lo.ToPtr(r.Enabled),
+	}
+}
+
+// AlertChannel 告警通知渠道，Config 为对应渠道类型的 json 配置
+type AlertChannel struct {
+	*gorm.Model
+	*AlertChannelEntity
+}
+
+type AlertChannelEntity struct {
+	Name     string `json:"name"`
+	UserID   int    `json:"user_id" gorm:"index"`
+	TenantID int    `json:"tenant_id" gorm:"index"`
+	Type     string `json:"type"`
+	Config   []byte `json:"config"`
+}
+
+func (*AlertChannel) TableName() string {
+	return "alert_channels"
+}
+
+func (c *AlertChannel) ToPB() *pb.AlertChannel {
+	return &pb.AlertChannel{
+		Id:     lo.ToPtr(uint32(c.ID)),
+		Name:   lo.ToPtr(c.Name),
+		Type:   lo.ToPtr(c.Type),
+		Config: lo.ToPtr(string(c.Config)),
+	}
+}
+
+// AlertEvent 规则在某个对象上的一次告警，同一规则同一对象同时只有一条未恢复的事件
+type AlertEvent struct {
+	ID uint `gorm:"primarykey"`
+	*AlertEventEntity
+}
+
+type AlertEventEntity struct {
+	RuleID    uint   `json:"rule_id" gorm:"index"`
+	UserID    int    `json:"user_id" gorm:"index"`
+	TenantID  int    `json:"tenant_id" gorm:"index"`
+	ObjectKey string `json:"object_key" gorm:"type:varchar(512);index"`
+	// ObjectName 对象的可读描述，用于通知内容
+	ObjectName     string    `json:"object_name"`
+	Status         string    `json:"status" gorm:"type:varchar(32);index"`
+	Value          float64   `json:"value"`
+	StartedAt      time.Time `json:"started_at"`
+	FiredAt        time.Time `json:"fired_at"`
+	ResolvedAt     time.Time `json:"resolved_at"`
+	LastNotifiedAt time.Time `json:"last_notified_at"`
+}
+
+func (*AlertEvent) TableName() string {
+	return "alert_events"
+}
+
+func (e *AlertEvent) ToPB() *pb.AlertEvent {
+	toMilli := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.UnixMilli()
+	}
+	return &pb.AlertEvent{
+		Id:         lo.ToPtr(uint32(e.ID)),
+		RuleId:     lo.ToPtr(uint32(e.RuleID)),
+		ObjectKey:  lo.ToPtr(e.ObjectKey),
+		ObjectName: lo.ToPtr(e.ObjectName),
+		Status:     lo.ToPtr(e.Status),
+		Value:      lo.ToPtr(e.Value),
+		StartedAt:  lo.ToPtr(toMilli(e.StartedAt)),
+		FiredAt:    lo.ToPtr(toMilli(e.FiredAt)),
+		ResolvedAt: lo.ToPtr(toMilli(e.ResolvedAt)),
+	}
+}
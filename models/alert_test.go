@@ -0,0 +1,18 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRuleSelects(t *testing.T) {
+	all := &AlertRuleEntity{}
+	assert.True(t, all.Selects([]string{"c1"}, nil))
+
+	r := &AlertRuleEntity{TargetIDs: []string{"c1", "12"}, TargetTags: []string{"prod"}}
+	assert.True(t, r.Selects([]string{"12", "c2"}, nil))
+	assert.True(t, r.Selects([]string{"c3"}, []string{"dev", "prod"}))
+	assert.False(t, r.Selects([]string{"c3"}, []string{"dev"}))
+	assert.False(t, r.Selects(nil, nil))
+}
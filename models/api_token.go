@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// APIToken 记录通过 SignToken 签发的 API JWT，以 JWT ID 作为唯一标识，用于列出与提前吊销
+type APIToken struct {
+	gorm.Model
+	*APITokenEntity
+}
+
+type APITokenEntity struct {
+	JTI         string                        `json:"jti" gorm:"type:varchar(64);uniqueIndex;not null"`
+	UserID      int                           `json:"user_id" gorm:"index"`
+	TenantID    int                           `json:"tenant_id" gorm:"index"`
+	Name        string                        `json:"name"`
+	Permissions GormArray[defs.APIPermission] `json:"permissions" gorm:"type:text"`
+	Status      defs.TokenStatus              `json:"status" gorm:"type:varchar(32);index"`
+	ExpiresAt   time.Time                     `json:"expires_at" gorm:"index"`
+	LastUsedAt  *time.Time                    `json:"last_used_at"`
+}
+
+func (*APIToken) TableName() string {
+	return "api_tokens"
+}
+
+// Usable token 处于 active 状态且未过期
+func (t *APITokenEntity) Usable(now time.Time) bool {
+	if t == nil || t.Status != defs.TokenStatusActive {
+		return false
+	}
+	return t.ExpiresAt.After(now)
+}
+
+func (t *APIToken) ToPB() *pb.APIToken {
+	resp := &pb.APIToken{
+		Id:         lo.ToPtr(uint32(t.ID)),
+		Name:       lo.ToPtr(t.Name),
+		Status:     lo.ToPtr(string(t.Status)),
+		ExpiresAt:  lo.ToPtr(t.ExpiresAt.UnixMilli()),
+		LastUsedAt: lo.ToPtr(int64(0)),
+		CreatedAt:  lo.ToPtr(t.CreatedAt.UnixMilli()),
+		Permissions: lo.Map(t.Permissions, func(p defs.APIPermission, _ int) *pb.APIPermission {
+			return &pb.APIPermission{Method: lo.ToPtr(p.Method), Path: lo.ToPtr(p.Path)}
+		}),
+	}
+	if t.LastUsedAt != nil {
+		resp.LastUsedAt = lo.ToPtr(t.LastUsedAt.UnixMilli())
+	}
+	return resp
+}
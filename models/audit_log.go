@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+)
+
+// AuditLog 记录修改类接口调用、终端会话以及下发到客户端的 rpc 事件，只追加不修改
+type AuditLog struct {
+	ID uint `gorm:"primarykey"`
+	*AuditLogEntity
+	CreatedAt time.Time `gorm:"index"`
+}
+
+type AuditLogEntity struct {
+	UserID   int    `json:"user_id" gorm:"index"`
+	TenantID int    `json:"tenant_id" gorm:"index"`
+	UserName string `json:"user_name"`
+	// TokenID 使用 API token 时为 jti，浏览器会话为空
+	TokenID string `json:"token_id" gorm:"type:varchar(64)"`
+	// Kind api/pty/rpc
+	Kind   string `json:"kind" gorm:"type:varchar(16);index"`
+	Method string `json:"method" gorm:"type:varchar(16)"`
+	// Route api 为路由模板，rpc 为事件名，pty 为 open/close
+	Route string `json:"route" gorm:"type:varchar(255);index"`
+	// TargetID 操作对象，如客户端 ID
+	TargetID      string `json:"target_id" gorm:"type:varchar(255);index"`
+	Summary       string `json:"summary" gorm:"type:text"`
+	ResultCode    int    `json:"result_code"`
+	ResultMessage string `json:"result_message" gorm:"type:text"`
+	SourceIP      string `json:"source_ip" gorm:"type:varchar(64)"`
+	DurationMs    int64  `json:"duration_ms"`
+}
+
+func (*AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+func (a *AuditLog) ToPB() *pb.AuditLog {
+	return &pb.AuditLog{
+		Id:            lo.ToPtr(uint32(a.ID)),
+		UserId:        lo.ToPtr(int64(a.UserID)),
+		TenantId:      lo.ToPtr(int64(a.TenantID)),
+		UserName:      lo.ToPtr(a.UserName),
+		TokenId:       lo.ToPtr(a.TokenID),
+		Kind:          lo.ToPtr(a.Kind),
+		Method:        lo.ToPtr(a.Method),
+		Route:         lo.ToPtr(a.Route),
+		TargetId:      lo.ToPtr(a.TargetID),
+		Summary:       lo.ToPtr(a.Summary),
+		ResultCode:    lo.ToPtr(int32(a.ResultCode)),
+		ResultMessage: lo.ToPtr(a.ResultMessage),
+		SourceIp:      lo.ToPtr(a.SourceIP),
+		DurationMs:    lo.ToPtr(a.DurationMs),
+		CreatedAt:     lo.ToPtr(a.CreatedAt.UnixMilli()),
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ClientToken 是 frpc 登录 frps 时使用的客户端级别凭证，每个子客户端独立，可单独吊销
+type ClientToken struct {
+	gorm.Model
+	*ClientTokenEntity
+}
+
+type ClientTokenEntity struct {
+	ClientID       string           `json:"client_id" gorm:"index;not null"`
+	OriginClientID string           `json:"origin_client_id" gorm:"index"`
+	UserID         int              `json:"user_id" gorm:"index"`
+	TenantID       int              `json:"tenant_id" gorm:"index"`
+	Token          string           `json:"token" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Status         defs.TokenStatus `json:"status" gorm:"type:varchar(32);index"`
+	ExpiresAt      *time.Time       `json:"expires_at" gorm:"index"`
+}
+
+func (*ClientToken) TableName() string {
+	return "client_tokens"
+}
+
+// Usable token 处于 active 状态且未过期
+func (t *ClientTokenEntity) Usable(now time.Time) bool {
+	if t == nil || t.Status != defs.TokenStatusActive {
+		return false
+	}
+	return t.ExpiresAt == nil || t.ExpiresAt.After(now)
+}
+
+func (t *ClientToken) ToPB() *pb.ClientToken {
+	resp := &pb.ClientToken{
+		Id:             lo.ToPtr(uint32(t.ID)),
+		ClientId:       lo.ToPtr(t.ClientID),
+		OriginClientId: lo.ToPtr(t.OriginClientID),
+		Status:         lo.ToPtr(string(t.Status)),
+		ExpiresAt:      lo.ToPtr(int64(0)),
+		CreatedAt:      lo.ToPtr(t.CreatedAt.UnixMilli()),
+	}
+	if t.ExpiresAt != nil {
+		resp.ExpiresAt = lo.ToPtr(t.ExpiresAt.UnixMilli())
+	}
+	return resp
+}
@@ -61,6 +61,45 @@ func (dbm *dbManagerImpl) Init() {
 			if err := db.AutoMigrate(&WireGuardLink{}); err != nil {
 				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&WireGuardLink{}).TableName())
 			}
+			if err := db.AutoMigrate(&WireGuardExternalPeer{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&WireGuardExternalPeer{}).TableName())
+			}
+			if err := db.AutoMigrate(&ClientToken{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&ClientToken{}).TableName())
+			}
+			if err := db.AutoMigrate(&APIToken{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&APIToken{}).TableName())
+			}
+			if err := db.AutoMigrate(&Tenant{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&Tenant{}).TableName())
+			}
+			if err := db.AutoMigrate(&AuditLog{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&AuditLog{}).TableName())
+			}
+			if err := db.AutoMigrate(&PTYRecording{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&PTYRecording{}).TableName())
+			}
+			if err := db.AutoMigrate(&TrafficPoint{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&TrafficPoint{}).TableName())
+			}
+			if err := db.AutoMigrate(&TrafficQuota{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&TrafficQuota{}).TableName())
+			}
+			if err := db.AutoMigrate(&AlertRule{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&AlertRule{}).TableName())
+			}
+			if err := db.AutoMigrate(&AlertChannel{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&AlertChannel{}).TableName())
+			}
+			if err := db.AutoMigrate(&AlertEvent{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&AlertEvent{}).TableName())
+			}
+			if err := db.AutoMigrate(&WebhookSubscription{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&WebhookSubscription{}).TableName())
+			}
+			if err := db.AutoMigrate(&WebhookDelivery{}); err != nil {
+				logger.Logger(ctx).WithError(err).Fatalf("cannot init db table [%s]", (&WebhookDelivery{}).TableName())
+			}
 
 		}
 	}
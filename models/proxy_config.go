@@ -28,6 +28,8 @@ type ProxyConfigEntity struct {
 	OriginClientID string `json:"origin_client_id" gorm:"index"`
 	Content        []byte `json:"content"`
 	Stopped        bool   `json:"stopped" gorm:"index"`
+	// StoppedByQuota 因流量超额被停止，计费周期重置时自动恢复
+	StoppedByQuota bool `json:"stopped_by_quota"`
 }
 
 func (*ProxyConfig) TableName() string {
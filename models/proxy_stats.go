@@ -23,6 +23,7 @@ type ProxyStatsEntity struct {
 	TodayTrafficOut   int64  `json:"today_traffic_out"`
 	HistoryTrafficIn  int64  `json:"history_traffic_in"`
 	HistoryTrafficOut int64  `json:"history_traffic_out"`
+	Online            bool   `json:"online"` // 隧道在 frps 上是否在线，由服务端随流量一起上报
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 	DeletedAt         gorm.DeletedAt `gorm:"index"`
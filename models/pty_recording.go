@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// PTYRecording 终端会话录像，内容以 asciicast v2 格式保存在 master 的录像目录中
+type PTYRecording struct {
+	gorm.Model
+	*PTYRecordingEntity
+}
+
+type PTYRecordingEntity struct {
+	SessionID string `json:"session_id" gorm:"type:varchar(64);uniqueIndex;not null"`
+	UserID    int    `json:"user_id" gorm:"index"`
+	TenantID  int    `json:"tenant_id" gorm:"index"`
+	UserName  string `json:"user_name"`
+	ClientID  string `json:"client_id" gorm:"type:varchar(255);index"`
+	// FileName 录像目录下的文件名，由 master 生成
+	FileName  string     `json:"file_name"`
+	Size      int64      `json:"size"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+}
+
+func (*PTYRecording) TableName() string {
+	return "pty_recordings"
+}
+
+func (r *PTYRecording) ToPB() *pb.PTYRecording {
+	resp := &pb.PTYRecording{
+		SessionId: lo.ToPtr(r.SessionID),
+		UserId:    lo.ToPtr(int64(r.UserID)),
+		UserName:  lo.ToPtr(r.UserName),
+		ClientId:  lo.ToPtr(r.ClientID),
+		Size:      lo.ToPtr(r.Size),
+		StartedAt: lo.ToPtr(r.StartedAt.UnixMilli()),
+		EndedAt:   lo.ToPtr(int64(0)),
+	}
+	if r.EndedAt != nil {
+		resp.EndedAt = lo.ToPtr(r.EndedAt.UnixMilli())
+	}
+	return resp
+}
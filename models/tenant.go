@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Tenant 租户，ID 即各资源上的 TenantID，TenantID 为 0 的是不落库的默认租户
+type Tenant struct {
+	gorm.Model
+	*TenantEntity
+}
+
+// TenantEntity 配额为 0 表示不限制
+type TenantEntity struct {
+	Name       string `json:"name" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Comment    string `json:"comment"`
+	MaxUsers   int    `json:"max_users"`
+	MaxClients int    `json:"max_clients"`
+	MaxServers int    `json:"max_servers"`
+}
+
+func (*Tenant) TableName() string {
+	return "tenants"
+}
+
+func (t *Tenant) GetTenantID() int {
+	return int(t.ID)
+}
+
+func (t *Tenant) ToPB() *pb.Tenant {
+	return &pb.Tenant{
+		TenantId:   lo.ToPtr(int64(t.ID)),
+		Name:       lo.ToPtr(t.Name),
+		Comment:    lo.ToPtr(t.Comment),
+		MaxUsers:   lo.ToPtr(int32(t.MaxUsers)),
+		MaxClients: lo.ToPtr(int32(t.MaxClients)),
+		MaxServers: lo.ToPtr(int32(t.MaxServers)),
+		CreatedAt:  lo.ToPtr(t.CreatedAt.UnixMilli()),
+	}
+}
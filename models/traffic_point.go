@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	// TrafficResolutionMinute 每次上报的增量按分钟落库
+	TrafficResolutionMinute = 60
+	// TrafficResolutionHour 超过保留时间的分钟数据会合并成小时数据
+	TrafficResolutionHour = 3600
+)
+
+// TrafficPoint 隧道在一个时间桶内的流量增量，同一个桶可能有多行，查询时求和
+type TrafficPoint struct {
+	ID uint `gorm:"primarykey"`
+	*TrafficPointEntity
+}
+
+type TrafficPointEntity struct {
+	ProxyID        int    `json:"proxy_id" gorm:"index"`
+	ProxyName      string `json:"proxy_name"`
+	ServerID       string `json:"server_id" gorm:"index"`
+	ClientID       string `json:"client_id" gorm:"index"`
+	OriginClientID string `json:"origin_client_id" gorm:"index"`
+	UserID         int    `json:"user_id" gorm:"index"`
+	TenantID       int    `json:"tenant_id" gorm:"index"`
+	// Resolution 时间桶长度，单位秒
+	Resolution int       `json:"resolution" gorm:"index:idx_traffic_points_bucket"`
+	BucketTime time.Time `json:"bucket_time" gorm:"index:idx_traffic_points_bucket"`
+	TrafficIn  int64     `json:"traffic_in"`
+	TrafficOut int64     `json:"traffic_out"`
+}
+
+func (*TrafficPoint) TableName() string {
+	return "traffic_points"
+}
+
+// DownsampleTrafficPoints 把数据按 resolution 重新分桶并合并同一隧道同一桶内的增量
+func DownsampleTrafficPoints(points []*TrafficPoint, resolution int) []*TrafficPoint {
+	type key struct {
+		proxyID int
+		bucket  int64
+	}
+
+	step := time.Duration(resolution) * time.Second
+	merged := map[key]*TrafficPoint{}
+	result := []*TrafficPoint{}
+	for _, p := range points {
+		if p == nil || p.TrafficPointEntity == nil {
+			continue
+		}
+		bucket := p.BucketTime.Truncate(step)
+		k := key{proxyID: p.ProxyID, bucket: bucket.Unix()}
+		if m, ok := merged[k]; ok {
+			m.TrafficIn += p.TrafficIn
+			m.TrafficOut += p.TrafficOut
+			continue
+		}
+		entity := *p.TrafficPointEntity
+		entity.Resolution = resolution
+		entity.BucketTime = bucket
+		merged[k] = &TrafficPoint{TrafficPointEntity: &entity}
+		result = append(result, merged[k])
+	}
+	return result
+}
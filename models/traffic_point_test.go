@@ -0,0 +1,45 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownsampleTrafficPoints(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	point := func(proxyID int, offset time.Duration, in, out int64) *models.TrafficPoint {
+		return &models.TrafficPoint{TrafficPointEntity: &models.TrafficPointEntity{
+			ProxyID:    proxyID,
+			ClientID:   "c1",
+			Resolution: models.TrafficResolutionMinute,
+			BucketTime: base.Add(offset),
+			TrafficIn:  in,
+			TrafficOut: out,
+		}}
+	}
+
+	got := models.DownsampleTrafficPoints([]*models.TrafficPoint{
+		point(1, 0, 10, 1),
+		point(1, 30*time.Minute, 20, 2),
+		point(2, 59*time.Minute, 5, 5),
+		point(1, 61*time.Minute, 7, 7),
+		nil,
+	}, models.TrafficResolutionHour)
+
+	assert.Len(t, got, 3)
+	assert.Equal(t, 1, got[0].ProxyID)
+	assert.Equal(t, base, got[0].BucketTime)
+	assert.Equal(t, models.TrafficResolutionHour, got[0].Resolution)
+	assert.Equal(t, int64(30), got[0].TrafficIn)
+	assert.Equal(t, int64(3), got[0].TrafficOut)
+	assert.Equal(t, "c1", got[0].ClientID)
+
+	assert.Equal(t, 2, got[1].ProxyID)
+	assert.Equal(t, int64(5), got[1].TrafficIn)
+
+	assert.Equal(t, base.Add(time.Hour), got[2].BucketTime)
+	assert.Equal(t, int64(7), got[2].TrafficIn)
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/fatedier/frp/pkg/config/types"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	TrafficQuotaTargetUser   = "user"
+	TrafficQuotaTargetClient = "client"
+	TrafficQuotaTargetProxy  = "proxy"
+
+	// TrafficQuotaActionStop 超额后停止隧道，计费周期重置时自动恢复
+	TrafficQuotaActionStop = "stop"
+	// TrafficQuotaActionLimit 超额后改用 ExceededBandwidthLimit 限速
+	TrafficQuotaActionLimit = "limit"
+)
+
+// TrafficQuota 挂在用户、客户端或隧道上的流量套餐，UserID 为被限制资源的属主
+type TrafficQuota struct {
+	*gorm.Model
+	*TrafficQuotaEntity
+}
+
+type TrafficQuotaEntity struct {
+	Name       string `json:"name"`
+	UserID     int    `json:"user_id" gorm:"index"`
+	TenantID   int    `json:"tenant_id" gorm:"index"`
+	TargetType string `json:"target_type" gorm:"type:varchar(32);index:idx_traffic_quotas_target"`
+	// TargetID 用户 id、客户端 id 或隧道配置 id
+	TargetID string `json:"target_id" gorm:"type:varchar(255);index:idx_traffic_quotas_target"`
+	// MonthlyBytes 每个计费周期允许的出入流量总和，0 表示不限
+	MonthlyBytes int64 `json:"monthly_bytes"`
+	// BandwidthLimit 平时的带宽上限，frp 格式如 1MB，空表示不限
+	BandwidthLimit         string    `json:"bandwidth_limit"`
+	ExceededAction         string    `json:"exceeded_action"`
+	ExceededBandwidthLimit string    `json:"exceeded_bandwidth_limit"`
+	CycleDay               int       `json:"cycle_day"` // 每月计费周期开始的日期，1-28
+	CycleStart             time.Time `json:"cycle_start"`
+	UsedBytes              int64     `json:"used_bytes"`
+	Exceeded               bool      `json:"exceeded" gorm:"index"`
+}
+
+func (*TrafficQuota) TableName() string {
+	return "traffic_quotas"
+}
+
+// Covers 判断配额是否作用于该隧道
+func (q *TrafficQuotaEntity) Covers(p *ProxyConfig) bool {
+	if p == nil || p.ProxyConfigEntity == nil || p.UserID != q.UserID || p.TenantID != q.TenantID {
+		return false
+	}
+	switch q.TargetType {
+	case TrafficQuotaTargetUser:
+		return q.TargetID == strconv.Itoa(p.UserID)
+	case TrafficQuotaTargetClient:
+		return q.TargetID == p.ClientID || q.TargetID == p.OriginClientID
+	case TrafficQuotaTargetProxy:
+		return p.Model != nil && q.TargetID == strconv.FormatUint(uint64(p.ID), 10)
+	}
+	return false
+}
+
+// Blocking 超额且超额动作为停止
+func (q *TrafficQuotaEntity) Blocking() bool {
+	return q.Exceeded && q.ExceededAction == TrafficQuotaActionStop
+}
+
+// EffectiveBandwidthLimit 当前生效的带宽上限，空表示不限
+func (q *TrafficQuotaEntity) EffectiveBandwidthLimit() string {
+	if q.Exceeded && q.ExceededAction == TrafficQuotaActionLimit {
+		return q.ExceededBandwidthLimit
+	}
+	return q.BandwidthLimit
+}
+
+// CycleStartAt 返回 now 所在计费周期的开始时间
+func (q *TrafficQuotaEntity) CycleStartAt(now time.Time) time.Time {
+	day := min(max(q.CycleDay, 1), 28)
+	start := time.Date(now.Year(), now.Month(), day, 0, 0, 0, 0, now.Location())
+	if start.After(now) {
+		start = start.AddDate(0, -1, 0)
+	}
+	return start
+}
+
+func (q *TrafficQuota) ToPB() *pb.TrafficQuota {
+	return &pb.TrafficQuota{
+		Id:                     lo.ToPtr(uint32(q.ID)),
+		Name:                   lo.ToPtr(q.Name),
+		UserId:                 lo.ToPtr(int64(q.UserID)),
+		TargetType:             lo.ToPtr(q.TargetType),
+		TargetId:               lo.ToPtr(q.TargetID),
+		MonthlyBytes:           lo.ToPtr(q.MonthlyBytes),
+		BandwidthLimit:         lo.ToPtr(q.BandwidthLimit),
+		ExceededAction:         lo.ToPtr(q.ExceededAction),
+		ExceededBandwidthLimit: lo.ToPtr(q.ExceededBandwidthLimit),
+		CycleDay:               lo.ToPtr(int32(q.CycleDay)),
+		CycleStart:             lo.ToPtr(q.CycleStart.UnixMilli()),
+		UsedBytes:              lo.ToPtr(q.UsedBytes),
+		Exceeded:               lo.ToPtr(q.Exceeded),
+	}
+}
+
+// MinBandwidthLimit 返回最严格的带宽上限，空字符串与无法解析的值会被忽略
+func MinBandwidthLimit(limits ...string) string {
+	result := ""
+	var minBytes int64
+	for _, l := range limits {
+		if len(l) == 0 {
+			continue
+		}
+		q, err := types.NewBandwidthQuantity(l)
+		if err != nil || q.Bytes() <= 0 {
+			continue
+		}
+		if len(result) == 0 || q.Bytes() < minBytes {
+			result, minBytes = q.String(), q.Bytes()
+		}
+	}
+	return result
+}
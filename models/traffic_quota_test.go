@@ -0,0 +1,56 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTrafficQuotaCovers(t *testing.T) {
+	proxyCfg := &models.ProxyConfig{
+		Model: &gorm.Model{ID: 7},
+		ProxyConfigEntity: &models.ProxyConfigEntity{
+			ClientID: "c1.s1", OriginClientID: "c1", UserID: 1, TenantID: 2,
+		},
+	}
+	quota := func(targetType, targetID string) *models.TrafficQuotaEntity {
+		return &models.TrafficQuotaEntity{UserID: 1, TenantID: 2, TargetType: targetType, TargetID: targetID}
+	}
+
+	assert.True(t, quota(models.TrafficQuotaTargetUser, "1").Covers(proxyCfg))
+	assert.True(t, quota(models.TrafficQuotaTargetClient, "c1").Covers(proxyCfg))
+	assert.True(t, quota(models.TrafficQuotaTargetClient, "c1.s1").Covers(proxyCfg))
+	assert.True(t, quota(models.TrafficQuotaTargetProxy, "7").Covers(proxyCfg))
+	assert.False(t, quota(models.TrafficQuotaTargetProxy, "8").Covers(proxyCfg))
+	assert.False(t, quota(models.TrafficQuotaTargetClient, "c2").Covers(proxyCfg))
+
+	other := quota(models.TrafficQuotaTargetUser, "1")
+	other.TenantID = 3
+	assert.False(t, other.Covers(proxyCfg))
+}
+
+func TestTrafficQuotaCycleStartAt(t *testing.T) {
+	q := &models.TrafficQuotaEntity{CycleDay: 15}
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		q.CycleStartAt(time.Date(2026, 3, 20, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		q.CycleStartAt(time.Date(2026, 3, 14, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+		q.CycleStartAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestTrafficQuotaBandwidth(t *testing.T) {
+	q := &models.TrafficQuotaEntity{
+		BandwidthLimit: "10MB", ExceededAction: models.TrafficQuotaActionLimit, ExceededBandwidthLimit: "100KB",
+	}
+	assert.Equal(t, "10MB", q.EffectiveBandwidthLimit())
+	q.Exceeded = true
+	assert.Equal(t, "100KB", q.EffectiveBandwidthLimit())
+	assert.False(t, q.Blocking())
+
+	assert.Equal(t, "100KB", models.MinBandwidthLimit("1MB", "", "100KB", "bad"))
+	assert.Equal(t, "", models.MinBandwidthLimit("", "bad"))
+}
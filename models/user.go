@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -24,23 +26,34 @@ type UserInfo interface {
 	GetTenantID() int
 	GetSafeUserInfo() UserEntity
 	IsAdmin() bool
+	IsTenantAdmin() bool
+	IsTOTPEnabled() bool
+	IsTOTPRequired() bool
 	Valid() bool
 }
 
 var _ UserInfo = (*UserEntity)(nil)
 
 type UserEntity struct {
-	UserID    int    `json:"user_id" gorm:"primaryKey"`
-	UserName  string `json:"user_name" gorm:"type:varchar(255);uniqueIndex;not null"`
-	Password  string `json:"password"`
-	Email     string `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"`
-	Status    int    `json:"status"`
-	Role      string `json:"role"`
-	TenantID  int    `json:"tenant_id"`
-	Token     string `json:"token"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	UserID   int    `json:"user_id" gorm:"primaryKey"`
+	UserName string `json:"user_name" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Password string `json:"password"`
+	Email    string `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Status   int    `json:"status"`
+	Role     string `json:"role"`
+	TenantID int    `json:"tenant_id"`
+	Token    string `json:"token"`
+	// SSOSubject 单点登录用户在 oidc issuer 下的 sub，本地用户为空
+	SSOSubject string `json:"sso_subject" gorm:"type:varchar(255);index"`
+	// TOTPSecret 在 TOTPEnabled 之前是待确认的密钥
+	TOTPSecret      string            `json:"-"`
+	TOTPEnabled     bool              `json:"totp_enabled"`
+	TOTPRequired    bool              `json:"totp_required"`
+	TOTPLastCounter int64             `json:"-"`
+	RecoveryCodes   GormArray[string] `json:"-" gorm:"type:text"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
 
 	Groups []*UserGroup `json:"groups,omitempty" gorm:"many2many:user_group_memberships;"`
 }
@@ -91,6 +104,21 @@ func (u *UserEntity) GetSafeUserInfo() UserEntity {
 	}
 }
 
+// ToSafePB 转换为不含 token 与密码的 pb 结构，用于展示其他用户
+func (u *UserEntity) ToSafePB() *pb.User {
+	return &pb.User{
+		UserID:   lo.ToPtr(int64(u.UserID)),
+		TenantID: lo.ToPtr(int64(u.TenantID)),
+		UserName: lo.ToPtr(u.UserName),
+		Email:    lo.ToPtr(u.Email),
+		Status:   lo.ToPtr(fmt.Sprint(u.Status)),
+		Role:     lo.ToPtr(u.Role),
+
+		TOTPEnabled:  lo.ToPtr(u.TOTPEnabled),
+		TOTPRequired: lo.ToPtr(u.TOTPRequired),
+	}
+}
+
 func (u *UserEntity) Valid() bool {
 	if u == nil {
 		return false
@@ -105,6 +133,20 @@ func (u *UserEntity) IsAdmin() bool {
 	return u.Role == defs.UserRole_Admin
 }
 
+// IsTenantAdmin 租户管理员，只能管理自己租户内的用户与资源
+func (u *UserEntity) IsTenantAdmin() bool {
+	return u.Role == defs.UserRole_TenantAdmin
+}
+
+func (u *UserEntity) IsTOTPEnabled() bool {
+	return u.TOTPEnabled
+}
+
+// IsTOTPRequired 管理员要求该用户必须开启两步验证
+func (u *UserEntity) IsTOTPRequired() bool {
+	return u.TOTPRequired
+}
+
 func (u *User) TableName() string {
 	return "users"
 }
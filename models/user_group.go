@@ -1,6 +1,11 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+)
 
 type UserGroup struct {
 	GroupID   string `json:"group_id" gorm:"primaryKey"`
@@ -16,3 +21,13 @@ type UserGroup struct {
 func (u *UserGroup) TableName() string {
 	return "user_groups"
 }
+
+func (u *UserGroup) ToPB() *pb.UserGroup {
+	return &pb.UserGroup{
+		GroupId:   lo.ToPtr(u.GroupID),
+		GroupName: lo.ToPtr(u.GroupName),
+		TenantId:  lo.ToPtr(int64(u.TenantID)),
+		Comment:   lo.ToPtr(u.Comment),
+		CreatedAt: lo.ToPtr(u.CreatedAt.UnixMilli()),
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	WebhookDeliveryStatusPending = "pending"
+	WebhookDeliveryStatusSuccess = "success"
+	WebhookDeliveryStatusFailed  = "failed"
+)
+
+// WebhookSubscription 用户配置的 webhook 订阅，只推送订阅者有读权限的资源上的事件
+type WebhookSubscription struct {
+	*gorm.Model
+	*WebhookSubscriptionEntity
+}
+
+type WebhookSubscriptionEntity struct {
+	Name       string            `json:"name"`
+	UserID     int               `json:"user_id" gorm:"index"`
+	TenantID   int               `json:"tenant_id" gorm:"index"`
+	URL        string            `json:"url"`
+	Secret     string            `json:"secret"`
+	EventTypes GormArray[string] `json:"event_types" gorm:"type:text"`
+	Enabled    bool              `json:"enabled"`
+}
+
+func (*WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Subscribes 判断订阅是否包含该事件类型
+func (s *WebhookSubscriptionEntity) Subscribes(eventType string) bool {
+	return s.Enabled && lo.Contains(s.EventTypes, eventType)
+}
+
+func (s *WebhookSubscription) ToPB() *pb.WebhookSubscription {
+	return &pb.WebhookSubscription{
+		Id:         lo.ToPtr(uint32(s.ID)),
+		Name:       lo.ToPtr(s.Name),
+		Url:        lo.ToPtr(s.URL),
+		EventTypes: s.EventTypes,
+		Enabled:    lo.ToPtr(s.Enabled),
+	}
+}
+
+// WebhookDelivery 一次事件推送，失败后按退避时间重试，Payload 为发送的 json
+type WebhookDelivery struct {
+	ID        uint      `gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	*WebhookDeliveryEntity
+}
+
+type WebhookDeliveryEntity struct {
+	SubscriptionID uint      `json:"subscription_id" gorm:"index"`
+	UserID         int       `json:"user_id" gorm:"index"`
+	TenantID       int       `json:"tenant_id" gorm:"index"`
+	EventID        string    `json:"event_id" gorm:"type:varchar(64);index"`
+	EventType      string    `json:"event_type" gorm:"type:varchar(64)"`
+	Payload        []byte    `json:"payload"`
+	Status         string    `json:"status" gorm:"type:varchar(32);index"`
+	Attempts       int       `json:"attempts"`
+	StatusCode     int       `json:"status_code"`
+	Error          string    `json:"error"`
+	DeliveredAt    time.Time `json:"delivered_at"`
+	NextRetryAt    time.Time `json:"next_retry_at" gorm:"index"`
+}
+
+func (*WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *WebhookDelivery) ToPB() *pb.WebhookDelivery {
+	toMilli := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.UnixMilli()
+	}
+	return &pb.WebhookDelivery{
+		Id:             lo.ToPtr(uint32(d.ID)),
+		SubscriptionId: lo.ToPtr(uint32(d.SubscriptionID)),
+		EventId:        lo.ToPtr(d.EventID),
+		EventType:      lo.ToPtr(d.EventType),
+		Payload:        lo.ToPtr(string(d.Payload)),
+		Status:         lo.ToPtr(d.Status),
+		Attempts:       lo.ToPtr(int32(d.Attempts)),
+		StatusCode:     lo.ToPtr(int32(d.StatusCode)),
+		Error:          lo.ToPtr(d.Error),
+		CreatedAt:      lo.ToPtr(toMilli(d.CreatedAt)),
+		DeliveredAt:    lo.ToPtr(toMilli(d.DeliveredAt)),
+		NextRetryAt:    lo.ToPtr(toMilli(d.NextRetryAt)),
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSubscriptionSubscribes(t *testing.T) {
+	s := &WebhookSubscriptionEntity{EventTypes: []string{"client.initialized"}, Enabled: true}
+	assert.True(t, s.Subscribes("client.initialized"))
+	assert.False(t, s.Subscribes("proxy.started"))
+
+	s.Enabled = false
+	assert.False(t, s.Subscribes("client.initialized"))
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"gorm.io/gorm"
+)
+
+// WireGuardExternalPeer 不运行 frpp 的外部设备，只与中继节点建立连接，
+// 网络内其他节点经由中继节点访问它
+type WireGuardExternalPeer struct {
+	gorm.Model
+	*WireGuardExternalPeerEntity
+
+	Network *Network   `json:"network,omitempty" gorm:"foreignKey:NetworkID;references:ID"`
+	Relay   *WireGuard `json:"relay,omitempty" gorm:"foreignKey:RelayWireGuardID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type WireGuardExternalPeerEntity struct {
+	Name     string `gorm:"type:varchar(255);index"`
+	UserId   uint32 `gorm:"index"`
+	TenantId uint32 `gorm:"index"`
+
+	NetworkID        uint `gorm:"index"`
+	RelayWireGuardID uint `gorm:"index"`
+
+	// PrivateKey 面板生成密钥对时保存，用于导出配置；设备自带公钥时为空
+	PrivateKey   string            `json:"private_key" gorm:"type:varchar(255)"`
+	PublicKey    string            `json:"public_key" gorm:"type:varchar(255);index"`
+	LocalAddress string            `json:"local_address" gorm:"type:varchar(255)"`
+	DnsServers   GormArray[string] `json:"dns_servers" gorm:"type:varchar(255)"`
+	AllowedIPs   GormArray[string] `json:"allowed_ips" gorm:"type:text"`
+}
+
+func (*WireGuardExternalPeer) TableName() string {
+	return "wireguard_external_peers"
+}
+
+func (p *WireGuardExternalPeer) ToPB() *pb.WireGuardExternalPeer {
+	return &pb.WireGuardExternalPeer{
+		Id:               uint32(p.ID),
+		UserId:           p.UserId,
+		TenantId:         p.TenantId,
+		Name:             p.Name,
+		NetworkId:        uint32(p.NetworkID),
+		RelayWireguardId: uint32(p.RelayWireGuardID),
+		PublicKey:        p.PublicKey,
+		LocalAddress:     p.LocalAddress,
+		DnsServers:       p.DnsServers,
+		AllowedIps:       p.AllowedIPs,
+	}
+}
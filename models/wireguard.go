@@ -38,6 +38,14 @@ type WireGuardEntity struct {
 
 	WsListenPort uint32 `json:"ws_listen_port" gorm:"uniqueIndex:idx_client_id_ws_listen_port"`
 	UseGvisorNet bool   `json:"use_gvisor_net"`
+
+	// AdvertisedRoutes 本节点作为子网路由通告给网络的网段
+	AdvertisedRoutes GormArray[string] `json:"advertised_routes" gorm:"type:text"`
+	// ExitNode 作为出口节点通告 0.0.0.0/0，UseExitNode 为 true 的节点经由最近的出口节点访问公网
+	ExitNode    bool `json:"exit_node"`
+	UseExitNode bool `json:"use_exit_node"`
+	// AcceptRoutes 为 true 时才使用其他节点通告的子网路由，避免覆盖节点本地的路由
+	AcceptRoutes bool `json:"accept_routes"`
 }
 
 func (*WireGuard) TableName() string {
@@ -48,6 +56,10 @@ func (w *WireGuard) GetTags() []string {
 	return w.Tags
 }
 
+func (w *WireGuard) GetLocalAddress() string {
+	return w.LocalAddress
+}
+
 func (w *WireGuard) GetID() uint {
 	return uint(w.ID)
 }
@@ -123,6 +135,10 @@ func (w *WireGuard) FromPB(pb *pb.WireGuardConfig) {
 	w.Tags = GormArray[string](pb.GetTags())
 	w.WsListenPort = pb.GetWsListenPort()
 	w.UseGvisorNet = pb.GetUseGvisorNet()
+	w.AdvertisedRoutes = GormArray[string](pb.GetAdvertisedRoutes())
+	w.ExitNode = pb.GetExitNode()
+	w.UseExitNode = pb.GetUseExitNode()
+	w.AcceptRoutes = pb.GetAcceptRoutes()
 	w.AdvertisedEndpoints = make([]*Endpoint, 0, len(pb.GetAdvertisedEndpoints()))
 	for _, e := range pb.GetAdvertisedEndpoints() {
 		endpointModel := &Endpoint{}
@@ -148,8 +164,12 @@ func (w *WireGuard) ToPB() *pb.WireGuardConfig {
 		AdvertisedEndpoints: lo.Map(w.AdvertisedEndpoints, func(e *Endpoint, _ int) *pb.Endpoint {
 			return e.ToPB()
 		}),
-		WsListenPort: w.ListenPort,
-		UseGvisorNet: w.UseGvisorNet,
+		WsListenPort:     w.ListenPort,
+		UseGvisorNet:     w.UseGvisorNet,
+		AdvertisedRoutes: w.AdvertisedRoutes,
+		ExitNode:         w.ExitNode,
+		UseExitNode:      w.UseExitNode,
+		AcceptRoutes:     w.AcceptRoutes,
 	}
 }
 
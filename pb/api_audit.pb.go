@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v3.21.11
+// source: api_audit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	UserId        *int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Kind          *string                `protobuf:"bytes,4,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	Route         *string                `protobuf:"bytes,5,opt,name=route,proto3,oneof" json:"route,omitempty"` // 模糊匹配
+	TargetId      *string                `protobuf:"bytes,6,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	StartTime     *int64                 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // unix milli
+	EndTime       *int64                 `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // unix milli
+	FailedOnly    *bool                  `protobuf:"varint,9,opt,name=failed_only,json=failedOnly,proto3,oneof" json:"failed_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_api_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAuditLogsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetRoute() string {
+	if x != nil && x.Route != nil {
+		return *x.Route
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetFailedOnly() bool {
+	if x != nil && x.FailedOnly != nil {
+		return *x.FailedOnly
+	}
+	return false
+}
+
+type ListAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Logs          []*AuditLog            `protobuf:"bytes,3,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_api_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditLogsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditLogsResponse) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+var File_api_audit_proto protoreflect.FileDescriptor
+
+const file_api_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x0fapi_audit.proto\x12\tapi_audit\x1a\fcommon.proto\"\x9f\x03\n" +
+	"\x14ListAuditLogsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x02R\x06userId\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x04 \x01(\tH\x03R\x04kind\x88\x01\x01\x12\x19\n" +
+	"\x05route\x18\x05 \x01(\tH\x04R\x05route\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\x06 \x01(\tH\x05R\btargetId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\a \x01(\x03H\x06R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\b \x01(\x03H\aR\aendTime\x88\x01\x01\x12$\n" +
+	"\vfailed_only\x18\t \x01(\bH\bR\n" +
+	"failedOnly\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_kindB\b\n" +
+	"\x06_routeB\f\n" +
+	"\n" +
+	"_target_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x0e\n" +
+	"\f_failed_only\"\x9a\x01\n" +
+	"\x15ListAuditLogsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12$\n" +
+	"\x04logs\x18\x03 \x03(\v2\x10.common.AuditLogR\x04logsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_totalB\aZ\x05../pbb\x06proto3"
+
+var (
+	file_api_audit_proto_rawDescOnce sync.Once
+	file_api_audit_proto_rawDescData []byte
+)
+
+func file_api_audit_proto_rawDescGZIP() []byte {
+	file_api_audit_proto_rawDescOnce.Do(func() {
+		file_api_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_audit_proto_rawDesc), len(file_api_audit_proto_rawDesc)))
+	})
+	return file_api_audit_proto_rawDescData
+}
+
+var file_api_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_audit_proto_goTypes = []any{
+	(*ListAuditLogsRequest)(nil),  // 0: api_audit.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil), // 1: api_audit.ListAuditLogsResponse
+	(*Status)(nil),                // 2: common.Status
+	(*AuditLog)(nil),              // 3: common.AuditLog
+}
+var file_api_audit_proto_depIdxs = []int32{
+	2, // 0: api_audit.ListAuditLogsResponse.status:type_name -> common.Status
+	3, // 1: api_audit.ListAuditLogsResponse.logs:type_name -> common.AuditLog
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_audit_proto_init() }
+func file_api_audit_proto_init() {
+	if File_api_audit_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_api_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_audit_proto_rawDesc), len(file_api_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_audit_proto_goTypes,
+		DependencyIndexes: file_api_audit_proto_depIdxs,
+		MessageInfos:      file_api_audit_proto_msgTypes,
+	}.Build()
+	File_api_audit_proto = out.File
+	file_api_audit_proto_goTypes = nil
+	file_api_audit_proto_depIdxs = nil
+}
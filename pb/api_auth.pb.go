@@ -25,6 +25,7 @@ type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
 	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	TotpCode      *string                `protobuf:"bytes,3,opt,name=totp_code,json=totpCode,proto3,oneof" json:"totp_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,10 +74,18 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetTotpCode() string {
+	if x != nil && x.TotpCode != nil {
+		return *x.TotpCode
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	Token         *string                `protobuf:"bytes,2,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	TotpRequired  *bool                  `protobuf:"varint,3,opt,name=totp_required,json=totpRequired,proto3,oneof" json:"totp_required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +134,13 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetTotpRequired() bool {
+	if x != nil && x.TotpRequired != nil {
+		return *x.TotpRequired
+	}
+	return false
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
@@ -285,6 +301,7 @@ type SignTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExpiresIn     *int64                 `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
 	Permissions   []*APIPermission       `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -333,10 +350,18 @@ func (x *SignTokenRequest) GetPermissions() []*APIPermission {
 	return nil
 }
 
+func (x *SignTokenRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
 type SignTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	Token         *string                `protobuf:"bytes,2,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	ApiToken      *APIToken              `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3,oneof" json:"api_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -385,21 +410,445 @@ func (x *SignTokenResponse) GetToken() string {
 	return ""
 }
 
+func (x *SignTokenResponse) GetApiToken() *APIToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+type APIToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Permissions   []*APIPermission       `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`                              // active, inactive, revoked
+	ExpiresAt     *int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`      // unix milli
+	LastUsedAt    *int64                 `protobuf:"varint,6,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"` // unix milli, 0 means never used
+	CreatedAt     *int64                 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_api_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *APIToken) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetPermissions() []*APIPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *APIToken) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *APIToken) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *APIToken) GetLastUsedAt() int64 {
+	if x != nil && x.LastUsedAt != nil {
+		return *x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *APIToken) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type ListAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_api_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAPITokensRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAPITokensRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAPITokensRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	ApiTokens     []*APIToken            `protobuf:"bytes,3,rep,name=api_tokens,json=apiTokens,proto3" json:"api_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_api_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAPITokensResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListAPITokensResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListAPITokensResponse) GetApiTokens() []*APIToken {
+	if x != nil {
+		return x.ApiTokens
+	}
+	return nil
+}
+
+type RevokeAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       *uint32                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenRequest) Reset() {
+	*x = RevokeAPITokenRequest{}
+	mi := &file_api_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenRequest) ProtoMessage() {}
+
+func (x *RevokeAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeAPITokenRequest) GetTokenId() uint32 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+type RevokeAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenResponse) Reset() {
+	*x = RevokeAPITokenResponse{}
+	mi := &file_api_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenResponse) ProtoMessage() {}
+
+func (x *RevokeAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeAPITokenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RotateAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       *uint32                `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"`
+	ExpiresIn     *int64                 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"` // use the lifetime of the old token if not set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPITokenRequest) Reset() {
+	*x = RotateAPITokenRequest{}
+	mi := &file_api_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPITokenRequest) ProtoMessage() {}
+
+func (x *RotateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RotateAPITokenRequest) GetTokenId() uint32 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+func (x *RotateAPITokenRequest) GetExpiresIn() int64 {
+	if x != nil && x.ExpiresIn != nil {
+		return *x.ExpiresIn
+	}
+	return 0
+}
+
+type RotateAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Token         *string                `protobuf:"bytes,2,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	ApiToken      *APIToken              `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3,oneof" json:"api_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPITokenResponse) Reset() {
+	*x = RotateAPITokenResponse{}
+	mi := &file_api_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPITokenResponse) ProtoMessage() {}
+
+func (x *RotateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RotateAPITokenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *RotateAPITokenResponse) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *RotateAPITokenResponse) GetApiToken() *APIToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
 var File_api_auth_proto protoreflect.FileDescriptor
 
 const file_api_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x0eapi_auth.proto\x12\bapi_auth\x1a\fcommon.proto\"j\n" +
+	"\x0eapi_auth.proto\x12\bapi_auth\x1a\fcommon.proto\"\x9a\x01\n" +
 	"\fLoginRequest\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
-	"\bpassword\x18\x02 \x01(\tH\x01R\bpassword\x88\x01\x01B\v\n" +
+	"\bpassword\x18\x02 \x01(\tH\x01R\bpassword\x88\x01\x01\x12 \n" +
+	"\ttotp_code\x18\x03 \x01(\tH\x02R\btotpCode\x88\x01\x01B\v\n" +
 	"\t_usernameB\v\n" +
-	"\t_password\"l\n" +
+	"\t_passwordB\f\n" +
+	"\n" +
+	"_totp_code\"\xa8\x01\n" +
 	"\rLoginResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
-	"\x05token\x18\x02 \x01(\tH\x01R\x05token\x88\x01\x01B\t\n" +
+	"\x05token\x18\x02 \x01(\tH\x01R\x05token\x88\x01\x01\x12(\n" +
+	"\rtotp_required\x18\x03 \x01(\bH\x02R\ftotpRequired\x88\x01\x01B\t\n" +
 	"\a_statusB\b\n" +
-	"\x06_token\"\x92\x01\n" +
+	"\x06_tokenB\x10\n" +
+	"\x0e_totp_required\"\x92\x01\n" +
 	"\x0fRegisterRequest\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
 	"\bpassword\x18\x02 \x01(\tH\x01R\bpassword\x88\x01\x01\x12\x19\n" +
@@ -414,17 +863,75 @@ const file_api_auth_proto_rawDesc = "" +
 	"\x06method\x18\x01 \x01(\tH\x00R\x06method\x88\x01\x01\x12\x17\n" +
 	"\x04path\x18\x02 \x01(\tH\x01R\x04path\x88\x01\x01B\t\n" +
 	"\a_methodB\a\n" +
-	"\x05_path\"\x80\x01\n" +
+	"\x05_path\"\xa2\x01\n" +
 	"\x10SignTokenRequest\x12\"\n" +
 	"\n" +
 	"expires_in\x18\x01 \x01(\x03H\x00R\texpiresIn\x88\x01\x01\x129\n" +
-	"\vpermissions\x18\x02 \x03(\v2\x17.api_auth.APIPermissionR\vpermissionsB\r\n" +
-	"\v_expires_in\"p\n" +
+	"\vpermissions\x18\x02 \x03(\v2\x17.api_auth.APIPermissionR\vpermissions\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01B\r\n" +
+	"\v_expires_inB\a\n" +
+	"\x05_name\"\xb4\x01\n" +
 	"\x11SignTokenResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
-	"\x05token\x18\x02 \x01(\tH\x01R\x05token\x88\x01\x01B\t\n" +
+	"\x05token\x18\x02 \x01(\tH\x01R\x05token\x88\x01\x01\x124\n" +
+	"\tapi_token\x18\x03 \x01(\v2\x12.api_auth.APITokenH\x02R\bapiToken\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_tokenB\f\n" +
+	"\n" +
+	"_api_token\"\xc9\x02\n" +
+	"\bAPIToken\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x129\n" +
+	"\vpermissions\x18\x03 \x03(\v2\x17.api_auth.APIPermissionR\vpermissions\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03H\x03R\texpiresAt\x88\x01\x01\x12%\n" +
+	"\flast_used_at\x18\x06 \x01(\x03H\x04R\n" +
+	"lastUsedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03H\x05R\tcreatedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_expires_atB\x0f\n" +
+	"\r_last_used_atB\r\n" +
+	"\v_created_at\"\x93\x01\n" +
+	"\x14ListAPITokensRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_keyword\"\xa7\x01\n" +
+	"\x15ListAPITokensResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x121\n" +
+	"\n" +
+	"api_tokens\x18\x03 \x03(\v2\x12.api_auth.APITokenR\tapiTokensB\t\n" +
 	"\a_statusB\b\n" +
-	"\x06_tokenB\aZ\x05../pbb\x06proto3"
+	"\x06_total\"D\n" +
+	"\x15RevokeAPITokenRequest\x12\x1e\n" +
+	"\btoken_id\x18\x01 \x01(\rH\x00R\atokenId\x88\x01\x01B\v\n" +
+	"\t_token_id\"P\n" +
+	"\x16RevokeAPITokenResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"w\n" +
+	"\x15RotateAPITokenRequest\x12\x1e\n" +
+	"\btoken_id\x18\x01 \x01(\rH\x00R\atokenId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03H\x01R\texpiresIn\x88\x01\x01B\v\n" +
+	"\t_token_idB\r\n" +
+	"\v_expires_in\"\xb9\x01\n" +
+	"\x16RotateAPITokenResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tH\x01R\x05token\x88\x01\x01\x124\n" +
+	"\tapi_token\x18\x03 \x01(\v2\x12.api_auth.APITokenH\x02R\bapiToken\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_tokenB\f\n" +
+	"\n" +
+	"_api_tokenB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_api_auth_proto_rawDescOnce sync.Once
@@ -438,27 +945,41 @@ func file_api_auth_proto_rawDescGZIP() []byte {
 	return file_api_auth_proto_rawDescData
 }
 
-var file_api_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_auth_proto_goTypes = []any{
-	(*LoginRequest)(nil),      // 0: api_auth.LoginRequest
-	(*LoginResponse)(nil),     // 1: api_auth.LoginResponse
-	(*RegisterRequest)(nil),   // 2: api_auth.RegisterRequest
-	(*RegisterResponse)(nil),  // 3: api_auth.RegisterResponse
-	(*APIPermission)(nil),     // 4: api_auth.APIPermission
-	(*SignTokenRequest)(nil),  // 5: api_auth.SignTokenRequest
-	(*SignTokenResponse)(nil), // 6: api_auth.SignTokenResponse
-	(*Status)(nil),            // 7: common.Status
+	(*LoginRequest)(nil),           // 0: api_auth.LoginRequest
+	(*LoginResponse)(nil),          // 1: api_auth.LoginResponse
+	(*RegisterRequest)(nil),        // 2: api_auth.RegisterRequest
+	(*RegisterResponse)(nil),       // 3: api_auth.RegisterResponse
+	(*APIPermission)(nil),          // 4: api_auth.APIPermission
+	(*SignTokenRequest)(nil),       // 5: api_auth.SignTokenRequest
+	(*SignTokenResponse)(nil),      // 6: api_auth.SignTokenResponse
+	(*APIToken)(nil),               // 7: api_auth.APIToken
+	(*ListAPITokensRequest)(nil),   // 8: api_auth.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),  // 9: api_auth.ListAPITokensResponse
+	(*RevokeAPITokenRequest)(nil),  // 10: api_auth.RevokeAPITokenRequest
+	(*RevokeAPITokenResponse)(nil), // 11: api_auth.RevokeAPITokenResponse
+	(*RotateAPITokenRequest)(nil),  // 12: api_auth.RotateAPITokenRequest
+	(*RotateAPITokenResponse)(nil), // 13: api_auth.RotateAPITokenResponse
+	(*Status)(nil),                 // 14: common.Status
 }
 var file_api_auth_proto_depIdxs = []int32{
-	7, // 0: api_auth.LoginResponse.status:type_name -> common.Status
-	7, // 1: api_auth.RegisterResponse.status:type_name -> common.Status
-	4, // 2: api_auth.SignTokenRequest.permissions:type_name -> api_auth.APIPermission
-	7, // 3: api_auth.SignTokenResponse.status:type_name -> common.Status
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	14, // 0: api_auth.LoginResponse.status:type_name -> common.Status
+	14, // 1: api_auth.RegisterResponse.status:type_name -> common.Status
+	4,  // 2: api_auth.SignTokenRequest.permissions:type_name -> api_auth.APIPermission
+	14, // 3: api_auth.SignTokenResponse.status:type_name -> common.Status
+	7,  // 4: api_auth.SignTokenResponse.api_token:type_name -> api_auth.APIToken
+	4,  // 5: api_auth.APIToken.permissions:type_name -> api_auth.APIPermission
+	14, // 6: api_auth.ListAPITokensResponse.status:type_name -> common.Status
+	7,  // 7: api_auth.ListAPITokensResponse.api_tokens:type_name -> api_auth.APIToken
+	14, // 8: api_auth.RevokeAPITokenResponse.status:type_name -> common.Status
+	14, // 9: api_auth.RotateAPITokenResponse.status:type_name -> common.Status
+	7,  // 10: api_auth.RotateAPITokenResponse.api_token:type_name -> api_auth.APIToken
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_auth_proto_init() }
@@ -474,13 +995,20 @@ func file_api_auth_proto_init() {
 	file_api_auth_proto_msgTypes[4].OneofWrappers = []any{}
 	file_api_auth_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_auth_proto_msgTypes[6].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[7].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_auth_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_auth_proto_rawDesc), len(file_api_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
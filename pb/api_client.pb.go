@@ -3102,6 +3102,294 @@ func (x *UpgradeFrppResponse) GetStatus() *Status {
 	return nil
 }
 
+type ListClientTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // origin or child client id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientTokensRequest) Reset() {
+	*x = ListClientTokensRequest{}
+	mi := &file_api_client_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientTokensRequest) ProtoMessage() {}
+
+func (x *ListClientTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListClientTokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListClientTokensRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+type ListClientTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Tokens        []*ClientToken         `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientTokensResponse) Reset() {
+	*x = ListClientTokensResponse{}
+	mi := &file_api_client_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientTokensResponse) ProtoMessage() {}
+
+func (x *ListClientTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListClientTokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListClientTokensResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListClientTokensResponse) GetTokens() []*ClientToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeClientTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	TokenId       *uint32                `protobuf:"varint,2,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"` // revoke all tokens of client if not set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientTokenRequest) Reset() {
+	*x = RevokeClientTokenRequest{}
+	mi := &file_api_client_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientTokenRequest) ProtoMessage() {}
+
+func (x *RevokeClientTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeClientTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RevokeClientTokenRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *RevokeClientTokenRequest) GetTokenId() uint32 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+type RevokeClientTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientTokenResponse) Reset() {
+	*x = RevokeClientTokenResponse{}
+	mi := &file_api_client_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientTokenResponse) ProtoMessage() {}
+
+func (x *RevokeClientTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeClientTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RevokeClientTokenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RotateClientTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ExpireSeconds *int64                 `protobuf:"varint,2,opt,name=expire_seconds,json=expireSeconds,proto3,oneof" json:"expire_seconds,omitempty"` // 0 means use default ttl
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateClientTokenRequest) Reset() {
+	*x = RotateClientTokenRequest{}
+	mi := &file_api_client_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateClientTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientTokenRequest) ProtoMessage() {}
+
+func (x *RotateClientTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateClientTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RotateClientTokenRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *RotateClientTokenRequest) GetExpireSeconds() int64 {
+	if x != nil && x.ExpireSeconds != nil {
+		return *x.ExpireSeconds
+	}
+	return 0
+}
+
+type RotateClientTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateClientTokenResponse) Reset() {
+	*x = RotateClientTokenResponse{}
+	mi := &file_api_client_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateClientTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateClientTokenResponse) ProtoMessage() {}
+
+func (x *RotateClientTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateClientTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateClientTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RotateClientTokenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 var File_api_client_proto protoreflect.FileDescriptor
 
 const file_api_client_proto_rawDesc = "" +
@@ -3466,6 +3754,32 @@ const file_api_client_proto_rawDesc = "" +
 	"\b_workdir\"M\n" +
 	"\x13UpgradeFrppResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"I\n" +
+	"\x17ListClientTokensRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_id\"\x7f\n" +
+	"\x18ListClientTokensResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12+\n" +
+	"\x06tokens\x18\x02 \x03(\v2\x13.common.ClientTokenR\x06tokensB\t\n" +
+	"\a_status\"w\n" +
+	"\x18RevokeClientTokenRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x1e\n" +
+	"\btoken_id\x18\x02 \x01(\rH\x01R\atokenId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\v\n" +
+	"\t_token_id\"S\n" +
+	"\x19RevokeClientTokenResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x89\x01\n" +
+	"\x18RotateClientTokenRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12*\n" +
+	"\x0eexpire_seconds\x18\x02 \x01(\x03H\x01R\rexpireSeconds\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\x11\n" +
+	"\x0f_expire_seconds\"S\n" +
+	"\x19RotateClientTokenResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
 	"\a_statusB\aZ\x05../pbb\x06proto3"
 
 var (
@@ -3480,7 +3794,7 @@ func file_api_client_proto_rawDescGZIP() []byte {
 	return file_api_client_proto_rawDescData
 }
 
-var file_api_client_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_api_client_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_api_client_proto_goTypes = []any{
 	(*InitClientRequest)(nil),               // 0: api_client.InitClientRequest
 	(*InitClientResponse)(nil),              // 1: api_client.InitClientResponse
@@ -3540,62 +3854,73 @@ var file_api_client_proto_goTypes = []any{
 	(*RedeployWorkerResponse)(nil),          // 55: api_client.RedeployWorkerResponse
 	(*UpgradeFrppRequest)(nil),              // 56: api_client.UpgradeFrppRequest
 	(*UpgradeFrppResponse)(nil),             // 57: api_client.UpgradeFrppResponse
-	nil,                                     // 58: api_client.GetWorkerStatusResponse.WorkerStatusEntry
-	(*Status)(nil),                          // 59: common.Status
-	(*Client)(nil),                          // 60: common.Client
-	(*ProxyInfo)(nil),                       // 61: common.ProxyInfo
-	(*ProxyConfig)(nil),                     // 62: common.ProxyConfig
-	(*ProxyWorkingStatus)(nil),              // 63: common.ProxyWorkingStatus
-	(*Worker)(nil),                          // 64: common.Worker
+	(*ListClientTokensRequest)(nil),         // 58: api_client.ListClientTokensRequest
+	(*ListClientTokensResponse)(nil),        // 59: api_client.ListClientTokensResponse
+	(*RevokeClientTokenRequest)(nil),        // 60: api_client.RevokeClientTokenRequest
+	(*RevokeClientTokenResponse)(nil),       // 61: api_client.RevokeClientTokenResponse
+	(*RotateClientTokenRequest)(nil),        // 62: api_client.RotateClientTokenRequest
+	(*RotateClientTokenResponse)(nil),       // 63: api_client.RotateClientTokenResponse
+	nil,                                     // 64: api_client.GetWorkerStatusResponse.WorkerStatusEntry
+	(*Status)(nil),                          // 65: common.Status
+	(*Client)(nil),                          // 66: common.Client
+	(*ProxyInfo)(nil),                       // 67: common.ProxyInfo
+	(*ProxyConfig)(nil),                     // 68: common.ProxyConfig
+	(*ProxyWorkingStatus)(nil),              // 69: common.ProxyWorkingStatus
+	(*Worker)(nil),                          // 70: common.Worker
+	(*ClientToken)(nil),                     // 71: common.ClientToken
 }
 var file_api_client_proto_depIdxs = []int32{
-	59, // 0: api_client.InitClientResponse.status:type_name -> common.Status
-	59, // 1: api_client.ListClientsResponse.status:type_name -> common.Status
-	60, // 2: api_client.ListClientsResponse.clients:type_name -> common.Client
-	59, // 3: api_client.GetClientResponse.status:type_name -> common.Status
-	60, // 4: api_client.GetClientResponse.client:type_name -> common.Client
-	59, // 5: api_client.DeleteClientResponse.status:type_name -> common.Status
-	59, // 6: api_client.UpdateFRPCResponse.status:type_name -> common.Status
-	59, // 7: api_client.RemoveFRPCResponse.status:type_name -> common.Status
-	59, // 8: api_client.StopFRPCResponse.status:type_name -> common.Status
-	59, // 9: api_client.StartFRPCResponse.status:type_name -> common.Status
-	59, // 10: api_client.GetProxyStatsByClientIDResponse.status:type_name -> common.Status
-	61, // 11: api_client.GetProxyStatsByClientIDResponse.proxy_infos:type_name -> common.ProxyInfo
-	59, // 12: api_client.ListProxyConfigsResponse.status:type_name -> common.Status
-	62, // 13: api_client.ListProxyConfigsResponse.proxy_configs:type_name -> common.ProxyConfig
-	59, // 14: api_client.CreateProxyConfigResponse.status:type_name -> common.Status
-	59, // 15: api_client.DeleteProxyConfigResponse.status:type_name -> common.Status
-	59, // 16: api_client.UpdateProxyConfigResponse.status:type_name -> common.Status
-	59, // 17: api_client.GetProxyConfigResponse.status:type_name -> common.Status
-	62, // 18: api_client.GetProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
-	63, // 19: api_client.GetProxyConfigResponse.working_status:type_name -> common.ProxyWorkingStatus
-	59, // 20: api_client.StopProxyResponse.status:type_name -> common.Status
-	59, // 21: api_client.StartProxyResponse.status:type_name -> common.Status
-	64, // 22: api_client.CreateWorkerRequest.worker:type_name -> common.Worker
-	59, // 23: api_client.CreateWorkerResponse.status:type_name -> common.Status
-	59, // 24: api_client.RemoveWorkerResponse.status:type_name -> common.Status
-	64, // 25: api_client.UpdateWorkerRequest.worker:type_name -> common.Worker
-	59, // 26: api_client.UpdateWorkerResponse.status:type_name -> common.Status
-	59, // 27: api_client.RunWorkerResponse.status:type_name -> common.Status
-	59, // 28: api_client.StopWorkerResponse.status:type_name -> common.Status
-	59, // 29: api_client.ListWorkersResponse.status:type_name -> common.Status
-	64, // 30: api_client.ListWorkersResponse.workers:type_name -> common.Worker
-	59, // 31: api_client.CreateWorkerIngressResponse.status:type_name -> common.Status
-	59, // 32: api_client.GetWorkerIngressResponse.status:type_name -> common.Status
-	62, // 33: api_client.GetWorkerIngressResponse.proxy_configs:type_name -> common.ProxyConfig
-	59, // 34: api_client.GetWorkerResponse.status:type_name -> common.Status
-	64, // 35: api_client.GetWorkerResponse.worker:type_name -> common.Worker
-	60, // 36: api_client.GetWorkerResponse.clients:type_name -> common.Client
-	59, // 37: api_client.GetWorkerStatusResponse.status:type_name -> common.Status
-	58, // 38: api_client.GetWorkerStatusResponse.worker_status:type_name -> api_client.GetWorkerStatusResponse.WorkerStatusEntry
-	59, // 39: api_client.InstallWorkerdResponse.status:type_name -> common.Status
-	59, // 40: api_client.RedeployWorkerResponse.status:type_name -> common.Status
-	59, // 41: api_client.UpgradeFrppResponse.status:type_name -> common.Status
-	42, // [42:42] is the sub-list for method output_type
-	42, // [42:42] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	65, // 0: api_client.InitClientResponse.status:type_name -> common.Status
+	65, // 1: api_client.ListClientsResponse.status:type_name -> common.Status
+	66, // 2: api_client.ListClientsResponse.clients:type_name -> common.Client
+	65, // 3: api_client.GetClientResponse.status:type_name -> common.Status
+	66, // 4: api_client.GetClientResponse.client:type_name -> common.Client
+	65, // 5: api_client.DeleteClientResponse.status:type_name -> common.Status
+	65, // 6: api_client.UpdateFRPCResponse.status:type_name -> common.Status
+	65, // 7: api_client.RemoveFRPCResponse.status:type_name -> common.Status
+	65, // 8: api_client.StopFRPCResponse.status:type_name -> common.Status
+	65, // 9: api_client.StartFRPCResponse.status:type_name -> common.Status
+	65, // 10: api_client.GetProxyStatsByClientIDResponse.status:type_name -> common.Status
+	67, // 11: api_client.GetProxyStatsByClientIDResponse.proxy_infos:type_name -> common.ProxyInfo
+	65, // 12: api_client.ListProxyConfigsResponse.status:type_name -> common.Status
+	68, // 13: api_client.ListProxyConfigsResponse.proxy_configs:type_name -> common.ProxyConfig
+	65, // 14: api_client.CreateProxyConfigResponse.status:type_name -> common.Status
+	65, // 15: api_client.DeleteProxyConfigResponse.status:type_name -> common.Status
+	65, // 16: api_client.UpdateProxyConfigResponse.status:type_name -> common.Status
+	65, // 17: api_client.GetProxyConfigResponse.status:type_name -> common.Status
+	68, // 18: api_client.GetProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
+	69, // 19: api_client.GetProxyConfigResponse.working_status:type_name -> common.ProxyWorkingStatus
+	65, // 20: api_client.StopProxyResponse.status:type_name -> common.Status
+	65, // 21: api_client.StartProxyResponse.status:type_name -> common.Status
+	70, // 22: api_client.CreateWorkerRequest.worker:type_name -> common.Worker
+	65, // 23: api_client.CreateWorkerResponse.status:type_name -> common.Status
+	65, // 24: api_client.RemoveWorkerResponse.status:type_name -> common.Status
+	70, // 25: api_client.UpdateWorkerRequest.worker:type_name -> common.Worker
+	65, // 26: api_client.UpdateWorkerResponse.status:type_name -> common.Status
+	65, // 27: api_client.RunWorkerResponse.status:type_name -> common.Status
+	65, // 28: api_client.StopWorkerResponse.status:type_name -> common.Status
+	65, // 29: api_client.ListWorkersResponse.status:type_name -> common.Status
+	70, // 30: api_client.ListWorkersResponse.workers:type_name -> common.Worker
+	65, // 31: api_client.CreateWorkerIngressResponse.status:type_name -> common.Status
+	65, // 32: api_client.GetWorkerIngressResponse.status:type_name -> common.Status
+	68, // 33: api_client.GetWorkerIngressResponse.proxy_configs:type_name -> common.ProxyConfig
+	65, // 34: api_client.GetWorkerResponse.status:type_name -> common.Status
+	70, // 35: api_client.GetWorkerResponse.worker:type_name -> common.Worker
+	66, // 36: api_client.GetWorkerResponse.clients:type_name -> common.Client
+	65, // 37: api_client.GetWorkerStatusResponse.status:type_name -> common.Status
+	64, // 38: api_client.GetWorkerStatusResponse.worker_status:type_name -> api_client.GetWorkerStatusResponse.WorkerStatusEntry
+	65, // 39: api_client.InstallWorkerdResponse.status:type_name -> common.Status
+	65, // 40: api_client.RedeployWorkerResponse.status:type_name -> common.Status
+	65, // 41: api_client.UpgradeFrppResponse.status:type_name -> common.Status
+	65, // 42: api_client.ListClientTokensResponse.status:type_name -> common.Status
+	71, // 43: api_client.ListClientTokensResponse.tokens:type_name -> common.ClientToken
+	65, // 44: api_client.RevokeClientTokenResponse.status:type_name -> common.Status
+	65, // 45: api_client.RotateClientTokenResponse.status:type_name -> common.Status
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_api_client_proto_init() }
@@ -3662,13 +3987,19 @@ func file_api_client_proto_init() {
 	file_api_client_proto_msgTypes[55].OneofWrappers = []any{}
 	file_api_client_proto_msgTypes[56].OneofWrappers = []any{}
 	file_api_client_proto_msgTypes[57].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[59].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[60].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[61].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[63].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_client_proto_rawDesc), len(file_api_client_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -74,16 +74,17 @@ func (ClientStatus_Status) EnumDescriptor() ([]byte, []int) {
 }
 
 type ClientStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientType    ClientType             `protobuf:"varint,1,opt,name=client_type,json=clientType,proto3,enum=common.ClientType" json:"client_type,omitempty"`
-	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	Status        ClientStatus_Status    `protobuf:"varint,3,opt,name=status,proto3,enum=api_master.ClientStatus_Status" json:"status,omitempty"`
-	Ping          int32                  `protobuf:"varint,4,opt,name=ping,proto3" json:"ping,omitempty"` // 单位为毫秒
-	Version       *ClientVersion         `protobuf:"bytes,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
-	Addr          *string                `protobuf:"bytes,6,opt,name=addr,proto3,oneof" json:"addr,omitempty"`
-	ConnectTime   *int64                 `protobuf:"varint,7,opt,name=connect_time,json=connectTime,proto3,oneof" json:"connect_time,omitempty"` // 连接建立的时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientType      ClientType             `protobuf:"varint,1,opt,name=client_type,json=clientType,proto3,enum=common.ClientType" json:"client_type,omitempty"`
+	ClientId        string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Status          ClientStatus_Status    `protobuf:"varint,3,opt,name=status,proto3,enum=api_master.ClientStatus_Status" json:"status,omitempty"`
+	Ping            int32                  `protobuf:"varint,4,opt,name=ping,proto3" json:"ping,omitempty"` // 单位为毫秒
+	Version         *ClientVersion         `protobuf:"bytes,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Addr            *string                `protobuf:"bytes,6,opt,name=addr,proto3,oneof" json:"addr,omitempty"`
+	ConnectTime     *int64                 `protobuf:"varint,7,opt,name=connect_time,json=connectTime,proto3,oneof" json:"connect_time,omitempty"`               // 连接建立的时间
+	LastSuccessTime *int64                 `protobuf:"varint,8,opt,name=last_success_time,json=lastSuccessTime,proto3,oneof" json:"last_success_time,omitempty"` // 最近一次探测成功的时间
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ClientStatus) Reset() {
@@ -165,6 +166,13 @@ func (x *ClientStatus) GetConnectTime() int64 {
 	return 0
 }
 
+func (x *ClientStatus) GetLastSuccessTime() int64 {
+	if x != nil && x.LastSuccessTime != nil {
+		return *x.LastSuccessTime
+	}
+	return 0
+}
+
 type ClientVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GitVersion    string                 `protobuf:"bytes,1,opt,name=GitVersion,proto3" json:"GitVersion,omitempty"`
@@ -561,66 +569,2685 @@ func (x *StartSteamLogResponse) GetStatus() *Status {
 	return nil
 }
 
-var File_api_master_proto protoreflect.FileDescriptor
+type ListPTYRecordingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_master_proto_rawDesc = "" +
-	"\n" +
-	"\x10api_master.proto\x12\n" +
-	"api_master\x1a\fcommon.proto\"\xa9\x03\n" +
-	"\fClientStatus\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x127\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x1f.api_master.ClientStatus.StatusR\x06status\x12\x12\n" +
-	"\x04ping\x18\x04 \x01(\x05R\x04ping\x128\n" +
-	"\aversion\x18\x05 \x01(\v2\x19.api_master.ClientVersionH\x00R\aversion\x88\x01\x01\x12\x17\n" +
-	"\x04addr\x18\x06 \x01(\tH\x01R\x04addr\x88\x01\x01\x12&\n" +
-	"\fconnect_time\x18\a \x01(\x03H\x02R\vconnectTime\x88\x01\x01\"Y\n" +
-	"\x06Status\x12\x16\n" +
-	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
-	"\rSTATUS_ONLINE\x10\x01\x12\x12\n" +
-	"\x0eSTATUS_OFFLINE\x10\x02\x12\x10\n" +
-	"\fSTATUS_ERROR\x10\x03B\n" +
-	"\n" +
-	"\b_versionB\a\n" +
-	"\x05_addrB\x0f\n" +
-	"\r_connect_time\"\xdf\x01\n" +
-	"\rClientVersion\x12\x1e\n" +
-	"\n" +
-	"GitVersion\x18\x01 \x01(\tR\n" +
-	"GitVersion\x12\x1c\n" +
-	"\tGitCommit\x18\x02 \x01(\tR\tGitCommit\x12\x1c\n" +
-	"\tBuildDate\x18\x03 \x01(\tR\tBuildDate\x12\x1c\n" +
-	"\tGoVersion\x18\x04 \x01(\tR\tGoVersion\x12\x1a\n" +
-	"\bCompiler\x18\x05 \x01(\tR\bCompiler\x12\x1a\n" +
-	"\bPlatform\x18\x06 \x01(\tR\bPlatform\x12\x1c\n" +
-	"\tGitBranch\x18\a \x01(\tR\tGitBranch\"m\n" +
-	"\x17GetClientsStatusRequest\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1d\n" +
-	"\n" +
-	"client_ids\x18\x02 \x03(\tR\tclientIds\"\xf5\x01\n" +
-	"\x18GetClientsStatusResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12K\n" +
-	"\aclients\x18\x02 \x03(\v21.api_master.GetClientsStatusResponse.ClientsEntryR\aclients\x1aT\n" +
-	"\fClientsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
-	"\x05value\x18\x02 \x01(\v2\x18.api_master.ClientStatusR\x05value:\x028\x01B\t\n" +
-	"\a_status\"\x8d\x01\n" +
-	"\x14GetClientCertRequest\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
-	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\"c\n" +
-	"\x15GetClientCertResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x12\n" +
-	"\x04cert\x18\x02 \x01(\fR\x04certB\t\n" +
-	"\a_status\"*\n" +
-	"\x14StartSteamLogRequest\x12\x12\n" +
-	"\x04pkgs\x18\x01 \x03(\tR\x04pkgs\"O\n" +
-	"\x15StartSteamLogResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_statusB\aZ\x05../pbb\x06proto3"
+func (x *ListPTYRecordingsRequest) Reset() {
+	*x = ListPTYRecordingsRequest{}
+	mi := &file_api_master_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPTYRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPTYRecordingsRequest) ProtoMessage() {}
+
+func (x *ListPTYRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPTYRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*ListPTYRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPTYRecordingsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListPTYRecordingsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPTYRecordingsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+type ListPTYRecordingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Recordings    []*PTYRecording        `protobuf:"bytes,3,rep,name=recordings,proto3" json:"recordings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPTYRecordingsResponse) Reset() {
+	*x = ListPTYRecordingsResponse{}
+	mi := &file_api_master_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPTYRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPTYRecordingsResponse) ProtoMessage() {}
+
+func (x *ListPTYRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPTYRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListPTYRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPTYRecordingsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListPTYRecordingsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListPTYRecordingsResponse) GetRecordings() []*PTYRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+type SearchLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`     // 客户端或服务端 id
+	StartTime     *int64                 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // unix milli
+	EndTime       *int64                 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // unix milli
+	Pkgs          []string               `protobuf:"bytes,4,rep,name=pkgs,proto3" json:"pkgs,omitempty"`
+	Keyword       *string                `protobuf:"bytes,5,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	Regex         *bool                  `protobuf:"varint,6,opt,name=regex,proto3,oneof" json:"regex,omitempty"` // keyword 是否为正则
+	Limit         *int32                 `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsRequest) Reset() {
+	*x = SearchLogsRequest{}
+	mi := &file_api_master_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsRequest) ProtoMessage() {}
+
+func (x *SearchLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchLogsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchLogsRequest) GetPkgs() []string {
+	if x != nil {
+		return x.Pkgs
+	}
+	return nil
+}
+
+func (x *SearchLogsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchLogsRequest) GetRegex() bool {
+	if x != nil && x.Regex != nil {
+		return *x.Regex
+	}
+	return false
+}
+
+func (x *SearchLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type SearchLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Logs          []*ArchivedLog         `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	Truncated     *bool                  `protobuf:"varint,3,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"` // 结果超过 limit 被截断
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLogsResponse) Reset() {
+	*x = SearchLogsResponse{}
+	mi := &file_api_master_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsResponse) ProtoMessage() {}
+
+func (x *SearchLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchLogsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SearchLogsResponse) GetLogs() []*ArchivedLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *SearchLogsResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+type QueryTrafficSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       *int32                 `protobuf:"varint,1,opt,name=proxy_id,json=proxyId,proto3,oneof" json:"proxy_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`          // 仅管理员可用
+	StartTime     *int64                 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // unix milli
+	EndTime       *int64                 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // unix milli
+	Step          *int64                 `protobuf:"varint,7,opt,name=step,proto3,oneof" json:"step,omitempty"`                            // 秒，为空时自动选择
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTrafficSeriesRequest) Reset() {
+	*x = QueryTrafficSeriesRequest{}
+	mi := &file_api_master_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTrafficSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTrafficSeriesRequest) ProtoMessage() {}
+
+func (x *QueryTrafficSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTrafficSeriesRequest.ProtoReflect.Descriptor instead.
+func (*QueryTrafficSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryTrafficSeriesRequest) GetProxyId() int32 {
+	if x != nil && x.ProxyId != nil {
+		return *x.ProxyId
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *QueryTrafficSeriesRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *QueryTrafficSeriesRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesRequest) GetStep() int64 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+type QueryTrafficSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Samples       []*TrafficSample       `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	Step          *int64                 `protobuf:"varint,3,opt,name=step,proto3,oneof" json:"step,omitempty"` // 秒
+	TotalIn       *int64                 `protobuf:"varint,4,opt,name=total_in,json=totalIn,proto3,oneof" json:"total_in,omitempty"`
+	TotalOut      *int64                 `protobuf:"varint,5,opt,name=total_out,json=totalOut,proto3,oneof" json:"total_out,omitempty"`
+	Truncated     *bool                  `protobuf:"varint,6,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"` // 流量点超过上限被截断，只统计了最新的部分
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTrafficSeriesResponse) Reset() {
+	*x = QueryTrafficSeriesResponse{}
+	mi := &file_api_master_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTrafficSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTrafficSeriesResponse) ProtoMessage() {}
+
+func (x *QueryTrafficSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTrafficSeriesResponse.ProtoReflect.Descriptor instead.
+func (*QueryTrafficSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryTrafficSeriesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *QueryTrafficSeriesResponse) GetSamples() []*TrafficSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *QueryTrafficSeriesResponse) GetStep() int64 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesResponse) GetTotalIn() int64 {
+	if x != nil && x.TotalIn != nil {
+		return *x.TotalIn
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesResponse) GetTotalOut() int64 {
+	if x != nil && x.TotalOut != nil {
+		return *x.TotalOut
+	}
+	return 0
+}
+
+func (x *QueryTrafficSeriesResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
+type CreateTrafficQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *TrafficQuota          `protobuf:"bytes,1,opt,name=quota,proto3,oneof" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTrafficQuotaRequest) Reset() {
+	*x = CreateTrafficQuotaRequest{}
+	mi := &file_api_master_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrafficQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrafficQuotaRequest) ProtoMessage() {}
+
+func (x *CreateTrafficQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrafficQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CreateTrafficQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTrafficQuotaRequest) GetQuota() *TrafficQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type CreateTrafficQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Quota         *TrafficQuota          `protobuf:"bytes,2,opt,name=quota,proto3,oneof" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTrafficQuotaResponse) Reset() {
+	*x = CreateTrafficQuotaResponse{}
+	mi := &file_api_master_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrafficQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrafficQuotaResponse) ProtoMessage() {}
+
+func (x *CreateTrafficQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrafficQuotaResponse.ProtoReflect.Descriptor instead.
+func (*CreateTrafficQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTrafficQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateTrafficQuotaResponse) GetQuota() *TrafficQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UpdateTrafficQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *TrafficQuota          `protobuf:"bytes,1,opt,name=quota,proto3,oneof" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTrafficQuotaRequest) Reset() {
+	*x = UpdateTrafficQuotaRequest{}
+	mi := &file_api_master_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrafficQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrafficQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateTrafficQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrafficQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTrafficQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateTrafficQuotaRequest) GetQuota() *TrafficQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UpdateTrafficQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Quota         *TrafficQuota          `protobuf:"bytes,2,opt,name=quota,proto3,oneof" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTrafficQuotaResponse) Reset() {
+	*x = UpdateTrafficQuotaResponse{}
+	mi := &file_api_master_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrafficQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrafficQuotaResponse) ProtoMessage() {}
+
+func (x *UpdateTrafficQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrafficQuotaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTrafficQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateTrafficQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateTrafficQuotaResponse) GetQuota() *TrafficQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type DeleteTrafficQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrafficQuotaRequest) Reset() {
+	*x = DeleteTrafficQuotaRequest{}
+	mi := &file_api_master_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrafficQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrafficQuotaRequest) ProtoMessage() {}
+
+func (x *DeleteTrafficQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrafficQuotaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTrafficQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteTrafficQuotaRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteTrafficQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrafficQuotaResponse) Reset() {
+	*x = DeleteTrafficQuotaResponse{}
+	mi := &file_api_master_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrafficQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrafficQuotaResponse) ProtoMessage() {}
+
+func (x *DeleteTrafficQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrafficQuotaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTrafficQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteTrafficQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListTrafficQuotasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    *string                `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3,oneof" json:"target_type,omitempty"`
+	TargetId      *string                `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrafficQuotasRequest) Reset() {
+	*x = ListTrafficQuotasRequest{}
+	mi := &file_api_master_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrafficQuotasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrafficQuotasRequest) ProtoMessage() {}
+
+func (x *ListTrafficQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrafficQuotasRequest.ProtoReflect.Descriptor instead.
+func (*ListTrafficQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTrafficQuotasRequest) GetTargetType() string {
+	if x != nil && x.TargetType != nil {
+		return *x.TargetType
+	}
+	return ""
+}
+
+func (x *ListTrafficQuotasRequest) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+type ListTrafficQuotasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Quotas        []*TrafficQuota        `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrafficQuotasResponse) Reset() {
+	*x = ListTrafficQuotasResponse{}
+	mi := &file_api_master_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrafficQuotasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrafficQuotasResponse) ProtoMessage() {}
+
+func (x *ListTrafficQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrafficQuotasResponse.ProtoReflect.Descriptor instead.
+func (*ListTrafficQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTrafficQuotasResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListTrafficQuotasResponse) GetQuotas() []*TrafficQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_api_master_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Rule          *AlertRule             `protobuf:"bytes,2,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_api_master_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAlertRuleResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_api_master_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Rule          *AlertRule             `protobuf:"bytes,2,opt,name=rule,proto3,oneof" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_api_master_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateAlertRuleResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_api_master_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_api_master_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteAlertRuleResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_api_master_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{28}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Rules         []*AlertRule           `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_api_master_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAlertRulesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateAlertChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *AlertChannel          `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertChannelRequest) Reset() {
+	*x = CreateAlertChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertChannelRequest) ProtoMessage() {}
+
+func (x *CreateAlertChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateAlertChannelRequest) GetChannel() *AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type CreateAlertChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Channel       *AlertChannel          `protobuf:"bytes,2,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertChannelResponse) Reset() {
+	*x = CreateAlertChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertChannelResponse) ProtoMessage() {}
+
+func (x *CreateAlertChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateAlertChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateAlertChannelResponse) GetChannel() *AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateAlertChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *AlertChannel          `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertChannelRequest) Reset() {
+	*x = UpdateAlertChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertChannelRequest) ProtoMessage() {}
+
+func (x *UpdateAlertChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateAlertChannelRequest) GetChannel() *AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateAlertChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Channel       *AlertChannel          `protobuf:"bytes,2,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertChannelResponse) Reset() {
+	*x = UpdateAlertChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertChannelResponse) ProtoMessage() {}
+
+func (x *UpdateAlertChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateAlertChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateAlertChannelResponse) GetChannel() *AlertChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type DeleteAlertChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertChannelRequest) Reset() {
+	*x = DeleteAlertChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertChannelRequest) ProtoMessage() {}
+
+func (x *DeleteAlertChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteAlertChannelRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteAlertChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertChannelResponse) Reset() {
+	*x = DeleteAlertChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertChannelResponse) ProtoMessage() {}
+
+func (x *DeleteAlertChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAlertChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListAlertChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertChannelsRequest) Reset() {
+	*x = ListAlertChannelsRequest{}
+	mi := &file_api_master_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertChannelsRequest) ProtoMessage() {}
+
+func (x *ListAlertChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{36}
+}
+
+type ListAlertChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Channels      []*AlertChannel        `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertChannelsResponse) Reset() {
+	*x = ListAlertChannelsResponse{}
+	mi := &file_api_master_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertChannelsResponse) ProtoMessage() {}
+
+func (x *ListAlertChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAlertChannelsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListAlertChannelsResponse) GetChannels() []*AlertChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type TestAlertChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAlertChannelRequest) Reset() {
+	*x = TestAlertChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAlertChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAlertChannelRequest) ProtoMessage() {}
+
+func (x *TestAlertChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAlertChannelRequest.ProtoReflect.Descriptor instead.
+func (*TestAlertChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TestAlertChannelRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type TestAlertChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestAlertChannelResponse) Reset() {
+	*x = TestAlertChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestAlertChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestAlertChannelResponse) ProtoMessage() {}
+
+func (x *TestAlertChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestAlertChannelResponse.ProtoReflect.Descriptor instead.
+func (*TestAlertChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TestAlertChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListAlertEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	RuleId        *uint32                `protobuf:"varint,3,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertEventsRequest) Reset() {
+	*x = ListAlertEventsRequest{}
+	mi := &file_api_master_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertEventsRequest) ProtoMessage() {}
+
+func (x *ListAlertEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListAlertEventsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAlertEventsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAlertEventsRequest) GetRuleId() uint32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *ListAlertEventsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type ListAlertEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Events        []*AlertEvent          `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertEventsResponse) Reset() {
+	*x = ListAlertEventsResponse{}
+	mi := &file_api_master_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertEventsResponse) ProtoMessage() {}
+
+func (x *ListAlertEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListAlertEventsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListAlertEventsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListAlertEventsResponse) GetEvents() []*AlertEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3,oneof" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_api_master_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,2,opt,name=subscription,proto3,oneof" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_api_master_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3,oneof" json:"subscription,omitempty"` // secret 为空时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionRequest) Reset() {
+	*x = UpdateWebhookSubscriptionRequest{}
+	mi := &file_api_master_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type UpdateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,2,opt,name=subscription,proto3,oneof" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookSubscriptionResponse) Reset() {
+	*x = UpdateWebhookSubscriptionResponse{}
+	mi := &file_api_master_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateWebhookSubscriptionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_api_master_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_api_master_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteWebhookSubscriptionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_api_master_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{48}
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_api_master_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize       *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	SubscriptionId *uint32                `protobuf:"varint,3,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	Status         *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_api_master_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() uint32 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,3,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_api_master_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_api_master_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RedeliverWebhookRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,2,opt,name=delivery,proto3,oneof" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_api_master_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RedeliverWebhookResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+var File_api_master_proto protoreflect.FileDescriptor
+
+const file_api_master_proto_rawDesc = "" +
+	"\n" +
+	"\x10api_master.proto\x12\n" +
+	"api_master\x1a\fcommon.proto\"\xf0\x03\n" +
+	"\fClientStatus\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.api_master.ClientStatus.StatusR\x06status\x12\x12\n" +
+	"\x04ping\x18\x04 \x01(\x05R\x04ping\x128\n" +
+	"\aversion\x18\x05 \x01(\v2\x19.api_master.ClientVersionH\x00R\aversion\x88\x01\x01\x12\x17\n" +
+	"\x04addr\x18\x06 \x01(\tH\x01R\x04addr\x88\x01\x01\x12&\n" +
+	"\fconnect_time\x18\a \x01(\x03H\x02R\vconnectTime\x88\x01\x01\x12/\n" +
+	"\x11last_success_time\x18\b \x01(\x03H\x03R\x0flastSuccessTime\x88\x01\x01\"Y\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ONLINE\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_OFFLINE\x10\x02\x12\x10\n" +
+	"\fSTATUS_ERROR\x10\x03B\n" +
+	"\n" +
+	"\b_versionB\a\n" +
+	"\x05_addrB\x0f\n" +
+	"\r_connect_timeB\x14\n" +
+	"\x12_last_success_time\"\xdf\x01\n" +
+	"\rClientVersion\x12\x1e\n" +
+	"\n" +
+	"GitVersion\x18\x01 \x01(\tR\n" +
+	"GitVersion\x12\x1c\n" +
+	"\tGitCommit\x18\x02 \x01(\tR\tGitCommit\x12\x1c\n" +
+	"\tBuildDate\x18\x03 \x01(\tR\tBuildDate\x12\x1c\n" +
+	"\tGoVersion\x18\x04 \x01(\tR\tGoVersion\x12\x1a\n" +
+	"\bCompiler\x18\x05 \x01(\tR\bCompiler\x12\x1a\n" +
+	"\bPlatform\x18\x06 \x01(\tR\bPlatform\x12\x1c\n" +
+	"\tGitBranch\x18\a \x01(\tR\tGitBranch\"m\n" +
+	"\x17GetClientsStatusRequest\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x02 \x03(\tR\tclientIds\"\xf5\x01\n" +
+	"\x18GetClientsStatusResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12K\n" +
+	"\aclients\x18\x02 \x03(\v21.api_master.GetClientsStatusResponse.ClientsEntryR\aclients\x1aT\n" +
+	"\fClientsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.api_master.ClientStatusR\x05value:\x028\x01B\t\n" +
+	"\a_status\"\x8d\x01\n" +
+	"\x14GetClientCertRequest\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\"c\n" +
+	"\x15GetClientCertResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x12\n" +
+	"\x04cert\x18\x02 \x01(\fR\x04certB\t\n" +
+	"\a_status\"*\n" +
+	"\x14StartSteamLogRequest\x12\x12\n" +
+	"\x04pkgs\x18\x01 \x03(\tR\x04pkgs\"O\n" +
+	"\x15StartSteamLogResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x9c\x01\n" +
+	"\x18ListPTYRecordingsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_client_id\"\xae\x01\n" +
+	"\x19ListPTYRecordingsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x124\n" +
+	"\n" +
+	"recordings\x18\x03 \x03(\v2\x14.common.PTYRecordingR\n" +
+	"recordingsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\xac\x02\n" +
+	"\x11SearchLogsRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03H\x01R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x03 \x01(\x03H\x02R\aendTime\x88\x01\x01\x12\x12\n" +
+	"\x04pkgs\x18\x04 \x03(\tR\x04pkgs\x12\x1d\n" +
+	"\akeyword\x18\x05 \x01(\tH\x03R\akeyword\x88\x01\x01\x12\x19\n" +
+	"\x05regex\x18\x06 \x01(\bH\x04R\x05regex\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\a \x01(\x05H\x05R\x05limit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\n" +
+	"\n" +
+	"\b_keywordB\b\n" +
+	"\x06_regexB\b\n" +
+	"\x06_limit\"\xa6\x01\n" +
+	"\x12SearchLogsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12'\n" +
+	"\x04logs\x18\x02 \x03(\v2\x13.common.ArchivedLogR\x04logs\x12!\n" +
+	"\ttruncated\x18\x03 \x01(\bH\x01R\ttruncated\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_truncated\"\xd4\x02\n" +
+	"\x19QueryTrafficSeriesRequest\x12\x1e\n" +
+	"\bproxy_id\x18\x01 \x01(\x05H\x00R\aproxyId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tH\x01R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x03 \x01(\tH\x02R\bserverId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\x03H\x03R\x06userId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03H\x04R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x06 \x01(\x03H\x05R\aendTime\x88\x01\x01\x12\x17\n" +
+	"\x04step\x18\a \x01(\x03H\x06R\x04step\x88\x01\x01B\v\n" +
+	"\t_proxy_idB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\n" +
+	"\n" +
+	"\b_user_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\a\n" +
+	"\x05_step\"\xb5\x02\n" +
+	"\x1aQueryTrafficSeriesResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12/\n" +
+	"\asamples\x18\x02 \x03(\v2\x15.common.TrafficSampleR\asamples\x12\x17\n" +
+	"\x04step\x18\x03 \x01(\x03H\x01R\x04step\x88\x01\x01\x12\x1e\n" +
+	"\btotal_in\x18\x04 \x01(\x03H\x02R\atotalIn\x88\x01\x01\x12 \n" +
+	"\ttotal_out\x18\x05 \x01(\x03H\x03R\btotalOut\x88\x01\x01\x12!\n" +
+	"\ttruncated\x18\x06 \x01(\bH\x04R\ttruncated\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_stepB\v\n" +
+	"\t_total_inB\f\n" +
+	"\n" +
+	"_total_outB\f\n" +
+	"\n" +
+	"_truncated\"V\n" +
+	"\x19CreateTrafficQuotaRequest\x12/\n" +
+	"\x05quota\x18\x01 \x01(\v2\x14.common.TrafficQuotaH\x00R\x05quota\x88\x01\x01B\b\n" +
+	"\x06_quota\"\x8f\x01\n" +
+	"\x1aCreateTrafficQuotaResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12/\n" +
+	"\x05quota\x18\x02 \x01(\v2\x14.common.TrafficQuotaH\x01R\x05quota\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_quota\"V\n" +
+	"\x19UpdateTrafficQuotaRequest\x12/\n" +
+	"\x05quota\x18\x01 \x01(\v2\x14.common.TrafficQuotaH\x00R\x05quota\x88\x01\x01B\b\n" +
+	"\x06_quota\"\x8f\x01\n" +
+	"\x1aUpdateTrafficQuotaResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12/\n" +
+	"\x05quota\x18\x02 \x01(\v2\x14.common.TrafficQuotaH\x01R\x05quota\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_quota\"7\n" +
+	"\x19DeleteTrafficQuotaRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"T\n" +
+	"\x1aDeleteTrafficQuotaResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x80\x01\n" +
+	"\x18ListTrafficQuotasRequest\x12$\n" +
+	"\vtarget_type\x18\x01 \x01(\tH\x00R\n" +
+	"targetType\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\x02 \x01(\tH\x01R\btargetId\x88\x01\x01B\x0e\n" +
+	"\f_target_typeB\f\n" +
+	"\n" +
+	"_target_id\"\x81\x01\n" +
+	"\x19ListTrafficQuotasResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12,\n" +
+	"\x06quotas\x18\x02 \x03(\v2\x14.common.TrafficQuotaR\x06quotasB\t\n" +
+	"\a_status\"M\n" +
+	"\x16CreateAlertRuleRequest\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x11.common.AlertRuleH\x00R\x04rule\x88\x01\x01B\a\n" +
+	"\x05_rule\"\x86\x01\n" +
+	"\x17CreateAlertRuleResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12*\n" +
+	"\x04rule\x18\x02 \x01(\v2\x11.common.AlertRuleH\x01R\x04rule\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_rule\"M\n" +
+	"\x16UpdateAlertRuleRequest\x12*\n" +
+	"\x04rule\x18\x01 \x01(\v2\x11.common.AlertRuleH\x00R\x04rule\x88\x01\x01B\a\n" +
+	"\x05_rule\"\x86\x01\n" +
+	"\x17UpdateAlertRuleResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12*\n" +
+	"\x04rule\x18\x02 \x01(\v2\x11.common.AlertRuleH\x01R\x04rule\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_rule\"4\n" +
+	"\x16DeleteAlertRuleRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"Q\n" +
+	"\x17DeleteAlertRuleResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x17\n" +
+	"\x15ListAlertRulesRequest\"y\n" +
+	"\x16ListAlertRulesResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12'\n" +
+	"\x05rules\x18\x02 \x03(\v2\x11.common.AlertRuleR\x05rulesB\t\n" +
+	"\a_status\"\\\n" +
+	"\x19CreateAlertChannelRequest\x123\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.common.AlertChannelH\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"\x95\x01\n" +
+	"\x1aCreateAlertChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x123\n" +
+	"\achannel\x18\x02 \x01(\v2\x14.common.AlertChannelH\x01R\achannel\x88\x01\x01B\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_channel\"\\\n" +
+	"\x19UpdateAlertChannelRequest\x123\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.common.AlertChannelH\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"\x95\x01\n" +
+	"\x1aUpdateAlertChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x123\n" +
+	"\achannel\x18\x02 \x01(\v2\x14.common.AlertChannelH\x01R\achannel\x88\x01\x01B\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_channel\"7\n" +
+	"\x19DeleteAlertChannelRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"T\n" +
+	"\x1aDeleteAlertChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x1a\n" +
+	"\x18ListAlertChannelsRequest\"\x85\x01\n" +
+	"\x19ListAlertChannelsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x120\n" +
+	"\bchannels\x18\x02 \x03(\v2\x14.common.AlertChannelR\bchannelsB\t\n" +
+	"\a_status\"5\n" +
+	"\x17TestAlertChannelRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"R\n" +
+	"\x18TestAlertChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xbc\x01\n" +
+	"\x16ListAlertEventsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1c\n" +
+	"\arule_id\x18\x03 \x01(\rH\x02R\x06ruleId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_rule_idB\t\n" +
+	"\a_status\"\xa2\x01\n" +
+	"\x17ListAlertEventsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12*\n" +
+	"\x06events\x18\x03 \x03(\v2\x12.common.AlertEventR\x06eventsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"y\n" +
+	" CreateWebhookSubscriptionRequest\x12D\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1b.common.WebhookSubscriptionH\x00R\fsubscription\x88\x01\x01B\x0f\n" +
+	"\r_subscription\"\xb2\x01\n" +
+	"!CreateWebhookSubscriptionResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12D\n" +
+	"\fsubscription\x18\x02 \x01(\v2\x1b.common.WebhookSubscriptionH\x01R\fsubscription\x88\x01\x01B\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_subscription\"y\n" +
+	" UpdateWebhookSubscriptionRequest\x12D\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1b.common.WebhookSubscriptionH\x00R\fsubscription\x88\x01\x01B\x0f\n" +
+	"\r_subscription\"\xb2\x01\n" +
+	"!UpdateWebhookSubscriptionResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12D\n" +
+	"\fsubscription\x18\x02 \x01(\v2\x1b.common.WebhookSubscriptionH\x01R\fsubscription\x88\x01\x01B\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_subscription\">\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"[\n" +
+	"!DeleteWebhookSubscriptionResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"!\n" +
+	"\x1fListWebhookSubscriptionsRequest\"\x9d\x01\n" +
+	" ListWebhookSubscriptionsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12A\n" +
+	"\rsubscriptions\x18\x02 \x03(\v2\x1b.common.WebhookSubscriptionR\rsubscriptionsB\t\n" +
+	"\a_status\"\xda\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12,\n" +
+	"\x0fsubscription_id\x18\x03 \x01(\rH\x02R\x0esubscriptionId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\x12\n" +
+	"\x10_subscription_idB\t\n" +
+	"\a_status\"\xb5\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x127\n" +
+	"\n" +
+	"deliveries\x18\x03 \x03(\v2\x17.common.WebhookDeliveryR\n" +
+	"deliveriesB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"5\n" +
+	"\x17RedeliverWebhookRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"\x99\x01\n" +
+	"\x18RedeliverWebhookResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x128\n" +
+	"\bdelivery\x18\x02 \x01(\v2\x17.common.WebhookDeliveryH\x01R\bdelivery\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_deliveryB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_api_master_proto_rawDescOnce sync.Once
@@ -635,37 +3262,141 @@ func file_api_master_proto_rawDescGZIP() []byte {
 }
 
 var file_api_master_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_master_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_master_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_api_master_proto_goTypes = []any{
-	(ClientStatus_Status)(0),         // 0: api_master.ClientStatus.Status
-	(*ClientStatus)(nil),             // 1: api_master.ClientStatus
-	(*ClientVersion)(nil),            // 2: api_master.ClientVersion
-	(*GetClientsStatusRequest)(nil),  // 3: api_master.GetClientsStatusRequest
-	(*GetClientsStatusResponse)(nil), // 4: api_master.GetClientsStatusResponse
-	(*GetClientCertRequest)(nil),     // 5: api_master.GetClientCertRequest
-	(*GetClientCertResponse)(nil),    // 6: api_master.GetClientCertResponse
-	(*StartSteamLogRequest)(nil),     // 7: api_master.StartSteamLogRequest
-	(*StartSteamLogResponse)(nil),    // 8: api_master.StartSteamLogResponse
-	nil,                              // 9: api_master.GetClientsStatusResponse.ClientsEntry
-	(ClientType)(0),                  // 10: common.ClientType
-	(*Status)(nil),                   // 11: common.Status
+	(ClientStatus_Status)(0),                  // 0: api_master.ClientStatus.Status
+	(*ClientStatus)(nil),                      // 1: api_master.ClientStatus
+	(*ClientVersion)(nil),                     // 2: api_master.ClientVersion
+	(*GetClientsStatusRequest)(nil),           // 3: api_master.GetClientsStatusRequest
+	(*GetClientsStatusResponse)(nil),          // 4: api_master.GetClientsStatusResponse
+	(*GetClientCertRequest)(nil),              // 5: api_master.GetClientCertRequest
+	(*GetClientCertResponse)(nil),             // 6: api_master.GetClientCertResponse
+	(*StartSteamLogRequest)(nil),              // 7: api_master.StartSteamLogRequest
+	(*StartSteamLogResponse)(nil),             // 8: api_master.StartSteamLogResponse
+	(*ListPTYRecordingsRequest)(nil),          // 9: api_master.ListPTYRecordingsRequest
+	(*ListPTYRecordingsResponse)(nil),         // 10: api_master.ListPTYRecordingsResponse
+	(*SearchLogsRequest)(nil),                 // 11: api_master.SearchLogsRequest
+	(*SearchLogsResponse)(nil),                // 12: api_master.SearchLogsResponse
+	(*QueryTrafficSeriesRequest)(nil),         // 13: api_master.QueryTrafficSeriesRequest
+	(*QueryTrafficSeriesResponse)(nil),        // 14: api_master.QueryTrafficSeriesResponse
+	(*CreateTrafficQuotaRequest)(nil),         // 15: api_master.CreateTrafficQuotaRequest
+	(*CreateTrafficQuotaResponse)(nil),        // 16: api_master.CreateTrafficQuotaResponse
+	(*UpdateTrafficQuotaRequest)(nil),         // 17: api_master.UpdateTrafficQuotaRequest
+	(*UpdateTrafficQuotaResponse)(nil),        // 18: api_master.UpdateTrafficQuotaResponse
+	(*DeleteTrafficQuotaRequest)(nil),         // 19: api_master.DeleteTrafficQuotaRequest
+	(*DeleteTrafficQuotaResponse)(nil),        // 20: api_master.DeleteTrafficQuotaResponse
+	(*ListTrafficQuotasRequest)(nil),          // 21: api_master.ListTrafficQuotasRequest
+	(*ListTrafficQuotasResponse)(nil),         // 22: api_master.ListTrafficQuotasResponse
+	(*CreateAlertRuleRequest)(nil),            // 23: api_master.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),           // 24: api_master.CreateAlertRuleResponse
+	(*UpdateAlertRuleRequest)(nil),            // 25: api_master.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),           // 26: api_master.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),            // 27: api_master.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),           // 28: api_master.DeleteAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),             // 29: api_master.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),            // 30: api_master.ListAlertRulesResponse
+	(*CreateAlertChannelRequest)(nil),         // 31: api_master.CreateAlertChannelRequest
+	(*CreateAlertChannelResponse)(nil),        // 32: api_master.CreateAlertChannelResponse
+	(*UpdateAlertChannelRequest)(nil),         // 33: api_master.UpdateAlertChannelRequest
+	(*UpdateAlertChannelResponse)(nil),        // 34: api_master.UpdateAlertChannelResponse
+	(*DeleteAlertChannelRequest)(nil),         // 35: api_master.DeleteAlertChannelRequest
+	(*DeleteAlertChannelResponse)(nil),        // 36: api_master.DeleteAlertChannelResponse
+	(*ListAlertChannelsRequest)(nil),          // 37: api_master.ListAlertChannelsRequest
+	(*ListAlertChannelsResponse)(nil),         // 38: api_master.ListAlertChannelsResponse
+	(*TestAlertChannelRequest)(nil),           // 39: api_master.TestAlertChannelRequest
+	(*TestAlertChannelResponse)(nil),          // 40: api_master.TestAlertChannelResponse
+	(*ListAlertEventsRequest)(nil),            // 41: api_master.ListAlertEventsRequest
+	(*ListAlertEventsResponse)(nil),           // 42: api_master.ListAlertEventsResponse
+	(*CreateWebhookSubscriptionRequest)(nil),  // 43: api_master.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 44: api_master.CreateWebhookSubscriptionResponse
+	(*UpdateWebhookSubscriptionRequest)(nil),  // 45: api_master.UpdateWebhookSubscriptionRequest
+	(*UpdateWebhookSubscriptionResponse)(nil), // 46: api_master.UpdateWebhookSubscriptionResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 47: api_master.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 48: api_master.DeleteWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsRequest)(nil),   // 49: api_master.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 50: api_master.ListWebhookSubscriptionsResponse
+	(*ListWebhookDeliveriesRequest)(nil),      // 51: api_master.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 52: api_master.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),           // 53: api_master.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),          // 54: api_master.RedeliverWebhookResponse
+	nil,                                       // 55: api_master.GetClientsStatusResponse.ClientsEntry
+	(ClientType)(0),                           // 56: common.ClientType
+	(*Status)(nil),                            // 57: common.Status
+	(*PTYRecording)(nil),                      // 58: common.PTYRecording
+	(*ArchivedLog)(nil),                       // 59: common.ArchivedLog
+	(*TrafficSample)(nil),                     // 60: common.TrafficSample
+	(*TrafficQuota)(nil),                      // 61: common.TrafficQuota
+	(*AlertRule)(nil),                         // 62: common.AlertRule
+	(*AlertChannel)(nil),                      // 63: common.AlertChannel
+	(*AlertEvent)(nil),                        // 64: common.AlertEvent
+	(*WebhookSubscription)(nil),               // 65: common.WebhookSubscription
+	(*WebhookDelivery)(nil),                   // 66: common.WebhookDelivery
 }
 var file_api_master_proto_depIdxs = []int32{
-	10, // 0: api_master.ClientStatus.client_type:type_name -> common.ClientType
+	56, // 0: api_master.ClientStatus.client_type:type_name -> common.ClientType
 	0,  // 1: api_master.ClientStatus.status:type_name -> api_master.ClientStatus.Status
 	2,  // 2: api_master.ClientStatus.version:type_name -> api_master.ClientVersion
-	10, // 3: api_master.GetClientsStatusRequest.client_type:type_name -> common.ClientType
-	11, // 4: api_master.GetClientsStatusResponse.status:type_name -> common.Status
-	9,  // 5: api_master.GetClientsStatusResponse.clients:type_name -> api_master.GetClientsStatusResponse.ClientsEntry
-	10, // 6: api_master.GetClientCertRequest.client_type:type_name -> common.ClientType
-	11, // 7: api_master.GetClientCertResponse.status:type_name -> common.Status
-	11, // 8: api_master.StartSteamLogResponse.status:type_name -> common.Status
-	1,  // 9: api_master.GetClientsStatusResponse.ClientsEntry.value:type_name -> api_master.ClientStatus
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	56, // 3: api_master.GetClientsStatusRequest.client_type:type_name -> common.ClientType
+	57, // 4: api_master.GetClientsStatusResponse.status:type_name -> common.Status
+	55, // 5: api_master.GetClientsStatusResponse.clients:type_name -> api_master.GetClientsStatusResponse.ClientsEntry
+	56, // 6: api_master.GetClientCertRequest.client_type:type_name -> common.ClientType
+	57, // 7: api_master.GetClientCertResponse.status:type_name -> common.Status
+	57, // 8: api_master.StartSteamLogResponse.status:type_name -> common.Status
+	57, // 9: api_master.ListPTYRecordingsResponse.status:type_name -> common.Status
+	58, // 10: api_master.ListPTYRecordingsResponse.recordings:type_name -> common.PTYRecording
+	57, // 11: api_master.SearchLogsResponse.status:type_name -> common.Status
+	59, // 12: api_master.SearchLogsResponse.logs:type_name -> common.ArchivedLog
+	57, // 13: api_master.QueryTrafficSeriesResponse.status:type_name -> common.Status
+	60, // 14: api_master.QueryTrafficSeriesResponse.samples:type_name -> common.TrafficSample
+	61, // 15: api_master.CreateTrafficQuotaRequest.quota:type_name -> common.TrafficQuota
+	57, // 16: api_master.CreateTrafficQuotaResponse.status:type_name -> common.Status
+	61, // 17: api_master.CreateTrafficQuotaResponse.quota:type_name -> common.TrafficQuota
+	61, // 18: api_master.UpdateTrafficQuotaRequest.quota:type_name -> common.TrafficQuota
+	57, // 19: api_master.UpdateTrafficQuotaResponse.status:type_name -> common.Status
+	61, // 20: api_master.UpdateTrafficQuotaResponse.quota:type_name -> common.TrafficQuota
+	57, // 21: api_master.DeleteTrafficQuotaResponse.status:type_name -> common.Status
+	57, // 22: api_master.ListTrafficQuotasResponse.status:type_name -> common.Status
+	61, // 23: api_master.ListTrafficQuotasResponse.quotas:type_name -> common.TrafficQuota
+	62, // 24: api_master.CreateAlertRuleRequest.rule:type_name -> common.AlertRule
+	57, // 25: api_master.CreateAlertRuleResponse.status:type_name -> common.Status
+	62, // 26: api_master.CreateAlertRuleResponse.rule:type_name -> common.AlertRule
+	62, // 27: api_master.UpdateAlertRuleRequest.rule:type_name -> common.AlertRule
+	57, // 28: api_master.UpdateAlertRuleResponse.status:type_name -> common.Status
+	62, // 29: api_master.UpdateAlertRuleResponse.rule:type_name -> common.AlertRule
+	57, // 30: api_master.DeleteAlertRuleResponse.status:type_name -> common.Status
+	57, // 31: api_master.ListAlertRulesResponse.status:type_name -> common.Status
+	62, // 32: api_master.ListAlertRulesResponse.rules:type_name -> common.AlertRule
+	63, // 33: api_master.CreateAlertChannelRequest.channel:type_name -> common.AlertChannel
+	57, // 34: api_master.CreateAlertChannelResponse.status:type_name -> common.Status
+	63, // 35: api_master.CreateAlertChannelResponse.channel:type_name -> common.AlertChannel
+	63, // 36: api_master.UpdateAlertChannelRequest.channel:type_name -> common.AlertChannel
+	57, // 37: api_master.UpdateAlertChannelResponse.status:type_name -> common.Status
+	63, // 38: api_master.UpdateAlertChannelResponse.channel:type_name -> common.AlertChannel
+	57, // 39: api_master.DeleteAlertChannelResponse.status:type_name -> common.Status
+	57, // 40: api_master.ListAlertChannelsResponse.status:type_name -> common.Status
+	63, // 41: api_master.ListAlertChannelsResponse.channels:type_name -> common.AlertChannel
+	57, // 42: api_master.TestAlertChannelResponse.status:type_name -> common.Status
+	57, // 43: api_master.ListAlertEventsResponse.status:type_name -> common.Status
+	64, // 44: api_master.ListAlertEventsResponse.events:type_name -> common.AlertEvent
+	65, // 45: api_master.CreateWebhookSubscriptionRequest.subscription:type_name -> common.WebhookSubscription
+	57, // 46: api_master.CreateWebhookSubscriptionResponse.status:type_name -> common.Status
+	65, // 47: api_master.CreateWebhookSubscriptionResponse.subscription:type_name -> common.WebhookSubscription
+	65, // 48: api_master.UpdateWebhookSubscriptionRequest.subscription:type_name -> common.WebhookSubscription
+	57, // 49: api_master.UpdateWebhookSubscriptionResponse.status:type_name -> common.Status
+	65, // 50: api_master.UpdateWebhookSubscriptionResponse.subscription:type_name -> common.WebhookSubscription
+	57, // 51: api_master.DeleteWebhookSubscriptionResponse.status:type_name -> common.Status
+	57, // 52: api_master.ListWebhookSubscriptionsResponse.status:type_name -> common.Status
+	65, // 53: api_master.ListWebhookSubscriptionsResponse.subscriptions:type_name -> common.WebhookSubscription
+	57, // 54: api_master.ListWebhookDeliveriesResponse.status:type_name -> common.Status
+	66, // 55: api_master.ListWebhookDeliveriesResponse.deliveries:type_name -> common.WebhookDelivery
+	57, // 56: api_master.RedeliverWebhookResponse.status:type_name -> common.Status
+	66, // 57: api_master.RedeliverWebhookResponse.delivery:type_name -> common.WebhookDelivery
+	1,  // 58: api_master.GetClientsStatusResponse.ClientsEntry.value:type_name -> api_master.ClientStatus
+	59, // [59:59] is the sub-list for method output_type
+	59, // [59:59] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_api_master_proto_init() }
@@ -678,13 +3409,56 @@ func file_api_master_proto_init() {
 	file_api_master_proto_msgTypes[3].OneofWrappers = []any{}
 	file_api_master_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_master_proto_msgTypes[7].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[14].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[19].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[20].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[23].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[26].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[29].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[31].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[33].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[37].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[38].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[39].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[40].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[41].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[42].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[43].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[44].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[45].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[46].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[50].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[51].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_master_proto_rawDesc), len(file_api_master_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
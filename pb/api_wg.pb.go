@@ -2457,6 +2457,656 @@ func (x *ListWireGuardLinksResponse) GetWireguardLinks() []*WireGuardLink {
 	return nil
 }
 
+type CreateWireGuardExternalPeerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalPeer  *WireGuardExternalPeer `protobuf:"bytes,1,opt,name=external_peer,json=externalPeer,proto3,oneof" json:"external_peer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWireGuardExternalPeerRequest) Reset() {
+	*x = CreateWireGuardExternalPeerRequest{}
+	mi := &file_api_wg_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWireGuardExternalPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWireGuardExternalPeerRequest) ProtoMessage() {}
+
+func (x *CreateWireGuardExternalPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWireGuardExternalPeerRequest.ProtoReflect.Descriptor instead.
+func (*CreateWireGuardExternalPeerRequest) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateWireGuardExternalPeerRequest) GetExternalPeer() *WireGuardExternalPeer {
+	if x != nil {
+		return x.ExternalPeer
+	}
+	return nil
+}
+
+type CreateWireGuardExternalPeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ExternalPeer  *WireGuardExternalPeer `protobuf:"bytes,2,opt,name=external_peer,json=externalPeer,proto3,oneof" json:"external_peer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWireGuardExternalPeerResponse) Reset() {
+	*x = CreateWireGuardExternalPeerResponse{}
+	mi := &file_api_wg_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWireGuardExternalPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWireGuardExternalPeerResponse) ProtoMessage() {}
+
+func (x *CreateWireGuardExternalPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWireGuardExternalPeerResponse.ProtoReflect.Descriptor instead.
+func (*CreateWireGuardExternalPeerResponse) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateWireGuardExternalPeerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateWireGuardExternalPeerResponse) GetExternalPeer() *WireGuardExternalPeer {
+	if x != nil {
+		return x.ExternalPeer
+	}
+	return nil
+}
+
+type DeleteWireGuardExternalPeerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWireGuardExternalPeerRequest) Reset() {
+	*x = DeleteWireGuardExternalPeerRequest{}
+	mi := &file_api_wg_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWireGuardExternalPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWireGuardExternalPeerRequest) ProtoMessage() {}
+
+func (x *DeleteWireGuardExternalPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWireGuardExternalPeerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWireGuardExternalPeerRequest) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteWireGuardExternalPeerRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteWireGuardExternalPeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWireGuardExternalPeerResponse) Reset() {
+	*x = DeleteWireGuardExternalPeerResponse{}
+	mi := &file_api_wg_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWireGuardExternalPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWireGuardExternalPeerResponse) ProtoMessage() {}
+
+func (x *DeleteWireGuardExternalPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWireGuardExternalPeerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWireGuardExternalPeerResponse) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteWireGuardExternalPeerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListWireGuardExternalPeersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	NetworkId     *uint32                `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWireGuardExternalPeersRequest) Reset() {
+	*x = ListWireGuardExternalPeersRequest{}
+	mi := &file_api_wg_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWireGuardExternalPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWireGuardExternalPeersRequest) ProtoMessage() {}
+
+func (x *ListWireGuardExternalPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWireGuardExternalPeersRequest.ProtoReflect.Descriptor instead.
+func (*ListWireGuardExternalPeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListWireGuardExternalPeersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWireGuardExternalPeersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWireGuardExternalPeersRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListWireGuardExternalPeersRequest) GetNetworkId() uint32 {
+	if x != nil && x.NetworkId != nil {
+		return *x.NetworkId
+	}
+	return 0
+}
+
+type ListWireGuardExternalPeersResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        *Status                  `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                   `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	ExternalPeers []*WireGuardExternalPeer `protobuf:"bytes,3,rep,name=external_peers,json=externalPeers,proto3" json:"external_peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWireGuardExternalPeersResponse) Reset() {
+	*x = ListWireGuardExternalPeersResponse{}
+	mi := &file_api_wg_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWireGuardExternalPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWireGuardExternalPeersResponse) ProtoMessage() {}
+
+func (x *ListWireGuardExternalPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWireGuardExternalPeersResponse.ProtoReflect.Descriptor instead.
+func (*ListWireGuardExternalPeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListWireGuardExternalPeersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWireGuardExternalPeersResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListWireGuardExternalPeersResponse) GetExternalPeers() []*WireGuardExternalPeer {
+	if x != nil {
+		return x.ExternalPeers
+	}
+	return nil
+}
+
+// GenWireGuardConfigRequest wireguard_id 与 external_peer_id 二选一
+type GenWireGuardConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WireguardId    *uint32                `protobuf:"varint,1,opt,name=wireguard_id,json=wireguardId,proto3,oneof" json:"wireguard_id,omitempty"`
+	ExternalPeerId *uint32                `protobuf:"varint,2,opt,name=external_peer_id,json=externalPeerId,proto3,oneof" json:"external_peer_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenWireGuardConfigRequest) Reset() {
+	*x = GenWireGuardConfigRequest{}
+	mi := &file_api_wg_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenWireGuardConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenWireGuardConfigRequest) ProtoMessage() {}
+
+func (x *GenWireGuardConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenWireGuardConfigRequest.ProtoReflect.Descriptor instead.
+func (*GenWireGuardConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GenWireGuardConfigRequest) GetWireguardId() uint32 {
+	if x != nil && x.WireguardId != nil {
+		return *x.WireguardId
+	}
+	return 0
+}
+
+func (x *GenWireGuardConfigRequest) GetExternalPeerId() uint32 {
+	if x != nil && x.ExternalPeerId != nil {
+		return *x.ExternalPeerId
+	}
+	return 0
+}
+
+type GenWireGuardConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Config        *string                `protobuf:"bytes,2,opt,name=config,proto3,oneof" json:"config,omitempty"`                        // wg-quick 格式的配置
+	QrContent     *string                `protobuf:"bytes,3,opt,name=qr_content,json=qrContent,proto3,oneof" json:"qr_content,omitempty"` // 去掉注释后的配置，用于生成二维码
+	FileName      *string                `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenWireGuardConfigResponse) Reset() {
+	*x = GenWireGuardConfigResponse{}
+	mi := &file_api_wg_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenWireGuardConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenWireGuardConfigResponse) ProtoMessage() {}
+
+func (x *GenWireGuardConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenWireGuardConfigResponse.ProtoReflect.Descriptor instead.
+func (*GenWireGuardConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GenWireGuardConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GenWireGuardConfigResponse) GetConfig() string {
+	if x != nil && x.Config != nil {
+		return *x.Config
+	}
+	return ""
+}
+
+func (x *GenWireGuardConfigResponse) GetQrContent() string {
+	if x != nil && x.QrContent != nil {
+		return *x.QrContent
+	}
+	return ""
+}
+
+func (x *GenWireGuardConfigResponse) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+// PunchWireGuardRequest master 协调打洞，通信双方同时收到对方的 NAT 映射地址并向其发送探测
+type PunchWireGuardRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	InterfaceName *string                 `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3,oneof" json:"interface_name,omitempty"`
+	Targets       []*WireGuardPunchTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PunchWireGuardRequest) Reset() {
+	*x = PunchWireGuardRequest{}
+	mi := &file_api_wg_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PunchWireGuardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunchWireGuardRequest) ProtoMessage() {}
+
+func (x *PunchWireGuardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunchWireGuardRequest.ProtoReflect.Descriptor instead.
+func (*PunchWireGuardRequest) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PunchWireGuardRequest) GetInterfaceName() string {
+	if x != nil && x.InterfaceName != nil {
+		return *x.InterfaceName
+	}
+	return ""
+}
+
+func (x *PunchWireGuardRequest) GetTargets() []*WireGuardPunchTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type WireGuardPunchTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerId        *uint32                `protobuf:"varint,1,opt,name=peer_id,json=peerId,proto3,oneof" json:"peer_id,omitempty"` // 对端 WireGuard ID
+	Endpoint      *string                `protobuf:"bytes,2,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`            // 对端 NAT 映射地址 ip:port
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireGuardPunchTarget) Reset() {
+	*x = WireGuardPunchTarget{}
+	mi := &file_api_wg_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireGuardPunchTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardPunchTarget) ProtoMessage() {}
+
+func (x *WireGuardPunchTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardPunchTarget.ProtoReflect.Descriptor instead.
+func (*WireGuardPunchTarget) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WireGuardPunchTarget) GetPeerId() uint32 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+func (x *WireGuardPunchTarget) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+type WireGuardPunchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeerId        *uint32                `protobuf:"varint,1,opt,name=peer_id,json=peerId,proto3,oneof" json:"peer_id,omitempty"`
+	Success       *bool                  `protobuf:"varint,2,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	RttMs         *uint32                `protobuf:"varint,3,opt,name=rtt_ms,json=rttMs,proto3,oneof" json:"rtt_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireGuardPunchResult) Reset() {
+	*x = WireGuardPunchResult{}
+	mi := &file_api_wg_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireGuardPunchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardPunchResult) ProtoMessage() {}
+
+func (x *WireGuardPunchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardPunchResult.ProtoReflect.Descriptor instead.
+func (*WireGuardPunchResult) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WireGuardPunchResult) GetPeerId() uint32 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+func (x *WireGuardPunchResult) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *WireGuardPunchResult) GetRttMs() uint32 {
+	if x != nil && x.RttMs != nil {
+		return *x.RttMs
+	}
+	return 0
+}
+
+type PunchWireGuardResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        *Status                 `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Results       []*WireGuardPunchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PunchWireGuardResponse) Reset() {
+	*x = PunchWireGuardResponse{}
+	mi := &file_api_wg_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PunchWireGuardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunchWireGuardResponse) ProtoMessage() {}
+
+func (x *PunchWireGuardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_wg_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunchWireGuardResponse.ProtoReflect.Descriptor instead.
+func (*PunchWireGuardResponse) Descriptor() ([]byte, []int) {
+	return file_api_wg_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PunchWireGuardResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PunchWireGuardResponse) GetResults() []*WireGuardPunchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_api_wg_proto protoreflect.FileDescriptor
 
 const file_api_wg_proto_rawDesc = "" +
@@ -2715,7 +3365,78 @@ const file_api_wg_proto_rawDesc = "" +
 	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12A\n" +
 	"\x0fwireguard_links\x18\x03 \x03(\v2\x18.wireguard.WireGuardLinkR\x0ewireguardLinksB\t\n" +
 	"\a_statusB\b\n" +
-	"\x06_totalB\aZ\x05../pbb\x06proto3"
+	"\x06_total\"\x82\x01\n" +
+	"\"CreateWireGuardExternalPeerRequest\x12J\n" +
+	"\rexternal_peer\x18\x01 \x01(\v2 .wireguard.WireGuardExternalPeerH\x00R\fexternalPeer\x88\x01\x01B\x10\n" +
+	"\x0e_external_peer\"\xbb\x01\n" +
+	"#CreateWireGuardExternalPeerResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12J\n" +
+	"\rexternal_peer\x18\x02 \x01(\v2 .wireguard.WireGuardExternalPeerH\x01R\fexternalPeer\x88\x01\x01B\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_external_peer\"@\n" +
+	"\"DeleteWireGuardExternalPeerRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"]\n" +
+	"#DeleteWireGuardExternalPeerResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xd3\x01\n" +
+	"!ListWireGuardExternalPeersRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"network_id\x18\x04 \x01(\rH\x03R\tnetworkId\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_keywordB\r\n" +
+	"\v_network_id\"\xca\x01\n" +
+	"\"ListWireGuardExternalPeersResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12G\n" +
+	"\x0eexternal_peers\x18\x03 \x03(\v2 .wireguard.WireGuardExternalPeerR\rexternalPeersB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\x98\x01\n" +
+	"\x19GenWireGuardConfigRequest\x12&\n" +
+	"\fwireguard_id\x18\x01 \x01(\rH\x00R\vwireguardId\x88\x01\x01\x12-\n" +
+	"\x10external_peer_id\x18\x02 \x01(\rH\x01R\x0eexternalPeerId\x88\x01\x01B\x0f\n" +
+	"\r_wireguard_idB\x13\n" +
+	"\x11_external_peer_id\"\xdf\x01\n" +
+	"\x1aGenWireGuardConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x02 \x01(\tH\x01R\x06config\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"qr_content\x18\x03 \x01(\tH\x02R\tqrContent\x88\x01\x01\x12 \n" +
+	"\tfile_name\x18\x04 \x01(\tH\x03R\bfileName\x88\x01\x01B\t\n" +
+	"\a_statusB\t\n" +
+	"\a_configB\r\n" +
+	"\v_qr_contentB\f\n" +
+	"\n" +
+	"_file_name\"\x95\x01\n" +
+	"\x15PunchWireGuardRequest\x12*\n" +
+	"\x0einterface_name\x18\x01 \x01(\tH\x00R\rinterfaceName\x88\x01\x01\x12=\n" +
+	"\atargets\x18\x02 \x03(\v2#.api_wireguard.WireGuardPunchTargetR\atargetsB\x11\n" +
+	"\x0f_interface_name\"n\n" +
+	"\x14WireGuardPunchTarget\x12\x1c\n" +
+	"\apeer_id\x18\x01 \x01(\rH\x00R\x06peerId\x88\x01\x01\x12\x1f\n" +
+	"\bendpoint\x18\x02 \x01(\tH\x01R\bendpoint\x88\x01\x01B\n" +
+	"\n" +
+	"\b_peer_idB\v\n" +
+	"\t_endpoint\"\x92\x01\n" +
+	"\x14WireGuardPunchResult\x12\x1c\n" +
+	"\apeer_id\x18\x01 \x01(\rH\x00R\x06peerId\x88\x01\x01\x12\x1d\n" +
+	"\asuccess\x18\x02 \x01(\bH\x01R\asuccess\x88\x01\x01\x12\x1a\n" +
+	"\x06rtt_ms\x18\x03 \x01(\rH\x02R\x05rttMs\x88\x01\x01B\n" +
+	"\n" +
+	"\b_peer_idB\n" +
+	"\n" +
+	"\b_successB\t\n" +
+	"\a_rtt_ms\"\x8f\x01\n" +
+	"\x16PunchWireGuardResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.api_wireguard.WireGuardPunchResultR\aresultsB\t\n" +
+	"\a_statusB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_api_wg_proto_rawDescOnce sync.Once
@@ -2730,121 +3451,144 @@ func file_api_wg_proto_rawDescGZIP() []byte {
 }
 
 var file_api_wg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_wg_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_api_wg_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_api_wg_proto_goTypes = []any{
-	(UpdateWireGuardRequest_UpdateType)(0),  // 0: api_wireguard.UpdateWireGuardRequest.UpdateType
-	(*CreateNetworkRequest)(nil),            // 1: api_wireguard.CreateNetworkRequest
-	(*CreateNetworkResponse)(nil),           // 2: api_wireguard.CreateNetworkResponse
-	(*DeleteNetworkRequest)(nil),            // 3: api_wireguard.DeleteNetworkRequest
-	(*DeleteNetworkResponse)(nil),           // 4: api_wireguard.DeleteNetworkResponse
-	(*UpdateNetworkRequest)(nil),            // 5: api_wireguard.UpdateNetworkRequest
-	(*UpdateNetworkResponse)(nil),           // 6: api_wireguard.UpdateNetworkResponse
-	(*GetNetworkRequest)(nil),               // 7: api_wireguard.GetNetworkRequest
-	(*GetNetworkResponse)(nil),              // 8: api_wireguard.GetNetworkResponse
-	(*ListNetworksRequest)(nil),             // 9: api_wireguard.ListNetworksRequest
-	(*ListNetworksResponse)(nil),            // 10: api_wireguard.ListNetworksResponse
-	(*GetNetworkTopologyRequest)(nil),       // 11: api_wireguard.GetNetworkTopologyRequest
-	(*GetNetworkTopologyResponse)(nil),      // 12: api_wireguard.GetNetworkTopologyResponse
-	(*CreateEndpointRequest)(nil),           // 13: api_wireguard.CreateEndpointRequest
-	(*CreateEndpointResponse)(nil),          // 14: api_wireguard.CreateEndpointResponse
-	(*DeleteEndpointRequest)(nil),           // 15: api_wireguard.DeleteEndpointRequest
-	(*DeleteEndpointResponse)(nil),          // 16: api_wireguard.DeleteEndpointResponse
-	(*UpdateEndpointRequest)(nil),           // 17: api_wireguard.UpdateEndpointRequest
-	(*UpdateEndpointResponse)(nil),          // 18: api_wireguard.UpdateEndpointResponse
-	(*GetEndpointRequest)(nil),              // 19: api_wireguard.GetEndpointRequest
-	(*GetEndpointResponse)(nil),             // 20: api_wireguard.GetEndpointResponse
-	(*ListEndpointsRequest)(nil),            // 21: api_wireguard.ListEndpointsRequest
-	(*ListEndpointsResponse)(nil),           // 22: api_wireguard.ListEndpointsResponse
-	(*CreateWireGuardRequest)(nil),          // 23: api_wireguard.CreateWireGuardRequest
-	(*CreateWireGuardResponse)(nil),         // 24: api_wireguard.CreateWireGuardResponse
-	(*DeleteWireGuardRequest)(nil),          // 25: api_wireguard.DeleteWireGuardRequest
-	(*DeleteWireGuardResponse)(nil),         // 26: api_wireguard.DeleteWireGuardResponse
-	(*RestartWireGuardRequest)(nil),         // 27: api_wireguard.RestartWireGuardRequest
-	(*RestartWireGuardResponse)(nil),        // 28: api_wireguard.RestartWireGuardResponse
-	(*UpdateWireGuardRequest)(nil),          // 29: api_wireguard.UpdateWireGuardRequest
-	(*UpdateWireGuardResponse)(nil),         // 30: api_wireguard.UpdateWireGuardResponse
-	(*GetWireGuardRequest)(nil),             // 31: api_wireguard.GetWireGuardRequest
-	(*GetWireGuardResponse)(nil),            // 32: api_wireguard.GetWireGuardResponse
-	(*GetWireGuardRuntimeInfoRequest)(nil),  // 33: api_wireguard.GetWireGuardRuntimeInfoRequest
-	(*GetWireGuardRuntimeInfoResponse)(nil), // 34: api_wireguard.GetWireGuardRuntimeInfoResponse
-	(*ListWireGuardsRequest)(nil),           // 35: api_wireguard.ListWireGuardsRequest
-	(*ListWireGuardsResponse)(nil),          // 36: api_wireguard.ListWireGuardsResponse
-	(*CreateWireGuardLinkRequest)(nil),      // 37: api_wireguard.CreateWireGuardLinkRequest
-	(*CreateWireGuardLinkResponse)(nil),     // 38: api_wireguard.CreateWireGuardLinkResponse
-	(*DeleteWireGuardLinkRequest)(nil),      // 39: api_wireguard.DeleteWireGuardLinkRequest
-	(*DeleteWireGuardLinkResponse)(nil),     // 40: api_wireguard.DeleteWireGuardLinkResponse
-	(*UpdateWireGuardLinkRequest)(nil),      // 41: api_wireguard.UpdateWireGuardLinkRequest
-	(*UpdateWireGuardLinkResponse)(nil),     // 42: api_wireguard.UpdateWireGuardLinkResponse
-	(*GetWireGuardLinkRequest)(nil),         // 43: api_wireguard.GetWireGuardLinkRequest
-	(*GetWireGuardLinkResponse)(nil),        // 44: api_wireguard.GetWireGuardLinkResponse
-	(*ListWireGuardLinksRequest)(nil),       // 45: api_wireguard.ListWireGuardLinksRequest
-	(*ListWireGuardLinksResponse)(nil),      // 46: api_wireguard.ListWireGuardLinksResponse
-	nil,                                     // 47: api_wireguard.GetNetworkTopologyResponse.AdjsEntry
-	(*Network)(nil),                         // 48: wireguard.Network
-	(*Status)(nil),                          // 49: common.Status
-	(*Endpoint)(nil),                        // 50: wireguard.Endpoint
-	(*WireGuardConfig)(nil),                 // 51: wireguard.WireGuardConfig
-	(*WGDeviceRuntimeInfo)(nil),             // 52: wireguard.WGDeviceRuntimeInfo
-	(*WireGuardLink)(nil),                   // 53: wireguard.WireGuardLink
-	(*WireGuardLinks)(nil),                  // 54: wireguard.WireGuardLinks
+	(UpdateWireGuardRequest_UpdateType)(0),      // 0: api_wireguard.UpdateWireGuardRequest.UpdateType
+	(*CreateNetworkRequest)(nil),                // 1: api_wireguard.CreateNetworkRequest
+	(*CreateNetworkResponse)(nil),               // 2: api_wireguard.CreateNetworkResponse
+	(*DeleteNetworkRequest)(nil),                // 3: api_wireguard.DeleteNetworkRequest
+	(*DeleteNetworkResponse)(nil),               // 4: api_wireguard.DeleteNetworkResponse
+	(*UpdateNetworkRequest)(nil),                // 5: api_wireguard.UpdateNetworkRequest
+	(*UpdateNetworkResponse)(nil),               // 6: api_wireguard.UpdateNetworkResponse
+	(*GetNetworkRequest)(nil),                   // 7: api_wireguard.GetNetworkRequest
+	(*GetNetworkResponse)(nil),                  // 8: api_wireguard.GetNetworkResponse
+	(*ListNetworksRequest)(nil),                 // 9: api_wireguard.ListNetworksRequest
+	(*ListNetworksResponse)(nil),                // 10: api_wireguard.ListNetworksResponse
+	(*GetNetworkTopologyRequest)(nil),           // 11: api_wireguard.GetNetworkTopologyRequest
+	(*GetNetworkTopologyResponse)(nil),          // 12: api_wireguard.GetNetworkTopologyResponse
+	(*CreateEndpointRequest)(nil),               // 13: api_wireguard.CreateEndpointRequest
+	(*CreateEndpointResponse)(nil),              // 14: api_wireguard.CreateEndpointResponse
+	(*DeleteEndpointRequest)(nil),               // 15: api_wireguard.DeleteEndpointRequest
+	(*DeleteEndpointResponse)(nil),              // 16: api_wireguard.DeleteEndpointResponse
+	(*UpdateEndpointRequest)(nil),               // 17: api_wireguard.UpdateEndpointRequest
+	(*UpdateEndpointResponse)(nil),              // 18: api_wireguard.UpdateEndpointResponse
+	(*GetEndpointRequest)(nil),                  // 19: api_wireguard.GetEndpointRequest
+	(*GetEndpointResponse)(nil),                 // 20: api_wireguard.GetEndpointResponse
+	(*ListEndpointsRequest)(nil),                // 21: api_wireguard.ListEndpointsRequest
+	(*ListEndpointsResponse)(nil),               // 22: api_wireguard.ListEndpointsResponse
+	(*CreateWireGuardRequest)(nil),              // 23: api_wireguard.CreateWireGuardRequest
+	(*CreateWireGuardResponse)(nil),             // 24: api_wireguard.CreateWireGuardResponse
+	(*DeleteWireGuardRequest)(nil),              // 25: api_wireguard.DeleteWireGuardRequest
+	(*DeleteWireGuardResponse)(nil),             // 26: api_wireguard.DeleteWireGuardResponse
+	(*RestartWireGuardRequest)(nil),             // 27: api_wireguard.RestartWireGuardRequest
+	(*RestartWireGuardResponse)(nil),            // 28: api_wireguard.RestartWireGuardResponse
+	(*UpdateWireGuardRequest)(nil),              // 29: api_wireguard.UpdateWireGuardRequest
+	(*UpdateWireGuardResponse)(nil),             // 30: api_wireguard.UpdateWireGuardResponse
+	(*GetWireGuardRequest)(nil),                 // 31: api_wireguard.GetWireGuardRequest
+	(*GetWireGuardResponse)(nil),                // 32: api_wireguard.GetWireGuardResponse
+	(*GetWireGuardRuntimeInfoRequest)(nil),      // 33: api_wireguard.GetWireGuardRuntimeInfoRequest
+	(*GetWireGuardRuntimeInfoResponse)(nil),     // 34: api_wireguard.GetWireGuardRuntimeInfoResponse
+	(*ListWireGuardsRequest)(nil),               // 35: api_wireguard.ListWireGuardsRequest
+	(*ListWireGuardsResponse)(nil),              // 36: api_wireguard.ListWireGuardsResponse
+	(*CreateWireGuardLinkRequest)(nil),          // 37: api_wireguard.CreateWireGuardLinkRequest
+	(*CreateWireGuardLinkResponse)(nil),         // 38: api_wireguard.CreateWireGuardLinkResponse
+	(*DeleteWireGuardLinkRequest)(nil),          // 39: api_wireguard.DeleteWireGuardLinkRequest
+	(*DeleteWireGuardLinkResponse)(nil),         // 40: api_wireguard.DeleteWireGuardLinkResponse
+	(*UpdateWireGuardLinkRequest)(nil),          // 41: api_wireguard.UpdateWireGuardLinkRequest
+	(*UpdateWireGuardLinkResponse)(nil),         // 42: api_wireguard.UpdateWireGuardLinkResponse
+	(*GetWireGuardLinkRequest)(nil),             // 43: api_wireguard.GetWireGuardLinkRequest
+	(*GetWireGuardLinkResponse)(nil),            // 44: api_wireguard.GetWireGuardLinkResponse
+	(*ListWireGuardLinksRequest)(nil),           // 45: api_wireguard.ListWireGuardLinksRequest
+	(*ListWireGuardLinksResponse)(nil),          // 46: api_wireguard.ListWireGuardLinksResponse
+	(*CreateWireGuardExternalPeerRequest)(nil),  // 47: api_wireguard.CreateWireGuardExternalPeerRequest
+	(*CreateWireGuardExternalPeerResponse)(nil), // 48: api_wireguard.CreateWireGuardExternalPeerResponse
+	(*DeleteWireGuardExternalPeerRequest)(nil),  // 49: api_wireguard.DeleteWireGuardExternalPeerRequest
+	(*DeleteWireGuardExternalPeerResponse)(nil), // 50: api_wireguard.DeleteWireGuardExternalPeerResponse
+	(*ListWireGuardExternalPeersRequest)(nil),   // 51: api_wireguard.ListWireGuardExternalPeersRequest
+	(*ListWireGuardExternalPeersResponse)(nil),  // 52: api_wireguard.ListWireGuardExternalPeersResponse
+	(*GenWireGuardConfigRequest)(nil),           // 53: api_wireguard.GenWireGuardConfigRequest
+	(*GenWireGuardConfigResponse)(nil),          // 54: api_wireguard.GenWireGuardConfigResponse
+	(*PunchWireGuardRequest)(nil),               // 55: api_wireguard.PunchWireGuardRequest
+	(*WireGuardPunchTarget)(nil),                // 56: api_wireguard.WireGuardPunchTarget
+	(*WireGuardPunchResult)(nil),                // 57: api_wireguard.WireGuardPunchResult
+	(*PunchWireGuardResponse)(nil),              // 58: api_wireguard.PunchWireGuardResponse
+	nil,                                         // 59: api_wireguard.GetNetworkTopologyResponse.AdjsEntry
+	(*Network)(nil),                             // 60: wireguard.Network
+	(*Status)(nil),                              // 61: common.Status
+	(*Endpoint)(nil),                            // 62: wireguard.Endpoint
+	(*WireGuardConfig)(nil),                     // 63: wireguard.WireGuardConfig
+	(*WGDeviceRuntimeInfo)(nil),                 // 64: wireguard.WGDeviceRuntimeInfo
+	(*WireGuardLink)(nil),                       // 65: wireguard.WireGuardLink
+	(*WireGuardExternalPeer)(nil),               // 66: wireguard.WireGuardExternalPeer
+	(*WireGuardLinks)(nil),                      // 67: wireguard.WireGuardLinks
 }
 var file_api_wg_proto_depIdxs = []int32{
-	48, // 0: api_wireguard.CreateNetworkRequest.network:type_name -> wireguard.Network
-	49, // 1: api_wireguard.CreateNetworkResponse.status:type_name -> common.Status
-	48, // 2: api_wireguard.CreateNetworkResponse.network:type_name -> wireguard.Network
-	49, // 3: api_wireguard.DeleteNetworkResponse.status:type_name -> common.Status
-	48, // 4: api_wireguard.UpdateNetworkRequest.network:type_name -> wireguard.Network
-	49, // 5: api_wireguard.UpdateNetworkResponse.status:type_name -> common.Status
-	48, // 6: api_wireguard.UpdateNetworkResponse.network:type_name -> wireguard.Network
-	49, // 7: api_wireguard.GetNetworkResponse.status:type_name -> common.Status
-	48, // 8: api_wireguard.GetNetworkResponse.network:type_name -> wireguard.Network
-	49, // 9: api_wireguard.ListNetworksResponse.status:type_name -> common.Status
-	48, // 10: api_wireguard.ListNetworksResponse.networks:type_name -> wireguard.Network
-	49, // 11: api_wireguard.GetNetworkTopologyResponse.status:type_name -> common.Status
-	47, // 12: api_wireguard.GetNetworkTopologyResponse.adjs:type_name -> api_wireguard.GetNetworkTopologyResponse.AdjsEntry
-	50, // 13: api_wireguard.CreateEndpointRequest.endpoint:type_name -> wireguard.Endpoint
-	49, // 14: api_wireguard.CreateEndpointResponse.status:type_name -> common.Status
-	50, // 15: api_wireguard.CreateEndpointResponse.endpoint:type_name -> wireguard.Endpoint
-	49, // 16: api_wireguard.DeleteEndpointResponse.status:type_name -> common.Status
-	50, // 17: api_wireguard.UpdateEndpointRequest.endpoint:type_name -> wireguard.Endpoint
-	49, // 18: api_wireguard.UpdateEndpointResponse.status:type_name -> common.Status
-	50, // 19: api_wireguard.UpdateEndpointResponse.endpoint:type_name -> wireguard.Endpoint
-	49, // 20: api_wireguard.GetEndpointResponse.status:type_name -> common.Status
-	50, // 21: api_wireguard.GetEndpointResponse.endpoint:type_name -> wireguard.Endpoint
-	49, // 22: api_wireguard.ListEndpointsResponse.status:type_name -> common.Status
-	50, // 23: api_wireguard.ListEndpointsResponse.endpoints:type_name -> wireguard.Endpoint
-	51, // 24: api_wireguard.CreateWireGuardRequest.wireguard_config:type_name -> wireguard.WireGuardConfig
-	49, // 25: api_wireguard.CreateWireGuardResponse.status:type_name -> common.Status
-	51, // 26: api_wireguard.CreateWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
-	49, // 27: api_wireguard.DeleteWireGuardResponse.status:type_name -> common.Status
-	49, // 28: api_wireguard.RestartWireGuardResponse.status:type_name -> common.Status
-	51, // 29: api_wireguard.UpdateWireGuardRequest.wireguard_config:type_name -> wireguard.WireGuardConfig
+	60, // 0: api_wireguard.CreateNetworkRequest.network:type_name -> wireguard.Network
+	61, // 1: api_wireguard.CreateNetworkResponse.status:type_name -> common.Status
+	60, // 2: api_wireguard.CreateNetworkResponse.network:type_name -> wireguard.Network
+	61, // 3: api_wireguard.DeleteNetworkResponse.status:type_name -> common.Status
+	60, // 4: api_wireguard.UpdateNetworkRequest.network:type_name -> wireguard.Network
+	61, // 5: api_wireguard.UpdateNetworkResponse.status:type_name -> common.Status
+	60, // 6: api_wireguard.UpdateNetworkResponse.network:type_name -> wireguard.Network
+	61, // 7: api_wireguard.GetNetworkResponse.status:type_name -> common.Status
+	60, // 8: api_wireguard.GetNetworkResponse.network:type_name -> wireguard.Network
+	61, // 9: api_wireguard.ListNetworksResponse.status:type_name -> common.Status
+	60, // 10: api_wireguard.ListNetworksResponse.networks:type_name -> wireguard.Network
+	61, // 11: api_wireguard.GetNetworkTopologyResponse.status:type_name -> common.Status
+	59, // 12: api_wireguard.GetNetworkTopologyResponse.adjs:type_name -> api_wireguard.GetNetworkTopologyResponse.AdjsEntry
+	62, // 13: api_wireguard.CreateEndpointRequest.endpoint:type_name -> wireguard.Endpoint
+	61, // 14: api_wireguard.CreateEndpointResponse.status:type_name -> common.Status
+	62, // 15: api_wireguard.CreateEndpointResponse.endpoint:type_name -> wireguard.Endpoint
+	61, // 16: api_wireguard.DeleteEndpointResponse.status:type_name -> common.Status
+	62, // 17: api_wireguard.UpdateEndpointRequest.endpoint:type_name -> wireguard.Endpoint
+	61, // 18: api_wireguard.UpdateEndpointResponse.status:type_name -> common.Status
+	62, // 19: api_wireguard.UpdateEndpointResponse.endpoint:type_name -> wireguard.Endpoint
+	61, // 20: api_wireguard.GetEndpointResponse.status:type_name -> common.Status
+	62, // 21: api_wireguard.GetEndpointResponse.endpoint:type_name -> wireguard.Endpoint
+	61, // 22: api_wireguard.ListEndpointsResponse.status:type_name -> common.Status
+	62, // 23: api_wireguard.ListEndpointsResponse.endpoints:type_name -> wireguard.Endpoint
+	63, // 24: api_wireguard.CreateWireGuardRequest.wireguard_config:type_name -> wireguard.WireGuardConfig
+	61, // 25: api_wireguard.CreateWireGuardResponse.status:type_name -> common.Status
+	63, // 26: api_wireguard.CreateWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
+	61, // 27: api_wireguard.DeleteWireGuardResponse.status:type_name -> common.Status
+	61, // 28: api_wireguard.RestartWireGuardResponse.status:type_name -> common.Status
+	63, // 29: api_wireguard.UpdateWireGuardRequest.wireguard_config:type_name -> wireguard.WireGuardConfig
 	0,  // 30: api_wireguard.UpdateWireGuardRequest.update_type:type_name -> api_wireguard.UpdateWireGuardRequest.UpdateType
-	49, // 31: api_wireguard.UpdateWireGuardResponse.status:type_name -> common.Status
-	51, // 32: api_wireguard.UpdateWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
-	49, // 33: api_wireguard.GetWireGuardResponse.status:type_name -> common.Status
-	51, // 34: api_wireguard.GetWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
-	49, // 35: api_wireguard.GetWireGuardRuntimeInfoResponse.status:type_name -> common.Status
-	52, // 36: api_wireguard.GetWireGuardRuntimeInfoResponse.wg_device_runtime_info:type_name -> wireguard.WGDeviceRuntimeInfo
-	49, // 37: api_wireguard.ListWireGuardsResponse.status:type_name -> common.Status
-	51, // 38: api_wireguard.ListWireGuardsResponse.wireguard_configs:type_name -> wireguard.WireGuardConfig
-	53, // 39: api_wireguard.CreateWireGuardLinkRequest.wireguard_link:type_name -> wireguard.WireGuardLink
-	49, // 40: api_wireguard.CreateWireGuardLinkResponse.status:type_name -> common.Status
-	53, // 41: api_wireguard.CreateWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
-	49, // 42: api_wireguard.DeleteWireGuardLinkResponse.status:type_name -> common.Status
-	53, // 43: api_wireguard.UpdateWireGuardLinkRequest.wireguard_link:type_name -> wireguard.WireGuardLink
-	49, // 44: api_wireguard.UpdateWireGuardLinkResponse.status:type_name -> common.Status
-	53, // 45: api_wireguard.UpdateWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
-	49, // 46: api_wireguard.GetWireGuardLinkResponse.status:type_name -> common.Status
-	53, // 47: api_wireguard.GetWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
-	49, // 48: api_wireguard.ListWireGuardLinksResponse.status:type_name -> common.Status
-	53, // 49: api_wireguard.ListWireGuardLinksResponse.wireguard_links:type_name -> wireguard.WireGuardLink
-	54, // 50: api_wireguard.GetNetworkTopologyResponse.AdjsEntry.value:type_name -> wireguard.WireGuardLinks
-	51, // [51:51] is the sub-list for method output_type
-	51, // [51:51] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	61, // 31: api_wireguard.UpdateWireGuardResponse.status:type_name -> common.Status
+	63, // 32: api_wireguard.UpdateWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
+	61, // 33: api_wireguard.GetWireGuardResponse.status:type_name -> common.Status
+	63, // 34: api_wireguard.GetWireGuardResponse.wireguard_config:type_name -> wireguard.WireGuardConfig
+	61, // 35: api_wireguard.GetWireGuardRuntimeInfoResponse.status:type_name -> common.Status
+	64, // 36: api_wireguard.GetWireGuardRuntimeInfoResponse.wg_device_runtime_info:type_name -> wireguard.WGDeviceRuntimeInfo
+	61, // 37: api_wireguard.ListWireGuardsResponse.status:type_name -> common.Status
+	63, // 38: api_wireguard.ListWireGuardsResponse.wireguard_configs:type_name -> wireguard.WireGuardConfig
+	65, // 39: api_wireguard.CreateWireGuardLinkRequest.wireguard_link:type_name -> wireguard.WireGuardLink
+	61, // 40: api_wireguard.CreateWireGuardLinkResponse.status:type_name -> common.Status
+	65, // 41: api_wireguard.CreateWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
+	61, // 42: api_wireguard.DeleteWireGuardLinkResponse.status:type_name -> common.Status
+	65, // 43: api_wireguard.UpdateWireGuardLinkRequest.wireguard_link:type_name -> wireguard.WireGuardLink
+	61, // 44: api_wireguard.UpdateWireGuardLinkResponse.status:type_name -> common.Status
+	65, // 45: api_wireguard.UpdateWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
+	61, // 46: api_wireguard.GetWireGuardLinkResponse.status:type_name -> common.Status
+	65, // 47: api_wireguard.GetWireGuardLinkResponse.wireguard_link:type_name -> wireguard.WireGuardLink
+	61, // 48: api_wireguard.ListWireGuardLinksResponse.status:type_name -> common.Status
+	65, // 49: api_wireguard.ListWireGuardLinksResponse.wireguard_links:type_name -> wireguard.WireGuardLink
+	66, // 50: api_wireguard.CreateWireGuardExternalPeerRequest.external_peer:type_name -> wireguard.WireGuardExternalPeer
+	61, // 51: api_wireguard.CreateWireGuardExternalPeerResponse.status:type_name -> common.Status
+	66, // 52: api_wireguard.CreateWireGuardExternalPeerResponse.external_peer:type_name -> wireguard.WireGuardExternalPeer
+	61, // 53: api_wireguard.DeleteWireGuardExternalPeerResponse.status:type_name -> common.Status
+	61, // 54: api_wireguard.ListWireGuardExternalPeersResponse.status:type_name -> common.Status
+	66, // 55: api_wireguard.ListWireGuardExternalPeersResponse.external_peers:type_name -> wireguard.WireGuardExternalPeer
+	61, // 56: api_wireguard.GenWireGuardConfigResponse.status:type_name -> common.Status
+	56, // 57: api_wireguard.PunchWireGuardRequest.targets:type_name -> api_wireguard.WireGuardPunchTarget
+	61, // 58: api_wireguard.PunchWireGuardResponse.status:type_name -> common.Status
+	57, // 59: api_wireguard.PunchWireGuardResponse.results:type_name -> api_wireguard.WireGuardPunchResult
+	67, // 60: api_wireguard.GetNetworkTopologyResponse.AdjsEntry.value:type_name -> wireguard.WireGuardLinks
+	61, // [61:61] is the sub-list for method output_type
+	61, // [61:61] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_api_wg_proto_init() }
@@ -2900,13 +3644,25 @@ func file_api_wg_proto_init() {
 	file_api_wg_proto_msgTypes[43].OneofWrappers = []any{}
 	file_api_wg_proto_msgTypes[44].OneofWrappers = []any{}
 	file_api_wg_proto_msgTypes[45].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[46].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[48].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[50].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[51].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[53].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[54].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[55].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_wg_proto_msgTypes[57].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_wg_proto_rawDesc), len(file_api_wg_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   47,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
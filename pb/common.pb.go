@@ -497,6 +497,8 @@ type User struct {
 	Role          *string                `protobuf:"bytes,6,opt,name=Role,proto3,oneof" json:"Role,omitempty"`
 	Token         *string                `protobuf:"bytes,7,opt,name=Token,proto3,oneof" json:"Token,omitempty"`
 	RawPassword   *string                `protobuf:"bytes,8,opt,name=RawPassword,proto3,oneof" json:"RawPassword,omitempty"`
+	TOTPEnabled   *bool                  `protobuf:"varint,9,opt,name=TOTPEnabled,proto3,oneof" json:"TOTPEnabled,omitempty"`
+	TOTPRequired  *bool                  `protobuf:"varint,10,opt,name=TOTPRequired,proto3,oneof" json:"TOTPRequired,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -587,6 +589,20 @@ func (x *User) GetRawPassword() string {
 	return ""
 }
 
+func (x *User) GetTOTPEnabled() bool {
+	if x != nil && x.TOTPEnabled != nil {
+		return *x.TOTPEnabled
+	}
+	return false
+}
+
+func (x *User) GetTOTPRequired() bool {
+	if x != nil && x.TOTPRequired != nil {
+		return *x.TOTPRequired
+	}
+	return false
+}
+
 type ProxyInfo struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Name              *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
@@ -598,6 +614,7 @@ type ProxyInfo struct {
 	HistoryTrafficIn  *int64                 `protobuf:"varint,7,opt,name=history_traffic_in,json=historyTrafficIn,proto3,oneof" json:"history_traffic_in,omitempty"`
 	HistoryTrafficOut *int64                 `protobuf:"varint,8,opt,name=history_traffic_out,json=historyTrafficOut,proto3,oneof" json:"history_traffic_out,omitempty"`
 	FirstSync         *bool                  `protobuf:"varint,9,opt,name=first_sync,json=firstSync,proto3,oneof" json:"first_sync,omitempty"`
+	Online            *bool                  `protobuf:"varint,10,opt,name=online,proto3,oneof" json:"online,omitempty"` // 隧道当前是否在 frps 上在线
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -695,6 +712,13 @@ func (x *ProxyInfo) GetFirstSync() bool {
 	return false
 }
 
+func (x *ProxyInfo) GetOnline() bool {
+	if x != nil && x.Online != nil {
+		return *x.Online
+	}
+	return false
+}
+
 type ProxyConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
@@ -1076,167 +1100,1745 @@ func (x *Socket) GetAddress() string {
 	return ""
 }
 
-var File_common_proto protoreflect.FileDescriptor
+type ClientToken struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	ClientId       *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	OriginClientId *string                `protobuf:"bytes,3,opt,name=origin_client_id,json=originClientId,proto3,oneof" json:"origin_client_id,omitempty"`
+	Status         *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`                         // active, inactive, revoked
+	ExpiresAt      *int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // unix milli, 0 means never expire
+	CreatedAt      *int64                 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_common_proto_rawDesc = "" +
-	"\n" +
-	"\fcommon.proto\x12\x06common\"H\n" +
-	"\x06Status\x12$\n" +
-	"\x04code\x18\x01 \x01(\x0e2\x10.common.RespCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
-	"\rCommonRequest\x12\x17\n" +
-	"\x04data\x18\x01 \x01(\tH\x00R\x04data\x88\x01\x01B\a\n" +
-	"\x05_data\"j\n" +
-	"\x0eCommonResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
-	"\x04data\x18\x02 \x01(\tH\x01R\x04data\x88\x01\x01B\t\n" +
-	"\a_statusB\a\n" +
-	"\x05_data\"\xf3\x03\n" +
-	"\x06Client\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
-	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x1b\n" +
-	"\x06config\x18\x03 \x01(\tH\x02R\x06config\x88\x01\x01\x12\x1d\n" +
-	"\acomment\x18\x05 \x01(\tH\x03R\acomment\x88\x01\x01\x12 \n" +
-	"\tserver_id\x18\x06 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1d\n" +
-	"\astopped\x18\a \x01(\bH\x05R\astopped\x88\x01\x01\x12\x1d\n" +
-	"\n" +
-	"client_ids\x18\b \x03(\tR\tclientIds\x12-\n" +
-	"\x10origin_client_id\x18\t \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1e\n" +
-	"\bfrps_url\x18\n" +
-	" \x01(\tH\aR\afrpsUrl\x88\x01\x01\x12!\n" +
-	"\tephemeral\x18\v \x01(\bH\bR\tephemeral\x88\x01\x01\x12%\n" +
-	"\flast_seen_at\x18\f \x01(\x03H\tR\n" +
-	"lastSeenAt\x88\x01\x01B\x05\n" +
-	"\x03_idB\t\n" +
-	"\a_secretB\t\n" +
-	"\a_configB\n" +
-	"\n" +
-	"\b_commentB\f\n" +
-	"\n" +
-	"_server_idB\n" +
-	"\n" +
-	"\b_stoppedB\x13\n" +
-	"\x11_origin_client_idB\v\n" +
-	"\t_frps_urlB\f\n" +
-	"\n" +
-	"_ephemeralB\x0f\n" +
-	"\r_last_seen_at\"\xd8\x01\n" +
-	"\x06Server\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
-	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x13\n" +
-	"\x02ip\x18\x03 \x01(\tH\x02R\x02ip\x88\x01\x01\x12\x1b\n" +
-	"\x06config\x18\x04 \x01(\tH\x03R\x06config\x88\x01\x01\x12\x1d\n" +
-	"\acomment\x18\x05 \x01(\tH\x04R\acomment\x88\x01\x01\x12\x1b\n" +
-	"\tfrps_urls\x18\x06 \x03(\tR\bfrpsUrlsB\x05\n" +
-	"\x03_idB\t\n" +
-	"\a_secretB\x05\n" +
-	"\x03_ipB\t\n" +
-	"\a_configB\n" +
-	"\n" +
-	"\b_comment\"\xd5\x02\n" +
-	"\x04User\x12\x1b\n" +
-	"\x06UserID\x18\x01 \x01(\x03H\x00R\x06UserID\x88\x01\x01\x12\x1f\n" +
-	"\bTenantID\x18\x02 \x01(\x03H\x01R\bTenantID\x88\x01\x01\x12\x1f\n" +
-	"\bUserName\x18\x03 \x01(\tH\x02R\bUserName\x88\x01\x01\x12\x19\n" +
-	"\x05Email\x18\x04 \x01(\tH\x03R\x05Email\x88\x01\x01\x12\x1b\n" +
-	"\x06Status\x18\x05 \x01(\tH\x04R\x06Status\x88\x01\x01\x12\x17\n" +
-	"\x04Role\x18\x06 \x01(\tH\x05R\x04Role\x88\x01\x01\x12\x19\n" +
-	"\x05Token\x18\a \x01(\tH\x06R\x05Token\x88\x01\x01\x12%\n" +
-	"\vRawPassword\x18\b \x01(\tH\aR\vRawPassword\x88\x01\x01B\t\n" +
-	"\a_UserIDB\v\n" +
-	"\t_TenantIDB\v\n" +
-	"\t_UserNameB\b\n" +
-	"\x06_EmailB\t\n" +
-	"\a_StatusB\a\n" +
-	"\x05_RoleB\b\n" +
-	"\x06_TokenB\x0e\n" +
-	"\f_RawPassword\"\x84\x04\n" +
-	"\tProxyInfo\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
-	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12 \n" +
-	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12 \n" +
-	"\tserver_id\x18\x04 \x01(\tH\x03R\bserverId\x88\x01\x01\x12-\n" +
-	"\x10today_traffic_in\x18\x05 \x01(\x03H\x04R\x0etodayTrafficIn\x88\x01\x01\x12/\n" +
-	"\x11today_traffic_out\x18\x06 \x01(\x03H\x05R\x0ftodayTrafficOut\x88\x01\x01\x121\n" +
-	"\x12history_traffic_in\x18\a \x01(\x03H\x06R\x10historyTrafficIn\x88\x01\x01\x123\n" +
-	"\x13history_traffic_out\x18\b \x01(\x03H\aR\x11historyTrafficOut\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"first_sync\x18\t \x01(\bH\bR\tfirstSync\x88\x01\x01B\a\n" +
-	"\x05_nameB\a\n" +
-	"\x05_typeB\f\n" +
-	"\n" +
-	"_client_idB\f\n" +
-	"\n" +
-	"_server_idB\x13\n" +
-	"\x11_today_traffic_inB\x14\n" +
-	"\x12_today_traffic_outB\x15\n" +
-	"\x13_history_traffic_inB\x16\n" +
-	"\x14_history_traffic_outB\r\n" +
-	"\v_first_sync\"\xe4\x02\n" +
-	"\vProxyConfig\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
-	"\x04type\x18\x03 \x01(\tH\x02R\x04type\x88\x01\x01\x12 \n" +
-	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
-	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1b\n" +
-	"\x06config\x18\x06 \x01(\tH\x05R\x06config\x88\x01\x01\x12-\n" +
-	"\x10origin_client_id\x18\a \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1d\n" +
-	"\astopped\x18\b \x01(\bH\aR\astopped\x88\x01\x01B\x05\n" +
-	"\x03_idB\a\n" +
-	"\x05_nameB\a\n" +
-	"\x05_typeB\f\n" +
-	"\n" +
-	"_client_idB\f\n" +
-	"\n" +
-	"_server_idB\t\n" +
-	"\a_configB\x13\n" +
-	"\x11_origin_client_idB\n" +
-	"\n" +
-	"\b_stopped\"\xd5\x01\n" +
-	"\x12ProxyWorkingStatus\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
-	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1b\n" +
-	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x15\n" +
-	"\x03err\x18\x04 \x01(\tH\x03R\x03err\x88\x01\x01\x12$\n" +
-	"\vremote_addr\x18\x05 \x01(\tH\x04R\n" +
-	"remoteAddr\x88\x01\x01B\a\n" +
-	"\x05_nameB\a\n" +
-	"\x05_typeB\t\n" +
-	"\a_statusB\x06\n" +
-	"\x04_errB\x0e\n" +
-	"\f_remote_addr\"\x83\x03\n" +
-	"\x06Worker\x12 \n" +
-	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x88\x01\x01\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1c\n" +
-	"\auser_id\x18\x03 \x01(\rH\x02R\x06userId\x88\x01\x01\x12 \n" +
-	"\ttenant_id\x18\x04 \x01(\rH\x03R\btenantId\x88\x01\x01\x12+\n" +
-	"\x06socket\x18\x05 \x01(\v2\x0e.common.SocketH\x04R\x06socket\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"code_entry\x18\x06 \x01(\tH\x05R\tcodeEntry\x88\x01\x01\x12\x17\n" +
-	"\x04code\x18\a \x01(\tH\x06R\x04code\x88\x01\x01\x12,\n" +
-	"\x0fconfig_template\x18\b \x01(\tH\aR\x0econfigTemplate\x88\x01\x01B\f\n" +
-	"\n" +
-	"_worker_idB\a\n" +
-	"\x05_nameB\n" +
-	"\n" +
-	"\b_user_idB\f\n" +
-	"\n" +
-	"_tenant_idB\t\n" +
-	"\a_socketB\r\n" +
-	"\v_code_entryB\a\n" +
-	"\x05_codeB\x12\n" +
-	"\x10_config_template\"d\n" +
-	"\n" +
-	"WorkerList\x12(\n" +
-	"\aworkers\x18\x01 \x03(\v2\x0e.common.WorkerR\aworkers\x12\x1f\n" +
-	"\bnodename\x18\x02 \x01(\tH\x00R\bnodename\x88\x01\x01B\v\n" +
-	"\t_nodename\"U\n" +
-	"\x06Socket\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
-	"\aaddress\x18\x02 \x01(\tH\x01R\aaddress\x88\x01\x01B\a\n" +
-	"\x05_nameB\n" +
+func (x *ClientToken) Reset() {
+	*x = ClientToken{}
+	mi := &file_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientToken) ProtoMessage() {}
+
+func (x *ClientToken) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientToken.ProtoReflect.Descriptor instead.
+func (*ClientToken) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClientToken) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ClientToken) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientToken) GetOriginClientId() string {
+	if x != nil && x.OriginClientId != nil {
+		return *x.OriginClientId
+	}
+	return ""
+}
+
+func (x *ClientToken) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ClientToken) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ClientToken) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type UserGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       *string                `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	GroupName     *string                `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3,oneof" json:"group_name,omitempty"`
+	TenantId      *int64                 `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	CreatedAt     *int64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGroup) Reset() {
+	*x = UserGroup{}
+	mi := &file_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGroup) ProtoMessage() {}
+
+func (x *UserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
+func (*UserGroup) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserGroup) GetGroupId() string {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return ""
+}
+
+func (x *UserGroup) GetGroupName() string {
+	if x != nil && x.GroupName != nil {
+		return *x.GroupName
+	}
+	return ""
+}
+
+func (x *UserGroup) GetTenantId() int64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *UserGroup) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *UserGroup) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type Tenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      *int64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Comment       *string                `protobuf:"bytes,3,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	MaxUsers      *int32                 `protobuf:"varint,4,opt,name=max_users,json=maxUsers,proto3,oneof" json:"max_users,omitempty"`
+	MaxClients    *int32                 `protobuf:"varint,5,opt,name=max_clients,json=maxClients,proto3,oneof" json:"max_clients,omitempty"`
+	MaxServers    *int32                 `protobuf:"varint,6,opt,name=max_servers,json=maxServers,proto3,oneof" json:"max_servers,omitempty"`
+	CreatedAt     *int64                 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Tenant) GetTenantId() int64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *Tenant) GetMaxUsers() int32 {
+	if x != nil && x.MaxUsers != nil {
+		return *x.MaxUsers
+	}
+	return 0
+}
+
+func (x *Tenant) GetMaxClients() int32 {
+	if x != nil && x.MaxClients != nil {
+		return *x.MaxClients
+	}
+	return 0
+}
+
+func (x *Tenant) GetMaxServers() int32 {
+	if x != nil && x.MaxServers != nil {
+		return *x.MaxServers
+	}
+	return 0
+}
+
+func (x *Tenant) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	TenantId      *int64                 `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	UserName      *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	TokenId       *string                `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3,oneof" json:"token_id,omitempty"` // api token 的 jti，浏览器会话为空
+	Kind          *string                `protobuf:"bytes,6,opt,name=kind,proto3,oneof" json:"kind,omitempty"`                      // api, pty, rpc
+	Method        *string                `protobuf:"bytes,7,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	Route         *string                `protobuf:"bytes,8,opt,name=route,proto3,oneof" json:"route,omitempty"`
+	TargetId      *string                `protobuf:"bytes,9,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	Summary       *string                `protobuf:"bytes,10,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	ResultCode    *int32                 `protobuf:"varint,11,opt,name=result_code,json=resultCode,proto3,oneof" json:"result_code,omitempty"`
+	ResultMessage *string                `protobuf:"bytes,12,opt,name=result_message,json=resultMessage,proto3,oneof" json:"result_message,omitempty"`
+	SourceIp      *string                `protobuf:"bytes,13,opt,name=source_ip,json=sourceIp,proto3,oneof" json:"source_ip,omitempty"`
+	DurationMs    *int64                 `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	CreatedAt     *int64                 `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AuditLog) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetTenantId() int64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTokenId() string {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRoute() string {
+	if x != nil && x.Route != nil {
+		return *x.Route
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResultCode() int32 {
+	if x != nil && x.ResultCode != nil {
+		return *x.ResultCode
+	}
+	return 0
+}
+
+func (x *AuditLog) GetResultMessage() string {
+	if x != nil && x.ResultMessage != nil {
+		return *x.ResultMessage
+	}
+	return ""
+}
+
+func (x *AuditLog) GetSourceIp() string {
+	if x != nil && x.SourceIp != nil {
+		return *x.SourceIp
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditLog) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type PTYRecording struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	UserName      *string                `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	Size          *int64                 `protobuf:"varint,5,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	StartedAt     *int64                 `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"` // unix milli
+	EndedAt       *int64                 `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`       // unix milli, 0 表示会话未结束
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PTYRecording) Reset() {
+	*x = PTYRecording{}
+	mi := &file_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PTYRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PTYRecording) ProtoMessage() {}
+
+func (x *PTYRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PTYRecording.ProtoReflect.Descriptor instead.
+func (*PTYRecording) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PTYRecording) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *PTYRecording) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PTYRecording) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *PTYRecording) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *PTYRecording) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *PTYRecording) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *PTYRecording) GetEndedAt() int64 {
+	if x != nil && x.EndedAt != nil {
+		return *x.EndedAt
+	}
+	return 0
+}
+
+type ArchivedLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *int64                 `protobuf:"varint,1,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"` // unix milli，master 收到日志的时间
+	Pkg           *string                `protobuf:"bytes,2,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"`
+	Level         *string                `protobuf:"bytes,3,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	Msg           *string                `protobuf:"bytes,4,opt,name=msg,proto3,oneof" json:"msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedLog) Reset() {
+	*x = ArchivedLog{}
+	mi := &file_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedLog) ProtoMessage() {}
+
+func (x *ArchivedLog) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedLog.ProtoReflect.Descriptor instead.
+func (*ArchivedLog) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ArchivedLog) GetTimestamp() int64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *ArchivedLog) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
+func (x *ArchivedLog) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+func (x *ArchivedLog) GetMsg() string {
+	if x != nil && x.Msg != nil {
+		return *x.Msg
+	}
+	return ""
+}
+
+type TrafficSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *int64                 `protobuf:"varint,1,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"` // unix milli，时间桶起始时间
+	TrafficIn     *int64                 `protobuf:"varint,2,opt,name=traffic_in,json=trafficIn,proto3,oneof" json:"traffic_in,omitempty"`
+	TrafficOut    *int64                 `protobuf:"varint,3,opt,name=traffic_out,json=trafficOut,proto3,oneof" json:"traffic_out,omitempty"`
+	InRate        *float64               `protobuf:"fixed64,4,opt,name=in_rate,json=inRate,proto3,oneof" json:"in_rate,omitempty"`    // bytes/s
+	OutRate       *float64               `protobuf:"fixed64,5,opt,name=out_rate,json=outRate,proto3,oneof" json:"out_rate,omitempty"` // bytes/s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficSample) Reset() {
+	*x = TrafficSample{}
+	mi := &file_common_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSample) ProtoMessage() {}
+
+func (x *TrafficSample) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSample.ProtoReflect.Descriptor instead.
+func (*TrafficSample) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TrafficSample) GetTimestamp() int64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *TrafficSample) GetTrafficIn() int64 {
+	if x != nil && x.TrafficIn != nil {
+		return *x.TrafficIn
+	}
+	return 0
+}
+
+func (x *TrafficSample) GetTrafficOut() int64 {
+	if x != nil && x.TrafficOut != nil {
+		return *x.TrafficOut
+	}
+	return 0
+}
+
+func (x *TrafficSample) GetInRate() float64 {
+	if x != nil && x.InRate != nil {
+		return *x.InRate
+	}
+	return 0
+}
+
+func (x *TrafficSample) GetOutRate() float64 {
+	if x != nil && x.OutRate != nil {
+		return *x.OutRate
+	}
+	return 0
+}
+
+type TrafficQuota struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name                   *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	UserId                 *int64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	TargetType             *string                `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3,oneof" json:"target_type,omitempty"`             // user, client, proxy
+	TargetId               *string                `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`                   // 用户 id、客户端 id 或隧道配置 id
+	MonthlyBytes           *int64                 `protobuf:"varint,6,opt,name=monthly_bytes,json=monthlyBytes,proto3,oneof" json:"monthly_bytes,omitempty"`      // 0 表示不限
+	BandwidthLimit         *string                `protobuf:"bytes,7,opt,name=bandwidth_limit,json=bandwidthLimit,proto3,oneof" json:"bandwidth_limit,omitempty"` // frp 格式如 1MB，空表示不限
+	ExceededAction         *string                `protobuf:"bytes,8,opt,name=exceeded_action,json=exceededAction,proto3,oneof" json:"exceeded_action,omitempty"` // stop, limit
+	ExceededBandwidthLimit *string                `protobuf:"bytes,9,opt,name=exceeded_bandwidth_limit,json=exceededBandwidthLimit,proto3,oneof" json:"exceeded_bandwidth_limit,omitempty"`
+	CycleDay               *int32                 `protobuf:"varint,10,opt,name=cycle_day,json=cycleDay,proto3,oneof" json:"cycle_day,omitempty"`
+	CycleStart             *int64                 `protobuf:"varint,11,opt,name=cycle_start,json=cycleStart,proto3,oneof" json:"cycle_start,omitempty"` // unix milli
+	UsedBytes              *int64                 `protobuf:"varint,12,opt,name=used_bytes,json=usedBytes,proto3,oneof" json:"used_bytes,omitempty"`
+	Exceeded               *bool                  `protobuf:"varint,13,opt,name=exceeded,proto3,oneof" json:"exceeded,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TrafficQuota) Reset() {
+	*x = TrafficQuota{}
+	mi := &file_common_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficQuota) ProtoMessage() {}
+
+func (x *TrafficQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficQuota.ProtoReflect.Descriptor instead.
+func (*TrafficQuota) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TrafficQuota) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetTargetType() string {
+	if x != nil && x.TargetType != nil {
+		return *x.TargetType
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetMonthlyBytes() int64 {
+	if x != nil && x.MonthlyBytes != nil {
+		return *x.MonthlyBytes
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetBandwidthLimit() string {
+	if x != nil && x.BandwidthLimit != nil {
+		return *x.BandwidthLimit
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetExceededAction() string {
+	if x != nil && x.ExceededAction != nil {
+		return *x.ExceededAction
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetExceededBandwidthLimit() string {
+	if x != nil && x.ExceededBandwidthLimit != nil {
+		return *x.ExceededBandwidthLimit
+	}
+	return ""
+}
+
+func (x *TrafficQuota) GetCycleDay() int32 {
+	if x != nil && x.CycleDay != nil {
+		return *x.CycleDay
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetCycleStart() int64 {
+	if x != nil && x.CycleStart != nil {
+		return *x.CycleStart
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetUsedBytes() int64 {
+	if x != nil && x.UsedBytes != nil {
+		return *x.UsedBytes
+	}
+	return 0
+}
+
+func (x *TrafficQuota) GetExceeded() bool {
+	if x != nil && x.Exceeded != nil {
+		return *x.Exceeded
+	}
+	return false
+}
+
+type AlertRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Condition      *string                `protobuf:"bytes,3,opt,name=condition,proto3,oneof" json:"condition,omitempty"`   // client_offline, proxy_stopped, wireguard_handshake_stale, worker_exited, traffic_spike
+	Threshold      *float64               `protobuf:"fixed64,4,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"` // 握手过期为秒数，流量突增为 bytes/s
+	Duration       *int32                 `protobuf:"varint,5,opt,name=duration,proto3,oneof" json:"duration,omitempty"`    // 条件持续多少秒后告警
+	TargetIds      []string               `protobuf:"bytes,6,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	TargetTags     []string               `protobuf:"bytes,7,rep,name=target_tags,json=targetTags,proto3" json:"target_tags,omitempty"`
+	ChannelIds     []uint32               `protobuf:"varint,8,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	RepeatInterval *int32                 `protobuf:"varint,9,opt,name=repeat_interval,json=repeatInterval,proto3,oneof" json:"repeat_interval,omitempty"` // 秒，0 表示只通知一次
+	Enabled        *bool                  `protobuf:"varint,10,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_common_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AlertRule) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCondition() string {
+	if x != nil && x.Condition != nil {
+		return *x.Condition
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetDuration() int32 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *AlertRule) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *AlertRule) GetTargetTags() []string {
+	if x != nil {
+		return x.TargetTags
+	}
+	return nil
+}
+
+func (x *AlertRule) GetChannelIds() []uint32 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *AlertRule) GetRepeatInterval() int32 {
+	if x != nil && x.RepeatInterval != nil {
+		return *x.RepeatInterval
+	}
+	return 0
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type AlertChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`     // webhook, email, telegram, dingtalk
+	Config        *string                `protobuf:"bytes,4,opt,name=config,proto3,oneof" json:"config,omitempty"` // json
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertChannel) Reset() {
+	*x = AlertChannel{}
+	mi := &file_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertChannel) ProtoMessage() {}
+
+func (x *AlertChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertChannel.ProtoReflect.Descriptor instead.
+func (*AlertChannel) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AlertChannel) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *AlertChannel) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *AlertChannel) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AlertChannel) GetConfig() string {
+	if x != nil && x.Config != nil {
+		return *x.Config
+	}
+	return ""
+}
+
+type AlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	RuleId        *uint32                `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	ObjectKey     *string                `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3,oneof" json:"object_key,omitempty"`
+	ObjectName    *string                `protobuf:"bytes,4,opt,name=object_name,json=objectName,proto3,oneof" json:"object_name,omitempty"`
+	Status        *string                `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"` // pending, firing, resolved
+	Value         *float64               `protobuf:"fixed64,6,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	StartedAt     *int64                 `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`    // unix milli
+	FiredAt       *int64                 `protobuf:"varint,8,opt,name=fired_at,json=firedAt,proto3,oneof" json:"fired_at,omitempty"`          // unix milli
+	ResolvedAt    *int64                 `protobuf:"varint,9,opt,name=resolved_at,json=resolvedAt,proto3,oneof" json:"resolved_at,omitempty"` // unix milli
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AlertEvent) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetRuleId() uint32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetObjectKey() string {
+	if x != nil && x.ObjectKey != nil {
+		return *x.ObjectKey
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetObjectName() string {
+	if x != nil && x.ObjectName != nil {
+		return *x.ObjectName
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetFiredAt() int64 {
+	if x != nil && x.FiredAt != nil {
+		return *x.FiredAt
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetResolvedAt() int64 {
+	if x != nil && x.ResolvedAt != nil {
+		return *x.ResolvedAt
+	}
+	return 0
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Url           *string                `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Secret        *string                `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"` // 只写，查询时不返回
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WebhookSubscription) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	SubscriptionId *uint32                `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	EventId        *string                `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3,oneof" json:"event_id,omitempty"`
+	EventType      *string                `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3,oneof" json:"event_type,omitempty"`
+	Payload        *string                `protobuf:"bytes,5,opt,name=payload,proto3,oneof" json:"payload,omitempty"` // json
+	Status         *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`   // pending, success, failed
+	Attempts       *int32                 `protobuf:"varint,7,opt,name=attempts,proto3,oneof" json:"attempts,omitempty"`
+	StatusCode     *int32                 `protobuf:"varint,8,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	Error          *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt      *int64                 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`         // unix milli
+	DeliveredAt    *int64                 `protobuf:"varint,11,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`   // unix milli
+	NextRetryAt    *int64                 `protobuf:"varint,12,opt,name=next_retry_at,json=nextRetryAt,proto3,oneof" json:"next_retry_at,omitempty"` // unix milli
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_common_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WebhookDelivery) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() uint32 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil && x.EventId != nil {
+		return *x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil && x.Payload != nil {
+		return *x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil && x.Attempts != nil {
+		return *x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil && x.DeliveredAt != nil {
+		return *x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextRetryAt() int64 {
+	if x != nil && x.NextRetryAt != nil {
+		return *x.NextRetryAt
+	}
+	return 0
+}
+
+var File_common_proto protoreflect.FileDescriptor
+
+const file_common_proto_rawDesc = "" +
+	"\n" +
+	"\fcommon.proto\x12\x06common\"H\n" +
+	"\x06Status\x12$\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x10.common.RespCodeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
+	"\rCommonRequest\x12\x17\n" +
+	"\x04data\x18\x01 \x01(\tH\x00R\x04data\x88\x01\x01B\a\n" +
+	"\x05_data\"j\n" +
+	"\x0eCommonResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04data\x18\x02 \x01(\tH\x01R\x04data\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_data\"\xf3\x03\n" +
+	"\x06Client\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x03 \x01(\tH\x02R\x06config\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x05 \x01(\tH\x03R\acomment\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x06 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1d\n" +
+	"\astopped\x18\a \x01(\bH\x05R\astopped\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\b \x03(\tR\tclientIds\x12-\n" +
+	"\x10origin_client_id\x18\t \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1e\n" +
+	"\bfrps_url\x18\n" +
+	" \x01(\tH\aR\afrpsUrl\x88\x01\x01\x12!\n" +
+	"\tephemeral\x18\v \x01(\bH\bR\tephemeral\x88\x01\x01\x12%\n" +
+	"\flast_seen_at\x18\f \x01(\x03H\tR\n" +
+	"lastSeenAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\t\n" +
+	"\a_secretB\t\n" +
+	"\a_configB\n" +
+	"\n" +
+	"\b_commentB\f\n" +
+	"\n" +
+	"_server_idB\n" +
+	"\n" +
+	"\b_stoppedB\x13\n" +
+	"\x11_origin_client_idB\v\n" +
+	"\t_frps_urlB\f\n" +
+	"\n" +
+	"_ephemeralB\x0f\n" +
+	"\r_last_seen_at\"\xd8\x01\n" +
+	"\x06Server\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x13\n" +
+	"\x02ip\x18\x03 \x01(\tH\x02R\x02ip\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x04 \x01(\tH\x03R\x06config\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x05 \x01(\tH\x04R\acomment\x88\x01\x01\x12\x1b\n" +
+	"\tfrps_urls\x18\x06 \x03(\tR\bfrpsUrlsB\x05\n" +
+	"\x03_idB\t\n" +
+	"\a_secretB\x05\n" +
+	"\x03_ipB\t\n" +
+	"\a_configB\n" +
+	"\n" +
+	"\b_comment\"\xc6\x03\n" +
+	"\x04User\x12\x1b\n" +
+	"\x06UserID\x18\x01 \x01(\x03H\x00R\x06UserID\x88\x01\x01\x12\x1f\n" +
+	"\bTenantID\x18\x02 \x01(\x03H\x01R\bTenantID\x88\x01\x01\x12\x1f\n" +
+	"\bUserName\x18\x03 \x01(\tH\x02R\bUserName\x88\x01\x01\x12\x19\n" +
+	"\x05Email\x18\x04 \x01(\tH\x03R\x05Email\x88\x01\x01\x12\x1b\n" +
+	"\x06Status\x18\x05 \x01(\tH\x04R\x06Status\x88\x01\x01\x12\x17\n" +
+	"\x04Role\x18\x06 \x01(\tH\x05R\x04Role\x88\x01\x01\x12\x19\n" +
+	"\x05Token\x18\a \x01(\tH\x06R\x05Token\x88\x01\x01\x12%\n" +
+	"\vRawPassword\x18\b \x01(\tH\aR\vRawPassword\x88\x01\x01\x12%\n" +
+	"\vTOTPEnabled\x18\t \x01(\bH\bR\vTOTPEnabled\x88\x01\x01\x12'\n" +
+	"\fTOTPRequired\x18\n" +
+	" \x01(\bH\tR\fTOTPRequired\x88\x01\x01B\t\n" +
+	"\a_UserIDB\v\n" +
+	"\t_TenantIDB\v\n" +
+	"\t_UserNameB\b\n" +
+	"\x06_EmailB\t\n" +
+	"\a_StatusB\a\n" +
+	"\x05_RoleB\b\n" +
+	"\x06_TokenB\x0e\n" +
+	"\f_RawPasswordB\x0e\n" +
+	"\f_TOTPEnabledB\x0f\n" +
+	"\r_TOTPRequired\"\xac\x04\n" +
+	"\tProxyInfo\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x04 \x01(\tH\x03R\bserverId\x88\x01\x01\x12-\n" +
+	"\x10today_traffic_in\x18\x05 \x01(\x03H\x04R\x0etodayTrafficIn\x88\x01\x01\x12/\n" +
+	"\x11today_traffic_out\x18\x06 \x01(\x03H\x05R\x0ftodayTrafficOut\x88\x01\x01\x121\n" +
+	"\x12history_traffic_in\x18\a \x01(\x03H\x06R\x10historyTrafficIn\x88\x01\x01\x123\n" +
+	"\x13history_traffic_out\x18\b \x01(\x03H\aR\x11historyTrafficOut\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"first_sync\x18\t \x01(\bH\bR\tfirstSync\x88\x01\x01\x12\x1b\n" +
+	"\x06online\x18\n" +
+	" \x01(\bH\tR\x06online\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\x13\n" +
+	"\x11_today_traffic_inB\x14\n" +
+	"\x12_today_traffic_outB\x15\n" +
+	"\x13_history_traffic_inB\x16\n" +
+	"\x14_history_traffic_outB\r\n" +
+	"\v_first_syncB\t\n" +
+	"\a_online\"\xe4\x02\n" +
+	"\vProxyConfig\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x02R\x04type\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x06 \x01(\tH\x05R\x06config\x88\x01\x01\x12-\n" +
+	"\x10origin_client_id\x18\a \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1d\n" +
+	"\astopped\x18\b \x01(\bH\aR\astopped\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\t\n" +
+	"\a_configB\x13\n" +
+	"\x11_origin_client_idB\n" +
+	"\n" +
+	"\b_stopped\"\xd5\x01\n" +
+	"\x12ProxyWorkingStatus\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x15\n" +
+	"\x03err\x18\x04 \x01(\tH\x03R\x03err\x88\x01\x01\x12$\n" +
+	"\vremote_addr\x18\x05 \x01(\tH\x04R\n" +
+	"remoteAddr\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_statusB\x06\n" +
+	"\x04_errB\x0e\n" +
+	"\f_remote_addr\"\x83\x03\n" +
+	"\x06Worker\x12 \n" +
+	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\rH\x02R\x06userId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x04 \x01(\rH\x03R\btenantId\x88\x01\x01\x12+\n" +
+	"\x06socket\x18\x05 \x01(\v2\x0e.common.SocketH\x04R\x06socket\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"code_entry\x18\x06 \x01(\tH\x05R\tcodeEntry\x88\x01\x01\x12\x17\n" +
+	"\x04code\x18\a \x01(\tH\x06R\x04code\x88\x01\x01\x12,\n" +
+	"\x0fconfig_template\x18\b \x01(\tH\aR\x0econfigTemplate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_worker_idB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_tenant_idB\t\n" +
+	"\a_socketB\r\n" +
+	"\v_code_entryB\a\n" +
+	"\x05_codeB\x12\n" +
+	"\x10_config_template\"d\n" +
+	"\n" +
+	"WorkerList\x12(\n" +
+	"\aworkers\x18\x01 \x03(\v2\x0e.common.WorkerR\aworkers\x12\x1f\n" +
+	"\bnodename\x18\x02 \x01(\tH\x00R\bnodename\x88\x01\x01B\v\n" +
+	"\t_nodename\"U\n" +
+	"\x06Socket\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x02 \x01(\tH\x01R\aaddress\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_address\"\xab\x02\n" +
+	"\vClientToken\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tH\x01R\bclientId\x88\x01\x01\x12-\n" +
+	"\x10origin_client_id\x18\x03 \x01(\tH\x02R\x0eoriginClientId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03H\x04R\texpiresAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03H\x05R\tcreatedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_client_idB\x13\n" +
+	"\x11_origin_client_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_expires_atB\r\n" +
+	"\v_created_at\"\xf9\x01\n" +
+	"\tUserGroup\x12\x1e\n" +
+	"\bgroup_id\x18\x01 \x01(\tH\x00R\agroupId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"group_name\x18\x02 \x01(\tH\x01R\tgroupName\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\x03H\x02R\btenantId\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x04 \x01(\tH\x03R\acomment\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03H\x04R\tcreatedAt\x88\x01\x01B\v\n" +
+	"\t_group_idB\r\n" +
+	"\v_group_nameB\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_commentB\r\n" +
+	"\v_created_at\"\xd4\x02\n" +
+	"\x06Tenant\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\x03H\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\x03 \x01(\tH\x02R\acomment\x88\x01\x01\x12 \n" +
+	"\tmax_users\x18\x04 \x01(\x05H\x03R\bmaxUsers\x88\x01\x01\x12$\n" +
+	"\vmax_clients\x18\x05 \x01(\x05H\x04R\n" +
+	"maxClients\x88\x01\x01\x12$\n" +
+	"\vmax_servers\x18\x06 \x01(\x05H\x05R\n" +
+	"maxServers\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03H\x06R\tcreatedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_commentB\f\n" +
+	"\n" +
+	"_max_usersB\x0e\n" +
+	"\f_max_clientsB\x0e\n" +
+	"\f_max_serversB\r\n" +
+	"\v_created_at\"\xb5\x05\n" +
+	"\bAuditLog\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\x03H\x01R\x06userId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\x03H\x02R\btenantId\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x03R\buserName\x88\x01\x01\x12\x1e\n" +
+	"\btoken_id\x18\x05 \x01(\tH\x04R\atokenId\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x06 \x01(\tH\x05R\x04kind\x88\x01\x01\x12\x1b\n" +
+	"\x06method\x18\a \x01(\tH\x06R\x06method\x88\x01\x01\x12\x19\n" +
+	"\x05route\x18\b \x01(\tH\aR\x05route\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\t \x01(\tH\bR\btargetId\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\n" +
+	" \x01(\tH\tR\asummary\x88\x01\x01\x12$\n" +
+	"\vresult_code\x18\v \x01(\x05H\n" +
+	"R\n" +
+	"resultCode\x88\x01\x01\x12*\n" +
+	"\x0eresult_message\x18\f \x01(\tH\vR\rresultMessage\x88\x01\x01\x12 \n" +
+	"\tsource_ip\x18\r \x01(\tH\fR\bsourceIp\x88\x01\x01\x12$\n" +
+	"\vduration_ms\x18\x0e \x01(\x03H\rR\n" +
+	"durationMs\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03H\x0eR\tcreatedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_tenant_idB\f\n" +
+	"\n" +
+	"_user_nameB\v\n" +
+	"\t_token_idB\a\n" +
+	"\x05_kindB\t\n" +
+	"\a_methodB\b\n" +
+	"\x06_routeB\f\n" +
+	"\n" +
+	"_target_idB\n" +
+	"\n" +
+	"\b_summaryB\x0e\n" +
+	"\f_result_codeB\x11\n" +
+	"\x0f_result_messageB\f\n" +
+	"\n" +
+	"_source_ipB\x0e\n" +
+	"\f_duration_msB\r\n" +
+	"\v_created_at\"\xcd\x02\n" +
+	"\fPTYRecording\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\x03H\x01R\x06userId\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x03 \x01(\tH\x02R\buserName\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x05 \x01(\x03H\x04R\x04size\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\x03H\x05R\tstartedAt\x88\x01\x01\x12\x1e\n" +
+	"\bended_at\x18\a \x01(\x03H\x06R\aendedAt\x88\x01\x01B\r\n" +
+	"\v_session_idB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_user_nameB\f\n" +
+	"\n" +
+	"_client_idB\a\n" +
+	"\x05_sizeB\r\n" +
+	"\v_started_atB\v\n" +
+	"\t_ended_at\"\xa1\x01\n" +
+	"\vArchivedLog\x12!\n" +
+	"\ttimestamp\x18\x01 \x01(\x03H\x00R\ttimestamp\x88\x01\x01\x12\x15\n" +
+	"\x03pkg\x18\x02 \x01(\tH\x01R\x03pkg\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x03 \x01(\tH\x02R\x05level\x88\x01\x01\x12\x15\n" +
+	"\x03msg\x18\x04 \x01(\tH\x03R\x03msg\x88\x01\x01B\f\n" +
+	"\n" +
+	"_timestampB\x06\n" +
+	"\x04_pkgB\b\n" +
+	"\x06_levelB\x06\n" +
+	"\x04_msg\"\x80\x02\n" +
+	"\rTrafficSample\x12!\n" +
+	"\ttimestamp\x18\x01 \x01(\x03H\x00R\ttimestamp\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"traffic_in\x18\x02 \x01(\x03H\x01R\ttrafficIn\x88\x01\x01\x12$\n" +
+	"\vtraffic_out\x18\x03 \x01(\x03H\x02R\n" +
+	"trafficOut\x88\x01\x01\x12\x1c\n" +
+	"\ain_rate\x18\x04 \x01(\x01H\x03R\x06inRate\x88\x01\x01\x12\x1e\n" +
+	"\bout_rate\x18\x05 \x01(\x01H\x04R\aoutRate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_timestampB\r\n" +
+	"\v_traffic_inB\x0e\n" +
+	"\f_traffic_outB\n" +
+	"\n" +
+	"\b_in_rateB\v\n" +
+	"\t_out_rate\"\xbf\x05\n" +
+	"\fTrafficQuota\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\x03H\x02R\x06userId\x88\x01\x01\x12$\n" +
+	"\vtarget_type\x18\x04 \x01(\tH\x03R\n" +
+	"targetType\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\x05 \x01(\tH\x04R\btargetId\x88\x01\x01\x12(\n" +
+	"\rmonthly_bytes\x18\x06 \x01(\x03H\x05R\fmonthlyBytes\x88\x01\x01\x12,\n" +
+	"\x0fbandwidth_limit\x18\a \x01(\tH\x06R\x0ebandwidthLimit\x88\x01\x01\x12,\n" +
+	"\x0fexceeded_action\x18\b \x01(\tH\aR\x0eexceededAction\x88\x01\x01\x12=\n" +
+	"\x18exceeded_bandwidth_limit\x18\t \x01(\tH\bR\x16exceededBandwidthLimit\x88\x01\x01\x12 \n" +
+	"\tcycle_day\x18\n" +
+	" \x01(\x05H\tR\bcycleDay\x88\x01\x01\x12$\n" +
+	"\vcycle_start\x18\v \x01(\x03H\n" +
+	"R\n" +
+	"cycleStart\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"used_bytes\x18\f \x01(\x03H\vR\tusedBytes\x88\x01\x01\x12\x1f\n" +
+	"\bexceeded\x18\r \x01(\bH\fR\bexceeded\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_target_typeB\f\n" +
+	"\n" +
+	"_target_idB\x10\n" +
+	"\x0e_monthly_bytesB\x12\n" +
+	"\x10_bandwidth_limitB\x12\n" +
+	"\x10_exceeded_actionB\x1b\n" +
+	"\x19_exceeded_bandwidth_limitB\f\n" +
+	"\n" +
+	"_cycle_dayB\x0e\n" +
+	"\f_cycle_startB\r\n" +
+	"\v_used_bytesB\v\n" +
+	"\t_exceeded\"\xa7\x03\n" +
+	"\tAlertRule\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12!\n" +
+	"\tcondition\x18\x03 \x01(\tH\x02R\tcondition\x88\x01\x01\x12!\n" +
+	"\tthreshold\x18\x04 \x01(\x01H\x03R\tthreshold\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x05 \x01(\x05H\x04R\bduration\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x06 \x03(\tR\ttargetIds\x12\x1f\n" +
+	"\vtarget_tags\x18\a \x03(\tR\n" +
+	"targetTags\x12\x1f\n" +
+	"\vchannel_ids\x18\b \x03(\rR\n" +
+	"channelIds\x12,\n" +
+	"\x0frepeat_interval\x18\t \x01(\x05H\x05R\x0erepeatInterval\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bH\x06R\aenabled\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_conditionB\f\n" +
+	"\n" +
+	"_thresholdB\v\n" +
+	"\t_durationB\x12\n" +
+	"\x10_repeat_intervalB\n" +
+	"\n" +
+	"\b_enabled\"\x96\x01\n" +
+	"\fAlertChannel\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x02R\x04type\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x04 \x01(\tH\x03R\x06config\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_config\"\x9e\x03\n" +
+	"\n" +
+	"AlertEvent\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x1c\n" +
+	"\arule_id\x18\x02 \x01(\rH\x01R\x06ruleId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"object_key\x18\x03 \x01(\tH\x02R\tobjectKey\x88\x01\x01\x12$\n" +
+	"\vobject_name\x18\x04 \x01(\tH\x03R\n" +
+	"objectName\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x05 \x01(\tH\x04R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05value\x18\x06 \x01(\x01H\x05R\x05value\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\a \x01(\x03H\x06R\tstartedAt\x88\x01\x01\x12\x1e\n" +
+	"\bfired_at\x18\b \x01(\x03H\aR\afiredAt\x88\x01\x01\x12$\n" +
+	"\vresolved_at\x18\t \x01(\x03H\bR\n" +
+	"resolvedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\n" +
+	"\n" +
+	"\b_rule_idB\r\n" +
+	"\v_object_keyB\x0e\n" +
+	"\f_object_nameB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_valueB\r\n" +
+	"\v_started_atB\v\n" +
+	"\t_fired_atB\x0e\n" +
+	"\f_resolved_at\"\xe6\x01\n" +
+	"\x13WebhookSubscription\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tH\x02R\x03url\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x04 \x01(\tH\x03R\x06secret\x88\x01\x01\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x04R\aenabled\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secretB\n" +
+	"\n" +
+	"\b_enabled\"\xd2\x04\n" +
+	"\x0fWebhookDelivery\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12,\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\rH\x01R\x0esubscriptionId\x88\x01\x01\x12\x1e\n" +
+	"\bevent_id\x18\x03 \x01(\tH\x02R\aeventId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tH\x03R\teventType\x88\x01\x01\x12\x1d\n" +
+	"\apayload\x18\x05 \x01(\tH\x04R\apayload\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x05R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\battempts\x18\a \x01(\x05H\x06R\battempts\x88\x01\x01\x12$\n" +
+	"\vstatus_code\x18\b \x01(\x05H\aR\n" +
+	"statusCode\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\bR\x05error\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03H\tR\tcreatedAt\x88\x01\x01\x12&\n" +
+	"\fdelivered_at\x18\v \x01(\x03H\n" +
+	"R\vdeliveredAt\x88\x01\x01\x12'\n" +
+	"\rnext_retry_at\x18\f \x01(\x03H\vR\vnextRetryAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\x12\n" +
+	"\x10_subscription_idB\v\n" +
+	"\t_event_idB\r\n" +
+	"\v_event_typeB\n" +
 	"\n" +
-	"\b_address*\xbc\x01\n" +
+	"\b_payloadB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_attemptsB\x0e\n" +
+	"\f_status_codeB\b\n" +
+	"\x06_errorB\r\n" +
+	"\v_created_atB\x0f\n" +
+	"\r_delivered_atB\x10\n" +
+	"\x0e_next_retry_at*\xbc\x01\n" +
 	"\bRespCode\x12\x19\n" +
 	"\x15RESP_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11RESP_CODE_SUCCESS\x10\x01\x12\x17\n" +
@@ -1264,22 +2866,35 @@ func file_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_common_proto_goTypes = []any{
-	(RespCode)(0),              // 0: common.RespCode
-	(ClientType)(0),            // 1: common.ClientType
-	(*Status)(nil),             // 2: common.Status
-	(*CommonRequest)(nil),      // 3: common.CommonRequest
-	(*CommonResponse)(nil),     // 4: common.CommonResponse
-	(*Client)(nil),             // 5: common.Client
-	(*Server)(nil),             // 6: common.Server
-	(*User)(nil),               // 7: common.User
-	(*ProxyInfo)(nil),          // 8: common.ProxyInfo
-	(*ProxyConfig)(nil),        // 9: common.ProxyConfig
-	(*ProxyWorkingStatus)(nil), // 10: common.ProxyWorkingStatus
-	(*Worker)(nil),             // 11: common.Worker
-	(*WorkerList)(nil),         // 12: common.WorkerList
-	(*Socket)(nil),             // 13: common.Socket
+	(RespCode)(0),               // 0: common.RespCode
+	(ClientType)(0),             // 1: common.ClientType
+	(*Status)(nil),              // 2: common.Status
+	(*CommonRequest)(nil),       // 3: common.CommonRequest
+	(*CommonResponse)(nil),      // 4: common.CommonResponse
+	(*Client)(nil),              // 5: common.Client
+	(*Server)(nil),              // 6: common.Server
+	(*User)(nil),                // 7: common.User
+	(*ProxyInfo)(nil),           // 8: common.ProxyInfo
+	(*ProxyConfig)(nil),         // 9: common.ProxyConfig
+	(*ProxyWorkingStatus)(nil),  // 10: common.ProxyWorkingStatus
+	(*Worker)(nil),              // 11: common.Worker
+	(*WorkerList)(nil),          // 12: common.WorkerList
+	(*Socket)(nil),              // 13: common.Socket
+	(*ClientToken)(nil),         // 14: common.ClientToken
+	(*UserGroup)(nil),           // 15: common.UserGroup
+	(*Tenant)(nil),              // 16: common.Tenant
+	(*AuditLog)(nil),            // 17: common.AuditLog
+	(*PTYRecording)(nil),        // 18: common.PTYRecording
+	(*ArchivedLog)(nil),         // 19: common.ArchivedLog
+	(*TrafficSample)(nil),       // 20: common.TrafficSample
+	(*TrafficQuota)(nil),        // 21: common.TrafficQuota
+	(*AlertRule)(nil),           // 22: common.AlertRule
+	(*AlertChannel)(nil),        // 23: common.AlertChannel
+	(*AlertEvent)(nil),          // 24: common.AlertEvent
+	(*WebhookSubscription)(nil), // 25: common.WebhookSubscription
+	(*WebhookDelivery)(nil),     // 26: common.WebhookDelivery
 }
 var file_common_proto_depIdxs = []int32{
 	0,  // 0: common.Status.code:type_name -> common.RespCode
@@ -1309,13 +2924,26 @@ func file_common_proto_init() {
 	file_common_proto_msgTypes[9].OneofWrappers = []any{}
 	file_common_proto_msgTypes[10].OneofWrappers = []any{}
 	file_common_proto_msgTypes[11].OneofWrappers = []any{}
+	file_common_proto_msgTypes[12].OneofWrappers = []any{}
+	file_common_proto_msgTypes[13].OneofWrappers = []any{}
+	file_common_proto_msgTypes[14].OneofWrappers = []any{}
+	file_common_proto_msgTypes[15].OneofWrappers = []any{}
+	file_common_proto_msgTypes[16].OneofWrappers = []any{}
+	file_common_proto_msgTypes[17].OneofWrappers = []any{}
+	file_common_proto_msgTypes[18].OneofWrappers = []any{}
+	file_common_proto_msgTypes[19].OneofWrappers = []any{}
+	file_common_proto_msgTypes[20].OneofWrappers = []any{}
+	file_common_proto_msgTypes[21].OneofWrappers = []any{}
+	file_common_proto_msgTypes[22].OneofWrappers = []any{}
+	file_common_proto_msgTypes[23].OneofWrappers = []any{}
+	file_common_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   12,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -53,6 +53,7 @@ const (
 	Event_EVENT_GET_WIREGUARD_RUNTIME_INFO Event = 26
 	Event_EVENT_RESTART_WIREGUARD          Event = 27
 	Event_EVENT_UPGRADE_FRPP               Event = 28
+	Event_EVENT_PUNCH_WIREGUARD            Event = 29
 )
 
 // Enum value maps for Event.
@@ -87,6 +88,7 @@ var (
 		26: "EVENT_GET_WIREGUARD_RUNTIME_INFO",
 		27: "EVENT_RESTART_WIREGUARD",
 		28: "EVENT_UPGRADE_FRPP",
+		29: "EVENT_PUNCH_WIREGUARD",
 	}
 	Event_value = map[string]int32{
 		"EVENT_UNSPECIFIED":                0,
@@ -118,6 +120,7 @@ var (
 		"EVENT_GET_WIREGUARD_RUNTIME_INFO": 26,
 		"EVENT_RESTART_WIREGUARD":          27,
 		"EVENT_UPGRADE_FRPP":               28,
+		"EVENT_PUNCH_WIREGUARD":            29,
 	}
 )
 
@@ -592,6 +595,7 @@ type FRPAuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // from frpc metas, used to validate client scoped token
 	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -641,6 +645,13 @@ func (x *FRPAuthRequest) GetToken() string {
 	return ""
 }
 
+func (x *FRPAuthRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
 func (x *FRPAuthRequest) GetBase() *ServerBase {
 	if x != nil {
 		return x.Base
@@ -700,6 +711,258 @@ func (x *FRPAuthResponse) GetOk() bool {
 	return false
 }
 
+type FRPPluginProxy struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // proxy name reported by frps, with user prefix
+	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	RemotePort         int32                  `protobuf:"varint,3,opt,name=remote_port,json=remotePort,proto3" json:"remote_port,omitempty"`
+	Subdomain          string                 `protobuf:"bytes,4,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	CustomDomains      []string               `protobuf:"bytes,5,rep,name=custom_domains,json=customDomains,proto3" json:"custom_domains,omitempty"`
+	BandwidthLimit     string                 `protobuf:"bytes,6,opt,name=bandwidth_limit,json=bandwidthLimit,proto3" json:"bandwidth_limit,omitempty"`
+	BandwidthLimitMode string                 `protobuf:"bytes,7,opt,name=bandwidth_limit_mode,json=bandwidthLimitMode,proto3" json:"bandwidth_limit_mode,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FRPPluginProxy) Reset() {
+	*x = FRPPluginProxy{}
+	mi := &file_rpc_master_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FRPPluginProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FRPPluginProxy) ProtoMessage() {}
+
+func (x *FRPPluginProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FRPPluginProxy.ProtoReflect.Descriptor instead.
+func (*FRPPluginProxy) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FRPPluginProxy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FRPPluginProxy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FRPPluginProxy) GetRemotePort() int32 {
+	if x != nil {
+		return x.RemotePort
+	}
+	return 0
+}
+
+func (x *FRPPluginProxy) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *FRPPluginProxy) GetCustomDomains() []string {
+	if x != nil {
+		return x.CustomDomains
+	}
+	return nil
+}
+
+func (x *FRPPluginProxy) GetBandwidthLimit() string {
+	if x != nil {
+		return x.BandwidthLimit
+	}
+	return ""
+}
+
+func (x *FRPPluginProxy) GetBandwidthLimitMode() string {
+	if x != nil {
+		return x.BandwidthLimitMode
+	}
+	return ""
+}
+
+type FRPPluginOpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"` // frp server plugin op, eg: NewProxy, CloseProxy, Ping, NewWorkConn, NewUserConn
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // from frpc metas
+	RunId         string                 `protobuf:"bytes,5,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Proxy         *FRPPluginProxy        `protobuf:"bytes,6,opt,name=proxy,proto3,oneof" json:"proxy,omitempty"`
+	RemoteAddr    string                 `protobuf:"bytes,7,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FRPPluginOpRequest) Reset() {
+	*x = FRPPluginOpRequest{}
+	mi := &file_rpc_master_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FRPPluginOpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FRPPluginOpRequest) ProtoMessage() {}
+
+func (x *FRPPluginOpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FRPPluginOpRequest.ProtoReflect.Descriptor instead.
+func (*FRPPluginOpRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FRPPluginOpRequest) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetProxy() *FRPPluginProxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *FRPPluginOpRequest) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *FRPPluginOpRequest) GetBase() *ServerBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type FRPPluginOpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reject        bool                   `protobuf:"varint,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,3,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FRPPluginOpResponse) Reset() {
+	*x = FRPPluginOpResponse{}
+	mi := &file_rpc_master_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FRPPluginOpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FRPPluginOpResponse) ProtoMessage() {}
+
+func (x *FRPPluginOpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FRPPluginOpResponse.ProtoReflect.Descriptor instead.
+func (*FRPPluginOpResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FRPPluginOpResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *FRPPluginOpResponse) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+func (x *FRPPluginOpResponse) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
 type PushProxyInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
@@ -710,7 +973,7 @@ type PushProxyInfoReq struct {
 
 func (x *PushProxyInfoReq) Reset() {
 	*x = PushProxyInfoReq{}
-	mi := &file_rpc_master_proto_msgTypes[10]
+	mi := &file_rpc_master_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +985,7 @@ func (x *PushProxyInfoReq) String() string {
 func (*PushProxyInfoReq) ProtoMessage() {}
 
 func (x *PushProxyInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[10]
+	mi := &file_rpc_master_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +998,7 @@ func (x *PushProxyInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushProxyInfoReq.ProtoReflect.Descriptor instead.
 func (*PushProxyInfoReq) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{10}
+	return file_rpc_master_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PushProxyInfoReq) GetBase() *ServerBase {
@@ -761,7 +1024,7 @@ type PushProxyInfoResp struct {
 
 func (x *PushProxyInfoResp) Reset() {
 	*x = PushProxyInfoResp{}
-	mi := &file_rpc_master_proto_msgTypes[11]
+	mi := &file_rpc_master_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1036,7 @@ func (x *PushProxyInfoResp) String() string {
 func (*PushProxyInfoResp) ProtoMessage() {}
 
 func (x *PushProxyInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[11]
+	mi := &file_rpc_master_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1049,7 @@ func (x *PushProxyInfoResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushProxyInfoResp.ProtoReflect.Descriptor instead.
 func (*PushProxyInfoResp) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{11}
+	return file_rpc_master_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PushProxyInfoResp) GetStatus() *Status {
@@ -799,6 +1062,8 @@ func (x *PushProxyInfoResp) GetStatus() *Status {
 type PushServerStreamLogReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Pkg           *string                `protobuf:"bytes,2,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"` // 日志所属的包，master 按订阅者过滤
+	Level         *string                `protobuf:"bytes,3,opt,name=level,proto3,oneof" json:"level,omitempty"`
 	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -806,7 +1071,7 @@ type PushServerStreamLogReq struct {
 
 func (x *PushServerStreamLogReq) Reset() {
 	*x = PushServerStreamLogReq{}
-	mi := &file_rpc_master_proto_msgTypes[12]
+	mi := &file_rpc_master_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +1083,7 @@ func (x *PushServerStreamLogReq) String() string {
 func (*PushServerStreamLogReq) ProtoMessage() {}
 
 func (x *PushServerStreamLogReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[12]
+	mi := &file_rpc_master_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1096,7 @@ func (x *PushServerStreamLogReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushServerStreamLogReq.ProtoReflect.Descriptor instead.
 func (*PushServerStreamLogReq) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{12}
+	return file_rpc_master_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PushServerStreamLogReq) GetLog() []byte {
@@ -841,6 +1106,20 @@ func (x *PushServerStreamLogReq) GetLog() []byte {
 	return nil
 }
 
+func (x *PushServerStreamLogReq) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
+func (x *PushServerStreamLogReq) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
 func (x *PushServerStreamLogReq) GetBase() *ServerBase {
 	if x != nil {
 		return x.Base
@@ -851,6 +1130,8 @@ func (x *PushServerStreamLogReq) GetBase() *ServerBase {
 type PushClientStreamLogReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Pkg           *string                `protobuf:"bytes,2,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"` // 日志所属的包，master 按订阅者过滤
+	Level         *string                `protobuf:"bytes,3,opt,name=level,proto3,oneof" json:"level,omitempty"`
 	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -858,7 +1139,7 @@ type PushClientStreamLogReq struct {
 
 func (x *PushClientStreamLogReq) Reset() {
 	*x = PushClientStreamLogReq{}
-	mi := &file_rpc_master_proto_msgTypes[13]
+	mi := &file_rpc_master_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -870,7 +1151,7 @@ func (x *PushClientStreamLogReq) String() string {
 func (*PushClientStreamLogReq) ProtoMessage() {}
 
 func (x *PushClientStreamLogReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[13]
+	mi := &file_rpc_master_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1164,7 @@ func (x *PushClientStreamLogReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushClientStreamLogReq.ProtoReflect.Descriptor instead.
 func (*PushClientStreamLogReq) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{13}
+	return file_rpc_master_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PushClientStreamLogReq) GetLog() []byte {
@@ -893,6 +1174,20 @@ func (x *PushClientStreamLogReq) GetLog() []byte {
 	return nil
 }
 
+func (x *PushClientStreamLogReq) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
+func (x *PushClientStreamLogReq) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
 func (x *PushClientStreamLogReq) GetBase() *ClientBase {
 	if x != nil {
 		return x.Base
@@ -910,7 +1205,7 @@ type PushStreamLogResp struct {
 
 func (x *PushStreamLogResp) Reset() {
 	*x = PushStreamLogResp{}
-	mi := &file_rpc_master_proto_msgTypes[14]
+	mi := &file_rpc_master_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -922,7 +1217,7 @@ func (x *PushStreamLogResp) String() string {
 func (*PushStreamLogResp) ProtoMessage() {}
 
 func (x *PushStreamLogResp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[14]
+	mi := &file_rpc_master_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,7 +1230,7 @@ func (x *PushStreamLogResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushStreamLogResp.ProtoReflect.Descriptor instead.
 func (*PushStreamLogResp) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{14}
+	return file_rpc_master_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PushStreamLogResp) GetStatus() *Status {
@@ -968,7 +1263,7 @@ type PTYClientMessage struct {
 
 func (x *PTYClientMessage) Reset() {
 	*x = PTYClientMessage{}
-	mi := &file_rpc_master_proto_msgTypes[15]
+	mi := &file_rpc_master_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -980,7 +1275,7 @@ func (x *PTYClientMessage) String() string {
 func (*PTYClientMessage) ProtoMessage() {}
 
 func (x *PTYClientMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[15]
+	mi := &file_rpc_master_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +1288,7 @@ func (x *PTYClientMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PTYClientMessage.ProtoReflect.Descriptor instead.
 func (*PTYClientMessage) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{15}
+	return file_rpc_master_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PTYClientMessage) GetData() []byte {
@@ -1070,7 +1365,7 @@ type PTYServerMessage struct {
 
 func (x *PTYServerMessage) Reset() {
 	*x = PTYServerMessage{}
-	mi := &file_rpc_master_proto_msgTypes[16]
+	mi := &file_rpc_master_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1082,7 +1377,7 @@ func (x *PTYServerMessage) String() string {
 func (*PTYServerMessage) ProtoMessage() {}
 
 func (x *PTYServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[16]
+	mi := &file_rpc_master_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1390,7 @@ func (x *PTYServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PTYServerMessage.ProtoReflect.Descriptor instead.
 func (*PTYServerMessage) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{16}
+	return file_rpc_master_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PTYServerMessage) GetData() []byte {
@@ -1135,7 +1430,7 @@ type ListClientWorkersRequest struct {
 
 func (x *ListClientWorkersRequest) Reset() {
 	*x = ListClientWorkersRequest{}
-	mi := &file_rpc_master_proto_msgTypes[17]
+	mi := &file_rpc_master_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1147,7 +1442,7 @@ func (x *ListClientWorkersRequest) String() string {
 func (*ListClientWorkersRequest) ProtoMessage() {}
 
 func (x *ListClientWorkersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[17]
+	mi := &file_rpc_master_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1455,7 @@ func (x *ListClientWorkersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWorkersRequest.ProtoReflect.Descriptor instead.
 func (*ListClientWorkersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{17}
+	return file_rpc_master_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListClientWorkersRequest) GetBase() *ClientBase {
@@ -1180,7 +1475,7 @@ type ListClientWorkersResponse struct {
 
 func (x *ListClientWorkersResponse) Reset() {
 	*x = ListClientWorkersResponse{}
-	mi := &file_rpc_master_proto_msgTypes[18]
+	mi := &file_rpc_master_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1192,7 +1487,7 @@ func (x *ListClientWorkersResponse) String() string {
 func (*ListClientWorkersResponse) ProtoMessage() {}
 
 func (x *ListClientWorkersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[18]
+	mi := &file_rpc_master_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1500,7 @@ func (x *ListClientWorkersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWorkersResponse.ProtoReflect.Descriptor instead.
 func (*ListClientWorkersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{18}
+	return file_rpc_master_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListClientWorkersResponse) GetStatus() *Status {
@@ -1231,7 +1526,7 @@ type ListClientWireGuardsRequest struct {
 
 func (x *ListClientWireGuardsRequest) Reset() {
 	*x = ListClientWireGuardsRequest{}
-	mi := &file_rpc_master_proto_msgTypes[19]
+	mi := &file_rpc_master_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1538,7 @@ func (x *ListClientWireGuardsRequest) String() string {
 func (*ListClientWireGuardsRequest) ProtoMessage() {}
 
 func (x *ListClientWireGuardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[19]
+	mi := &file_rpc_master_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1551,7 @@ func (x *ListClientWireGuardsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWireGuardsRequest.ProtoReflect.Descriptor instead.
 func (*ListClientWireGuardsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{19}
+	return file_rpc_master_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListClientWireGuardsRequest) GetBase() *ClientBase {
@@ -1276,7 +1571,7 @@ type ListClientWireGuardsResponse struct {
 
 func (x *ListClientWireGuardsResponse) Reset() {
 	*x = ListClientWireGuardsResponse{}
-	mi := &file_rpc_master_proto_msgTypes[20]
+	mi := &file_rpc_master_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1288,7 +1583,7 @@ func (x *ListClientWireGuardsResponse) String() string {
 func (*ListClientWireGuardsResponse) ProtoMessage() {}
 
 func (x *ListClientWireGuardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[20]
+	mi := &file_rpc_master_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1301,7 +1596,7 @@ func (x *ListClientWireGuardsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWireGuardsResponse.ProtoReflect.Descriptor instead.
 func (*ListClientWireGuardsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{20}
+	return file_rpc_master_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListClientWireGuardsResponse) GetStatus() *Status {
@@ -1329,7 +1624,7 @@ type ReportWireGuardRuntimeInfoReq struct {
 
 func (x *ReportWireGuardRuntimeInfoReq) Reset() {
 	*x = ReportWireGuardRuntimeInfoReq{}
-	mi := &file_rpc_master_proto_msgTypes[21]
+	mi := &file_rpc_master_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1341,7 +1636,7 @@ func (x *ReportWireGuardRuntimeInfoReq) String() string {
 func (*ReportWireGuardRuntimeInfoReq) ProtoMessage() {}
 
 func (x *ReportWireGuardRuntimeInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[21]
+	mi := &file_rpc_master_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1649,7 @@ func (x *ReportWireGuardRuntimeInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportWireGuardRuntimeInfoReq.ProtoReflect.Descriptor instead.
 func (*ReportWireGuardRuntimeInfoReq) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{21}
+	return file_rpc_master_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ReportWireGuardRuntimeInfoReq) GetInterfaceName() string {
@@ -1387,7 +1682,7 @@ type ReportWireGuardRuntimeInfoResp struct {
 
 func (x *ReportWireGuardRuntimeInfoResp) Reset() {
 	*x = ReportWireGuardRuntimeInfoResp{}
-	mi := &file_rpc_master_proto_msgTypes[22]
+	mi := &file_rpc_master_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +1694,7 @@ func (x *ReportWireGuardRuntimeInfoResp) String() string {
 func (*ReportWireGuardRuntimeInfoResp) ProtoMessage() {}
 
 func (x *ReportWireGuardRuntimeInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[22]
+	mi := &file_rpc_master_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +1707,7 @@ func (x *ReportWireGuardRuntimeInfoResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportWireGuardRuntimeInfoResp.ProtoReflect.Descriptor instead.
 func (*ReportWireGuardRuntimeInfoResp) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{22}
+	return file_rpc_master_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReportWireGuardRuntimeInfoResp) GetStatus() *Status {
@@ -1457,26 +1752,59 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"f\n" +
 	"\x14PullServerConfigResp\x12&\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12&\n" +
-	"\x06server\x18\x02 \x01(\v2\x0e.common.ServerR\x06server\"c\n" +
+	"\x06server\x18\x02 \x01(\v2\x0e.common.ServerR\x06server\"\x80\x01\n" +
 	"\x0eFRPAuthRequest\x12\x12\n" +
 	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\x12'\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12'\n" +
 	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"I\n" +
 	"\x0fFRPAuthResponse\x12&\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
-	"\x02ok\x18\x02 \x01(\bR\x02ok\"o\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\"\xf9\x01\n" +
+	"\x0eFRPPluginProxy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
+	"\vremote_port\x18\x03 \x01(\x05R\n" +
+	"remotePort\x12\x1c\n" +
+	"\tsubdomain\x18\x04 \x01(\tR\tsubdomain\x12%\n" +
+	"\x0ecustom_domains\x18\x05 \x03(\tR\rcustomDomains\x12'\n" +
+	"\x0fbandwidth_limit\x18\x06 \x01(\tR\x0ebandwidthLimit\x120\n" +
+	"\x14bandwidth_limit_mode\x18\a \x01(\tR\x12bandwidthLimitMode\"\x89\x02\n" +
+	"\x12FRPPluginOpRequest\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12\x15\n" +
+	"\x06run_id\x18\x05 \x01(\tR\x05runId\x121\n" +
+	"\x05proxy\x18\x06 \x01(\v2\x16.master.FRPPluginProxyH\x00R\x05proxy\x88\x01\x01\x12\x1f\n" +
+	"\vremote_addr\x18\a \x01(\tR\n" +
+	"remoteAddr\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04baseB\b\n" +
+	"\x06_proxy\"z\n" +
+	"\x13FRPPluginOpResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x16\n" +
+	"\x06reject\x18\x02 \x01(\bR\x06reject\x12#\n" +
+	"\rreject_reason\x18\x03 \x01(\tR\frejectReason\"o\n" +
 	"\x10PushProxyInfoReq\x12'\n" +
 	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\x122\n" +
 	"\vproxy_infos\x18\x01 \x03(\v2\x11.common.ProxyInfoR\n" +
 	"proxyInfos\";\n" +
 	"\x11PushProxyInfoResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"S\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"\x97\x01\n" +
 	"\x16PushServerStreamLogReq\x12\x10\n" +
-	"\x03log\x18\x01 \x01(\fR\x03log\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"S\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\x12\x15\n" +
+	"\x03pkg\x18\x02 \x01(\tH\x00R\x03pkg\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x03 \x01(\tH\x01R\x05level\x88\x01\x01\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04baseB\x06\n" +
+	"\x04_pkgB\b\n" +
+	"\x06_level\"\x97\x01\n" +
 	"\x16PushClientStreamLogReq\x12\x10\n" +
-	"\x03log\x18\x01 \x01(\fR\x03log\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"K\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\x12\x15\n" +
+	"\x03pkg\x18\x02 \x01(\tH\x00R\x03pkg\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x03 \x01(\tH\x01R\x05level\x88\x01\x01\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04baseB\x06\n" +
+	"\x04_pkgB\b\n" +
+	"\x06_level\"K\n" +
 	"\x11PushStreamLogResp\x12&\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
 	"\x02ok\x18\x02 \x01(\bR\x02ok\"\xdf\x01\n" +
@@ -1516,7 +1844,7 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x0f_interface_nameB\x0f\n" +
 	"\r_runtime_info\"H\n" +
 	"\x1eReportWireGuardRuntimeInfoResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status*\xce\x05\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status*\xe9\x05\n" +
 	"\x05Event\x12\x15\n" +
 	"\x11EVENT_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15EVENT_REGISTER_CLIENT\x10\x01\x12\x19\n" +
@@ -1550,7 +1878,8 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x16EVENT_UPDATE_WIREGUARD\x10\x19\x12$\n" +
 	" EVENT_GET_WIREGUARD_RUNTIME_INFO\x10\x1a\x12\x1b\n" +
 	"\x17EVENT_RESTART_WIREGUARD\x10\x1b\x12\x16\n" +
-	"\x12EVENT_UPGRADE_FRPP\x10\x1c2\x81\a\n" +
+	"\x12EVENT_UPGRADE_FRPP\x10\x1c\x12\x19\n" +
+	"\x15EVENT_PUNCH_WIREGUARD\x10\x1d2\xc9\a\n" +
 	"\x06Master\x12>\n" +
 	"\n" +
 	"ServerSend\x12\x15.master.ClientMessage\x1a\x15.master.ServerMessage(\x010\x01\x12M\n" +
@@ -1558,7 +1887,8 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x10PullServerConfig\x12\x1b.master.PullServerConfigReq\x1a\x1c.master.PullServerConfigResp\x12X\n" +
 	"\x11ListClientWorkers\x12 .master.ListClientWorkersRequest\x1a!.master.ListClientWorkersResponse\x12a\n" +
 	"\x14ListClientWireGuards\x12#.master.ListClientWireGuardsRequest\x1a$.master.ListClientWireGuardsResponse\x12;\n" +
-	"\bFRPCAuth\x12\x16.master.FRPAuthRequest\x1a\x17.master.FRPAuthResponse\x12D\n" +
+	"\bFRPCAuth\x12\x16.master.FRPAuthRequest\x1a\x17.master.FRPAuthResponse\x12F\n" +
+	"\vFRPPluginOp\x12\x1a.master.FRPPluginOpRequest\x1a\x1b.master.FRPPluginOpResponse\x12D\n" +
 	"\rPushProxyInfo\x12\x18.master.PushProxyInfoReq\x1a\x19.master.PushProxyInfoResp\x12R\n" +
 	"\x13PushClientStreamLog\x12\x1e.master.PushClientStreamLogReq\x1a\x19.master.PushStreamLogResp(\x01\x12R\n" +
 	"\x13PushServerStreamLog\x12\x1e.master.PushServerStreamLogReq\x1a\x19.master.PushStreamLogResp(\x01\x12D\n" +
@@ -1579,7 +1909,7 @@ func file_rpc_master_proto_rawDescGZIP() []byte {
 }
 
 var file_rpc_master_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_master_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_rpc_master_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_rpc_master_proto_goTypes = []any{
 	(Event)(0),                             // 0: master.Event
 	(*ServerBase)(nil),                     // 1: master.ServerBase
@@ -1592,82 +1922,90 @@ var file_rpc_master_proto_goTypes = []any{
 	(*PullServerConfigResp)(nil),           // 8: master.PullServerConfigResp
 	(*FRPAuthRequest)(nil),                 // 9: master.FRPAuthRequest
 	(*FRPAuthResponse)(nil),                // 10: master.FRPAuthResponse
-	(*PushProxyInfoReq)(nil),               // 11: master.PushProxyInfoReq
-	(*PushProxyInfoResp)(nil),              // 12: master.PushProxyInfoResp
-	(*PushServerStreamLogReq)(nil),         // 13: master.PushServerStreamLogReq
-	(*PushClientStreamLogReq)(nil),         // 14: master.PushClientStreamLogReq
-	(*PushStreamLogResp)(nil),              // 15: master.PushStreamLogResp
-	(*PTYClientMessage)(nil),               // 16: master.PTYClientMessage
-	(*PTYServerMessage)(nil),               // 17: master.PTYServerMessage
-	(*ListClientWorkersRequest)(nil),       // 18: master.ListClientWorkersRequest
-	(*ListClientWorkersResponse)(nil),      // 19: master.ListClientWorkersResponse
-	(*ListClientWireGuardsRequest)(nil),    // 20: master.ListClientWireGuardsRequest
-	(*ListClientWireGuardsResponse)(nil),   // 21: master.ListClientWireGuardsResponse
-	(*ReportWireGuardRuntimeInfoReq)(nil),  // 22: master.ReportWireGuardRuntimeInfoReq
-	(*ReportWireGuardRuntimeInfoResp)(nil), // 23: master.ReportWireGuardRuntimeInfoResp
-	(*Status)(nil),                         // 24: common.Status
-	(*Client)(nil),                         // 25: common.Client
-	(*Server)(nil),                         // 26: common.Server
-	(*ProxyInfo)(nil),                      // 27: common.ProxyInfo
-	(*Worker)(nil),                         // 28: common.Worker
-	(*WireGuardConfig)(nil),                // 29: wireguard.WireGuardConfig
-	(*WGDeviceRuntimeInfo)(nil),            // 30: wireguard.WGDeviceRuntimeInfo
+	(*FRPPluginProxy)(nil),                 // 11: master.FRPPluginProxy
+	(*FRPPluginOpRequest)(nil),             // 12: master.FRPPluginOpRequest
+	(*FRPPluginOpResponse)(nil),            // 13: master.FRPPluginOpResponse
+	(*PushProxyInfoReq)(nil),               // 14: master.PushProxyInfoReq
+	(*PushProxyInfoResp)(nil),              // 15: master.PushProxyInfoResp
+	(*PushServerStreamLogReq)(nil),         // 16: master.PushServerStreamLogReq
+	(*PushClientStreamLogReq)(nil),         // 17: master.PushClientStreamLogReq
+	(*PushStreamLogResp)(nil),              // 18: master.PushStreamLogResp
+	(*PTYClientMessage)(nil),               // 19: master.PTYClientMessage
+	(*PTYServerMessage)(nil),               // 20: master.PTYServerMessage
+	(*ListClientWorkersRequest)(nil),       // 21: master.ListClientWorkersRequest
+	(*ListClientWorkersResponse)(nil),      // 22: master.ListClientWorkersResponse
+	(*ListClientWireGuardsRequest)(nil),    // 23: master.ListClientWireGuardsRequest
+	(*ListClientWireGuardsResponse)(nil),   // 24: master.ListClientWireGuardsResponse
+	(*ReportWireGuardRuntimeInfoReq)(nil),  // 25: master.ReportWireGuardRuntimeInfoReq
+	(*ReportWireGuardRuntimeInfoResp)(nil), // 26: master.ReportWireGuardRuntimeInfoResp
+	(*Status)(nil),                         // 27: common.Status
+	(*Client)(nil),                         // 28: common.Client
+	(*Server)(nil),                         // 29: common.Server
+	(*ProxyInfo)(nil),                      // 30: common.ProxyInfo
+	(*Worker)(nil),                         // 31: common.Worker
+	(*WireGuardConfig)(nil),                // 32: wireguard.WireGuardConfig
+	(*WGDeviceRuntimeInfo)(nil),            // 33: wireguard.WGDeviceRuntimeInfo
 }
 var file_rpc_master_proto_depIdxs = []int32{
 	0,  // 0: master.ServerMessage.event:type_name -> master.Event
 	0,  // 1: master.ClientMessage.event:type_name -> master.Event
 	2,  // 2: master.PullClientConfigReq.base:type_name -> master.ClientBase
-	24, // 3: master.PullClientConfigResp.status:type_name -> common.Status
-	25, // 4: master.PullClientConfigResp.client:type_name -> common.Client
+	27, // 3: master.PullClientConfigResp.status:type_name -> common.Status
+	28, // 4: master.PullClientConfigResp.client:type_name -> common.Client
 	1,  // 5: master.PullServerConfigReq.base:type_name -> master.ServerBase
-	24, // 6: master.PullServerConfigResp.status:type_name -> common.Status
-	26, // 7: master.PullServerConfigResp.server:type_name -> common.Server
+	27, // 6: master.PullServerConfigResp.status:type_name -> common.Status
+	29, // 7: master.PullServerConfigResp.server:type_name -> common.Server
 	1,  // 8: master.FRPAuthRequest.base:type_name -> master.ServerBase
-	24, // 9: master.FRPAuthResponse.status:type_name -> common.Status
-	1,  // 10: master.PushProxyInfoReq.base:type_name -> master.ServerBase
-	27, // 11: master.PushProxyInfoReq.proxy_infos:type_name -> common.ProxyInfo
-	24, // 12: master.PushProxyInfoResp.status:type_name -> common.Status
-	1,  // 13: master.PushServerStreamLogReq.base:type_name -> master.ServerBase
-	2,  // 14: master.PushClientStreamLogReq.base:type_name -> master.ClientBase
-	24, // 15: master.PushStreamLogResp.status:type_name -> common.Status
-	1,  // 16: master.PTYClientMessage.server_base:type_name -> master.ServerBase
-	2,  // 17: master.PTYClientMessage.client_base:type_name -> master.ClientBase
-	2,  // 18: master.ListClientWorkersRequest.base:type_name -> master.ClientBase
-	24, // 19: master.ListClientWorkersResponse.status:type_name -> common.Status
-	28, // 20: master.ListClientWorkersResponse.workers:type_name -> common.Worker
-	2,  // 21: master.ListClientWireGuardsRequest.base:type_name -> master.ClientBase
-	24, // 22: master.ListClientWireGuardsResponse.status:type_name -> common.Status
-	29, // 23: master.ListClientWireGuardsResponse.wireguard_configs:type_name -> wireguard.WireGuardConfig
-	30, // 24: master.ReportWireGuardRuntimeInfoReq.runtime_info:type_name -> wireguard.WGDeviceRuntimeInfo
-	2,  // 25: master.ReportWireGuardRuntimeInfoReq.base:type_name -> master.ClientBase
-	24, // 26: master.ReportWireGuardRuntimeInfoResp.status:type_name -> common.Status
-	4,  // 27: master.Master.ServerSend:input_type -> master.ClientMessage
-	5,  // 28: master.Master.PullClientConfig:input_type -> master.PullClientConfigReq
-	7,  // 29: master.Master.PullServerConfig:input_type -> master.PullServerConfigReq
-	18, // 30: master.Master.ListClientWorkers:input_type -> master.ListClientWorkersRequest
-	20, // 31: master.Master.ListClientWireGuards:input_type -> master.ListClientWireGuardsRequest
-	9,  // 32: master.Master.FRPCAuth:input_type -> master.FRPAuthRequest
-	11, // 33: master.Master.PushProxyInfo:input_type -> master.PushProxyInfoReq
-	14, // 34: master.Master.PushClientStreamLog:input_type -> master.PushClientStreamLogReq
-	13, // 35: master.Master.PushServerStreamLog:input_type -> master.PushServerStreamLogReq
-	16, // 36: master.Master.PTYConnect:input_type -> master.PTYClientMessage
-	22, // 37: master.Master.ReportWireGuardRuntimeInfo:input_type -> master.ReportWireGuardRuntimeInfoReq
-	3,  // 38: master.Master.ServerSend:output_type -> master.ServerMessage
-	6,  // 39: master.Master.PullClientConfig:output_type -> master.PullClientConfigResp
-	8,  // 40: master.Master.PullServerConfig:output_type -> master.PullServerConfigResp
-	19, // 41: master.Master.ListClientWorkers:output_type -> master.ListClientWorkersResponse
-	21, // 42: master.Master.ListClientWireGuards:output_type -> master.ListClientWireGuardsResponse
-	10, // 43: master.Master.FRPCAuth:output_type -> master.FRPAuthResponse
-	12, // 44: master.Master.PushProxyInfo:output_type -> master.PushProxyInfoResp
-	15, // 45: master.Master.PushClientStreamLog:output_type -> master.PushStreamLogResp
-	15, // 46: master.Master.PushServerStreamLog:output_type -> master.PushStreamLogResp
-	17, // 47: master.Master.PTYConnect:output_type -> master.PTYServerMessage
-	23, // 48: master.Master.ReportWireGuardRuntimeInfo:output_type -> master.ReportWireGuardRuntimeInfoResp
-	38, // [38:49] is the sub-list for method output_type
-	27, // [27:38] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	27, // 9: master.FRPAuthResponse.status:type_name -> common.Status
+	11, // 10: master.FRPPluginOpRequest.proxy:type_name -> master.FRPPluginProxy
+	1,  // 11: master.FRPPluginOpRequest.base:type_name -> master.ServerBase
+	27, // 12: master.FRPPluginOpResponse.status:type_name -> common.Status
+	1,  // 13: master.PushProxyInfoReq.base:type_name -> master.ServerBase
+	30, // 14: master.PushProxyInfoReq.proxy_infos:type_name -> common.ProxyInfo
+	27, // 15: master.PushProxyInfoResp.status:type_name -> common.Status
+	1,  // 16: master.PushServerStreamLogReq.base:type_name -> master.ServerBase
+	2,  // 17: master.PushClientStreamLogReq.base:type_name -> master.ClientBase
+	27, // 18: master.PushStreamLogResp.status:type_name -> common.Status
+	1,  // 19: master.PTYClientMessage.server_base:type_name -> master.ServerBase
+	2,  // 20: master.PTYClientMessage.client_base:type_name -> master.ClientBase
+	2,  // 21: master.ListClientWorkersRequest.base:type_name -> master.ClientBase
+	27, // 22: master.ListClientWorkersResponse.status:type_name -> common.Status
+	31, // 23: master.ListClientWorkersResponse.workers:type_name -> common.Worker
+	2,  // 24: master.ListClientWireGuardsRequest.base:type_name -> master.ClientBase
+	27, // 25: master.ListClientWireGuardsResponse.status:type_name -> common.Status
+	32, // 26: master.ListClientWireGuardsResponse.wireguard_configs:type_name -> wireguard.WireGuardConfig
+	33, // 27: master.ReportWireGuardRuntimeInfoReq.runtime_info:type_name -> wireguard.WGDeviceRuntimeInfo
+	2,  // 28: master.ReportWireGuardRuntimeInfoReq.base:type_name -> master.ClientBase
+	27, // 29: master.ReportWireGuardRuntimeInfoResp.status:type_name -> common.Status
+	4,  // 30: master.Master.ServerSend:input_type -> master.ClientMessage
+	5,  // 31: master.Master.PullClientConfig:input_type -> master.PullClientConfigReq
+	7,  // 32: master.Master.PullServerConfig:input_type -> master.PullServerConfigReq
+	21, // 33: master.Master.ListClientWorkers:input_type -> master.ListClientWorkersRequest
+	23, // 34: master.Master.ListClientWireGuards:input_type -> master.ListClientWireGuardsRequest
+	9,  // 35: master.Master.FRPCAuth:input_type -> master.FRPAuthRequest
+	12, // 36: master.Master.FRPPluginOp:input_type -> master.FRPPluginOpRequest
+	14, // 37: master.Master.PushProxyInfo:input_type -> master.PushProxyInfoReq
+	17, // 38: master.Master.PushClientStreamLog:input_type -> master.PushClientStreamLogReq
+	16, // 39: master.Master.PushServerStreamLog:input_type -> master.PushServerStreamLogReq
+	19, // 40: master.Master.PTYConnect:input_type -> master.PTYClientMessage
+	25, // 41: master.Master.ReportWireGuardRuntimeInfo:input_type -> master.ReportWireGuardRuntimeInfoReq
+	3,  // 42: master.Master.ServerSend:output_type -> master.ServerMessage
+	6,  // 43: master.Master.PullClientConfig:output_type -> master.PullClientConfigResp
+	8,  // 44: master.Master.PullServerConfig:output_type -> master.PullServerConfigResp
+	22, // 45: master.Master.ListClientWorkers:output_type -> master.ListClientWorkersResponse
+	24, // 46: master.Master.ListClientWireGuards:output_type -> master.ListClientWireGuardsResponse
+	10, // 47: master.Master.FRPCAuth:output_type -> master.FRPAuthResponse
+	13, // 48: master.Master.FRPPluginOp:output_type -> master.FRPPluginOpResponse
+	15, // 49: master.Master.PushProxyInfo:output_type -> master.PushProxyInfoResp
+	18, // 50: master.Master.PushClientStreamLog:output_type -> master.PushStreamLogResp
+	18, // 51: master.Master.PushServerStreamLog:output_type -> master.PushStreamLogResp
+	20, // 52: master.Master.PTYConnect:output_type -> master.PTYServerMessage
+	26, // 53: master.Master.ReportWireGuardRuntimeInfo:output_type -> master.ReportWireGuardRuntimeInfoResp
+	42, // [42:54] is the sub-list for method output_type
+	30, // [30:42] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_rpc_master_proto_init() }
@@ -1677,19 +2015,22 @@ func file_rpc_master_proto_init() {
 	}
 	file_common_proto_init()
 	file_types_wg_proto_init()
-	file_rpc_master_proto_msgTypes[15].OneofWrappers = []any{
+	file_rpc_master_proto_msgTypes[11].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[15].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[16].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[18].OneofWrappers = []any{
 		(*PTYClientMessage_ServerBase)(nil),
 		(*PTYClientMessage_ClientBase)(nil),
 	}
-	file_rpc_master_proto_msgTypes[16].OneofWrappers = []any{}
-	file_rpc_master_proto_msgTypes[21].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[19].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_master_proto_rawDesc), len(file_rpc_master_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   23,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
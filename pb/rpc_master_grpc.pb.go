@@ -25,6 +25,7 @@ const (
 	Master_ListClientWorkers_FullMethodName          = "/master.Master/ListClientWorkers"
 	Master_ListClientWireGuards_FullMethodName       = "/master.Master/ListClientWireGuards"
 	Master_FRPCAuth_FullMethodName                   = "/master.Master/FRPCAuth"
+	Master_FRPPluginOp_FullMethodName                = "/master.Master/FRPPluginOp"
 	Master_PushProxyInfo_FullMethodName              = "/master.Master/PushProxyInfo"
 	Master_PushClientStreamLog_FullMethodName        = "/master.Master/PushClientStreamLog"
 	Master_PushServerStreamLog_FullMethodName        = "/master.Master/PushServerStreamLog"
@@ -42,6 +43,7 @@ type MasterClient interface {
 	ListClientWorkers(ctx context.Context, in *ListClientWorkersRequest, opts ...grpc.CallOption) (*ListClientWorkersResponse, error)
 	ListClientWireGuards(ctx context.Context, in *ListClientWireGuardsRequest, opts ...grpc.CallOption) (*ListClientWireGuardsResponse, error)
 	FRPCAuth(ctx context.Context, in *FRPAuthRequest, opts ...grpc.CallOption) (*FRPAuthResponse, error)
+	FRPPluginOp(ctx context.Context, in *FRPPluginOpRequest, opts ...grpc.CallOption) (*FRPPluginOpResponse, error)
 	PushProxyInfo(ctx context.Context, in *PushProxyInfoReq, opts ...grpc.CallOption) (*PushProxyInfoResp, error)
 	PushClientStreamLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[PushClientStreamLogReq, PushStreamLogResp], error)
 	PushServerStreamLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[PushServerStreamLogReq, PushStreamLogResp], error)
@@ -120,6 +122,16 @@ func (c *masterClient) FRPCAuth(ctx context.Context, in *FRPAuthRequest, opts ..
 	return out, nil
 }
 
+func (c *masterClient) FRPPluginOp(ctx context.Context, in *FRPPluginOpRequest, opts ...grpc.CallOption) (*FRPPluginOpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FRPPluginOpResponse)
+	err := c.cc.Invoke(ctx, Master_FRPPluginOp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterClient) PushProxyInfo(ctx context.Context, in *PushProxyInfoReq, opts ...grpc.CallOption) (*PushProxyInfoResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PushProxyInfoResp)
@@ -189,6 +201,7 @@ type MasterServer interface {
 	ListClientWorkers(context.Context, *ListClientWorkersRequest) (*ListClientWorkersResponse, error)
 	ListClientWireGuards(context.Context, *ListClientWireGuardsRequest) (*ListClientWireGuardsResponse, error)
 	FRPCAuth(context.Context, *FRPAuthRequest) (*FRPAuthResponse, error)
+	FRPPluginOp(context.Context, *FRPPluginOpRequest) (*FRPPluginOpResponse, error)
 	PushProxyInfo(context.Context, *PushProxyInfoReq) (*PushProxyInfoResp, error)
 	PushClientStreamLog(grpc.ClientStreamingServer[PushClientStreamLogReq, PushStreamLogResp]) error
 	PushServerStreamLog(grpc.ClientStreamingServer[PushServerStreamLogReq, PushStreamLogResp]) error
@@ -222,6 +235,9 @@ func (UnimplementedMasterServer) ListClientWireGuards(context.Context, *ListClie
 func (UnimplementedMasterServer) FRPCAuth(context.Context, *FRPAuthRequest) (*FRPAuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FRPCAuth not implemented")
 }
+func (UnimplementedMasterServer) FRPPluginOp(context.Context, *FRPPluginOpRequest) (*FRPPluginOpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FRPPluginOp not implemented")
+}
 func (UnimplementedMasterServer) PushProxyInfo(context.Context, *PushProxyInfoReq) (*PushProxyInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushProxyInfo not implemented")
 }
@@ -355,6 +371,24 @@ func _Master_FRPCAuth_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Master_FRPPluginOp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FRPPluginOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).FRPPluginOp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_FRPPluginOp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).FRPPluginOp(ctx, req.(*FRPPluginOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Master_PushProxyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushProxyInfoReq)
 	if err := dec(in); err != nil {
@@ -439,6 +473,10 @@ var Master_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FRPCAuth",
 			Handler:    _Master_FRPCAuth_Handler,
 		},
+		{
+			MethodName: "FRPPluginOp",
+			Handler:    _Master_FRPPluginOp_Handler,
+		},
 		{
 			MethodName: "PushProxyInfo",
 			Handler:    _Master_PushProxyInfo_Handler,
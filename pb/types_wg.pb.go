@@ -190,6 +190,13 @@ type WireGuardConfig struct {
 	WsListenPort        uint32                     `protobuf:"varint,15,opt,name=ws_listen_port,json=wsListenPort,proto3" json:"ws_listen_port,omitempty"`                                     // (可选) WebSocket 监听端口，如果没有配置，则使用默认端口
 	UseGvisorNet        bool                       `protobuf:"varint,16,opt,name=use_gvisor_net,json=useGvisorNet,proto3" json:"use_gvisor_net,omitempty"`                                     // (可选) 是否使用 gvisor netstack，环境变量中的ture可以覆盖该配置
 	Adjs                map[uint32]*WireGuardLinks `protobuf:"bytes,17,rep,name=adjs,proto3" json:"adjs,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 当前网络内所有节点ID->Edge 列表，全量图结构
+	Acl                 *AclConfig                 `protobuf:"bytes,18,opt,name=acl,proto3" json:"acl,omitempty"`                                                                              // 节点数据面执行的 ACL，标签已展开为 CIDR，为空时不过滤
+	NetworkName         string                     `protobuf:"bytes,19,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`                                           // 归属网络名称，用于 MagicDNS 域名
+	DnsRecords          []*WireGuardDNSRecord      `protobuf:"bytes,20,rep,name=dns_records,json=dnsRecords,proto3" json:"dns_records,omitempty"`                                              // 网络内所有节点的名称与虚拟 IP，用于 MagicDNS
+	AdvertisedRoutes    []string                   `protobuf:"bytes,21,rep,name=advertised_routes,json=advertisedRoutes,proto3" json:"advertised_routes,omitempty"`                            // (可选) 通告给网络的子网，本节点作为子网路由转发并做 NAT
+	ExitNode            bool                       `protobuf:"varint,22,opt,name=exit_node,json=exitNode,proto3" json:"exit_node,omitempty"`                                                   // (可选) 作为出口节点通告 0.0.0.0/0
+	UseExitNode         bool                       `protobuf:"varint,23,opt,name=use_exit_node,json=useExitNode,proto3" json:"use_exit_node,omitempty"`                                        // (可选) 是否经由出口节点访问公网
+	AcceptRoutes        bool                       `protobuf:"varint,24,opt,name=accept_routes,json=acceptRoutes,proto3" json:"accept_routes,omitempty"`                                       // (可选) 是否接收其他节点通告的子网路由
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -343,6 +350,115 @@ func (x *WireGuardConfig) GetAdjs() map[uint32]*WireGuardLinks {
 	return nil
 }
 
+func (x *WireGuardConfig) GetAcl() *AclConfig {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+func (x *WireGuardConfig) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
+func (x *WireGuardConfig) GetDnsRecords() []*WireGuardDNSRecord {
+	if x != nil {
+		return x.DnsRecords
+	}
+	return nil
+}
+
+func (x *WireGuardConfig) GetAdvertisedRoutes() []string {
+	if x != nil {
+		return x.AdvertisedRoutes
+	}
+	return nil
+}
+
+func (x *WireGuardConfig) GetExitNode() bool {
+	if x != nil {
+		return x.ExitNode
+	}
+	return false
+}
+
+func (x *WireGuardConfig) GetUseExitNode() bool {
+	if x != nil {
+		return x.UseExitNode
+	}
+	return false
+}
+
+func (x *WireGuardConfig) GetAcceptRoutes() bool {
+	if x != nil {
+		return x.AcceptRoutes
+	}
+	return false
+}
+
+type WireGuardDNSRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // WireGuard 名称
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"` // 外部设备为空
+	VirtualIp     string                 `protobuf:"bytes,3,opt,name=virtual_ip,json=virtualIp,proto3" json:"virtual_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireGuardDNSRecord) Reset() {
+	*x = WireGuardDNSRecord{}
+	mi := &file_types_wg_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireGuardDNSRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardDNSRecord) ProtoMessage() {}
+
+func (x *WireGuardDNSRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_types_wg_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardDNSRecord.ProtoReflect.Descriptor instead.
+func (*WireGuardDNSRecord) Descriptor() ([]byte, []int) {
+	return file_types_wg_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WireGuardDNSRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WireGuardDNSRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *WireGuardDNSRecord) GetVirtualIp() string {
+	if x != nil {
+		return x.VirtualIp
+	}
+	return ""
+}
+
 type Endpoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -358,7 +474,7 @@ type Endpoint struct {
 
 func (x *Endpoint) Reset() {
 	*x = Endpoint{}
-	mi := &file_types_wg_proto_msgTypes[2]
+	mi := &file_types_wg_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +486,7 @@ func (x *Endpoint) String() string {
 func (*Endpoint) ProtoMessage() {}
 
 func (x *Endpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[2]
+	mi := &file_types_wg_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +499,7 @@ func (x *Endpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
 func (*Endpoint) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{2}
+	return file_types_wg_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Endpoint) GetId() uint32 {
@@ -452,7 +568,7 @@ type WireGuardLink struct {
 
 func (x *WireGuardLink) Reset() {
 	*x = WireGuardLink{}
-	mi := &file_types_wg_proto_msgTypes[3]
+	mi := &file_types_wg_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +580,7 @@ func (x *WireGuardLink) String() string {
 func (*WireGuardLink) ProtoMessage() {}
 
 func (x *WireGuardLink) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[3]
+	mi := &file_types_wg_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +593,7 @@ func (x *WireGuardLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireGuardLink.ProtoReflect.Descriptor instead.
 func (*WireGuardLink) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{3}
+	return file_types_wg_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WireGuardLink) GetId() uint32 {
@@ -552,7 +668,7 @@ type WireGuardLinks struct {
 
 func (x *WireGuardLinks) Reset() {
 	*x = WireGuardLinks{}
-	mi := &file_types_wg_proto_msgTypes[4]
+	mi := &file_types_wg_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +680,7 @@ func (x *WireGuardLinks) String() string {
 func (*WireGuardLinks) ProtoMessage() {}
 
 func (x *WireGuardLinks) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[4]
+	mi := &file_types_wg_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +693,7 @@ func (x *WireGuardLinks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireGuardLinks.ProtoReflect.Descriptor instead.
 func (*WireGuardLinks) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{4}
+	return file_types_wg_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WireGuardLinks) GetLinks() []*WireGuardLink {
@@ -601,7 +717,7 @@ type Network struct {
 
 func (x *Network) Reset() {
 	*x = Network{}
-	mi := &file_types_wg_proto_msgTypes[5]
+	mi := &file_types_wg_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -613,7 +729,7 @@ func (x *Network) String() string {
 func (*Network) ProtoMessage() {}
 
 func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[5]
+	mi := &file_types_wg_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +742,7 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Network.ProtoReflect.Descriptor instead.
 func (*Network) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{5}
+	return file_types_wg_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Network) GetId() uint32 {
@@ -680,7 +796,7 @@ type AclConfig struct {
 
 func (x *AclConfig) Reset() {
 	*x = AclConfig{}
-	mi := &file_types_wg_proto_msgTypes[6]
+	mi := &file_types_wg_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +808,7 @@ func (x *AclConfig) String() string {
 func (*AclConfig) ProtoMessage() {}
 
 func (x *AclConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[6]
+	mi := &file_types_wg_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +821,7 @@ func (x *AclConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AclConfig.ProtoReflect.Descriptor instead.
 func (*AclConfig) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{6}
+	return file_types_wg_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AclConfig) GetAcls() []*AclRuleConfig {
@@ -717,16 +833,18 @@ func (x *AclConfig) GetAcls() []*AclRuleConfig {
 
 type AclRuleConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // accept or deny
-	Src           []string               `protobuf:"bytes,2,rep,name=src,proto3" json:"src,omitempty"`
-	Dst           []string               `protobuf:"bytes,3,rep,name=dst,proto3" json:"dst,omitempty"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`     // accept or deny
+	Src           []string               `protobuf:"bytes,2,rep,name=src,proto3" json:"src,omitempty"`           // 标签或 CIDR/IP
+	Dst           []string               `protobuf:"bytes,3,rep,name=dst,proto3" json:"dst,omitempty"`           // 标签或 CIDR/IP
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"` // (可选) tcp/udp/icmp，为空表示全部协议
+	Ports         []string               `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`       // (可选) 目的端口，支持 80 或 8000-9000，为空表示全部端口
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AclRuleConfig) Reset() {
 	*x = AclRuleConfig{}
-	mi := &file_types_wg_proto_msgTypes[7]
+	mi := &file_types_wg_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +856,7 @@ func (x *AclRuleConfig) String() string {
 func (*AclRuleConfig) ProtoMessage() {}
 
 func (x *AclRuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[7]
+	mi := &file_types_wg_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +869,7 @@ func (x *AclRuleConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AclRuleConfig.ProtoReflect.Descriptor instead.
 func (*AclRuleConfig) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{7}
+	return file_types_wg_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AclRuleConfig) GetAction() string {
@@ -775,6 +893,20 @@ func (x *AclRuleConfig) GetDst() []string {
 	return nil
 }
 
+func (x *AclRuleConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *AclRuleConfig) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
 type WGPeerRuntimeInfo struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	PublicKey    string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
@@ -796,7 +928,7 @@ type WGPeerRuntimeInfo struct {
 
 func (x *WGPeerRuntimeInfo) Reset() {
 	*x = WGPeerRuntimeInfo{}
-	mi := &file_types_wg_proto_msgTypes[8]
+	mi := &file_types_wg_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +940,7 @@ func (x *WGPeerRuntimeInfo) String() string {
 func (*WGPeerRuntimeInfo) ProtoMessage() {}
 
 func (x *WGPeerRuntimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[8]
+	mi := &file_types_wg_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +953,7 @@ func (x *WGPeerRuntimeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WGPeerRuntimeInfo.ProtoReflect.Descriptor instead.
 func (*WGPeerRuntimeInfo) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{8}
+	return file_types_wg_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WGPeerRuntimeInfo) GetPublicKey() string {
@@ -902,27 +1034,28 @@ func (x *WGPeerRuntimeInfo) GetExtra() map[string]string {
 }
 
 type WGDeviceRuntimeInfo struct {
-	state           protoimpl.MessageState          `protogen:"open.v1"`
-	PrivateKey      string                          `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	ListenPort      uint32                          `protobuf:"varint,2,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
-	Peers           []*WGPeerRuntimeInfo            `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
-	ProtocolVersion uint32                          `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	Errno           int32                           `protobuf:"varint,5,opt,name=errno,proto3" json:"errno,omitempty"`
-	ClientId        string                          `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	PingMap         map[uint32]uint32               `protobuf:"bytes,7,rep,name=ping_map,json=pingMap,proto3" json:"ping_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // to peer endpoint ping
-	InterfaceName   string                          `protobuf:"bytes,8,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
-	VirtAddrPingMap map[string]uint32               `protobuf:"bytes,9,rep,name=virt_addr_ping_map,json=virtAddrPingMap,proto3" json:"virt_addr_ping_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`  // to peer virtual address ping
-	PeerVirtAddrMap map[string]uint32               `protobuf:"bytes,10,rep,name=peer_virt_addr_map,json=peerVirtAddrMap,proto3" json:"peer_virt_addr_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // to peer virtual address map
-	PeerConfigMap   map[string]*WireGuardPeerConfig `protobuf:"bytes,11,rep,name=peer_config_map,json=peerConfigMap,proto3" json:"peer_config_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`          // to peer config map
-	VirtualIp       string                          `protobuf:"bytes,12,opt,name=virtual_ip,json=virtualIp,proto3" json:"virtual_ip,omitempty"`                                                                                                  // 节点虚拟 IP
-	Extra           map[string]string               `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState          `protogen:"open.v1"`
+	PrivateKey        string                          `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	ListenPort        uint32                          `protobuf:"varint,2,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	Peers             []*WGPeerRuntimeInfo            `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	ProtocolVersion   uint32                          `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Errno             int32                           `protobuf:"varint,5,opt,name=errno,proto3" json:"errno,omitempty"`
+	ClientId          string                          `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PingMap           map[uint32]uint32               `protobuf:"bytes,7,rep,name=ping_map,json=pingMap,proto3" json:"ping_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // to peer endpoint ping
+	InterfaceName     string                          `protobuf:"bytes,8,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	VirtAddrPingMap   map[string]uint32               `protobuf:"bytes,9,rep,name=virt_addr_ping_map,json=virtAddrPingMap,proto3" json:"virt_addr_ping_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`  // to peer virtual address ping
+	PeerVirtAddrMap   map[string]uint32               `protobuf:"bytes,10,rep,name=peer_virt_addr_map,json=peerVirtAddrMap,proto3" json:"peer_virt_addr_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // to peer virtual address map
+	PeerConfigMap     map[string]*WireGuardPeerConfig `protobuf:"bytes,11,rep,name=peer_config_map,json=peerConfigMap,proto3" json:"peer_config_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`          // to peer config map
+	VirtualIp         string                          `protobuf:"bytes,12,opt,name=virtual_ip,json=virtualIp,proto3" json:"virtual_ip,omitempty"`                                                                                                  // 节点虚拟 IP
+	ReflexiveEndpoint string                          `protobuf:"bytes,13,opt,name=reflexive_endpoint,json=reflexiveEndpoint,proto3" json:"reflexive_endpoint,omitempty"`                                                                          // STUN 探测到的 NAT 映射地址 ip:port，没有公网 endpoint 的节点据此打洞
+	Extra             map[string]string               `protobuf:"bytes,100,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *WGDeviceRuntimeInfo) Reset() {
 	*x = WGDeviceRuntimeInfo{}
-	mi := &file_types_wg_proto_msgTypes[9]
+	mi := &file_types_wg_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1067,7 @@ func (x *WGDeviceRuntimeInfo) String() string {
 func (*WGDeviceRuntimeInfo) ProtoMessage() {}
 
 func (x *WGDeviceRuntimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_types_wg_proto_msgTypes[9]
+	mi := &file_types_wg_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1080,7 @@ func (x *WGDeviceRuntimeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WGDeviceRuntimeInfo.ProtoReflect.Descriptor instead.
 func (*WGDeviceRuntimeInfo) Descriptor() ([]byte, []int) {
-	return file_types_wg_proto_rawDescGZIP(), []int{9}
+	return file_types_wg_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WGDeviceRuntimeInfo) GetPrivateKey() string {
@@ -1034,6 +1167,13 @@ func (x *WGDeviceRuntimeInfo) GetVirtualIp() string {
 	return ""
 }
 
+func (x *WGDeviceRuntimeInfo) GetReflexiveEndpoint() string {
+	if x != nil {
+		return x.ReflexiveEndpoint
+	}
+	return ""
+}
+
 func (x *WGDeviceRuntimeInfo) GetExtra() map[string]string {
 	if x != nil {
 		return x.Extra
@@ -1041,6 +1181,123 @@ func (x *WGDeviceRuntimeInfo) GetExtra() map[string]string {
 	return nil
 }
 
+// WireGuardExternalPeer 不运行 frpp 的外部设备（手机、笔记本等），通过中继节点接入网络
+type WireGuardExternalPeer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId         uint32                 `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	NetworkId        uint32                 `protobuf:"varint,5,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RelayWireguardId uint32                 `protobuf:"varint,6,opt,name=relay_wireguard_id,json=relayWireguardId,proto3" json:"relay_wireguard_id,omitempty"` // 中继节点的 WireGuard ID
+	PublicKey        string                 `protobuf:"bytes,7,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`                         // (可选) 设备自带的公钥，为空时由面板生成密钥对
+	LocalAddress     string                 `protobuf:"bytes,8,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`                // (可选) 期望的虚拟地址，为空时自动分配
+	DnsServers       []string               `protobuf:"bytes,9,rep,name=dns_servers,json=dnsServers,proto3" json:"dns_servers,omitempty"`                      // (可选) DNS 服务器列表
+	AllowedIps       []string               `protobuf:"bytes,10,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`                     // (可选) 设备经中继访问的网段，为空时使用网络 CIDR
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WireGuardExternalPeer) Reset() {
+	*x = WireGuardExternalPeer{}
+	mi := &file_types_wg_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WireGuardExternalPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardExternalPeer) ProtoMessage() {}
+
+func (x *WireGuardExternalPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_types_wg_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardExternalPeer.ProtoReflect.Descriptor instead.
+func (*WireGuardExternalPeer) Descriptor() ([]byte, []int) {
+	return file_types_wg_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WireGuardExternalPeer) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WireGuardExternalPeer) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WireGuardExternalPeer) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *WireGuardExternalPeer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WireGuardExternalPeer) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *WireGuardExternalPeer) GetRelayWireguardId() uint32 {
+	if x != nil {
+		return x.RelayWireguardId
+	}
+	return 0
+}
+
+func (x *WireGuardExternalPeer) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WireGuardExternalPeer) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *WireGuardExternalPeer) GetDnsServers() []string {
+	if x != nil {
+		return x.DnsServers
+	}
+	return nil
+}
+
+func (x *WireGuardExternalPeer) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
 var File_types_wg_proto protoreflect.FileDescriptor
 
 const file_types_wg_proto_rawDesc = "" +
@@ -1065,7 +1322,7 @@ const file_types_wg_proto_rawDesc = "" +
 	"\vlisten_port\x18\f \x01(\rR\n" +
 	"listenPort\x12$\n" +
 	"\x0ews_listen_port\x18\r \x01(\rR\fwsListenPort\x12$\n" +
-	"\x0euse_gvisor_net\x18\x0e \x01(\bR\fuseGvisorNet\"\xd3\x05\n" +
+	"\x0euse_gvisor_net\x18\x0e \x01(\bR\fuseGvisorNet\"\xf1\a\n" +
 	"\x0fWireGuardConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
 	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x17\n" +
@@ -1088,10 +1345,23 @@ const file_types_wg_proto_rawDesc = "" +
 	"\x04tags\x18\x0e \x03(\tR\x04tags\x12$\n" +
 	"\x0ews_listen_port\x18\x0f \x01(\rR\fwsListenPort\x12$\n" +
 	"\x0euse_gvisor_net\x18\x10 \x01(\bR\fuseGvisorNet\x128\n" +
-	"\x04adjs\x18\x11 \x03(\v2$.wireguard.WireGuardConfig.AdjsEntryR\x04adjs\x1aR\n" +
+	"\x04adjs\x18\x11 \x03(\v2$.wireguard.WireGuardConfig.AdjsEntryR\x04adjs\x12&\n" +
+	"\x03acl\x18\x12 \x01(\v2\x14.wireguard.AclConfigR\x03acl\x12!\n" +
+	"\fnetwork_name\x18\x13 \x01(\tR\vnetworkName\x12>\n" +
+	"\vdns_records\x18\x14 \x03(\v2\x1d.wireguard.WireGuardDNSRecordR\n" +
+	"dnsRecords\x12+\n" +
+	"\x11advertised_routes\x18\x15 \x03(\tR\x10advertisedRoutes\x12\x1b\n" +
+	"\texit_node\x18\x16 \x01(\bR\bexitNode\x12\"\n" +
+	"\ruse_exit_node\x18\x17 \x01(\bR\vuseExitNode\x12#\n" +
+	"\raccept_routes\x18\x18 \x01(\bR\facceptRoutes\x1aR\n" +
 	"\tAdjsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.wireguard.WireGuardLinksR\x05value:\x028\x01\"\xa8\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.wireguard.WireGuardLinksR\x05value:\x028\x01\"d\n" +
+	"\x12WireGuardDNSRecord\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"virtual_ip\x18\x03 \x01(\tR\tvirtualIp\"\xa8\x01\n" +
 	"\bEndpoint\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
@@ -1122,11 +1392,13 @@ const file_types_wg_proto_rawDesc = "" +
 	"\x04cidr\x18\x05 \x01(\tR\x04cidr\x12&\n" +
 	"\x03acl\x18\x06 \x01(\v2\x14.wireguard.AclConfigR\x03acl\"9\n" +
 	"\tAclConfig\x12,\n" +
-	"\x04acls\x18\x01 \x03(\v2\x18.wireguard.AclRuleConfigR\x04acls\"K\n" +
+	"\x04acls\x18\x01 \x03(\v2\x18.wireguard.AclRuleConfigR\x04acls\"}\n" +
 	"\rAclRuleConfig\x12\x16\n" +
 	"\x06action\x18\x01 \x01(\tR\x06action\x12\x10\n" +
 	"\x03src\x18\x02 \x03(\tR\x03src\x12\x10\n" +
-	"\x03dst\x18\x03 \x03(\tR\x03dst\"\x94\x04\n" +
+	"\x03dst\x18\x03 \x03(\tR\x03dst\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x14\n" +
+	"\x05ports\x18\x05 \x03(\tR\x05ports\"\x94\x04\n" +
 	"\x11WGPeerRuntimeInfo\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12#\n" +
@@ -1145,7 +1417,7 @@ const file_types_wg_proto_rawDesc = "" +
 	"\n" +
 	"ExtraEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\b\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe6\b\n" +
 	"\x13WGDeviceRuntimeInfo\x12\x1f\n" +
 	"\vprivate_key\x18\x01 \x01(\tR\n" +
 	"privateKey\x12\x1f\n" +
@@ -1162,7 +1434,8 @@ const file_types_wg_proto_rawDesc = "" +
 	" \x03(\v23.wireguard.WGDeviceRuntimeInfo.PeerVirtAddrMapEntryR\x0fpeerVirtAddrMap\x12Y\n" +
 	"\x0fpeer_config_map\x18\v \x03(\v21.wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntryR\rpeerConfigMap\x12\x1d\n" +
 	"\n" +
-	"virtual_ip\x18\f \x01(\tR\tvirtualIp\x12?\n" +
+	"virtual_ip\x18\f \x01(\tR\tvirtualIp\x12-\n" +
+	"\x12reflexive_endpoint\x18\r \x01(\tR\x11reflexiveEndpoint\x12?\n" +
 	"\x05extra\x18d \x03(\v2).wireguard.WGDeviceRuntimeInfo.ExtraEntryR\x05extra\x1a:\n" +
 	"\fPingMapEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\rR\x03key\x12\x14\n" +
@@ -1179,7 +1452,23 @@ const file_types_wg_proto_rawDesc = "" +
 	"\n" +
 	"ExtraEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\aZ\x05../pbb\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc4\x02\n" +
+	"\x15WireGuardExternalPeer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\rR\btenantId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x05 \x01(\rR\tnetworkId\x12,\n" +
+	"\x12relay_wireguard_id\x18\x06 \x01(\rR\x10relayWireguardId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\a \x01(\tR\tpublicKey\x12#\n" +
+	"\rlocal_address\x18\b \x01(\tR\flocalAddress\x12\x1f\n" +
+	"\vdns_servers\x18\t \x03(\tR\n" +
+	"dnsServers\x12\x1f\n" +
+	"\vallowed_ips\x18\n" +
+	" \x03(\tR\n" +
+	"allowedIpsB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_types_wg_proto_rawDescOnce sync.Once
@@ -1193,49 +1482,53 @@ func file_types_wg_proto_rawDescGZIP() []byte {
 	return file_types_wg_proto_rawDescData
 }
 
-var file_types_wg_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_types_wg_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_types_wg_proto_goTypes = []any{
-	(*WireGuardPeerConfig)(nil), // 0: wireguard.WireGuardPeerConfig
-	(*WireGuardConfig)(nil),     // 1: wireguard.WireGuardConfig
-	(*Endpoint)(nil),            // 2: wireguard.Endpoint
-	(*WireGuardLink)(nil),       // 3: wireguard.WireGuardLink
-	(*WireGuardLinks)(nil),      // 4: wireguard.WireGuardLinks
-	(*Network)(nil),             // 5: wireguard.Network
-	(*AclConfig)(nil),           // 6: wireguard.AclConfig
-	(*AclRuleConfig)(nil),       // 7: wireguard.AclRuleConfig
-	(*WGPeerRuntimeInfo)(nil),   // 8: wireguard.WGPeerRuntimeInfo
-	(*WGDeviceRuntimeInfo)(nil), // 9: wireguard.WGDeviceRuntimeInfo
-	nil,                         // 10: wireguard.WireGuardConfig.AdjsEntry
-	nil,                         // 11: wireguard.WGPeerRuntimeInfo.ExtraEntry
-	nil,                         // 12: wireguard.WGDeviceRuntimeInfo.PingMapEntry
-	nil,                         // 13: wireguard.WGDeviceRuntimeInfo.VirtAddrPingMapEntry
-	nil,                         // 14: wireguard.WGDeviceRuntimeInfo.PeerVirtAddrMapEntry
-	nil,                         // 15: wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry
-	nil,                         // 16: wireguard.WGDeviceRuntimeInfo.ExtraEntry
+	(*WireGuardPeerConfig)(nil),   // 0: wireguard.WireGuardPeerConfig
+	(*WireGuardConfig)(nil),       // 1: wireguard.WireGuardConfig
+	(*WireGuardDNSRecord)(nil),    // 2: wireguard.WireGuardDNSRecord
+	(*Endpoint)(nil),              // 3: wireguard.Endpoint
+	(*WireGuardLink)(nil),         // 4: wireguard.WireGuardLink
+	(*WireGuardLinks)(nil),        // 5: wireguard.WireGuardLinks
+	(*Network)(nil),               // 6: wireguard.Network
+	(*AclConfig)(nil),             // 7: wireguard.AclConfig
+	(*AclRuleConfig)(nil),         // 8: wireguard.AclRuleConfig
+	(*WGPeerRuntimeInfo)(nil),     // 9: wireguard.WGPeerRuntimeInfo
+	(*WGDeviceRuntimeInfo)(nil),   // 10: wireguard.WGDeviceRuntimeInfo
+	(*WireGuardExternalPeer)(nil), // 11: wireguard.WireGuardExternalPeer
+	nil,                           // 12: wireguard.WireGuardConfig.AdjsEntry
+	nil,                           // 13: wireguard.WGPeerRuntimeInfo.ExtraEntry
+	nil,                           // 14: wireguard.WGDeviceRuntimeInfo.PingMapEntry
+	nil,                           // 15: wireguard.WGDeviceRuntimeInfo.VirtAddrPingMapEntry
+	nil,                           // 16: wireguard.WGDeviceRuntimeInfo.PeerVirtAddrMapEntry
+	nil,                           // 17: wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry
+	nil,                           // 18: wireguard.WGDeviceRuntimeInfo.ExtraEntry
 }
 var file_types_wg_proto_depIdxs = []int32{
-	2,  // 0: wireguard.WireGuardPeerConfig.endpoint:type_name -> wireguard.Endpoint
+	3,  // 0: wireguard.WireGuardPeerConfig.endpoint:type_name -> wireguard.Endpoint
 	0,  // 1: wireguard.WireGuardConfig.peers:type_name -> wireguard.WireGuardPeerConfig
-	2,  // 2: wireguard.WireGuardConfig.advertised_endpoints:type_name -> wireguard.Endpoint
-	10, // 3: wireguard.WireGuardConfig.adjs:type_name -> wireguard.WireGuardConfig.AdjsEntry
-	2,  // 4: wireguard.WireGuardLink.to_endpoint:type_name -> wireguard.Endpoint
-	3,  // 5: wireguard.WireGuardLinks.links:type_name -> wireguard.WireGuardLink
-	6,  // 6: wireguard.Network.acl:type_name -> wireguard.AclConfig
-	7,  // 7: wireguard.AclConfig.acls:type_name -> wireguard.AclRuleConfig
-	11, // 8: wireguard.WGPeerRuntimeInfo.extra:type_name -> wireguard.WGPeerRuntimeInfo.ExtraEntry
-	8,  // 9: wireguard.WGDeviceRuntimeInfo.peers:type_name -> wireguard.WGPeerRuntimeInfo
-	12, // 10: wireguard.WGDeviceRuntimeInfo.ping_map:type_name -> wireguard.WGDeviceRuntimeInfo.PingMapEntry
-	13, // 11: wireguard.WGDeviceRuntimeInfo.virt_addr_ping_map:type_name -> wireguard.WGDeviceRuntimeInfo.VirtAddrPingMapEntry
-	14, // 12: wireguard.WGDeviceRuntimeInfo.peer_virt_addr_map:type_name -> wireguard.WGDeviceRuntimeInfo.PeerVirtAddrMapEntry
-	15, // 13: wireguard.WGDeviceRuntimeInfo.peer_config_map:type_name -> wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry
-	16, // 14: wireguard.WGDeviceRuntimeInfo.extra:type_name -> wireguard.WGDeviceRuntimeInfo.ExtraEntry
-	4,  // 15: wireguard.WireGuardConfig.AdjsEntry.value:type_name -> wireguard.WireGuardLinks
-	0,  // 16: wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry.value:type_name -> wireguard.WireGuardPeerConfig
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	3,  // 2: wireguard.WireGuardConfig.advertised_endpoints:type_name -> wireguard.Endpoint
+	12, // 3: wireguard.WireGuardConfig.adjs:type_name -> wireguard.WireGuardConfig.AdjsEntry
+	7,  // 4: wireguard.WireGuardConfig.acl:type_name -> wireguard.AclConfig
+	2,  // 5: wireguard.WireGuardConfig.dns_records:type_name -> wireguard.WireGuardDNSRecord
+	3,  // 6: wireguard.WireGuardLink.to_endpoint:type_name -> wireguard.Endpoint
+	4,  // 7: wireguard.WireGuardLinks.links:type_name -> wireguard.WireGuardLink
+	7,  // 8: wireguard.Network.acl:type_name -> wireguard.AclConfig
+	8,  // 9: wireguard.AclConfig.acls:type_name -> wireguard.AclRuleConfig
+	13, // 10: wireguard.WGPeerRuntimeInfo.extra:type_name -> wireguard.WGPeerRuntimeInfo.ExtraEntry
+	9,  // 11: wireguard.WGDeviceRuntimeInfo.peers:type_name -> wireguard.WGPeerRuntimeInfo
+	14, // 12: wireguard.WGDeviceRuntimeInfo.ping_map:type_name -> wireguard.WGDeviceRuntimeInfo.PingMapEntry
+	15, // 13: wireguard.WGDeviceRuntimeInfo.virt_addr_ping_map:type_name -> wireguard.WGDeviceRuntimeInfo.VirtAddrPingMapEntry
+	16, // 14: wireguard.WGDeviceRuntimeInfo.peer_virt_addr_map:type_name -> wireguard.WGDeviceRuntimeInfo.PeerVirtAddrMapEntry
+	17, // 15: wireguard.WGDeviceRuntimeInfo.peer_config_map:type_name -> wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry
+	18, // 16: wireguard.WGDeviceRuntimeInfo.extra:type_name -> wireguard.WGDeviceRuntimeInfo.ExtraEntry
+	5,  // 17: wireguard.WireGuardConfig.AdjsEntry.value:type_name -> wireguard.WireGuardLinks
+	0,  // 18: wireguard.WGDeviceRuntimeInfo.PeerConfigMapEntry.value:type_name -> wireguard.WireGuardPeerConfig
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_types_wg_proto_init() }
@@ -1249,7 +1542,7 @@ func file_types_wg_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_types_wg_proto_rawDesc), len(file_types_wg_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils"
+)
+
+// EmailConfig smtp 邮件渠道，TLS 为 true 时使用隐式 TLS（通常是 465 端口），否则在服务器支持时使用 STARTTLS
+type EmailConfig struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	TLS      bool     `json:"tls"`
+}
+
+// headerReplacer 去掉换行，避免规则名称注入邮件头
+var headerReplacer = strings.NewReplacer("\r", "", "\n", " ")
+
+// emailPorts 只允许常见的 smtp 端口，避免把邮件渠道当作任意端口的探测工具
+var emailPorts = map[int]bool{25: true, 465: true, 587: true, 2525: true}
+
+type emailNotifier struct {
+	cfg EmailConfig
+}
+
+func newEmailNotifier(cfg EmailConfig) (*emailNotifier, error) {
+	if len(cfg.Host) == 0 || len(cfg.From) == 0 || len(cfg.To) == 0 {
+		return nil, fmt.Errorf("host, from and to are required")
+	}
+	if net.ParseIP(cfg.Host) == nil && strings.ContainsAny(cfg.Host, ":/@ ") {
+		return nil, fmt.Errorf("invalid smtp host: [%s]", cfg.Host)
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 25
+		if cfg.TLS {
+			cfg.Port = 465
+		}
+	}
+	if !emailPorts[cfg.Port] {
+		return nil, fmt.Errorf("smtp port [%d] is not allowed", cfg.Port)
+	}
+	return &emailNotifier{cfg: cfg}, nil
+}
+
+func (e *emailNotifier) message(n *Notification) []byte {
+	headers := []string{
+		"From: " + e.cfg.From,
+		"To: " + strings.Join(e.cfg.To, ", "),
+		"Subject: " + headerReplacer.Replace(n.Title()),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	body := strings.ReplaceAll(n.Text(), "\n", "\r\n")
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + body + "\r\n")
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, n *Notification) error {
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+	// 拨号时检查解析后的地址，不允许连到内网
+	dialer := utils.NewOutboundDialer(utils.OutboundTimeout)
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	if e.cfg.TLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: e.cfg.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(utils.OutboundTimeout))
+
+	c, err := smtp.NewClient(conn, e.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if !e.cfg.TLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: e.cfg.Host}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(e.cfg.Username) > 0 {
+		if err := c.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(e.cfg.From); err != nil {
+		return err
+	}
+	for _, to := range e.cfg.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(e.message(n)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
@@ -0,0 +1,90 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+// Notification 一次告警或恢复通知的内容
+type Notification struct {
+	RuleID     uint      `json:"rule_id"`
+	RuleName   string    `json:"rule_name"`
+	Condition  string    `json:"condition"`
+	Status     string    `json:"status"` // firing, resolved
+	ObjectKey  string    `json:"object_key"`
+	ObjectName string    `json:"object_name"`
+	Value      float64   `json:"value"`
+	Threshold  float64   `json:"threshold"`
+	StartedAt  time.Time `json:"started_at"`
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+}
+
+// Title 通知标题，如 [FIRING] rule name
+func (n *Notification) Title() string {
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(n.Status), n.RuleName)
+}
+
+// Text 纯文本的通知正文，供邮件与 IM 渠道使用
+func (n *Notification) Text() string {
+	lines := []string{
+		n.Title(),
+		fmt.Sprintf("condition: %s", n.Condition),
+		fmt.Sprintf("object: %s", n.ObjectName),
+		fmt.Sprintf("value: %g", n.Value),
+	}
+	if n.Threshold > 0 {
+		lines = append(lines, fmt.Sprintf("threshold: %g", n.Threshold))
+	}
+	lines = append(lines, fmt.Sprintf("started at: %s", n.StartedAt.Format(time.RFC3339)))
+	if !n.ResolvedAt.IsZero() {
+		lines = append(lines, fmt.Sprintf("resolved at: %s", n.ResolvedAt.Format(time.RFC3339)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// NewNotifier 按渠道类型解析 json 配置并创建通知器
+func NewNotifier(channelType string, config []byte) (Notifier, error) {
+	decode := func(v any) error {
+		if err := json.Unmarshal(config, v); err != nil {
+			return fmt.Errorf("invalid %s channel config: %v", channelType, err)
+		}
+		return nil
+	}
+
+	switch channelType {
+	case models.AlertChannelTypeWebhook:
+		cfg := WebhookConfig{}
+		if err := decode(&cfg); err != nil {
+			return nil, err
+		}
+		return newWebhookNotifier(cfg)
+	case models.AlertChannelTypeTelegram:
+		cfg := TelegramConfig{}
+		if err := decode(&cfg); err != nil {
+			return nil, err
+		}
+		return newTelegramNotifier(cfg)
+	case models.AlertChannelTypeDingTalk:
+		cfg := DingTalkConfig{}
+		if err := decode(&cfg); err != nil {
+			return nil, err
+		}
+		return newDingTalkNotifier(cfg)
+	case models.AlertChannelTypeEmail:
+		cfg := EmailConfig{}
+		if err := decode(&cfg); err != nil {
+			return nil, err
+		}
+		return newEmailNotifier(cfg)
+	}
+	return nil, fmt.Errorf("unsupported channel type: %s", channelType)
+}
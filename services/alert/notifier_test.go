@@ -0,0 +1,144 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// allowLoopback 测试服务器监听在回环地址上，需要临时放开出站限制
+func allowLoopback(t *testing.T) {
+	utils.SetOutboundAllowPrivate(true)
+	t.Cleanup(func() { utils.SetOutboundAllowPrivate(false) })
+}
+
+func testNotification() *Notification {
+	return &Notification{
+		RuleID:     1,
+		RuleName:   "client down",
+		Condition:  models.AlertConditionClientOffline,
+		Status:     models.AlertEventStatusFiring,
+		ObjectKey:  "client:c1",
+		ObjectName: `client "c1"`,
+		Value:      120,
+		StartedAt:  time.Unix(1000, 0),
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	allowLoopback(t)
+	var bodies [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, b)
+		assert.Equal(t, "v", r.Header.Get("X-Test"))
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(models.AlertChannelTypeWebhook, []byte(`{"url":"`+srv.URL+`","headers":{"X-Test":"v"}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testNotification()))
+
+	n, err = NewNotifier(models.AlertChannelTypeWebhook, []byte(`{"url":"`+srv.URL+`","headers":{"X-Test":"v"},"template":"{\"msg\":{{ json .ObjectName }}}"}`))
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testNotification()))
+
+	assert.Len(t, bodies, 2)
+	payload := map[string]any{}
+	assert.NoError(t, json.Unmarshal(bodies[0], &payload))
+	assert.Equal(t, "firing", payload["status"])
+	assert.Equal(t, "[FIRING] client down", payload["title"])
+	assert.JSONEq(t, `{"msg":"client \"c1\""}`, string(bodies[1]))
+}
+
+func TestWebhookNotifierError(t *testing.T) {
+	allowLoopback(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(models.AlertChannelTypeWebhook, []byte(`{"url":"`+srv.URL+`"}`))
+	assert.NoError(t, err)
+	assert.ErrorContains(t, n.Notify(context.Background(), testNotification()), "502")
+
+	_, err = NewNotifier(models.AlertChannelTypeTelegram, []byte(`{"bot_token":"t"}`))
+	assert.Error(t, err)
+}
+
+func TestTelegramNotifier(t *testing.T) {
+	allowLoopback(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bottoken/sendMessage", r.URL.Path)
+		payload := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "-100", payload["chat_id"])
+		assert.Equal(t, testNotification().Text(), payload["text"])
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(models.AlertChannelTypeTelegram, []byte(`{"bot_token":"token","chat_id":"-100","api_url":"`+srv.URL+`"}`))
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), testNotification()))
+}
+
+func TestSignDingTalkURL(t *testing.T) {
+	signed, err := signDingTalkURL("https://oapi.dingtalk.com/robot/send?access_token=abc", "secret", time.UnixMilli(1700000000000))
+	assert.NoError(t, err)
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", u.Query().Get("access_token"))
+	assert.Equal(t, "1700000000000", u.Query().Get("timestamp"))
+	assert.NotEmpty(t, u.Query().Get("sign"))
+}
+
+func TestWebhookNotifierRejectsPrivateTarget(t *testing.T) {
+	hit := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(models.AlertChannelTypeWebhook, []byte(`{"url":"`+srv.URL+`"}`))
+	assert.NoError(t, err)
+	assert.ErrorContains(t, n.Notify(context.Background(), testNotification()), "not allowed")
+	assert.False(t, hit)
+}
+
+func TestWebhookNotifierHidesResponse(t *testing.T) {
+	allowLoopback(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal secret", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(models.AlertChannelTypeWebhook, []byte(`{"url":"`+srv.URL+`"}`))
+	assert.NoError(t, err)
+	err = n.Notify(context.Background(), testNotification())
+	assert.ErrorContains(t, err, "403")
+	assert.NotContains(t, err.Error(), "internal secret")
+}
+
+func TestEmailNotifierLimitsHostAndPort(t *testing.T) {
+	newEmail := func(host string, port int) error {
+		cfg, _ := json.Marshal(EmailConfig{Host: host, Port: port, From: "a@example.com", To: []string{"b@example.com"}})
+		_, err := NewNotifier(models.AlertChannelTypeEmail, cfg)
+		return err
+	}
+
+	assert.NoError(t, newEmail("smtp.example.com", 0))
+	assert.NoError(t, newEmail("smtp.example.com", 587))
+	assert.NoError(t, newEmail("2001:db8::1", 465))
+	assert.Error(t, newEmail("smtp.example.com", 6379))
+	assert.Error(t, newEmail("smtp.example.com:6379", 25))
+	assert.Error(t, newEmail("user@smtp.example.com", 25))
+}
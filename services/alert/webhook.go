@@ -0,0 +1,164 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils"
+)
+
+const defaultTelegramAPI = "https://api.telegram.org"
+
+// WebhookConfig 通用 webhook，Template 为空时发送 Notification 的 json，否则按 text/template 渲染请求体
+// 模板中可用 {{ json .Text }} 输出转义后的 json 字符串
+type WebhookConfig struct {
+	URL      string            `json:"url"`
+	Method   string            `json:"method"`
+	Headers  map[string]string `json:"headers"`
+	Template string            `json:"template"`
+}
+
+type TelegramConfig struct {
+	BotToken string `json:"bot_token"`
+	ChatID   string `json:"chat_id"`
+	APIURL   string `json:"api_url"` // 为空时使用官方 api
+}
+
+type DingTalkConfig struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret"` // 机器人开启加签时填写
+}
+
+type webhookNotifier struct {
+	cfg  WebhookConfig
+	tmpl *template.Template
+	// signURL 发送前改写 url，用于需要按时间戳签名的渠道
+	signURL func(string) (string, error)
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func newWebhookNotifier(cfg WebhookConfig) (*webhookNotifier, error) {
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf("invalid webhook url: %v", err)
+	}
+	if len(cfg.Method) == 0 {
+		cfg.Method = http.MethodPost
+	}
+
+	n := &webhookNotifier{cfg: cfg}
+	if len(cfg.Template) > 0 {
+		tmpl, err := template.New("webhook").Funcs(templateFuncs).Parse(cfg.Template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook template: %v", err)
+		}
+		n.tmpl = tmpl
+	}
+	return n, nil
+}
+
+func newTelegramNotifier(cfg TelegramConfig) (*webhookNotifier, error) {
+	if len(cfg.BotToken) == 0 || len(cfg.ChatID) == 0 {
+		return nil, fmt.Errorf("bot token and chat id are required")
+	}
+	api := strings.TrimSuffix(cfg.APIURL, "/")
+	if len(api) == 0 {
+		api = defaultTelegramAPI
+	}
+	chatID, _ := json.Marshal(cfg.ChatID)
+	return newWebhookNotifier(WebhookConfig{
+		URL:      fmt.Sprintf("%s/bot%s/sendMessage", api, cfg.BotToken),
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Template: fmt.Sprintf(`{"chat_id":%s,"text":{{ json .Text }}}`, chatID),
+	})
+}
+
+func newDingTalkNotifier(cfg DingTalkConfig) (*webhookNotifier, error) {
+	n, err := newWebhookNotifier(WebhookConfig{
+		URL:      cfg.URL,
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Template: `{"msgtype":"text","text":{"content":{{ json .Text }}}}`,
+	})
+	if err != nil || len(cfg.Secret) == 0 {
+		return n, err
+	}
+	n.signURL = func(raw string) (string, error) {
+		return signDingTalkURL(raw, cfg.Secret, time.Now())
+	}
+	return n, nil
+}
+
+// signDingTalkURL 钉钉机器人加签，sign = base64(hmac_sha256(timestamp + "\n" + secret))
+func signDingTalkURL(raw, secret string, now time.Time) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+
+	q := u.Query()
+	q.Set("timestamp", timestamp)
+	q.Set("sign", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (w *webhookNotifier) body(n *Notification) ([]byte, error) {
+	if w.tmpl == nil {
+		return json.Marshal(struct {
+			*Notification
+			Title string `json:"title"`
+			Text  string `json:"text"`
+		}{Notification: n, Title: n.Title(), Text: n.Text()})
+	}
+	buf := &bytes.Buffer{}
+	if err := w.tmpl.Execute(buf, n); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := w.body(n)
+	if err != nil {
+		return fmt.Errorf("render webhook body: %v", err)
+	}
+
+	target := w.cfg.URL
+	if w.signURL != nil {
+		if target, err = w.signURL(target); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, w.cfg.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+
+	// 测试渠道时错误会返回给前端，不带上响应内容，避免被用来读取内网服务
+	_, err = utils.SendOutbound(req)
+	return err
+}
@@ -1,18 +1,26 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/gin-gonic/gin"
 )
 
+// shutdownTimeout 停止时等待进行中的请求结束的最长时间
+const shutdownTimeout = 5 * time.Second
+
 type ApiService interface {
 	Run()
 	Stop()
 }
 
 type server struct {
-	srv    *gin.Engine
+	srv    *http.Server
 	addr   net.Listener
 	enable bool
 }
@@ -23,7 +31,7 @@ var (
 
 func NewApiService(listen net.Listener, router *gin.Engine, enable bool) *server {
 	return &server{
-		srv:    router,
+		srv:    &http.Server{Handler: router},
 		addr:   listen,
 		enable: enable,
 	}
@@ -34,8 +42,18 @@ func (s *server) Run() {
 	if !s.enable {
 		return
 	}
-	s.srv.RunListener(s.addr)
+	if err := s.srv.Serve(s.addr); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Logger(context.Background()).WithError(err).Errorf("api service exited, addr: [%s]", s.addr.Addr())
+	}
 }
 
 func (s *server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot shutdown api service gracefully, addr: [%s]", s.addr.Addr())
+	}
+	if !s.enable {
+		s.addr.Close()
+	}
 }
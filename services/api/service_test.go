@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStopReturnsRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.GET("/ping", func(c *gin.Context) { c.String(http.StatusOK, "pong") })
+	s := NewApiService(l, router, true)
+
+	done := make(chan struct{})
+	go func() {
+		s.Run()
+		close(done)
+	}()
+
+	resp, err := http.Get("http://" + l.Addr().String() + "/ping")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	s.Stop()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after Stop")
+	}
+}
@@ -18,6 +18,9 @@ type application struct {
 
 	shellPTYMgr          ShellPTYMgr
 	clientLogManager     ClientLogManager
+	logArchive           LogArchive
+	clientStatusManager  ClientStatusManager
+	eventBus             EventBus
 	clientRPCHandler     ClientRPCHandler
 	dbManager            DBManager
 	clientController     ClientController
@@ -223,6 +226,36 @@ func (a *application) SetClientLogManager(clientLogManager ClientLogManager) {
 	a.clientLogManager = clientLogManager
 }
 
+// GetLogArchive implements Application.
+func (a *application) GetLogArchive() LogArchive {
+	return a.logArchive
+}
+
+// SetLogArchive implements Application.
+func (a *application) SetLogArchive(logArchive LogArchive) {
+	a.logArchive = logArchive
+}
+
+// GetClientStatusManager implements Application.
+func (a *application) GetClientStatusManager() ClientStatusManager {
+	return a.clientStatusManager
+}
+
+// SetClientStatusManager implements Application.
+func (a *application) SetClientStatusManager(clientStatusManager ClientStatusManager) {
+	a.clientStatusManager = clientStatusManager
+}
+
+// GetEventBus implements Application.
+func (a *application) GetEventBus() EventBus {
+	return a.eventBus
+}
+
+// SetEventBus implements Application.
+func (a *application) SetEventBus(eventBus EventBus) {
+	a.eventBus = eventBus
+}
+
 // GetShellPTYMgr implements Application.
 func (a *application) GetShellPTYMgr() ShellPTYMgr {
 	return a.shellPTYMgr
@@ -19,6 +19,12 @@ type Application interface {
 	SetShellPTYMgr(ShellPTYMgr)
 	GetClientLogManager() ClientLogManager
 	SetClientLogManager(ClientLogManager)
+	GetLogArchive() LogArchive
+	SetLogArchive(LogArchive)
+	GetClientStatusManager() ClientStatusManager
+	SetClientStatusManager(ClientStatusManager)
+	GetEventBus() EventBus
+	SetEventBus(EventBus)
 	GetDBManager() DBManager
 	SetDBManager(DBManager)
 	GetClientRecvMap() *sync.Map
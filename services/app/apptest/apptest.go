@@ -0,0 +1,93 @@
+// Package apptest 为 biz/dao 的测试提供带内存数据库、缓存与 casbin 的 app.Context
+package apptest
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/cache"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/glebarez/sqlite"
+	"github.com/ilyakaznacheev/cleanenv"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var dbSeq atomic.Int64
+
+// NewContext 每次调用都使用独立的内存数据库，cfgFn 可在初始化前修改配置
+func NewContext(t testing.TB, cfgFn ...func(*conf.Config)) *app.Context {
+	t.Helper()
+
+	cfg := conf.Config{}
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		t.Fatalf("read default config: %v", err)
+	}
+	cfg.Complete()
+	for _, fn := range cfgFn {
+		fn(&cfg)
+	}
+
+	appInstance := app.NewApp()
+	appInstance.SetConfig(cfg)
+
+	dsn := fmt.Sprintf("file:apptest%d?mode=memory&cache=shared", dbSeq.Add(1))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get sql db: %v", err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	dbMgr := models.NewDBManager(defs.DBTypeSQLite3)
+	dbMgr.SetDB(defs.DBTypeSQLite3, defs.DBRoleDefault, db)
+	dbMgr.Init()
+	appInstance.SetDBManager(dbMgr)
+
+	if cache.Get() == nil {
+		cache.InitCache(cfg)
+	}
+
+	ctx := app.NewContext(context.Background(), appInstance)
+	enforcer, err := rbac.InitializeCasbin(ctx, db)
+	if err != nil {
+		t.Fatalf("init casbin: %v", err)
+	}
+	appInstance.SetEnforcer(enforcer)
+	appInstance.SetPermManager(rbac.NewPermManager(enforcer))
+
+	return ctx
+}
+
+// WithUser 返回以 u 身份发起请求的 context
+func WithUser(ctx *app.Context, u *models.UserEntity) *app.Context {
+	return app.NewContext(context.WithValue(ctx.GetCtx(), defs.UserInfoKey, u), ctx.GetApp())
+}
+
+// CreateUser 创建一个普通用户
+func CreateUser(t testing.TB, ctx *app.Context, name string, mutate ...func(*models.UserEntity)) *models.UserEntity {
+	t.Helper()
+	u := &models.UserEntity{
+		UserName: name,
+		Email:    name + "@example.com",
+		Status:   models.STATUS_NORMAL,
+		Role:     defs.UserRole_Normal,
+		Token:    name + "-token",
+	}
+	for _, fn := range mutate {
+		fn(u)
+	}
+	if err := ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.User{UserEntity: u}).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return u
+}
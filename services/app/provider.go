@@ -6,7 +6,9 @@ import (
 
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/logarchive"
 	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/casbin/casbin/v2"
 
 	"github.com/fatedier/frp/client/proxy"
@@ -17,7 +19,7 @@ import (
 
 // biz/common/stream_log.go
 type StreamLogHookMgr interface {
-	AddStream(send func(msg string), closeSend func())
+	AddStream(send func(line logger.StreamLogLine), closeSend func())
 	SetPkgs(pkgs []string)
 	Close()
 	Lock()
@@ -63,8 +65,37 @@ type ShellPTYMgr interface {
 
 // biz/master/streamlog/collect_log.go
 type ClientLogManager interface {
-	SyncMap[string, chan string]
 	GetClientLock(clientId string) *sync.Mutex
+	Publish(clientID string, line logger.StreamLogLine)
+	Subscribe(clientID string, pkgs []string, level string) (subID string, ch <-chan logger.StreamLogLine, history []logger.StreamLogLine)
+	Unsubscribe(clientID string, subID string) (remaining int)
+	SubscriberCount(clientID string) int
+	EvictIdle(idle time.Duration) (evicted int)
+}
+
+// biz/master/platform/client_status.go
+type ClientStatusManager interface {
+	Get(clientID string) (*pb.ClientStatus, bool)
+	List() []*pb.ClientStatus
+	Update(status *pb.ClientStatus)
+	Subscribe() (subID string, ch <-chan *pb.ClientStatus)
+	Unsubscribe(subID string)
+}
+
+// services/eventbus/bus.go
+type EventBus interface {
+	Publish(e *defs.PanelEvent)
+	Subscribe() (subID string, ch <-chan *defs.PanelEvent)
+	Unsubscribe(subID string)
+}
+
+// services/logarchive/store.go
+type LogArchive interface {
+	Append(clientID string, e logarchive.Entry) error
+	Search(q logarchive.Query) (entries []logarchive.Entry, truncated bool, err error)
+	Purge(now, before time.Time) (removed int, err error)
+	Flush() error
+	Close() error
 }
 
 // models/db.go
@@ -81,10 +112,17 @@ type ClientsManager interface {
 	Get(cliID string) *defs.Connector
 	Set(cliID, clientType string, sender pb.Master_ServerSendServer)
 	Remove(cliID string)
+	Kick(cliID string)
+	Kicked(cliID string) <-chan struct{}
 	ClientAddr(cliID string) string
 	ConnectTime(cliID string) (time.Time, bool)
 	UpdateLastSeenAt(cliID string)
 	GetLastSeenAt(cliID string) (time.Time, bool)
+	List() []*defs.Connector
+	// OnChange 注册客户端连接与断开的回调
+	OnChange(fn func(conn *defs.Connector, connected bool))
+	// RemoveSender 连接断开时移除，客户端已用新连接重连时不移除
+	RemoveSender(cliID string, sender pb.Master_ServerSendServer)
 }
 
 type Service interface {
@@ -164,7 +202,12 @@ type PermissionManager interface {
 	Enforcer() *casbin.Enforcer
 	GrantGroupPermission(groupID string, objType defs.RBACObj, objID string, action defs.RBACAction, tenantID int) (bool, error)
 	GrantUserPermission(userID int, objType defs.RBACObj, objID string, action defs.RBACAction, tenantID int) (bool, error)
+	ListObjectPolicies(objType defs.RBACObj, objID string, tenantID int) ([][]string, error)
+	RemoveObjectPolicies(objType defs.RBACObj, objID string, tenantID int) (bool, error)
+	RemoveGroup(groupID string, tenantID int) (bool, error)
+	RemoveTenant(tenantID int) (bool, error)
 	RemoveUserFromGroup(userID int, groupID string, tenantID int) (bool, error)
+	RemoveUserFromTenant(userID int, tenantID int) (bool, error)
 	RevokeGroupPermission(groupID string, objType defs.RBACObj, objID string, action defs.RBACAction, tenantID int) (bool, error)
 	RevokeUserPermission(userID int, objType defs.RBACObj, objID string, action defs.RBACAction, tenantID int) (bool, error)
 }
@@ -193,6 +236,7 @@ type WorkersManager interface {
 	RunWorker(ctx *Context, id string, worker WorkerController) error
 	StopWorker(ctx *Context, id string) error
 	GetWorkerStatus(ctx *Context, id string) (defs.WorkerStatus, error)
+	ListWorkerIDs() []string
 	// install workerd bin to workerd bin path, if not specified, use default path /usr/local/bin/workerd
 	InstallWorkerd(ctx *Context, url string, path string) (string, error)
 }
@@ -250,9 +294,13 @@ type WireGuard interface {
 	NeedRecreate(newCfg *defs.WireGuardConfig) bool
 
 	// Config相关
-	GenWGConfig() (string, error) // unimplemented
+	GenWGConfig() (string, error)
 	GetWGRuntimeInfo() (*pb.WGDeviceRuntimeInfo, error)
 	UpdateAdjs(adjs map[uint32]*pb.WireGuardLinks) error
+	UpdateACL(acl *pb.AclConfig) error
+	UpdateMagicDNS(networkName string, records []*pb.WireGuardDNSRecord) error
+	UpdateAdvertisedRoutes(routes []string, exitNode, acceptRoutes bool) error
+	Punch(targets []*pb.WireGuardPunchTarget) []*pb.WireGuardPunchResult
 }
 
 type NetworkTopologyCache interface {
@@ -260,4 +308,5 @@ type NetworkTopologyCache interface {
 	SetRuntimeInfo(wireguardId uint, runtimeInfo *pb.WGDeviceRuntimeInfo)
 	DeleteRuntimeInfo(wireguardId uint)
 	GetLatencyMs(fromWGID, toWGID uint) (uint32, bool)
+	ListRuntimeInfo() map[uint]*pb.WGDeviceRuntimeInfo
 }
@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+const (
+	redacted = "***"
+	// minEncodedLen 短于该长度的字符串不尝试按 base64 解码
+	minEncodedLen = 16
+)
+
+// sensitiveKeyParts 字段名包含这些片段时，摘要中的值会被隐藏
+var sensitiveKeyParts = []string{"password", "secret", "token", "private_key"}
+
+// sensitiveKeys 需要精确匹配的敏感字段，如两步验证码
+var sensitiveKeys = map[string]bool{"code": true, "totp_code": true}
+
+// targetKeys 按顺序从请求中取第一个存在的字段作为操作对象
+var targetKeys = []string{"client_id", "server_id", "worker_id", "network_id", "user_id", "group_id", "tenant_id", "id", "name"}
+
+// Record 写入一条审计日志，自动补全操作者、token 与来源 IP，写入失败不影响业务
+func Record(ctx *app.Context, entry *models.AuditLogEntity) {
+	if operator := common.GetUserInfo(ctx); operator != nil {
+		entry.UserID = operator.GetUserID()
+		entry.TenantID = operator.GetTenantID()
+		entry.UserName = operator.GetUserName()
+	}
+	entry.TokenID = cast.ToString(ctx.Value(defs.TokenPayloadKey_JTI))
+	if ginCtx, ok := ctx.Context.(*gin.Context); ok && len(entry.SourceIP) == 0 {
+		entry.SourceIP = ginCtx.ClientIP()
+	}
+	entry.Summary = truncate(entry.Summary, defs.AuditSummaryMaxLen)
+
+	if err := dao.NewMutation(ctx).AdminCreateAuditLog(&models.AuditLog{AuditLogEntity: entry}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save audit log, kind: [%s], route: [%s]", entry.Kind, entry.Route)
+	}
+}
+
+// Summarize 生成请求摘要并提取操作对象，json 请求会隐藏敏感字段，其他类型只记录长度
+func Summarize(contentType string, body []byte) (summary string, targetID string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	var payload any
+	if !strings.Contains(contentType, "json") || json.Unmarshal(body, &payload) != nil {
+		return fmt.Sprintf("<%s %d bytes>", contentType, len(body)), ""
+	}
+
+	if m, ok := payload.(map[string]any); ok {
+		for _, key := range targetKeys {
+			if v, ok := m[key]; ok && v != nil {
+				targetID = cast.ToString(v)
+				break
+			}
+		}
+	}
+
+	raw, err := json.Marshal(redact(payload))
+	if err != nil {
+		return fmt.Sprintf("<%s %d bytes>", contentType, len(body)), targetID
+	}
+	return truncate(string(raw), defs.AuditSummaryMaxLen), targetID
+}
+
+// redactEncoded 配置等字段以 base64 编码传输，解码后包含敏感字段时整体隐藏
+func redactEncoded(s string) string {
+	if len(s) < minEncodedLen {
+		return s
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return s
+	}
+	text := strings.ToLower(string(decoded))
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(text, part) {
+			return fmt.Sprintf("<base64 %d bytes %s>", len(decoded), redacted)
+		}
+	}
+	return s
+}
+
+func redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if isSensitiveKey(k) {
+				val[k] = redacted
+				continue
+			}
+			val[k] = redact(item)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = redact(item)
+		}
+		return val
+	case string:
+		return redactEncoded(val)
+	}
+	return v
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate 按字节截断，不会截断多字节字符
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "...(truncated)"
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeRedactsSensitiveFields(t *testing.T) {
+	body := `{"username":"alice","password":"p@ss","client":{"client_secret":"s","id":"c1"},"totp_code":"123456","items":[{"token":"t"}]}`
+	got, target := Summarize("application/json", []byte(body))
+
+	assert.NotContains(t, got, "p@ss")
+	assert.NotContains(t, got, `"s"`)
+	assert.NotContains(t, got, "123456")
+	assert.NotContains(t, got, `"t"`)
+	assert.Contains(t, got, "alice")
+	assert.Contains(t, got, "c1")
+	assert.Equal(t, "", target)
+}
+
+func TestSummarizeTarget(t *testing.T) {
+	_, target := Summarize("application/json", []byte(`{"name":"n","client_id":"c1"}`))
+	assert.Equal(t, "c1", target)
+
+	_, target = Summarize("application/json", []byte(`{"user_id":12}`))
+	assert.Equal(t, "12", target)
+}
+
+func TestSummarizeNonJSON(t *testing.T) {
+	got, _ := Summarize("application/json", nil)
+	assert.Equal(t, "", got)
+	got, _ = Summarize("application/x-protobuf", []byte{1, 2, 3})
+	assert.Equal(t, "<application/x-protobuf 3 bytes>", got)
+	got, _ = Summarize("application/json", []byte("{x}"))
+	assert.Equal(t, "<application/json 3 bytes>", got)
+}
+
+func TestSummarizeTruncate(t *testing.T) {
+	got, _ := Summarize("application/json", []byte(`{"comment":"`+strings.Repeat("a", 4096)+`"}`))
+	assert.True(t, strings.HasSuffix(got, "...(truncated)"))
+}
+
+func TestSummarizeRedactsEncodedConfig(t *testing.T) {
+	cfg := base64.StdEncoding.EncodeToString([]byte(`{"auth":{"token":"frp-secret"},"serverPort":7000}`))
+	plain := base64.StdEncoding.EncodeToString([]byte(`{"serverPort":7000,"bindAddr":"0.0.0.0"}`))
+	got, _ := Summarize("application/json", []byte(`{"client_id":"c1","config":"`+cfg+`","other":"`+plain+`"}`))
+
+	assert.NotContains(t, got, cfg)
+	assert.Contains(t, got, plain)
+	assert.Contains(t, got, "c1")
+}
+
+func TestTruncateRuneBoundary(t *testing.T) {
+	got := truncate("中文字符", 4)
+	assert.True(t, utf8.ValidString(got))
+	assert.Equal(t, "中...(truncated)", got)
+	assert.Equal(t, "abc", truncate("abc", 4))
+}
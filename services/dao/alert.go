@@ -0,0 +1,228 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+type AlertQuery interface {
+	GetAlertRule(userInfo models.UserInfo, id uint) (*models.AlertRule, error)
+	ListAlertRules(userInfo models.UserInfo) ([]*models.AlertRule, error)
+	AdminListEnabledAlertRules() ([]*models.AlertRule, error)
+	GetAlertChannel(userInfo models.UserInfo, id uint) (*models.AlertChannel, error)
+	ListAlertChannels(userInfo models.UserInfo) ([]*models.AlertChannel, error)
+	AdminListAlertChannelsByIDs(ids []uint) ([]*models.AlertChannel, error)
+	ListAlertEvents(userInfo models.UserInfo, ruleID uint, status string, page, pageSize int) ([]*models.AlertEvent, error)
+	CountAlertEvents(userInfo models.UserInfo, ruleID uint, status string) (int64, error)
+	AdminListOpenAlertEvents(ruleID uint) ([]*models.AlertEvent, error)
+}
+
+type AlertMutation interface {
+	AdminCreateAlertRule(r *models.AlertRuleEntity) (*models.AlertRule, error)
+	AdminUpdateAlertRule(r *models.AlertRule) error
+	AdminDeleteAlertRule(id uint) error
+	AdminCreateAlertChannel(c *models.AlertChannelEntity) (*models.AlertChannel, error)
+	AdminUpdateAlertChannel(c *models.AlertChannel) error
+	AdminDeleteAlertChannel(id uint) error
+	AdminCreateAlertEvent(e *models.AlertEventEntity) (*models.AlertEvent, error)
+	AdminUpdateAlertEvent(e *models.AlertEvent) error
+	AdminDeleteAlertEvent(id uint) error
+}
+
+type alertQuery struct{ *queryImpl }
+type alertMutation struct{ *mutationImpl }
+
+func newAlertQuery(base *queryImpl) AlertQuery          { return &alertQuery{base} }
+func newAlertMutation(base *mutationImpl) AlertMutation { return &alertMutation{base} }
+
+func (q *alertQuery) GetAlertRule(userInfo models.UserInfo, id uint) (*models.AlertRule, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid alert rule id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	rule := &models.AlertRule{}
+	if err := db.Where(tenantScope(db, userInfo)).Where("id = ?", id).First(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (q *alertQuery) ListAlertRules(userInfo models.UserInfo) ([]*models.AlertRule, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.AlertRule{}
+	if err := db.Where(tenantScope(db, userInfo)).Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *alertQuery) AdminListEnabledAlertRules() ([]*models.AlertRule, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.AlertRule{}
+	if err := db.Where("enabled = ?", true).Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *alertQuery) GetAlertChannel(userInfo models.UserInfo, id uint) (*models.AlertChannel, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid alert channel id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	ch := &models.AlertChannel{}
+	if err := db.Where(tenantScope(db, userInfo)).Where("id = ?", id).First(ch).Error; err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (q *alertQuery) ListAlertChannels(userInfo models.UserInfo) ([]*models.AlertChannel, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.AlertChannel{}
+	if err := db.Where(tenantScope(db, userInfo)).Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *alertQuery) AdminListAlertChannelsByIDs(ids []uint) ([]*models.AlertChannel, error) {
+	list := []*models.AlertChannel{}
+	if len(ids) == 0 {
+		return list, nil
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	if err := db.Where("id IN ?", ids).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *alertQuery) alertEventScope(userInfo models.UserInfo, ruleID uint, status string) *gorm.DB {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Model(&models.AlertEvent{}).Where(tenantScope(db, userInfo))
+	if ruleID > 0 {
+		scoped = scoped.Where("rule_id = ?", ruleID)
+	}
+	if len(status) > 0 {
+		scoped = scoped.Where("status = ?", status)
+	}
+	return scoped
+}
+
+func (q *alertQuery) ListAlertEvents(userInfo models.UserInfo, ruleID uint, status string, page, pageSize int) ([]*models.AlertEvent, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	list := []*models.AlertEvent{}
+	err := q.alertEventScope(userInfo, ruleID, status).
+		Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *alertQuery) CountAlertEvents(userInfo models.UserInfo, ruleID uint, status string) (int64, error) {
+	var count int64
+	if err := q.alertEventScope(userInfo, ruleID, status).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AdminListOpenAlertEvents 规则下未恢复的事件，包括 pending 与 firing
+func (q *alertQuery) AdminListOpenAlertEvents(ruleID uint) ([]*models.AlertEvent, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.AlertEvent{}
+	err := db.Where("rule_id = ?", ruleID).
+		Where("status IN ?", []string{models.AlertEventStatusPending, models.AlertEventStatusFiring}).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *alertMutation) AdminCreateAlertRule(r *models.AlertRuleEntity) (*models.AlertRule, error) {
+	if r == nil {
+		return nil, fmt.Errorf("invalid alert rule")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	rule := &models.AlertRule{Model: &gorm.Model{}, AlertRuleEntity: r}
+	if err := db.Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (m *alertMutation) AdminUpdateAlertRule(r *models.AlertRule) error {
+	if r == nil || r.Model == nil || r.ID == 0 {
+		return fmt.Errorf("invalid alert rule")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(r).Error
+}
+
+// AdminDeleteAlertRule 删除规则及其全部事件
+func (m *alertMutation) AdminDeleteAlertRule(id uint) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rule_id = ?", id).Delete(&models.AlertEvent{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", id).Delete(&models.AlertRule{}).Error
+	})
+}
+
+func (m *alertMutation) AdminCreateAlertChannel(c *models.AlertChannelEntity) (*models.AlertChannel, error) {
+	if c == nil {
+		return nil, fmt.Errorf("invalid alert channel")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	ch := &models.AlertChannel{Model: &gorm.Model{}, AlertChannelEntity: c}
+	if err := db.Create(ch).Error; err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (m *alertMutation) AdminUpdateAlertChannel(c *models.AlertChannel) error {
+	if c == nil || c.Model == nil || c.ID == 0 {
+		return fmt.Errorf("invalid alert channel")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(c).Error
+}
+
+func (m *alertMutation) AdminDeleteAlertChannel(id uint) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Unscoped().Where("id = ?", id).Delete(&models.AlertChannel{}).Error
+}
+
+func (m *alertMutation) AdminCreateAlertEvent(e *models.AlertEventEntity) (*models.AlertEvent, error) {
+	if e == nil {
+		return nil, fmt.Errorf("invalid alert event")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	event := &models.AlertEvent{AlertEventEntity: e}
+	if err := db.Create(event).Error; err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (m *alertMutation) AdminUpdateAlertEvent(e *models.AlertEvent) error {
+	if e == nil || e.ID == 0 {
+		return fmt.Errorf("invalid alert event")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(e).Error
+}
+
+func (m *alertMutation) AdminDeleteAlertEvent(id uint) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Where("id = ?", id).Delete(&models.AlertEvent{}).Error
+}
@@ -0,0 +1,129 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+type APITokenQuery interface {
+	AdminGetAPITokenByJTI(jti string) (*models.APIToken, error)
+	GetAPITokenByID(userInfo models.UserInfo, id uint) (*models.APIToken, error)
+	ListAPITokensWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.APIToken, error)
+	CountAPITokensWithKeyword(userInfo models.UserInfo, keyword string) (int64, error)
+}
+
+type APITokenMutation interface {
+	CreateAPIToken(userInfo models.UserInfo, token *models.APIToken) error
+	RevokeAPIToken(userInfo models.UserInfo, id uint) (*models.APIToken, error)
+	AdminUpdateAPITokenLastUsed(jti string, usedAt time.Time) error
+}
+
+type apiTokenQuery struct{ *queryImpl }
+type apiTokenMutation struct{ *mutationImpl }
+
+func newAPITokenQuery(base *queryImpl) APITokenQuery          { return &apiTokenQuery{base} }
+func newAPITokenMutation(base *mutationImpl) APITokenMutation { return &apiTokenMutation{base} }
+
+func (q *apiTokenQuery) AdminGetAPITokenByJTI(jti string) (*models.APIToken, error) {
+	if jti == "" {
+		return nil, fmt.Errorf("invalid jti")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	t := &models.APIToken{}
+	if err := db.Where(&models.APIToken{APITokenEntity: &models.APITokenEntity{
+		JTI: jti,
+	}}).First(t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (q *apiTokenQuery) GetAPITokenByID(userInfo models.UserInfo, id uint) (*models.APIToken, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid api token id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	t := &models.APIToken{}
+	if err := db.Where(&models.APIToken{
+		Model: gorm.Model{ID: id},
+		APITokenEntity: &models.APITokenEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		},
+	}).First(t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (q *apiTokenQuery) ListAPITokensWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.APIToken, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.APIToken{}
+	base := db.Where(&models.APIToken{APITokenEntity: &models.APITokenEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}})
+	if len(keyword) > 0 {
+		base = base.Where("name like ?", "%"+keyword+"%")
+	}
+	if err := base.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *apiTokenQuery) CountAPITokensWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	base := db.Model(&models.APIToken{}).Where(&models.APIToken{APITokenEntity: &models.APITokenEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}})
+	if len(keyword) > 0 {
+		base = base.Where("name like ?", "%"+keyword+"%")
+	}
+	if err := base.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *apiTokenMutation) CreateAPIToken(userInfo models.UserInfo, token *models.APIToken) error {
+	if token == nil || token.APITokenEntity == nil || token.JTI == "" {
+		return fmt.Errorf("invalid api token")
+	}
+	token.UserID = userInfo.GetUserID()
+	token.TenantID = userInfo.GetTenantID()
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(token).Error
+}
+
+// RevokeAPIToken 吊销指定 token，返回吊销前的记录
+func (m *apiTokenMutation) RevokeAPIToken(userInfo models.UserInfo, id uint) (*models.APIToken, error) {
+	t, err := newAPITokenQuery(&queryImpl{ctx: m.ctx}).GetAPITokenByID(userInfo, id)
+	if err != nil {
+		return nil, err
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	if err := db.Model(&models.APIToken{}).Where("id = ?", t.ID).
+		Update("status", defs.TokenStatusRevoked).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (m *apiTokenMutation) AdminUpdateAPITokenLastUsed(jti string, usedAt time.Time) error {
+	if jti == "" {
+		return fmt.Errorf("invalid jti")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.APIToken{}).Where("jti = ?", jti).
+		Update("last_used_at", usedAt).Error
+}
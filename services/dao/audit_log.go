@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"gorm.io/gorm"
+)
+
+// AuditLogFilter 审计日志筛选条件，零值表示不筛选
+type AuditLogFilter struct {
+	UserID     int
+	Kind       string
+	Route      string
+	TargetID   string
+	StartTime  time.Time
+	EndTime    time.Time
+	FailedOnly bool
+}
+
+type AuditLogQuery interface {
+	ListAuditLogs(userInfo models.UserInfo, page, pageSize int, filter AuditLogFilter) ([]*models.AuditLog, error)
+	CountAuditLogs(userInfo models.UserInfo, filter AuditLogFilter) (int64, error)
+}
+
+type AuditLogMutation interface {
+	AdminCreateAuditLog(log *models.AuditLog) error
+	AdminDeleteAuditLogsBefore(before time.Time) (int64, error)
+}
+
+type auditLogQuery struct{ *queryImpl }
+type auditLogMutation struct{ *mutationImpl }
+
+func newAuditLogQuery(base *queryImpl) AuditLogQuery          { return &auditLogQuery{base} }
+func newAuditLogMutation(base *mutationImpl) AuditLogMutation { return &auditLogMutation{base} }
+
+func auditLogFilterScope(db *gorm.DB, userInfo models.UserInfo, filter AuditLogFilter) *gorm.DB {
+	q := db.Model(&models.AuditLog{}).Where(tenantManagerScope(db, userInfo))
+	if filter.UserID > 0 {
+		q = q.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.Kind) > 0 {
+		q = q.Where("kind = ?", filter.Kind)
+	}
+	if len(filter.Route) > 0 {
+		q = q.Where("route like ?", "%"+filter.Route+"%")
+	}
+	if len(filter.TargetID) > 0 {
+		q = q.Where("target_id = ?", filter.TargetID)
+	}
+	if !filter.StartTime.IsZero() {
+		q = q.Where("created_at >= ?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		q = q.Where("created_at < ?", filter.EndTime)
+	}
+	if filter.FailedOnly {
+		q = q.Where("result_code <> ?", int(pb.RespCode_RESP_CODE_SUCCESS))
+	}
+	return q
+}
+
+func (q *auditLogQuery) ListAuditLogs(userInfo models.UserInfo, page, pageSize int, filter AuditLogFilter) ([]*models.AuditLog, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.AuditLog{}
+	if err := auditLogFilterScope(db, userInfo, filter).
+		Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *auditLogQuery) CountAuditLogs(userInfo models.UserInfo, filter AuditLogFilter) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	if err := auditLogFilterScope(db, userInfo, filter).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *auditLogMutation) AdminCreateAuditLog(log *models.AuditLog) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(log).Error
+}
+
+func (m *auditLogMutation) AdminDeleteAuditLogsBefore(before time.Time) (int64, error) {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	result := db.Where("created_at < ?", before).Delete(&models.AuditLog{})
+	return result.RowsAffected, result.Error
+}
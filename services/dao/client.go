@@ -213,12 +213,7 @@ func (q *clientQuery) ListClients(userInfo models.UserInfo, page, pageSize int)
 	offset := (page - 1) * pageSize
 
 	var clients []*models.Client
-	err := db.Where(&models.Client{
-		ClientEntity: &models.ClientEntity{
-			UserID:   userInfo.GetUserID(),
-			TenantID: userInfo.GetTenantID(),
-		},
-	}).
+	err := db.Where(tenantScope(db, userInfo)).
 		Where(
 			db.Where(
 				normalClientFilter(db),
@@ -245,10 +240,7 @@ func (q *clientQuery) ListClientsWithKeyword(userInfo models.UserInfo, page, pag
 
 	var clients []*models.Client
 	err := db.Where("client_id like ?", "%"+keyword+"%").
-		Where(&models.Client{ClientEntity: &models.ClientEntity{
-			UserID:   userInfo.GetUserID(),
-			TenantID: userInfo.GetTenantID(),
-		}}).
+		Where(tenantScope(db, userInfo)).
 		Where(normalClientFilter(db)).
 		Offset(offset).Limit(pageSize).Find(&clients).Error
 	if err != nil {
@@ -281,12 +273,7 @@ func (q *clientQuery) GetAllClients(userInfo models.UserInfo) ([]*models.ClientE
 func (q *clientQuery) CountClients(userInfo models.UserInfo) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
-	err := db.Model(&models.Client{}).Where(&models.Client{
-		ClientEntity: &models.ClientEntity{
-			UserID:   userInfo.GetUserID(),
-			TenantID: userInfo.GetTenantID(),
-		},
-	}).
+	err := db.Model(&models.Client{}).Where(tenantScope(db, userInfo)).
 		Where(normalClientFilter(db)).Count(&count).Error
 	if err != nil {
 		return 0, err
@@ -297,12 +284,7 @@ func (q *clientQuery) CountClients(userInfo models.UserInfo) (int64, error) {
 func (q *clientQuery) CountClientsWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
-	err := db.Model(&models.Client{}).Where(&models.Client{
-		ClientEntity: &models.ClientEntity{
-			UserID:   userInfo.GetUserID(),
-			TenantID: userInfo.GetTenantID(),
-		},
-	}).
+	err := db.Model(&models.Client{}).Where(tenantScope(db, userInfo)).
 		Where(normalClientFilter(db)).Where("client_id like ?", "%"+keyword+"%").Count(&count).Error
 	if err != nil {
 		return 0, err
@@ -314,11 +296,7 @@ func (q *clientQuery) CountConfiguredClients(userInfo models.UserInfo) (int64, e
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
 	err := db.Model(&models.Client{}).
-		Where(&models.Client{
-			ClientEntity: &models.ClientEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			}}).
+		Where(tenantScope(db, userInfo)).
 		Where(normalClientFilter(db)).
 		Count(&count).Error
 	if err != nil {
@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+type ClientTokenQuery interface {
+	AdminGetUsableClientTokens(clientID string) ([]*models.ClientToken, error)
+	AdminGetClientTokenByToken(token string) (*models.ClientToken, error)
+	ListClientTokens(userInfo models.UserInfo, clientID string) ([]*models.ClientToken, error)
+	AdminListUsableClientTokensByUserID(userID int) ([]*models.ClientToken, error)
+}
+
+type ClientTokenMutation interface {
+	AdminCreateClientToken(token *models.ClientToken) error
+	RevokeClientTokens(userInfo models.UserInfo, clientID string, tokenID uint) ([]*models.ClientToken, error)
+}
+
+type clientTokenQuery struct{ *queryImpl }
+type clientTokenMutation struct{ *mutationImpl }
+
+func newClientTokenQuery(base *queryImpl) ClientTokenQuery { return &clientTokenQuery{base} }
+func newClientTokenMutation(base *mutationImpl) ClientTokenMutation {
+	return &clientTokenMutation{base}
+}
+
+func (q *clientTokenQuery) AdminGetUsableClientTokens(clientID string) ([]*models.ClientToken, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("invalid client id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.ClientToken{}
+	err := db.Where(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		ClientID: clientID,
+		Status:   defs.TokenStatusActive,
+	}}).Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("id desc").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *clientTokenQuery) AdminGetClientTokenByToken(token string) (*models.ClientToken, error) {
+	if token == "" {
+		return nil, fmt.Errorf("invalid token")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	t := &models.ClientToken{}
+	err := db.Where(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		Token: token,
+	}}).First(t).Error
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (q *clientTokenQuery) ListClientTokens(userInfo models.UserInfo, clientID string) ([]*models.ClientToken, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("invalid client id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.ClientToken{}
+	err := db.Where(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}}).Where(db.Where(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		ClientID: clientID,
+	}}).Or(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		OriginClientID: clientID,
+	}})).Order("id desc").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *clientTokenQuery) AdminListUsableClientTokensByUserID(userID int) ([]*models.ClientToken, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.ClientToken{}
+	err := db.Where(&models.ClientToken{ClientTokenEntity: &models.ClientTokenEntity{
+		UserID: userID,
+		Status: defs.TokenStatusActive,
+	}}).Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *clientTokenMutation) AdminCreateClientToken(token *models.ClientToken) error {
+	if token == nil || token.ClientTokenEntity == nil || token.ClientID == "" || token.Token == "" {
+		return fmt.Errorf("invalid client token")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(token).Error
+}
+
+// RevokeClientTokens 吊销客户端（含其子客户端）的 token，tokenID 为 0 时吊销全部，返回被吊销的 token
+func (m *clientTokenMutation) RevokeClientTokens(userInfo models.UserInfo, clientID string, tokenID uint) ([]*models.ClientToken, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("invalid client id")
+	}
+	tokens, err := newClientTokenQuery(&queryImpl{ctx: m.ctx}).ListClientTokens(userInfo, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := []*models.ClientToken{}
+	for _, t := range tokens {
+		if t.Status == defs.TokenStatusRevoked || (tokenID != 0 && t.ID != tokenID) {
+			continue
+		}
+		revoked = append(revoked, t)
+	}
+	if len(revoked) == 0 {
+		return revoked, nil
+	}
+
+	ids := make([]uint, 0, len(revoked))
+	for _, t := range revoked {
+		ids = append(ids, t.ID)
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	if err := db.Model(&models.ClientToken{}).Where("id IN ?", ids).
+		Update("status", defs.TokenStatusRevoked).Error; err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
@@ -3,7 +3,6 @@ package dao
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/VaalaCat/frp-panel/models"
@@ -11,7 +10,6 @@ import (
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/samber/lo"
-	"github.com/sourcegraph/conc/pool"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -21,6 +19,7 @@ type ProxyQuery interface {
 	GetProxyStatsByServerID(userInfo models.UserInfo, serverID string) ([]*models.ProxyStatsEntity, error)
 	AdminGetTenantProxyStats(tenantID int) ([]*models.ProxyStatsEntity, error)
 	AdminGetAllProxyStats(tx *gorm.DB) ([]*models.ProxyStatsEntity, error)
+	AdminListProxyStats() ([]*models.ProxyStatsEntity, error)
 	AdminGetProxyConfigByClientIDAndName(clientID string, name string) (*models.ProxyConfig, error)
 	GetProxyConfigsByClientID(userInfo models.UserInfo, clientID string) ([]*models.ProxyConfigEntity, error)
 	GetProxyConfigByFilter(userInfo models.UserInfo, proxyConfig *models.ProxyConfigEntity) (*models.ProxyConfig, error)
@@ -34,10 +33,11 @@ type ProxyQuery interface {
 	CountProxyConfigsWithFilters(userInfo models.UserInfo, filters *models.ProxyConfigEntity) (int64, error)
 	CountProxyConfigsWithFiltersAndKeyword(userInfo models.UserInfo, filters *models.ProxyConfigEntity, keyword string) (int64, error)
 	GetProxyConfigsByWorkerId(userInfo models.UserInfo, workerID string) ([]*models.ProxyConfig, error)
+	AdminGetProxyConfigByID(id uint) (*models.ProxyConfig, error)
 }
 
 type ProxyMutation interface {
-	AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb.ProxyInfo) error
+	AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb.ProxyInfo) ([]*models.TrafficPoint, error)
 	AdminCreateProxyConfig(proxyCfg *models.ProxyConfig) error
 	RebuildProxyConfigFromClient(userInfo models.UserInfo, client *models.Client) error
 	CreateProxyConfig(userInfo models.UserInfo, proxyCfg *models.ProxyConfigEntity) error
@@ -114,111 +114,96 @@ func (q *proxyQuery) GetProxyStatsByServerID(userInfo models.UserInfo, serverID
 	}), nil
 }
 
-func (m *proxyMutation) AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb.ProxyInfo) error {
+// AdminUpdateProxyStats 更新隧道流量统计，返回本次上报写入的流量增量
+func (m *proxyMutation) AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb.ProxyInfo) ([]*models.TrafficPoint, error) {
 	if srv.ServerID == "" {
-		return fmt.Errorf("invalid server id")
+		return nil, fmt.Errorf("invalid server id")
 	}
 
 	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
-	return db.Transaction(func(tx *gorm.DB) error {
-
-		queryResults := make([]interface{}, 3)
-		p := pool.New().WithErrors()
-		p.Go(
-			func() error {
-				user := models.User{}
-				if err := tx.Where(&models.User{
-					UserEntity: &models.UserEntity{
-						UserID: srv.UserID,
-					},
-				}).First(&user).Error; err != nil {
-					return err
-				}
-				queryResults[0] = user
-				return nil
-			},
-		)
-		p.Go(
-			func() error {
-				clients := []*models.Client{}
-				if err := tx.
-					Where(&models.Client{ClientEntity: &models.ClientEntity{
-						UserID:   srv.UserID,
-						ServerID: srv.ServerID,
-					}}).Find(&clients).Error; err != nil {
-					return err
-				}
-				queryResults[1] = clients
-				return nil
-			},
-		)
-		p.Go(
-			func() error {
-				oldProxy := []*models.ProxyStats{}
-				if err := tx.
-					Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
-						UserID:   srv.UserID,
-						ServerID: srv.ServerID,
-					}}).Find(&oldProxy).Error; err != nil {
-					return err
-				}
-				oldProxyMap := lo.SliceToMap(oldProxy, func(p *models.ProxyStats) (string, *models.ProxyStats) {
-					return p.Name, p
-				})
-				queryResults[2] = oldProxyMap
-				return nil
-			},
-		)
-		if err := p.Wait(); err != nil {
+	points := []*models.TrafficPoint{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// 共享的服务端上会有其他用户的客户端，隧道和流量都按客户端的所有者归属
+		clients := []*models.Client{}
+		if err := tx.Where(&models.Client{ClientEntity: &models.ClientEntity{
+			ServerID: srv.ServerID,
+		}}).Find(&clients).Error; err != nil {
 			return err
 		}
 
-		user := queryResults[0].(models.User)
-		clients := queryResults[1].([]*models.Client)
-		oldProxyMap := queryResults[2].(map[string]*models.ProxyStats)
-
-		inputMap := map[string]*pb.ProxyInfo{}
-		proxyMap := map[string]*models.ProxyStatsEntity{}
-		for _, proxyInfo := range inputs {
-			if proxyInfo == nil {
-				continue
-			}
-			proxyName := strings.TrimPrefix(proxyInfo.GetName(), user.UserName+".")
-			proxyMap[proxyName] = &models.ProxyStatsEntity{
-				ServerID:        srv.ServerID,
-				Name:            proxyName,
-				Type:            proxyInfo.GetType(),
-				UserID:          srv.UserID,
-				TenantID:        srv.TenantID,
-				TodayTrafficIn:  proxyInfo.GetTodayTrafficIn(),
-				TodayTrafficOut: proxyInfo.GetTodayTrafficOut(),
-			}
-			inputMap[proxyName] = proxyInfo
+		oldProxy := []*models.ProxyStats{}
+		if err := tx.Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
+			ServerID: srv.ServerID,
+		}}).Find(&oldProxy).Error; err != nil {
+			return err
 		}
+		oldProxyMap := lo.SliceToMap(oldProxy, func(p *models.ProxyStats) (string, *models.ProxyStats) {
+			return proxyStatsKey(p.ClientID, p.Name), p
+		})
+
+		users := []*models.User{}
+		if err := tx.Where("user_id IN ?", lo.Uniq(lo.Map(clients, func(c *models.Client, _ int) int {
+			return c.UserID
+		}))).Find(&users).Error; err != nil {
+			return err
+		}
+		userNames := lo.SliceToMap(users, func(u *models.User) (int, string) { return u.UserID, u.UserName })
+
+		reported := lo.SliceToMap(lo.Compact(inputs), func(p *pb.ProxyInfo) (string, *pb.ProxyInfo) {
+			return p.GetName(), p
+		})
 
+		// frpc 以所有者的用户名作为 user，frps 上报的隧道名会带上 "<用户名>." 前缀
+		inputMap := map[string]*pb.ProxyInfo{}
 		proxyEntityMap := map[string]*models.ProxyStatsEntity{}
 		for _, client := range clients {
 			cliCfg, err := client.GetConfigContent()
 			if err != nil || cliCfg == nil {
 				continue
 			}
+			userName, ok := userNames[client.UserID]
+			if !ok {
+				continue
+			}
 			for _, cfg := range cliCfg.Proxies {
-				if proxy, ok := proxyMap[cfg.GetBaseConfig().Name]; ok {
-					proxy.ClientID = client.ClientID
-					proxy.OriginClientID = client.OriginClientID
-					proxyEntityMap[proxy.Name] = proxy
+				proxyName := cfg.GetBaseConfig().Name
+				proxyInfo, ok := reported[userName+"."+proxyName]
+				if !ok && client.UserID == srv.UserID {
+					// 没有设置 user 的旧配置不带前缀，只可能属于服务端所有者
+					proxyInfo, ok = reported[proxyName]
+				}
+				if !ok {
+					continue
+				}
+				key := proxyStatsKey(client.ClientID, proxyName)
+				inputMap[key] = proxyInfo
+				proxyEntityMap[key] = &models.ProxyStatsEntity{
+					ServerID:        srv.ServerID,
+					ClientID:        client.ClientID,
+					OriginClientID:  client.OriginClientID,
+					Name:            proxyName,
+					Type:            proxyInfo.GetType(),
+					UserID:          client.UserID,
+					TenantID:        client.TenantID,
+					TodayTrafficIn:  proxyInfo.GetTodayTrafficIn(),
+					TodayTrafficOut: proxyInfo.GetTodayTrafficOut(),
+					// 旧版本服务端不上报在线状态，视为在线
+					Online: proxyInfo.Online == nil || proxyInfo.GetOnline(),
 				}
 			}
 		}
 
 		nowTime := time.Now()
-		results := lo.Values(lo.MapValues(proxyEntityMap, func(p *models.ProxyStatsEntity, name string) *models.ProxyStats {
+		// deltas 本次上报相对上次的流量增量，frps 重启或跨天时今日流量会归零，此时增量就是新的今日流量
+		deltas := map[string][2]int64{}
+		results := lo.Values(lo.MapValues(proxyEntityMap, func(p *models.ProxyStatsEntity, key string) *models.ProxyStats {
 			item := &models.ProxyStats{
 				ProxyStatsEntity: p,
 			}
-			if oldProxy, ok := oldProxyMap[name]; ok {
+			deltaIn, deltaOut := p.TodayTrafficIn, p.TodayTrafficOut
+			if oldProxy, ok := oldProxyMap[key]; ok {
 				item.ProxyID = oldProxy.ProxyID
-				firstSync := inputMap[name].GetFirstSync()
+				firstSync := inputMap[key].GetFirstSync()
 				isSameDay := utils.IsSameDay(nowTime, oldProxy.UpdatedAt)
 
 				item.HistoryTrafficIn = oldProxy.HistoryTrafficIn
@@ -226,16 +211,55 @@ func (m *proxyMutation) AdminUpdateProxyStats(srv *models.ServerEntity, inputs [
 				if !isSameDay || firstSync {
 					item.HistoryTrafficIn += oldProxy.TodayTrafficIn
 					item.HistoryTrafficOut += oldProxy.TodayTrafficOut
+				} else {
+					deltaIn -= oldProxy.TodayTrafficIn
+					deltaOut -= oldProxy.TodayTrafficOut
 				}
 			}
+			deltas[key] = [2]int64{max(deltaIn, 0), max(deltaOut, 0)}
 			return item
 		}))
 
-		if len(results) > 0 {
-			return tx.Save(results).Error
+		if len(results) == 0 {
+			return nil
+		}
+		if err := tx.Save(results).Error; err != nil {
+			return err
+		}
+
+		bucket := nowTime.Truncate(time.Minute)
+		for _, item := range results {
+			delta := deltas[proxyStatsKey(item.ClientID, item.Name)]
+			if delta[0] == 0 && delta[1] == 0 {
+				continue
+			}
+			points = append(points, &models.TrafficPoint{TrafficPointEntity: &models.TrafficPointEntity{
+				ProxyID:        item.ProxyID,
+				ProxyName:      item.Name,
+				ServerID:       item.ServerID,
+				ClientID:       item.ClientID,
+				OriginClientID: item.OriginClientID,
+				UserID:         item.UserID,
+				TenantID:       item.TenantID,
+				Resolution:     models.TrafficResolutionMinute,
+				BucketTime:     bucket,
+				TrafficIn:      delta[0],
+				TrafficOut:     delta[1],
+			}})
+		}
+		if len(points) > 0 {
+			return tx.CreateInBatches(points, MSetBatchSize).Error
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func proxyStatsKey(clientID, name string) string {
+	return clientID + "/" + name
 }
 
 func (q *proxyQuery) AdminGetTenantProxyStats(tenantID int) ([]*models.ProxyStatsEntity, error) {
@@ -267,6 +291,18 @@ func (q *proxyQuery) AdminGetAllProxyStats(tx *gorm.DB) ([]*models.ProxyStatsEnt
 	}), nil
 }
 
+// AdminListProxyStats 只读地列出全部隧道流量，不加锁
+func (q *proxyQuery) AdminListProxyStats() ([]*models.ProxyStatsEntity, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.ProxyStats{}
+	if err := db.Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return lo.Map(list, func(item *models.ProxyStats, _ int) *models.ProxyStatsEntity {
+		return item.ProxyStatsEntity
+	}), nil
+}
+
 func (m *proxyMutation) AdminCreateProxyConfig(proxyCfg *models.ProxyConfig) error {
 	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
 	return db.Create(proxyCfg).Error
@@ -394,11 +430,8 @@ func (q *proxyQuery) ListProxyConfigsWithFilters(userInfo models.UserInfo, page,
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	offset := (page - 1) * pageSize
 
-	filters.UserID = userInfo.GetUserID()
-	filters.TenantID = userInfo.GetTenantID()
-
 	var proxyConfigs []*models.ProxyConfig
-	err := db.Where(&models.ProxyConfig{
+	err := db.Where(tenantScope(db, userInfo)).Where(&models.ProxyConfig{
 		ProxyConfigEntity: filters,
 	}).Where(filters).Offset(offset).Limit(pageSize).Find(&proxyConfigs).Error
 	if err != nil {
@@ -430,11 +463,8 @@ func (q *proxyQuery) ListProxyConfigsWithFiltersAndKeyword(userInfo models.UserI
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	offset := (page - 1) * pageSize
 
-	filters.UserID = userInfo.GetUserID()
-	filters.TenantID = userInfo.GetTenantID()
-
 	var proxyConfigs []*models.ProxyConfig
-	err := db.Where(&models.ProxyConfig{
+	err := db.Where(tenantScope(db, userInfo)).Where(&models.ProxyConfig{
 		ProxyConfigEntity: filters,
 	}).Where(filters).Where("name like ?", "%"+keyword+"%").Offset(offset).Limit(pageSize).Find(&proxyConfigs).Error
 	if err != nil {
@@ -555,11 +585,9 @@ func (q *proxyQuery) CountProxyConfigs(userInfo models.UserInfo) (int64, error)
 
 func (q *proxyQuery) CountProxyConfigsWithFilters(userInfo models.UserInfo, filters *models.ProxyConfigEntity) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
-	filters.UserID = userInfo.GetUserID()
-	filters.TenantID = userInfo.GetTenantID()
 
 	var count int64
-	err := db.Model(&models.ProxyConfig{}).Where(&models.ProxyConfig{
+	err := db.Model(&models.ProxyConfig{}).Where(tenantScope(db, userInfo)).Where(&models.ProxyConfig{
 		ProxyConfigEntity: filters,
 	}).Count(&count).Error
 	if err != nil {
@@ -574,11 +602,9 @@ func (q *proxyQuery) CountProxyConfigsWithFiltersAndKeyword(userInfo models.User
 	}
 
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
-	filters.UserID = userInfo.GetUserID()
-	filters.TenantID = userInfo.GetTenantID()
 
 	var count int64
-	err := db.Model(&models.ProxyConfig{}).Where(&models.ProxyConfig{
+	err := db.Model(&models.ProxyConfig{}).Where(tenantScope(db, userInfo)).Where(&models.ProxyConfig{
 		ProxyConfigEntity: filters,
 	}).Where("name like ?", "%"+keyword+"%").Count(&count).Error
 	if err != nil {
@@ -604,3 +630,15 @@ func (q *proxyQuery) GetProxyConfigsByWorkerId(userInfo models.UserInfo, workerI
 	}
 	return items, nil
 }
+
+func (q *proxyQuery) AdminGetProxyConfigByID(id uint) (*models.ProxyConfig, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid proxy config id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	proxyCfg := &models.ProxyConfig{}
+	if err := db.Where("id = ?", id).First(proxyCfg).Error; err != nil {
+		return nil, err
+	}
+	return proxyCfg, nil
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+type PTYRecordingQuery interface {
+	GetPTYRecording(userInfo models.UserInfo, sessionID string) (*models.PTYRecording, error)
+	ListPTYRecordings(userInfo models.UserInfo, page, pageSize int, clientID string) ([]*models.PTYRecording, error)
+	CountPTYRecordings(userInfo models.UserInfo, clientID string) (int64, error)
+	AdminListPTYRecordingsBefore(before time.Time) ([]*models.PTYRecording, error)
+}
+
+type PTYRecordingMutation interface {
+	AdminCreatePTYRecording(r *models.PTYRecording) error
+	AdminFinishPTYRecording(sessionID string, endedAt time.Time, size int64) error
+	AdminDeletePTYRecording(sessionID string) error
+}
+
+type ptyRecordingQuery struct{ *queryImpl }
+type ptyRecordingMutation struct{ *mutationImpl }
+
+func newPTYRecordingQuery(base *queryImpl) PTYRecordingQuery { return &ptyRecordingQuery{base} }
+func newPTYRecordingMutation(base *mutationImpl) PTYRecordingMutation {
+	return &ptyRecordingMutation{base}
+}
+
+func (q *ptyRecordingQuery) GetPTYRecording(userInfo models.UserInfo, sessionID string) (*models.PTYRecording, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("invalid session id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	r := &models.PTYRecording{}
+	if err := db.Where(tenantManagerScope(db, userInfo)).
+		Where("session_id = ?", sessionID).First(r).Error; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (q *ptyRecordingQuery) ListPTYRecordings(userInfo models.UserInfo, page, pageSize int, clientID string) ([]*models.PTYRecording, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Where(tenantManagerScope(db, userInfo))
+	if len(clientID) > 0 {
+		scoped = scoped.Where("client_id = ?", clientID)
+	}
+	list := []*models.PTYRecording{}
+	if err := scoped.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *ptyRecordingQuery) CountPTYRecordings(userInfo models.UserInfo, clientID string) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Model(&models.PTYRecording{}).Where(tenantManagerScope(db, userInfo))
+	if len(clientID) > 0 {
+		scoped = scoped.Where("client_id = ?", clientID)
+	}
+	var count int64
+	if err := scoped.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *ptyRecordingMutation) AdminCreatePTYRecording(r *models.PTYRecording) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(r).Error
+}
+
+func (m *ptyRecordingMutation) AdminFinishPTYRecording(sessionID string, endedAt time.Time, size int64) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.PTYRecording{}).Where("session_id = ?", sessionID).
+		Updates(map[string]any{"ended_at": endedAt, "size": size}).Error
+}
+
+// AdminListPTYRecordingsBefore 列出开始时间早于 before 的录像
+func (q *ptyRecordingQuery) AdminListPTYRecordingsBefore(before time.Time) ([]*models.PTYRecording, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.PTYRecording{}
+	if err := db.Where("started_at < ?", before).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *ptyRecordingMutation) AdminDeletePTYRecording(sessionID string) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Unscoped().Where("session_id = ?", sessionID).Delete(&models.PTYRecording{}).Error
+}
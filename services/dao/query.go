@@ -3,29 +3,48 @@ package dao
 import "github.com/VaalaCat/frp-panel/services/app"
 
 type Query interface {
+	AlertQuery
+	APITokenQuery
+	AuditLogQuery
 	CertQuery
 	ClientQuery
+	ClientTokenQuery
 	EndpointQuery
+	ExternalPeerQuery
 	LinkQuery
 	NetworkQuery
 	ProxyQuery
+	PTYRecordingQuery
 	ServerQuery
 	StatsQuery
+	TenantQuery
+	TrafficQuotaQuery
 	UserQuery
+	UserGroupQuery
+	WebhookQuery
 	WireGuardQuery
 	WorkerQuery
 }
 
 type Mutation interface {
+	AlertMutation
+	APITokenMutation
+	AuditLogMutation
 	CertMutation
 	ClientMutation
+	ClientTokenMutation
 	EndpointMutation
+	ExternalPeerMutation
 	LinkMutation
 	NetworkMutation
 	ProxyMutation
+	PTYRecordingMutation
 	ServerMutation
 	StatsMutation
+	TenantMutation
+	TrafficQuotaMutation
 	UserMutation
+	WebhookMutation
 	WireGuardMutation
 	WorkerMutation
 	UserGroupMutation
@@ -42,29 +61,48 @@ type mutationImpl struct {
 
 // compositeQuery / compositeMutation 组合各子领域实现，对外暴露统一入口。
 type compositeQuery struct {
+	AlertQuery
+	APITokenQuery
+	AuditLogQuery
 	CertQuery
 	ClientQuery
+	ClientTokenQuery
 	EndpointQuery
+	ExternalPeerQuery
 	LinkQuery
 	NetworkQuery
 	ProxyQuery
+	PTYRecordingQuery
 	ServerQuery
 	StatsQuery
+	TenantQuery
+	TrafficQuotaQuery
 	UserQuery
+	UserGroupQuery
+	WebhookQuery
 	WireGuardQuery
 	WorkerQuery
 }
 
 type compositeMutation struct {
+	AlertMutation
+	APITokenMutation
+	AuditLogMutation
 	CertMutation
 	ClientMutation
+	ClientTokenMutation
 	EndpointMutation
+	ExternalPeerMutation
 	LinkMutation
 	NetworkMutation
 	ProxyMutation
+	PTYRecordingMutation
 	ServerMutation
 	StatsMutation
+	TenantMutation
+	TrafficQuotaMutation
 	UserMutation
+	WebhookMutation
 	WireGuardMutation
 	WorkerMutation
 	UserGroupMutation
@@ -73,35 +111,54 @@ type compositeMutation struct {
 func NewQuery(ctx *app.Context) Query {
 	base := &queryImpl{ctx: ctx}
 	return &compositeQuery{
-		CertQuery:      newCertQuery(base),
-		ClientQuery:    newClientQuery(base),
-		EndpointQuery:  newEndpointQuery(base),
-		LinkQuery:      newLinkQuery(base),
-		NetworkQuery:   newNetworkQuery(base),
-		ProxyQuery:     newProxyQuery(base),
-		ServerQuery:    newServerQuery(base),
-		StatsQuery:     newStatsQuery(base),
-		UserQuery:      newUserQuery(base),
-		WireGuardQuery: newWireGuardQuery(base),
-		WorkerQuery:    newWorkerQuery(base),
+		AlertQuery:        newAlertQuery(base),
+		APITokenQuery:     newAPITokenQuery(base),
+		AuditLogQuery:     newAuditLogQuery(base),
+		CertQuery:         newCertQuery(base),
+		ClientQuery:       newClientQuery(base),
+		ClientTokenQuery:  newClientTokenQuery(base),
+		EndpointQuery:     newEndpointQuery(base),
+		ExternalPeerQuery: newExternalPeerQuery(base),
+		LinkQuery:         newLinkQuery(base),
+		NetworkQuery:      newNetworkQuery(base),
+		ProxyQuery:        newProxyQuery(base),
+		PTYRecordingQuery: newPTYRecordingQuery(base),
+		ServerQuery:       newServerQuery(base),
+		StatsQuery:        newStatsQuery(base),
+		TenantQuery:       newTenantQuery(base),
+		TrafficQuotaQuery: newTrafficQuotaQuery(base),
+		UserQuery:         newUserQuery(base),
+		UserGroupQuery:    newUserGroupQuery(base),
+		WebhookQuery:      newWebhookQuery(base),
+		WireGuardQuery:    newWireGuardQuery(base),
+		WorkerQuery:       newWorkerQuery(base),
 	}
 }
 
 func NewMutation(ctx *app.Context) Mutation {
 	base := &mutationImpl{ctx: ctx}
 	return &compositeMutation{
-		CertMutation:      newCertMutation(base),
-		ClientMutation:    newClientMutation(base),
-		EndpointMutation:  newEndpointMutation(base),
-		LinkMutation:      newLinkMutation(base),
-		NetworkMutation:   newNetworkMutation(base),
-		ProxyMutation:     newProxyMutation(base),
-		ServerMutation:    newServerMutation(base),
-		StatsMutation:     newStatsMutation(base),
-		UserMutation:      newUserMutation(base),
-		WireGuardMutation: newWireGuardMutation(base),
-		WorkerMutation:    newWorkerMutation(base),
-		UserGroupMutation: newUserGroupMutation(base),
+		AlertMutation:        newAlertMutation(base),
+		APITokenMutation:     newAPITokenMutation(base),
+		AuditLogMutation:     newAuditLogMutation(base),
+		CertMutation:         newCertMutation(base),
+		ClientMutation:       newClientMutation(base),
+		ClientTokenMutation:  newClientTokenMutation(base),
+		EndpointMutation:     newEndpointMutation(base),
+		ExternalPeerMutation: newExternalPeerMutation(base),
+		LinkMutation:         newLinkMutation(base),
+		NetworkMutation:      newNetworkMutation(base),
+		ProxyMutation:        newProxyMutation(base),
+		PTYRecordingMutation: newPTYRecordingMutation(base),
+		ServerMutation:       newServerMutation(base),
+		StatsMutation:        newStatsMutation(base),
+		TenantMutation:       newTenantMutation(base),
+		TrafficQuotaMutation: newTrafficQuotaMutation(base),
+		UserMutation:         newUserMutation(base),
+		WebhookMutation:      newWebhookMutation(base),
+		WireGuardMutation:    newWireGuardMutation(base),
+		WorkerMutation:       newWorkerMutation(base),
+		UserGroupMutation:    newUserGroupMutation(base),
 	}
 }
 
@@ -15,6 +15,7 @@ type ServerQuery interface {
 	AdminGetServerByServerID(serverID string) (*models.ServerEntity, error)
 	GetServerByServerID(userInfo models.UserInfo, serverID string) (*models.ServerEntity, error)
 	ListServers(userInfo models.UserInfo, page, pageSize int) ([]*models.ServerEntity, error)
+	GetAllServers(userInfo models.UserInfo) ([]*models.ServerEntity, error)
 	ListServersWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.ServerEntity, error)
 	CountServers(userInfo models.UserInfo) (int64, error)
 	CountServersWithKeyword(userInfo models.UserInfo, keyword string) (int64, error)
@@ -192,14 +193,7 @@ func (q *serverQuery) ListServers(userInfo models.UserInfo, page, pageSize int)
 	offset := (page - 1) * pageSize
 
 	var servers []*models.Server
-	err := db.Where(
-		&models.Server{
-			ServerEntity: &models.ServerEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			},
-		},
-	).Or(&models.Server{
+	err := db.Where(tenantScope(db, userInfo)).Or(&models.Server{
 		ServerEntity: &models.ServerEntity{
 			ServerID: defs.DefaultServerID,
 		},
@@ -213,6 +207,25 @@ func (q *serverQuery) ListServers(userInfo models.UserInfo, page, pageSize int)
 	}), nil
 }
 
+// GetAllServers 返回用户拥有的全部服务端，不包含默认服务端
+func (q *serverQuery) GetAllServers(userInfo models.UserInfo) ([]*models.ServerEntity, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var servers []*models.Server
+	err := db.Where(&models.Server{
+		ServerEntity: &models.ServerEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		},
+	}).Find(&servers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(c *models.Server, _ int) *models.ServerEntity {
+		return c.ServerEntity
+	}), nil
+}
+
 func (q *serverQuery) ListServersWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.ServerEntity, error) {
 	if page < 1 || pageSize < 1 || len(keyword) == 0 {
 		return nil, fmt.Errorf("invalid page or page size or keyword")
@@ -222,14 +235,7 @@ func (q *serverQuery) ListServersWithKeyword(userInfo models.UserInfo, page, pag
 	offset := (page - 1) * pageSize
 
 	var servers []*models.Server
-	err := db.Where(
-		&models.Server{
-			ServerEntity: &models.ServerEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			},
-		},
-	).Where("server_id like ?", "%"+keyword+"%").
+	err := db.Where(tenantScope(db, userInfo)).Where("server_id like ?", "%"+keyword+"%").
 		Offset(offset).Limit(pageSize).Find(&servers).Error
 	if err != nil {
 		return nil, err
@@ -243,14 +249,7 @@ func (q *serverQuery) ListServersWithKeyword(userInfo models.UserInfo, page, pag
 func (q *serverQuery) CountServers(userInfo models.UserInfo) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
-	err := db.Model(&models.Server{}).Where(
-		&models.Server{
-			ServerEntity: &models.ServerEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			},
-		},
-	).Count(&count).Error
+	err := db.Model(&models.Server{}).Where(tenantScope(db, userInfo)).Count(&count).Error
 	if err != nil {
 		return 0, err
 	}
@@ -260,14 +259,7 @@ func (q *serverQuery) CountServers(userInfo models.UserInfo) (int64, error) {
 func (q *serverQuery) CountServersWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
-	err := db.Model(&models.Server{}).Where(
-		&models.Server{
-			ServerEntity: &models.ServerEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			},
-		},
-	).Where("server_id like ?", "%"+keyword+"%").Count(&count).Error
+	err := db.Model(&models.Server{}).Where(tenantScope(db, userInfo)).Where("server_id like ?", "%"+keyword+"%").Count(&count).Error
 	if err != nil {
 		return 0, err
 	}
@@ -277,14 +269,7 @@ func (q *serverQuery) CountServersWithKeyword(userInfo models.UserInfo, keyword
 func (q *serverQuery) CountConfiguredServers(userInfo models.UserInfo) (int64, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var count int64
-	err := db.Model(&models.Server{}).Where(
-		&models.Server{
-			ServerEntity: &models.ServerEntity{
-				UserID:   userInfo.GetUserID(),
-				TenantID: userInfo.GetTenantID(),
-			},
-		},
-	).Not(
+	err := db.Model(&models.Server{}).Where(tenantScope(db, userInfo)).Not(
 		&models.Server{
 			ServerEntity: &models.ServerEntity{
 				ConfigContent: []byte{},
@@ -2,24 +2,43 @@ package dao
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/VaalaCat/frp-panel/models"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
 const (
 	MSetBatchSize = 100
+	// MaxTrafficPoints 单次最多读取的流量时序行数，避免大范围查询把整张表读进内存
+	MaxTrafficPoints = 100000
 )
 
+// TrafficPointFilter 流量时序筛选条件，零值表示不筛选，ClientID 同时匹配影子客户端的源客户端
+// Limit 为零或超过 MaxTrafficPoints 时按 MaxTrafficPoints 截断，截断时保留最新的数据
+type TrafficPointFilter struct {
+	ProxyID  int
+	ClientID string
+	ServerID string
+	UserID   int
+	Start    time.Time
+	End      time.Time
+	Limit    int
+}
+
 type StatsQuery interface {
 	GetHistoryStatsByProxyID(userInfo models.UserInfo, proxyID int) ([]*models.HistoryProxyStats, error)
 	GetHistoryStatsByClientID(userInfo models.UserInfo, clientID string) ([]*models.HistoryProxyStats, error)
 	GetHistoryStatsByServerID(userInfo models.UserInfo, serverID string) ([]*models.HistoryProxyStats, error)
+	ListTrafficPoints(userInfo models.UserInfo, filter TrafficPointFilter) ([]*models.TrafficPoint, error)
 }
 
 type StatsMutation interface {
 	AdminSaveTodyStats(s *models.HistoryProxyStats) error
 	AdminMSaveTodyStats(tx *gorm.DB, s []*models.HistoryProxyStats) error
+	AdminDownsampleTrafficPoints(before time.Time) (int, error)
+	AdminDeleteTrafficPointsBefore(resolution int, before time.Time) (int64, error)
 }
 
 type statsQuery struct{ *queryImpl }
@@ -89,3 +108,68 @@ func (q *statsQuery) GetHistoryStatsByServerID(userInfo models.UserInfo, serverI
 	}
 	return stats, nil
 }
+
+func (q *statsQuery) ListTrafficPoints(userInfo models.UserInfo, filter TrafficPointFilter) ([]*models.TrafficPoint, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	query := db.Model(&models.TrafficPoint{}).Where(tenantScope(db, userInfo))
+	if filter.ProxyID > 0 {
+		query = query.Where("proxy_id = ?", filter.ProxyID)
+	}
+	if len(filter.ClientID) > 0 {
+		query = query.Where("client_id = ? OR origin_client_id = ?", filter.ClientID, filter.ClientID)
+	}
+	if len(filter.ServerID) > 0 {
+		query = query.Where("server_id = ?", filter.ServerID)
+	}
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if !filter.Start.IsZero() {
+		query = query.Where("bucket_time >= ?", filter.Start)
+	}
+	if !filter.End.IsZero() {
+		query = query.Where("bucket_time < ?", filter.End)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > MaxTrafficPoints {
+		limit = MaxTrafficPoints
+	}
+
+	points := []*models.TrafficPoint{}
+	if err := query.Order("bucket_time desc").Limit(limit).Find(&points).Error; err != nil {
+		return nil, err
+	}
+	return lo.Reverse(points), nil
+}
+
+// AdminDownsampleTrafficPoints 把 before 之前的分钟数据合并为小时数据，返回合并掉的分钟数据行数
+func (m *statsMutation) AdminDownsampleTrafficPoints(before time.Time) (int, error) {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	merged := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		points := []*models.TrafficPoint{}
+		if err := tx.Where("resolution = ? AND bucket_time < ?", models.TrafficResolutionMinute, before).
+			Find(&points).Error; err != nil {
+			return err
+		}
+		if len(points) == 0 {
+			return nil
+		}
+
+		hourly := models.DownsampleTrafficPoints(points, models.TrafficResolutionHour)
+		if err := tx.CreateInBatches(hourly, MSetBatchSize).Error; err != nil {
+			return err
+		}
+		merged = len(points)
+		return tx.Where("resolution = ? AND bucket_time < ?", models.TrafficResolutionMinute, before).
+			Delete(&models.TrafficPoint{}).Error
+	})
+	return merged, err
+}
+
+func (m *statsMutation) AdminDeleteTrafficPointsBefore(resolution int, before time.Time) (int64, error) {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	result := db.Where("resolution = ? AND bucket_time < ?", resolution, before).Delete(&models.TrafficPoint{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,80 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProxyStatsAttributesProxyOwner(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	owner := apptest.CreateUser(t, ctx, "owner")
+	guest := apptest.CreateUser(t, ctx, "guest", func(u *models.UserEntity) { u.TenantID = 2 })
+
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	for _, c := range []*models.ClientEntity{
+		{ClientID: "owner-c", UserID: owner.UserID, ServerID: "s1"},
+		{ClientID: "guest-c", UserID: guest.UserID, TenantID: 2, ServerID: "s1"},
+	} {
+		c.ConnectSecret = "x"
+		c.ConfigContent = []byte(`{"proxies":[{"name":"web","type":"tcp","localPort":80}]}`)
+		require.NoError(t, db.Create(&models.Client{ClientEntity: c}).Error)
+	}
+
+	srv := &models.ServerEntity{ServerID: "s1", UserID: owner.UserID}
+	points, err := dao.NewMutation(ctx).AdminUpdateProxyStats(srv, []*pb.ProxyInfo{
+		{Name: lo.ToPtr("owner.web"), Type: lo.ToPtr("tcp"), TodayTrafficIn: lo.ToPtr(int64(10))},
+		{Name: lo.ToPtr("guest.web"), Type: lo.ToPtr("tcp"), TodayTrafficIn: lo.ToPtr(int64(20))},
+		{Name: lo.ToPtr("nobody.web"), Type: lo.ToPtr("tcp"), TodayTrafficIn: lo.ToPtr(int64(30))},
+	})
+	require.NoError(t, err)
+
+	byClient := lo.SliceToMap(points, func(p *models.TrafficPoint) (string, *models.TrafficPoint) { return p.ClientID, p })
+	require.Len(t, byClient, 2)
+	assert.Equal(t, owner.UserID, byClient["owner-c"].UserID)
+	assert.EqualValues(t, 10, byClient["owner-c"].TrafficIn)
+	assert.Equal(t, guest.UserID, byClient["guest-c"].UserID)
+	assert.Equal(t, 2, byClient["guest-c"].TenantID)
+	assert.EqualValues(t, 20, byClient["guest-c"].TrafficIn)
+
+	// 同名隧道按客户端区分，第二次上报只记录增量
+	points, err = dao.NewMutation(ctx).AdminUpdateProxyStats(srv, []*pb.ProxyInfo{
+		{Name: lo.ToPtr("guest.web"), Type: lo.ToPtr("tcp"), TodayTrafficIn: lo.ToPtr(int64(25))},
+	})
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	assert.Equal(t, "guest-c", points[0].ClientID)
+	assert.EqualValues(t, 5, points[0].TrafficIn)
+
+	guestPoints, err := dao.NewQuery(ctx).ListTrafficPoints(guest, dao.TrafficPointFilter{})
+	require.NoError(t, err)
+	assert.Len(t, guestPoints, 2)
+}
+
+func TestListTrafficPointsKeepsLatestWithinLimit(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	user := apptest.CreateUser(t, ctx, "alice")
+
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Create(&models.TrafficPoint{TrafficPointEntity: &models.TrafficPointEntity{
+			ProxyName:  "web",
+			UserID:     user.UserID,
+			Resolution: models.TrafficResolutionMinute,
+			BucketTime: base.Add(time.Duration(i) * time.Minute),
+			TrafficIn:  int64(i),
+		}}).Error)
+	}
+
+	points, err := dao.NewQuery(ctx).ListTrafficPoints(user, dao.TrafficPointFilter{Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 3, 4}, lo.Map(points, func(p *models.TrafficPoint, _ int) int64 { return p.TrafficIn }))
+}
@@ -0,0 +1,229 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+// tenantScope 资源可见范围：租户管理员可见所在租户全部资源，管理员与普通用户仍只可见自己的资源
+// tenant_id 显式拼条件，避免结构体条件忽略默认租户的 0 值
+func tenantScope(db *gorm.DB, userInfo models.UserInfo) *gorm.DB {
+	scoped := db.Where("tenant_id = ?", userInfo.GetTenantID())
+	if userInfo.IsTenantAdmin() {
+		return scoped
+	}
+	return scoped.Where("user_id = ?", userInfo.GetUserID())
+}
+
+// tenantManagerScope 审计等管理类数据，管理员与租户管理员可见所在租户全部记录
+func tenantManagerScope(db *gorm.DB, userInfo models.UserInfo) *gorm.DB {
+	scoped := db.Where("tenant_id = ?", userInfo.GetTenantID())
+	if userInfo.IsAdmin() || userInfo.IsTenantAdmin() {
+		return scoped
+	}
+	return scoped.Where("user_id = ?", userInfo.GetUserID())
+}
+
+// tenantOwnedTables 带有 user_id/tenant_id 的表，用户换租户时需要一起迁移
+var tenantOwnedTables = []any{
+	&models.Client{},
+	&models.Server{},
+	&models.ProxyConfig{},
+	&models.ProxyStats{},
+	&models.HistoryProxyStats{},
+	&models.ClientToken{},
+	&models.APIToken{},
+	&models.Worker{},
+	&models.WireGuard{},
+	&models.WireGuardLink{},
+	&models.WireGuardExternalPeer{},
+	&models.Network{},
+	&models.PTYRecording{},
+	&models.TrafficPoint{},
+	&models.TrafficQuota{},
+	&models.AlertRule{},
+	&models.AlertChannel{},
+	&models.AlertEvent{},
+	&models.WebhookSubscription{},
+	&models.WebhookDelivery{},
+}
+
+type TenantQuery interface {
+	AdminGetTenant(tenantID int) (*models.Tenant, error)
+	AdminListTenants(page, pageSize int, keyword string) ([]*models.Tenant, error)
+	AdminCountTenants(keyword string) (int64, error)
+	AdminCountTenantUsers(tenantID int) (int64, error)
+	AdminCountTenantClients(tenantID int) (int64, error)
+	AdminCountTenantServers(tenantID int) (int64, error)
+}
+
+type TenantMutation interface {
+	AdminCreateTenant(tenant *models.TenantEntity) (*models.Tenant, error)
+	AdminUpdateTenant(tenantID int, tenant *models.TenantEntity) error
+	AdminDeleteTenant(tenantID int) error
+	AdminAssignUserTenant(userID, tenantID int) error
+}
+
+type tenantQuery struct{ *queryImpl }
+
+type tenantMutation struct{ *mutationImpl }
+
+func newTenantQuery(base *queryImpl) TenantQuery { return &tenantQuery{base} }
+
+func newTenantMutation(base *mutationImpl) TenantMutation { return &tenantMutation{base} }
+
+func (q *tenantQuery) AdminGetTenant(tenantID int) (*models.Tenant, error) {
+	if tenantID <= 0 {
+		return nil, fmt.Errorf("invalid tenant id")
+	}
+
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	t := &models.Tenant{}
+	if err := db.Where("id = ?", tenantID).First(t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (q *tenantQuery) AdminListTenants(page, pageSize int, keyword string) ([]*models.Tenant, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	query := db.Model(&models.Tenant{})
+	if len(keyword) > 0 {
+		query = query.Where("name like ?", "%"+keyword+"%")
+	}
+
+	tenants := []*models.Tenant{}
+	err := query.Order("id asc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&tenants).Error
+	if err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (q *tenantQuery) AdminCountTenants(keyword string) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	query := db.Model(&models.Tenant{})
+	if len(keyword) > 0 {
+		query = query.Where("name like ?", "%"+keyword+"%")
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *tenantQuery) AdminCountTenantUsers(tenantID int) (int64, error) {
+	return q.countByTenant(&models.User{}, tenantID)
+}
+
+func (q *tenantQuery) AdminCountTenantClients(tenantID int) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	err := db.Model(&models.Client{}).
+		Where("tenant_id = ?", tenantID).
+		Where(normalClientFilter(db)).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *tenantQuery) AdminCountTenantServers(tenantID int) (int64, error) {
+	return q.countByTenant(&models.Server{}, tenantID)
+}
+
+func (q *tenantQuery) countByTenant(model any, tenantID int) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	if err := db.Model(model).Where("tenant_id = ?", tenantID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (m *tenantMutation) AdminCreateTenant(tenant *models.TenantEntity) (*models.Tenant, error) {
+	if tenant == nil || len(tenant.Name) == 0 {
+		return nil, fmt.Errorf("invalid tenant name")
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	t := &models.Tenant{TenantEntity: tenant}
+	if err := db.Create(t).Error; err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (m *tenantMutation) AdminUpdateTenant(tenantID int, tenant *models.TenantEntity) error {
+	if tenantID <= 0 || tenant == nil || len(tenant.Name) == 0 {
+		return fmt.Errorf("invalid tenant id or name")
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.Tenant{}).Where("id = ?", tenantID).Updates(map[string]interface{}{
+		"name":        tenant.Name,
+		"comment":     tenant.Comment,
+		"max_users":   tenant.MaxUsers,
+		"max_clients": tenant.MaxClients,
+		"max_servers": tenant.MaxServers,
+	}).Error
+}
+
+// AdminDeleteTenant 删除租户，租户下仍有用户时拒绝删除
+func (m *tenantMutation) AdminDeleteTenant(tenantID int) error {
+	if tenantID <= 0 {
+		return fmt.Errorf("invalid tenant id")
+	}
+
+	userCount, err := newTenantQuery(&queryImpl{ctx: m.ctx}).AdminCountTenantUsers(tenantID)
+	if err != nil {
+		return err
+	}
+	if userCount > 0 {
+		return fmt.Errorf("tenant still has %d users", userCount)
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tenant_id = ?", tenantID).Delete(&models.UserGroup{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", tenantID).Delete(&models.Tenant{}).Error
+	})
+}
+
+// AdminAssignUserTenant 把用户及其名下全部资源迁移到目标租户，原租户下的用户组关系会被清除
+func (m *tenantMutation) AdminAssignUserTenant(userID, tenantID int) error {
+	if userID <= 0 || tenantID < 0 {
+		return fmt.Errorf("invalid user id or tenant id")
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		u := &models.User{UserEntity: &models.UserEntity{UserID: userID}}
+		if err := tx.Model(u).Association("Groups").Clear(); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).Where("user_id = ?", userID).Update("tenant_id", tenantID).Error; err != nil {
+			return err
+		}
+
+		for _, table := range tenantOwnedTables {
+			if err := tx.Unscoped().Model(table).Where("user_id = ?", userID).
+				Update("tenant_id", tenantID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantScope(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	root := apptest.CreateUser(t, ctx, "root", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	tadmin := apptest.CreateUser(t, ctx, "tadmin", func(u *models.UserEntity) {
+		u.Role = defs.UserRole_TenantAdmin
+		u.TenantID = 2
+	})
+	alice := apptest.CreateUser(t, ctx, "alice", func(u *models.UserEntity) { u.TenantID = 2 })
+	bob := apptest.CreateUser(t, ctx, "bob")
+	outsider := apptest.CreateUser(t, ctx, "outsider", func(u *models.UserEntity) { u.TenantID = 3 })
+
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	for _, c := range []*models.ClientEntity{
+		{ClientID: "root-c", UserID: root.UserID, TenantID: 0},
+		{ClientID: "bob-c", UserID: bob.UserID, TenantID: 0},
+		{ClientID: "alice-c1", UserID: alice.UserID, TenantID: 2},
+		{ClientID: "alice-c2", UserID: alice.UserID, TenantID: 2},
+		{ClientID: "tadmin-c", UserID: tadmin.UserID, TenantID: 2},
+		{ClientID: "outsider-c", UserID: outsider.UserID, TenantID: 3},
+	} {
+		c.ConnectSecret = "x"
+		require.NoError(t, db.Create(&models.Client{ClientEntity: c}).Error)
+	}
+
+	count := func(u models.UserInfo) int64 {
+		n, err := dao.NewQuery(ctx).CountClients(u)
+		require.NoError(t, err)
+		return n
+	}
+
+	// 全局管理员保持原有范围，只能看到自己的资源
+	assert.EqualValues(t, 1, count(root))
+	// 租户管理员可见本租户全部资源
+	assert.EqualValues(t, 3, count(tadmin))
+	assert.EqualValues(t, 2, count(alice))
+	assert.EqualValues(t, 1, count(bob))
+	assert.EqualValues(t, 1, count(outsider))
+
+	clients, err := dao.NewQuery(ctx).ListClients(tadmin, 1, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"alice-c1", "alice-c2", "tadmin-c"},
+		lo.Map(clients, func(c *models.ClientEntity, _ int) string { return c.ClientID }))
+
+	// 单个资源经 rbac 鉴权后以属主身份读取
+	owner, err := rbac.Authorize(ctx, tadmin, defs.RBACObjClient, "alice-c1", defs.RBACActionUpdate)
+	require.NoError(t, err)
+	_, err = dao.NewQuery(ctx).GetClientByClientID(owner, "alice-c1")
+	assert.NoError(t, err)
+	_, err = rbac.Authorize(ctx, tadmin, defs.RBACObjClient, "outsider-c", defs.RBACActionRead)
+	assert.Error(t, err)
+}
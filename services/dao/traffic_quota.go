@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+type TrafficQuotaQuery interface {
+	GetTrafficQuota(userInfo models.UserInfo, id uint) (*models.TrafficQuota, error)
+	ListTrafficQuotas(userInfo models.UserInfo, targetType, targetID string) ([]*models.TrafficQuota, error)
+	AdminListTrafficQuotas() ([]*models.TrafficQuota, error)
+	AdminListTrafficQuotasByUserID(userID int) ([]*models.TrafficQuota, error)
+}
+
+type TrafficQuotaMutation interface {
+	AdminCreateTrafficQuota(q *models.TrafficQuotaEntity) (*models.TrafficQuota, error)
+	AdminUpdateTrafficQuota(q *models.TrafficQuota) error
+	AdminDeleteTrafficQuota(id uint) error
+	AdminAddTrafficQuotaUsage(id uint, bytes int64) (*models.TrafficQuota, error)
+	AdminMarkTrafficQuotaExceeded(id uint) (bool, error)
+	AdminResetTrafficQuota(id uint, cycleStart time.Time) error
+}
+
+type trafficQuotaQuery struct{ *queryImpl }
+type trafficQuotaMutation struct{ *mutationImpl }
+
+func newTrafficQuotaQuery(base *queryImpl) TrafficQuotaQuery { return &trafficQuotaQuery{base} }
+func newTrafficQuotaMutation(base *mutationImpl) TrafficQuotaMutation {
+	return &trafficQuotaMutation{base}
+}
+
+func (q *trafficQuotaQuery) GetTrafficQuota(userInfo models.UserInfo, id uint) (*models.TrafficQuota, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid quota id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	quota := &models.TrafficQuota{}
+	if err := db.Where(tenantScope(db, userInfo)).Where("id = ?", id).First(quota).Error; err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+func (q *trafficQuotaQuery) ListTrafficQuotas(userInfo models.UserInfo, targetType, targetID string) ([]*models.TrafficQuota, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Where(tenantScope(db, userInfo))
+	if len(targetType) > 0 {
+		scoped = scoped.Where("target_type = ?", targetType)
+	}
+	if len(targetID) > 0 {
+		scoped = scoped.Where("target_id = ?", targetID)
+	}
+	list := []*models.TrafficQuota{}
+	if err := scoped.Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *trafficQuotaQuery) AdminListTrafficQuotas() ([]*models.TrafficQuota, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.TrafficQuota{}
+	if err := db.Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *trafficQuotaQuery) AdminListTrafficQuotasByUserID(userID int) ([]*models.TrafficQuota, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.TrafficQuota{}
+	if err := db.Where("user_id = ?", userID).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *trafficQuotaMutation) AdminCreateTrafficQuota(q *models.TrafficQuotaEntity) (*models.TrafficQuota, error) {
+	if q == nil {
+		return nil, fmt.Errorf("invalid quota")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	quota := &models.TrafficQuota{Model: &gorm.Model{}, TrafficQuotaEntity: q}
+	if err := db.Create(quota).Error; err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+func (m *trafficQuotaMutation) AdminUpdateTrafficQuota(q *models.TrafficQuota) error {
+	if q == nil || q.Model == nil || q.ID == 0 {
+		return fmt.Errorf("invalid quota")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(q).Error
+}
+
+func (m *trafficQuotaMutation) AdminDeleteTrafficQuota(id uint) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Unscoped().Where("id = ?", id).Delete(&models.TrafficQuota{}).Error
+}
+
+// AdminAddTrafficQuotaUsage 原子累加已用流量并返回最新的配额
+func (m *trafficQuotaMutation) AdminAddTrafficQuotaUsage(id uint, bytes int64) (*models.TrafficQuota, error) {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	if err := db.Model(&models.TrafficQuota{}).Where("id = ?", id).
+		Update("used_bytes", gorm.Expr("used_bytes + ?", bytes)).Error; err != nil {
+		return nil, err
+	}
+	quota := &models.TrafficQuota{}
+	if err := db.Where("id = ?", id).First(quota).Error; err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// AdminMarkTrafficQuotaExceeded 标记配额超额，返回是否由本次调用完成标记，避免并发上报重复执行超额动作
+func (m *trafficQuotaMutation) AdminMarkTrafficQuotaExceeded(id uint) (bool, error) {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	result := db.Model(&models.TrafficQuota{}).Where("id = ? AND exceeded = ?", id, false).
+		Update("exceeded", true)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (m *trafficQuotaMutation) AdminResetTrafficQuota(id uint, cycleStart time.Time) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.TrafficQuota{}).Where("id = ?", id).
+		Updates(map[string]any{"used_bytes": 0, "exceeded": false, "cycle_start": cycleStart}).Error
+}
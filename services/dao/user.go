@@ -6,13 +6,18 @@ import (
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 type UserQuery interface {
 	AdminGetAllUsers() ([]*models.UserEntity, error)
 	AdminCountUsers() (int64, error)
+	ListUsers(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.UserEntity, error)
+	CountUsers(userInfo models.UserInfo, keyword string) (int64, error)
 	GetUserByUserID(userID int) (*models.UserEntity, error)
 	GetUserByUserName(userName string) (*models.UserEntity, error)
+	AdminGetUserBySSOSubject(subject string) (*models.UserEntity, error)
+	AdminGetUserByEmail(email string) (*models.UserEntity, error)
 	CheckUserPassword(userNameOrEmail, password string) (bool, models.UserInfo, error)
 	CheckUserNameAndEmail(userName, email string) error
 }
@@ -52,6 +57,43 @@ func (q *userQuery) AdminCountUsers() (int64, error) {
 	return count, nil
 }
 
+// userListScope 管理员可见全部用户，租户管理员只能看到本租户的用户
+func userListScope(db *gorm.DB, userInfo models.UserInfo, keyword string) *gorm.DB {
+	scoped := db.Model(&models.User{})
+	if !userInfo.IsAdmin() {
+		scoped = scoped.Where("tenant_id = ?", userInfo.GetTenantID())
+	}
+	if len(keyword) > 0 {
+		scoped = scoped.Where("user_name like ? or email like ?", "%"+keyword+"%", "%"+keyword+"%")
+	}
+	return scoped
+}
+
+func (q *userQuery) ListUsers(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.UserEntity, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	users := make([]*models.User, 0)
+	if err := userListScope(db, userInfo, keyword).Order("user_id asc").
+		Offset((page - 1) * pageSize).Limit(pageSize).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	return lo.Map(users,
+		func(u *models.User, _ int) *models.UserEntity {
+			return u.UserEntity
+		}), nil
+}
+
+func (q *userQuery) CountUsers(userInfo models.UserInfo, keyword string) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	if err := userListScope(db, userInfo, keyword).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (q *userQuery) GetUserByUserID(userID int) (*models.UserEntity, error) {
 	if userID == 0 {
 		return nil, fmt.Errorf("invalid user id")
@@ -114,6 +156,40 @@ func (q *userQuery) GetUserByUserName(userName string) (*models.UserEntity, erro
 	return u.UserEntity, nil
 }
 
+func (q *userQuery) AdminGetUserBySSOSubject(subject string) (*models.UserEntity, error) {
+	if subject == "" {
+		return nil, fmt.Errorf("invalid sso subject")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	u := &models.User{}
+	err := db.Where(&models.User{
+		UserEntity: &models.UserEntity{
+			SSOSubject: subject,
+		},
+	}).First(u).Error
+	if err != nil {
+		return nil, err
+	}
+	return u.UserEntity, nil
+}
+
+func (q *userQuery) AdminGetUserByEmail(email string) (*models.UserEntity, error) {
+	if email == "" {
+		return nil, fmt.Errorf("invalid email")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	u := &models.User{}
+	err := db.Where(&models.User{
+		UserEntity: &models.UserEntity{
+			Email: email,
+		},
+	}).First(u).Error
+	if err != nil {
+		return nil, err
+	}
+	return u.UserEntity, nil
+}
+
 func (q *userQuery) CheckUserPassword(userNameOrEmail, password string) (bool, models.UserInfo, error) {
 	var user models.User
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
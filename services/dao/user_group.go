@@ -3,13 +3,18 @@ package dao
 import (
 	"fmt"
 
-	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 )
 
 type UserGroupMutation interface {
 	CreateGroup(userInfo models.UserInfo, groupID, groupName, comment string) (*models.UserGroup, error)
 	DeleteGroup(userInfo models.UserInfo, groupID string) error
+	RenameGroup(userInfo models.UserInfo, groupID, groupName, comment string) error
+	AddGroupMember(userInfo models.UserInfo, groupID string, userID int) error
+	RemoveGroupMember(userInfo models.UserInfo, groupID string, userID int) error
+	AdminCreateGroup(group *models.UserGroup) error
+	AdminAddGroupMember(groupID string, userID int) error
+	AdminRemoveGroupMember(groupID string, userID int) error
 }
 
 type userGroupMutation struct{ *mutationImpl }
@@ -21,7 +26,7 @@ func (m *userGroupMutation) CreateGroup(userInfo models.UserInfo, groupID, group
 		return nil, fmt.Errorf("invalid group id or group name")
 	}
 
-	if userInfo.GetRole() != defs.UserRole_Admin {
+	if !userInfo.IsAdmin() && !userInfo.IsTenantAdmin() {
 		return nil, fmt.Errorf("only admin can create group")
 	}
 
@@ -42,13 +47,199 @@ func (m *userGroupMutation) CreateGroup(userInfo models.UserInfo, groupID, group
 }
 
 func (m *userGroupMutation) DeleteGroup(userInfo models.UserInfo, groupID string) error {
-	if userInfo.GetRole() != defs.UserRole_Admin {
+	if !userInfo.IsAdmin() && !userInfo.IsTenantAdmin() {
 		return fmt.Errorf("only admin can delete group")
 	}
 
+	g, err := newUserGroupQuery(&queryImpl{ctx: m.ctx}).GetGroup(userInfo, groupID)
+	if err != nil {
+		return err
+	}
+
 	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	if err := db.Model(g).Association("Users").Clear(); err != nil {
+		return err
+	}
 	return db.Unscoped().Where(&models.UserGroup{
 		TenantID: userInfo.GetTenantID(),
 		GroupID:  groupID,
 	}).Delete(&models.UserGroup{}).Error
 }
+
+type UserGroupQuery interface {
+	GetGroup(userInfo models.UserInfo, groupID string) (*models.UserGroup, error)
+	ListGroups(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.UserGroup, error)
+	CountGroups(userInfo models.UserInfo, keyword string) (int64, error)
+	ListGroupMembers(userInfo models.UserInfo, groupID string) ([]*models.UserEntity, error)
+	AdminGetGroupByName(tenantID int, groupName string) (*models.UserGroup, error)
+	AdminListUserGroups(userID int) ([]*models.UserGroup, error)
+}
+
+type userGroupQuery struct{ *queryImpl }
+
+func newUserGroupQuery(base *queryImpl) UserGroupQuery { return &userGroupQuery{base} }
+
+func (q *userGroupQuery) GetGroup(userInfo models.UserInfo, groupID string) (*models.UserGroup, error) {
+	if groupID == "" {
+		return nil, fmt.Errorf("invalid group id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	g := &models.UserGroup{}
+	if err := db.Where(&models.UserGroup{
+		TenantID: userInfo.GetTenantID(),
+		GroupID:  groupID,
+	}).First(g).Error; err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (q *userGroupQuery) ListGroups(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.UserGroup, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.UserGroup{}
+	base := db.Where(&models.UserGroup{TenantID: userInfo.GetTenantID()})
+	if len(keyword) > 0 {
+		base = base.Where("group_name like ?", "%"+keyword+"%")
+	}
+	if err := base.Order("created_at desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *userGroupQuery) CountGroups(userInfo models.UserInfo, keyword string) (int64, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var count int64
+	base := db.Model(&models.UserGroup{}).Where(&models.UserGroup{TenantID: userInfo.GetTenantID()})
+	if len(keyword) > 0 {
+		base = base.Where("group_name like ?", "%"+keyword+"%")
+	}
+	if err := base.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *userGroupQuery) ListGroupMembers(userInfo models.UserInfo, groupID string) ([]*models.UserEntity, error) {
+	g, err := q.GetGroup(userInfo, groupID)
+	if err != nil {
+		return nil, err
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	users := []*models.User{}
+	if err := db.Model(g).Association("Users").Find(&users); err != nil {
+		return nil, err
+	}
+	ret := make([]*models.UserEntity, 0, len(users))
+	for _, u := range users {
+		ret = append(ret, u.UserEntity)
+	}
+	return ret, nil
+}
+
+func (m *userGroupMutation) RenameGroup(userInfo models.UserInfo, groupID, groupName, comment string) error {
+	if groupID == "" || groupName == "" {
+		return fmt.Errorf("invalid group id or group name")
+	}
+
+	if !userInfo.IsAdmin() && !userInfo.IsTenantAdmin() {
+		return fmt.Errorf("only admin can update group")
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.UserGroup{}).Where(&models.UserGroup{
+		TenantID: userInfo.GetTenantID(),
+		GroupID:  groupID,
+	}).Updates(map[string]interface{}{
+		"group_name": groupName,
+		"comment":    comment,
+	}).Error
+}
+
+// AddGroupMember 将同租户下的用户加入用户组
+func (m *userGroupMutation) AddGroupMember(userInfo models.UserInfo, groupID string, userID int) error {
+	if !userInfo.IsAdmin() && !userInfo.IsTenantAdmin() {
+		return fmt.Errorf("only admin can manage group members")
+	}
+
+	q := &queryImpl{ctx: m.ctx}
+	g, err := newUserGroupQuery(q).GetGroup(userInfo, groupID)
+	if err != nil {
+		return err
+	}
+	u, err := newUserQuery(q).GetUserByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if u.GetTenantID() != g.TenantID {
+		return fmt.Errorf("user not in group tenant")
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(g).Association("Users").Append(&models.User{UserEntity: u})
+}
+
+func (m *userGroupMutation) RemoveGroupMember(userInfo models.UserInfo, groupID string, userID int) error {
+	if !userInfo.IsAdmin() && !userInfo.IsTenantAdmin() {
+		return fmt.Errorf("only admin can manage group members")
+	}
+
+	q := &queryImpl{ctx: m.ctx}
+	g, err := newUserGroupQuery(q).GetGroup(userInfo, groupID)
+	if err != nil {
+		return err
+	}
+	u, err := newUserQuery(q).GetUserByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(g).Association("Users").Delete(&models.User{UserEntity: u})
+}
+
+func (q *userGroupQuery) AdminGetGroupByName(tenantID int, groupName string) (*models.UserGroup, error) {
+	if groupName == "" {
+		return nil, fmt.Errorf("invalid group name")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	g := &models.UserGroup{}
+	if err := db.Where("tenant_id = ? AND group_name = ?", tenantID, groupName).First(g).Error; err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// AdminListUserGroups 列出用户加入的全部用户组
+func (q *userGroupQuery) AdminListUserGroups(userID int) ([]*models.UserGroup, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	groups := []*models.UserGroup{}
+	u := &models.User{UserEntity: &models.UserEntity{UserID: userID}}
+	if err := db.Model(u).Association("Groups").Find(&groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (m *userGroupMutation) AdminCreateGroup(group *models.UserGroup) error {
+	if group == nil || group.GroupID == "" || group.GroupName == "" {
+		return fmt.Errorf("invalid group id or group name")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(group).Error
+}
+
+func (m *userGroupMutation) AdminAddGroupMember(groupID string, userID int) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.UserGroup{GroupID: groupID}).Omit("Users.*").Association("Users").
+		Append(&models.User{UserEntity: &models.UserEntity{UserID: userID}})
+}
+
+func (m *userGroupMutation) AdminRemoveGroupMember(groupID string, userID int) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Model(&models.UserGroup{GroupID: groupID}).Association("Users").
+		Delete(&models.User{UserEntity: &models.UserEntity{UserID: userID}})
+}
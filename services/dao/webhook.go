@@ -0,0 +1,182 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+type WebhookQuery interface {
+	GetWebhookSubscription(userInfo models.UserInfo, id uint) (*models.WebhookSubscription, error)
+	ListWebhookSubscriptions(userInfo models.UserInfo) ([]*models.WebhookSubscription, error)
+	AdminGetWebhookSubscription(id uint) (*models.WebhookSubscription, error)
+	AdminListEnabledWebhookSubscriptions() ([]*models.WebhookSubscription, error)
+	GetWebhookDelivery(userInfo models.UserInfo, id uint) (*models.WebhookDelivery, error)
+	ListWebhookDeliveries(userInfo models.UserInfo, subscriptionID uint, status string, page, pageSize int) ([]*models.WebhookDelivery, error)
+	CountWebhookDeliveries(userInfo models.UserInfo, subscriptionID uint, status string) (int64, error)
+	AdminListDueWebhookDeliveries(now time.Time, limit int) ([]*models.WebhookDelivery, error)
+}
+
+type WebhookMutation interface {
+	AdminCreateWebhookSubscription(s *models.WebhookSubscriptionEntity) (*models.WebhookSubscription, error)
+	AdminUpdateWebhookSubscription(s *models.WebhookSubscription) error
+	AdminDeleteWebhookSubscription(id uint) error
+	AdminCreateWebhookDelivery(d *models.WebhookDeliveryEntity) (*models.WebhookDelivery, error)
+	AdminUpdateWebhookDelivery(d *models.WebhookDelivery) error
+}
+
+type webhookQuery struct{ *queryImpl }
+type webhookMutation struct{ *mutationImpl }
+
+func newWebhookQuery(base *queryImpl) WebhookQuery          { return &webhookQuery{base} }
+func newWebhookMutation(base *mutationImpl) WebhookMutation { return &webhookMutation{base} }
+
+func (q *webhookQuery) GetWebhookSubscription(userInfo models.UserInfo, id uint) (*models.WebhookSubscription, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid webhook subscription id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	sub := &models.WebhookSubscription{}
+	if err := db.Where(tenantScope(db, userInfo)).Where("id = ?", id).First(sub).Error; err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (q *webhookQuery) ListWebhookSubscriptions(userInfo models.UserInfo) ([]*models.WebhookSubscription, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.WebhookSubscription{}
+	if err := db.Where(tenantScope(db, userInfo)).Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *webhookQuery) AdminGetWebhookSubscription(id uint) (*models.WebhookSubscription, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	sub := &models.WebhookSubscription{}
+	if err := db.Where("id = ?", id).First(sub).Error; err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (q *webhookQuery) AdminListEnabledWebhookSubscriptions() ([]*models.WebhookSubscription, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.WebhookSubscription{}
+	if err := db.Where("enabled = ?", true).Order("id asc").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *webhookQuery) GetWebhookDelivery(userInfo models.UserInfo, id uint) (*models.WebhookDelivery, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid webhook delivery id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	d := &models.WebhookDelivery{}
+	if err := db.Where(tenantScope(db, userInfo)).Where("id = ?", id).First(d).Error; err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (q *webhookQuery) webhookDeliveryScope(userInfo models.UserInfo, subscriptionID uint, status string) *gorm.DB {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Model(&models.WebhookDelivery{}).Where(tenantScope(db, userInfo))
+	if subscriptionID > 0 {
+		scoped = scoped.Where("subscription_id = ?", subscriptionID)
+	}
+	if len(status) > 0 {
+		scoped = scoped.Where("status = ?", status)
+	}
+	return scoped
+}
+
+func (q *webhookQuery) ListWebhookDeliveries(userInfo models.UserInfo, subscriptionID uint, status string, page, pageSize int) ([]*models.WebhookDelivery, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	list := []*models.WebhookDelivery{}
+	err := q.webhookDeliveryScope(userInfo, subscriptionID, status).
+		Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *webhookQuery) CountWebhookDeliveries(userInfo models.UserInfo, subscriptionID uint, status string) (int64, error) {
+	var count int64
+	if err := q.webhookDeliveryScope(userInfo, subscriptionID, status).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AdminListDueWebhookDeliveries 到达重试时间的 pending 推送，按重试时间排序
+func (q *webhookQuery) AdminListDueWebhookDeliveries(now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	list := []*models.WebhookDelivery{}
+	err := db.Where("status = ?", models.WebhookDeliveryStatusPending).
+		Where("next_retry_at <= ?", now).
+		Order("next_retry_at asc").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (m *webhookMutation) AdminCreateWebhookSubscription(s *models.WebhookSubscriptionEntity) (*models.WebhookSubscription, error) {
+	if s == nil {
+		return nil, fmt.Errorf("invalid webhook subscription")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	sub := &models.WebhookSubscription{Model: &gorm.Model{}, WebhookSubscriptionEntity: s}
+	if err := db.Create(sub).Error; err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (m *webhookMutation) AdminUpdateWebhookSubscription(s *models.WebhookSubscription) error {
+	if s == nil || s.Model == nil || s.ID == 0 {
+		return fmt.Errorf("invalid webhook subscription")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(s).Error
+}
+
+// AdminDeleteWebhookSubscription 删除订阅及其推送记录
+func (m *webhookMutation) AdminDeleteWebhookSubscription(id uint) error {
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", id).Delete(&models.WebhookSubscription{}).Error
+	})
+}
+
+func (m *webhookMutation) AdminCreateWebhookDelivery(d *models.WebhookDeliveryEntity) (*models.WebhookDelivery, error) {
+	if d == nil {
+		return nil, fmt.Errorf("invalid webhook delivery")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	delivery := &models.WebhookDelivery{WebhookDeliveryEntity: d}
+	if err := db.Create(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (m *webhookMutation) AdminUpdateWebhookDelivery(d *models.WebhookDelivery) error {
+	if d == nil || d.ID == 0 {
+		return fmt.Errorf("invalid webhook delivery")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Save(d).Error
+}
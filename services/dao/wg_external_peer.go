@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+type ExternalPeerQuery interface {
+	GetWireGuardExternalPeerByID(userInfo models.UserInfo, id uint) (*models.WireGuardExternalPeer, error)
+	GetWireGuardExternalPeerAddressesByNetworkID(userInfo models.UserInfo, networkID uint) ([]string, error)
+	ListWireGuardExternalPeersWithFilters(userInfo models.UserInfo, page, pageSize int, networkID uint, keyword string) ([]*models.WireGuardExternalPeer, error)
+	CountWireGuardExternalPeersWithFilters(userInfo models.UserInfo, networkID uint, keyword string) (int64, error)
+	AdminListWireGuardExternalPeersWithNetworkIDs(networkIDs []uint) ([]*models.WireGuardExternalPeer, error)
+}
+
+type ExternalPeerMutation interface {
+	CreateWireGuardExternalPeer(userInfo models.UserInfo, peer *models.WireGuardExternalPeer) error
+	DeleteWireGuardExternalPeer(userInfo models.UserInfo, id uint) error
+	DeleteWireGuardExternalPeersByRelayID(userInfo models.UserInfo, relayWireGuardID uint) error
+}
+
+type externalPeerQuery struct{ *queryImpl }
+type externalPeerMutation struct{ *mutationImpl }
+
+func newExternalPeerQuery(base *queryImpl) ExternalPeerQuery { return &externalPeerQuery{base} }
+func newExternalPeerMutation(base *mutationImpl) ExternalPeerMutation {
+	return &externalPeerMutation{base}
+}
+
+func (m *externalPeerMutation) CreateWireGuardExternalPeer(userInfo models.UserInfo, peer *models.WireGuardExternalPeer) error {
+	if peer == nil || peer.WireGuardExternalPeerEntity == nil {
+		return fmt.Errorf("invalid wireguard external peer entity")
+	}
+	if len(peer.Name) == 0 || len(peer.PublicKey) == 0 || len(peer.LocalAddress) == 0 {
+		return fmt.Errorf("invalid wireguard external peer fields")
+	}
+
+	peer.UserId = uint32(userInfo.GetUserID())
+	peer.TenantId = uint32(userInfo.GetTenantID())
+
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Create(peer).Error
+}
+
+func (m *externalPeerMutation) DeleteWireGuardExternalPeer(userInfo models.UserInfo, id uint) error {
+	if id == 0 {
+		return fmt.Errorf("invalid wireguard external peer id")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Unscoped().Where(&models.WireGuardExternalPeer{
+		Model: gorm.Model{ID: id},
+		WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+			UserId:   uint32(userInfo.GetUserID()),
+			TenantId: uint32(userInfo.GetTenantID()),
+		},
+	}).Delete(&models.WireGuardExternalPeer{}).Error
+}
+
+// DeleteWireGuardExternalPeersByRelayID 中继节点删除后，挂在它上面的外部设备无法再接入
+func (m *externalPeerMutation) DeleteWireGuardExternalPeersByRelayID(userInfo models.UserInfo, relayWireGuardID uint) error {
+	if relayWireGuardID == 0 {
+		return fmt.Errorf("invalid relay wireguard id")
+	}
+	db := m.ctx.GetApp().GetDBManager().GetDefaultDB()
+	return db.Unscoped().Where(&models.WireGuardExternalPeer{
+		WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+			UserId:           uint32(userInfo.GetUserID()),
+			TenantId:         uint32(userInfo.GetTenantID()),
+			RelayWireGuardID: relayWireGuardID,
+		},
+	}).Delete(&models.WireGuardExternalPeer{}).Error
+}
+
+func (q *externalPeerQuery) GetWireGuardExternalPeerByID(userInfo models.UserInfo, id uint) (*models.WireGuardExternalPeer, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid wireguard external peer id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var m models.WireGuardExternalPeer
+	if err := db.Preload("Network").Preload("Relay").Preload("Relay.AdvertisedEndpoints").
+		Where(&models.WireGuardExternalPeer{
+			Model: gorm.Model{ID: id},
+			WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+				UserId:   uint32(userInfo.GetUserID()),
+				TenantId: uint32(userInfo.GetTenantID()),
+			},
+		}).First(&m).Error; err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (q *externalPeerQuery) GetWireGuardExternalPeerAddressesByNetworkID(userInfo models.UserInfo, networkID uint) ([]string, error) {
+	if networkID == 0 {
+		return nil, fmt.Errorf("invalid network id")
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var list []string
+	if err := db.Model(&models.WireGuardExternalPeer{}).Where(&models.WireGuardExternalPeer{
+		WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+			UserId:    uint32(userInfo.GetUserID()),
+			TenantId:  uint32(userInfo.GetTenantID()),
+			NetworkID: networkID,
+		},
+	}).Pluck("local_address", &list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *externalPeerQuery) externalPeerScope(userInfo models.UserInfo, networkID uint, keyword string) *gorm.DB {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	scoped := db.Model(&models.WireGuardExternalPeer{}).Where(&models.WireGuardExternalPeer{
+		WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+			UserId:    uint32(userInfo.GetUserID()),
+			TenantId:  uint32(userInfo.GetTenantID()),
+			NetworkID: networkID,
+		},
+	})
+	if len(keyword) > 0 {
+		scoped = scoped.Where("name like ? OR local_address like ?", "%"+keyword+"%", "%"+keyword+"%")
+	}
+	return scoped
+}
+
+func (q *externalPeerQuery) ListWireGuardExternalPeersWithFilters(userInfo models.UserInfo, page, pageSize int, networkID uint, keyword string) ([]*models.WireGuardExternalPeer, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+	var list []*models.WireGuardExternalPeer
+	if err := q.externalPeerScope(userInfo, networkID, keyword).
+		Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *externalPeerQuery) CountWireGuardExternalPeersWithFilters(userInfo models.UserInfo, networkID uint, keyword string) (int64, error) {
+	var count int64
+	if err := q.externalPeerScope(userInfo, networkID, keyword).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *externalPeerQuery) AdminListWireGuardExternalPeersWithNetworkIDs(networkIDs []uint) ([]*models.WireGuardExternalPeer, error) {
+	if len(networkIDs) == 0 {
+		return []*models.WireGuardExternalPeer{}, nil
+	}
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var list []*models.WireGuardExternalPeer
+	if err := db.Where("network_id IN ?", networkIDs).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -13,6 +13,7 @@ type WireGuardQuery interface {
 	GetWireGuardsByNetworkID(userInfo models.UserInfo, networkID uint) ([]*models.WireGuard, error)
 	GetWireGuardLocalAddressesByNetworkID(userInfo models.UserInfo, networkID uint) ([]string, error)
 	ListWireGuardsWithFilters(userInfo models.UserInfo, page, pageSize int, filter *models.WireGuardEntity, keyword string) ([]*models.WireGuard, error)
+	GetAllWireGuards(userInfo models.UserInfo) ([]*models.WireGuard, error)
 	AdminListWireGuardsWithClientID(clientID string) ([]*models.WireGuard, error)
 	AdminListWireGuardsWithNetworkIDs(networkIDs []uint) ([]*models.WireGuard, error)
 	CountWireGuardsWithFilters(userInfo models.UserInfo, filter *models.WireGuardEntity, keyword string) (int64, error)
@@ -193,6 +194,18 @@ func (q *wireGuardQuery) ListWireGuardsWithFilters(userInfo models.UserInfo, pag
 	return list, nil
 }
 
+func (q *wireGuardQuery) GetAllWireGuards(userInfo models.UserInfo) ([]*models.WireGuard, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var list []*models.WireGuard
+	if err := db.Where(&models.WireGuard{WireGuardEntity: &models.WireGuardEntity{
+		UserId:   uint32(userInfo.GetUserID()),
+		TenantId: uint32(userInfo.GetTenantID()),
+	}}).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
 func (q *wireGuardQuery) AdminListWireGuardsWithClientID(clientID string) ([]*models.WireGuard, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	var list []*models.WireGuard
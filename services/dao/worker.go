@@ -9,6 +9,7 @@ import (
 type WorkerQuery interface {
 	GetWorkerByWorkerID(userInfo models.UserInfo, workerID string) (*models.Worker, error)
 	ListWorkers(userInfo models.UserInfo, page, pageSize int) ([]*models.Worker, error)
+	GetAllWorkers(userInfo models.UserInfo) ([]*models.Worker, error)
 	AdminListWorkersByClientID(clientID string) ([]*models.Worker, error)
 	ListWorkersWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.Worker, error)
 	CountWorkers(userInfo models.UserInfo) (int64, error)
@@ -117,6 +118,21 @@ func (q *workerQuery) ListWorkers(userInfo models.UserInfo, page, pageSize int)
 	return workers, nil
 }
 
+func (q *workerQuery) GetAllWorkers(userInfo models.UserInfo) ([]*models.Worker, error) {
+	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	var workers []*models.Worker
+	err := db.Where(&models.Worker{
+		WorkerEntity: &models.WorkerEntity{
+			UserId:   uint32(userInfo.GetUserID()),
+			TenantId: uint32(userInfo.GetTenantID()),
+		},
+	}).Preload("Clients").Find(&workers).Error
+	if err != nil {
+		return nil, err
+	}
+	return workers, nil
+}
+
 func (q *workerQuery) AdminListWorkersByClientID(clientID string) ([]*models.Worker, error) {
 	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
 	client, err := newClientQuery(q.queryImpl).AdminGetClientByClientID(clientID)
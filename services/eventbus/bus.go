@@ -0,0 +1,85 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/google/uuid"
+)
+
+const subscriberBufSize = 256
+
+// Bus 进程内的事件总线，订阅者消费过慢时丢弃事件，不阻塞发布方
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[string]chan *defs.PanelEvent
+}
+
+func NewBus() app.EventBus {
+	return &Bus{subscribers: map[string]chan *defs.PanelEvent{}}
+}
+
+func (b *Bus) Publish(e *defs.PanelEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+func (b *Bus) Subscribe() (string, <-chan *defs.PanelEvent) {
+	id := uuid.New().String()
+	ch := make(chan *defs.PanelEvent, subscriberBufSize)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[id] = ch
+	return id, ch
+}
+
+func (b *Bus) Unsubscribe(subID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ch, ok := b.subscribers[subID]; ok {
+		delete(b.subscribers, subID)
+		close(ch)
+	}
+}
+
+// Emit 发布事件，未启用事件总线（非 master）时忽略
+func Emit(ctx *app.Context, eventType string, objType defs.RBACObj, objID string, data any) {
+	bus := ctx.GetApp().GetEventBus()
+	if bus == nil {
+		return
+	}
+	bus.Publish(&defs.PanelEvent{
+		ID:      uuid.New().String(),
+		Type:    eventType,
+		Time:    time.Now().UnixMilli(),
+		ObjType: objType,
+		ObjID:   objID,
+		Data:    data,
+	})
+}
+
+// WatchClients 把客户端与服务端的连接、断开转为事件
+func WatchClients(appInstance app.Application) {
+	ctx := app.NewContext(context.Background(), appInstance)
+	appInstance.GetClientsManager().OnChange(func(conn *defs.Connector, connected bool) {
+		objType := defs.RBACObjClient
+		if conn.CliType == defs.CliTypeServer {
+			objType = defs.RBACObjServer
+		}
+		eventType := defs.EventClientDisconnected
+		if connected {
+			eventType = defs.EventClientConnected
+		}
+		Emit(ctx, eventType, objType, conn.CliID, nil)
+	})
+}
@@ -0,0 +1,29 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus(t *testing.T) {
+	bus := NewBus()
+	id1, ch1 := bus.Subscribe()
+	_, ch2 := bus.Subscribe()
+
+	e := &defs.PanelEvent{Type: defs.EventClientConnected, ObjType: defs.RBACObjClient, ObjID: "c1"}
+	bus.Publish(e)
+	assert.Equal(t, e, <-ch1)
+	assert.Equal(t, e, <-ch2)
+
+	bus.Unsubscribe(id1)
+	_, ok := <-ch1
+	assert.False(t, ok)
+
+	// 订阅者不消费时丢弃事件，发布方不阻塞
+	for i := 0; i < subscriberBufSize+10; i++ {
+		bus.Publish(e)
+	}
+	assert.Len(t, ch2, subscriberBufSize)
+}
@@ -0,0 +1,364 @@
+package logarchive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// segmentLayout 每小时一个分段文件，文件名按字典序即时间序
+	segmentLayout = "2006010215"
+	segmentExt    = ".log.gz"
+)
+
+// Entry 归档的一行日志
+type Entry struct {
+	Time  int64  `json:"ts"` // unix milli
+	Pkg   string `json:"pkg,omitempty"`
+	Level string `json:"level,omitempty"`
+	Msg   string `json:"msg"`
+}
+
+// Query 检索条件，Start/End 为零值表示不限，Pkgs 为空表示全部包，Keyword 为空表示不过滤内容
+type Query struct {
+	ClientID string
+	Start    time.Time
+	End      time.Time
+	Pkgs     []string
+	Keyword  string
+	Regex    bool
+	Limit    int
+}
+
+// segmentWriter 单个客户端正在写的分段，压缩和落盘只持有自己的锁，不同客户端互不阻塞
+type segmentWriter struct {
+	mu     sync.Mutex
+	hour   string
+	file   *os.File
+	gz     *gzip.Writer
+	dirty  bool
+	closed bool
+}
+
+// flush 把压缩缓冲写到文件，调用方需持有 w.mu
+func (w *segmentWriter) flush() error {
+	if w.gz == nil || !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	return w.gz.Flush()
+}
+
+// close 关闭当前分段，调用方需持有 w.mu
+func (w *segmentWriter) close() error {
+	if w.gz == nil {
+		return nil
+	}
+	err := errors.Join(w.gz.Close(), w.file.Close())
+	w.gz, w.file, w.hour, w.dirty = nil, nil, "", false
+	return err
+}
+
+// Store 按客户端与小时分段，把日志以 gzip 压缩的 json lines 存到磁盘
+// 目录结构为 <dir>/<clientID>/<YYYYMMDDHH>.log.gz，时间均为 UTC
+// mu 只保护 writers 表，加锁顺序固定为 mu -> segmentWriter.mu
+type Store struct {
+	dir     string
+	mu      sync.Mutex
+	writers map[string]*segmentWriter
+}
+
+func NewStore(dir string) *Store {
+	return &Store{
+		dir:     dir,
+		writers: map[string]*segmentWriter{},
+	}
+}
+
+func (s *Store) clientDir(clientID string) (string, error) {
+	if len(clientID) == 0 || clientID == "." || clientID == ".." || strings.ContainsAny(clientID, `/\`) {
+		return "", fmt.Errorf("invalid client id: [%s]", clientID)
+	}
+	return filepath.Join(s.dir, clientID), nil
+}
+
+// Append 写入一行日志，跨小时时自动切换到新的分段
+func (s *Store) Append(clientID string, e Entry) error {
+	dir, err := s.clientDir(clientID)
+	if err != nil {
+		return err
+	}
+	hour := time.UnixMilli(e.Time).UTC().Format(segmentLayout)
+
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	w := s.lockWriter(clientID)
+	defer w.mu.Unlock()
+
+	if w.gz != nil && w.hour != hour {
+		if err := w.close(); err != nil {
+			return err
+		}
+	}
+	if w.gz == nil {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		// 重启后追加到同一个文件会产生新的 gzip member，读取时会自动拼接
+		f, err := os.OpenFile(filepath.Join(dir, hour+segmentExt), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		w.hour, w.file, w.gz = hour, f, gzip.NewWriter(f)
+	}
+
+	w.dirty = true
+	_, err = w.gz.Write(append(raw, '\n'))
+	return err
+}
+
+// lockWriter 返回已加锁的客户端 writer，拿到的 writer 刚被 Purge 回收时重新获取
+func (s *Store) lockWriter(clientID string) *segmentWriter {
+	for {
+		s.mu.Lock()
+		w, ok := s.writers[clientID]
+		if !ok {
+			w = &segmentWriter{}
+			s.writers[clientID] = w
+		}
+		s.mu.Unlock()
+
+		w.mu.Lock()
+		if !w.closed {
+			return w
+		}
+		w.mu.Unlock()
+	}
+}
+
+func (s *Store) snapshotWriters() []*segmentWriter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return lo.Values(s.writers)
+}
+
+// Flush 把所有客户端的压缩缓冲写到文件，定时调用以减少进程崩溃时丢失的日志
+func (s *Store) Flush() error {
+	errs := []error{}
+	for _, w := range s.snapshotWriters() {
+		w.mu.Lock()
+		errs = append(errs, w.flush())
+		w.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Search 按时间顺序返回匹配的日志，超过 Limit 时截断并返回 true
+func (s *Store) Search(q Query) ([]Entry, bool, error) {
+	dir, err := s.clientDir(q.ClientID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	match := func(string) bool { return true }
+	if len(q.Keyword) > 0 {
+		if q.Regex {
+			re, err := regexp.Compile(q.Keyword)
+			if err != nil {
+				return nil, false, err
+			}
+			match = re.MatchString
+		} else {
+			match = func(msg string) bool { return strings.Contains(msg, q.Keyword) }
+		}
+	}
+	pkgs := lo.SliceToMap(lo.Compact(q.Pkgs), func(p string) (string, bool) { return p, true })
+
+	// 正在写的分段先刷出压缩缓冲，保证能搜到最新的日志
+	s.mu.Lock()
+	w, ok := s.writers[q.ClientID]
+	s.mu.Unlock()
+	if ok {
+		w.mu.Lock()
+		w.flush()
+		w.mu.Unlock()
+	}
+
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	result := []Entry{}
+	truncated := false
+	for _, f := range files {
+		hour, ok := segmentHour(f.Name())
+		if !ok {
+			continue
+		}
+		if !q.End.IsZero() && hour.After(q.End) {
+			break
+		}
+		if !q.Start.IsZero() && !hour.Add(time.Hour).After(q.Start) {
+			continue
+		}
+
+		err := readSegment(filepath.Join(dir, f.Name()), func(e Entry) bool {
+			t := time.UnixMilli(e.Time)
+			if (!q.Start.IsZero() && t.Before(q.Start)) || (!q.End.IsZero() && t.After(q.End)) {
+				return true
+			}
+			if len(pkgs) > 0 && !pkgs[e.Pkg] {
+				return true
+			}
+			if !match(e.Msg) {
+				return true
+			}
+			if q.Limit > 0 && len(result) >= q.Limit {
+				truncated = true
+				return false
+			}
+			result = append(result, e)
+			return true
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if truncated {
+			break
+		}
+	}
+	return result, truncated, nil
+}
+
+// Purge 关闭已经过了当前小时的分段，并删除早于 before 的分段，返回删除的文件数，before 为零值时不删除
+func (s *Store) Purge(now, before time.Time) (int, error) {
+	current := now.UTC().Format(segmentLayout)
+
+	s.mu.Lock()
+	for clientID, w := range s.writers {
+		w.mu.Lock()
+		if w.hour != current {
+			w.close()
+			w.closed = true
+			delete(s.writers, clientID)
+		}
+		w.mu.Unlock()
+	}
+	s.mu.Unlock()
+
+	if before.IsZero() {
+		return 0, nil
+	}
+
+	clients, err := os.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, c := range clients {
+		if !c.IsDir() {
+			continue
+		}
+		dir := filepath.Join(s.dir, c.Name())
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			return removed, err
+		}
+		left := len(files)
+		for _, f := range files {
+			hour, ok := segmentHour(f.Name())
+			if !ok || hour.Add(time.Hour).After(before) {
+				continue
+			}
+			if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+				return removed, err
+			}
+			removed++
+			left--
+		}
+		if left == 0 {
+			os.Remove(dir)
+		}
+	}
+	return removed, nil
+}
+
+// Close 关闭所有正在写的分段
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	errs := []error{}
+	for clientID, w := range s.writers {
+		w.mu.Lock()
+		errs = append(errs, w.close())
+		w.closed = true
+		w.mu.Unlock()
+		delete(s.writers, clientID)
+	}
+	return errors.Join(errs...)
+}
+
+func segmentHour(name string) (time.Time, bool) {
+	if !strings.HasSuffix(name, segmentExt) {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(segmentLayout, strings.TrimSuffix(name, segmentExt), time.UTC)
+	return t, err == nil
+}
+
+// readSegment 逐行读取分段，fn 返回 false 时停止，正在写入的分段末尾不完整时视为读完
+func readSegment(path string, fn func(e Entry) bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if json.Unmarshal(scanner.Bytes(), &e) != nil {
+			continue
+		}
+		if !fn(e) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package logarchive
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreAppendAndSearch(t *testing.T) {
+	s := NewStore(t.TempDir())
+	defer s.Close()
+
+	base := time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC)
+	entries := []Entry{
+		{Time: base.UnixMilli(), Pkg: "frpc", Level: "info", Msg: "login to server success"},
+		{Time: base.Add(10 * time.Minute).UnixMilli(), Pkg: "wg", Level: "error", Msg: "handshake timeout"},
+		{Time: base.Add(40 * time.Minute).UnixMilli(), Pkg: "frpc", Level: "warning", Msg: "proxy [web] reconnect"},
+		{Time: base.Add(2 * time.Hour).UnixMilli(), Pkg: "frpc", Level: "error", Msg: "login to server failed"},
+	}
+	for _, e := range entries {
+		require.NoError(t, s.Append("c1", e))
+	}
+
+	// 未关闭的分段也能搜到
+	all, truncated, err := s.Search(Query{ClientID: "c1"})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, entries, all)
+
+	byPkg, _, err := s.Search(Query{ClientID: "c1", Pkgs: []string{"wg"}})
+	require.NoError(t, err)
+	assert.Equal(t, entries[1:2], byPkg)
+
+	byKeyword, _, err := s.Search(Query{ClientID: "c1", Keyword: "login"})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{entries[0], entries[3]}, byKeyword)
+
+	byRegex, _, err := s.Search(Query{ClientID: "c1", Keyword: `proxy \[\w+\]`, Regex: true})
+	require.NoError(t, err)
+	assert.Equal(t, entries[2:3], byRegex)
+
+	byTime, _, err := s.Search(Query{ClientID: "c1", Start: base.Add(5 * time.Minute), End: base.Add(time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, entries[1:3], byTime)
+
+	limited, truncated, err := s.Search(Query{ClientID: "c1", Limit: 2})
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, entries[:2], limited)
+
+	none, _, err := s.Search(Query{ClientID: "c2"})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	_, _, err = s.Search(Query{ClientID: "../c1"})
+	assert.Error(t, err)
+	_, _, err = s.Search(Query{ClientID: "c1", Keyword: "(", Regex: true})
+	assert.Error(t, err)
+}
+
+func TestStoreReopenAppends(t *testing.T) {
+	dir := t.TempDir()
+	ts := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC).UnixMilli()
+
+	s := NewStore(dir)
+	require.NoError(t, s.Append("c1", Entry{Time: ts, Msg: "before restart"}))
+	require.NoError(t, s.Close())
+
+	s = NewStore(dir)
+	defer s.Close()
+	require.NoError(t, s.Append("c1", Entry{Time: ts + 1, Msg: "after restart"}))
+
+	got, _, err := s.Search(Query{ClientID: "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Time: ts, Msg: "before restart"}, {Time: ts + 1, Msg: "after restart"}}, got)
+}
+
+func TestStorePurge(t *testing.T) {
+	dir := t.TempDir()
+	s := NewStore(dir)
+	defer s.Close()
+
+	now := time.Date(2026, 1, 10, 12, 15, 0, 0, time.UTC)
+	require.NoError(t, s.Append("old", Entry{Time: now.Add(-72 * time.Hour).UnixMilli(), Msg: "old"}))
+	require.NoError(t, s.Append("c1", Entry{Time: now.Add(-72 * time.Hour).UnixMilli(), Msg: "old"}))
+	require.NoError(t, s.Append("c1", Entry{Time: now.UnixMilli(), Msg: "new"}))
+
+	removed, err := s.Purge(now, now.Add(-48*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	_, err = os.Stat(filepath.Join(dir, "old"))
+	assert.True(t, os.IsNotExist(err))
+
+	got, _, err := s.Search(Query{ClientID: "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Time: now.UnixMilli(), Msg: "new"}}, got)
+}
+
+func TestStoreFlushMakesLinesDurable(t *testing.T) {
+	dir := t.TempDir()
+	s := NewStore(dir)
+	defer s.Close()
+
+	ts := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, s.Append("c1", Entry{Time: ts.UnixMilli(), Msg: "pending"}))
+	require.NoError(t, s.Flush())
+
+	// 不经过 Store 直接读文件，模拟崩溃后只剩磁盘上的内容
+	got := []Entry{}
+	require.NoError(t, readSegment(filepath.Join(dir, "c1", ts.Format(segmentLayout)+segmentExt), func(e Entry) bool {
+		got = append(got, e)
+		return true
+	}))
+	assert.Equal(t, []Entry{{Time: ts.UnixMilli(), Msg: "pending"}}, got)
+}
+
+func TestStoreConcurrentClientsAndPurge(t *testing.T) {
+	s := NewStore(t.TempDir())
+	defer s.Close()
+
+	ts := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	clients := []string{"c1", "c2", "c3", "c4"}
+	const perClient = 200
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		wg.Add(1)
+		go func(clientID string) {
+			defer wg.Done()
+			for i := 0; i < perClient; i++ {
+				assert.NoError(t, s.Append(clientID, Entry{Time: ts.UnixMilli() + int64(i), Msg: clientID}))
+			}
+		}(c)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			_, err := s.Purge(ts.Add(time.Hour), time.Time{})
+			assert.NoError(t, err)
+			assert.NoError(t, s.Flush())
+		}
+	}()
+	wg.Wait()
+
+	for _, c := range clients {
+		got, _, err := s.Search(Query{ClientID: c})
+		require.NoError(t, err)
+		assert.Len(t, got, perClient)
+	}
+}
@@ -141,12 +141,22 @@ func (s *server) FRPCAuth(ctx context.Context, req *pb.FRPAuthRequest) (*pb.FRPA
 	return masterserver.FRPAuth(app.NewContext(ctx, s.appInstance), req)
 }
 
+// FRPPluginOp implements pb.MasterServer.
+func (s *server) FRPPluginOp(ctx context.Context, req *pb.FRPPluginOpRequest) (*pb.FRPPluginOpResponse, error) {
+	logger.Logger(ctx).Debugf("frp plugin op, op: [%s], user: [%+v], serverID: [%+v]", req.GetOp(), req.GetUser(), req.GetBase().GetServerId())
+	return masterserver.FRPPluginOp(app.NewContext(ctx, s.appInstance), req)
+}
+
 // ServerSend implements pb.MasterServer.
 func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 	ctx := app.NewContext(context.Background(), s.appInstance)
 
 	logger.Logger(ctx).Infof("server get a client connected")
-	var done chan bool
+	var (
+		done     chan bool
+		kicked   <-chan struct{}
+		clientID string
+	)
 	for {
 		req, err := sender.Recv()
 		if err == io.EOF {
@@ -182,6 +192,14 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 					})
 					return err
 				}
+				if ownerBanned(ctx, cli.UserID) {
+					logger.Logger(ctx).Errorf("client owner is banned, %s id: [%s]", req.GetEvent().String(), req.GetClientId())
+					sender.Send(&pb.ServerMessage{
+						Event: req.GetEvent(),
+						Data:  []byte("rpc auth token is invalid"),
+					})
+					return fmt.Errorf("client owner is banned, id: [%s]", req.GetClientId())
+				}
 				secret = cli.ConnectSecret
 				cliType = defs.CliTypeClient
 			case pb.Event_EVENT_REGISTER_SERVER:
@@ -194,6 +212,14 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 					})
 					return err
 				}
+				if srv.ServerID != defs.DefaultServerID && ownerBanned(ctx, srv.UserID) {
+					logger.Logger(ctx).Errorf("server owner is banned, %s id: [%s]", req.GetEvent().String(), req.GetClientId())
+					sender.Send(&pb.ServerMessage{
+						Event: req.GetEvent(),
+						Data:  []byte("rpc auth token is invalid"),
+					})
+					return fmt.Errorf("server owner is banned, id: [%s]", req.GetClientId())
+				}
 				secret = srv.ConnectSecret
 				cliType = defs.CliTypeServer
 			}
@@ -213,7 +239,9 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 				}
 			}
 
-			s.appInstance.GetClientsManager().Set(req.GetClientId(), cliType, sender)
+			clientID = req.GetClientId()
+			s.appInstance.GetClientsManager().Set(clientID, cliType, sender)
+			kicked = s.appInstance.GetClientsManager().Kicked(req.GetClientId())
 			done = rpc.Recv(s.appInstance, req.GetClientId())
 			sender.Send(&pb.ServerMessage{
 				Event:     req.GetEvent(),
@@ -221,13 +249,29 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 				SessionId: req.GetClientId(),
 			})
 			logger.Logger(ctx).Infof("register success, req: [%+v]", req)
+			go streamlog.StartArchiveStream(ctx, req.GetClientId())
 			break
 		}
 	}
-	<-done
+	defer s.appInstance.GetClientsManager().RemoveSender(clientID, sender)
+	select {
+	case <-done:
+	case <-kicked:
+		logger.Logger(ctx).Infof("client is kicked")
+		return fmt.Errorf("client is kicked")
+	}
 	return nil
 }
 
+// ownerBanned 属主被封禁后，其客户端/服务端不允许再连接 master
+func ownerBanned(ctx *app.Context, userID int) bool {
+	u, err := dao.NewQuery(ctx).GetUserByUserID(userID)
+	if err != nil {
+		return false
+	}
+	return !u.Valid()
+}
+
 // PushProxyInfo implements pb.MasterServer.
 func (s *server) PushProxyInfo(ctx context.Context, req *pb.PushProxyInfoReq) (*pb.PushProxyInfoResp, error) {
 	logger.Logger(ctx).Debugf("push proxy info, req server: [%+v]", req.GetProxyInfos())
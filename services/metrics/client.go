@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/fatedier/frp/client/proxy"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	clientProxyUpDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "client", "proxy_up"),
+		"Whether a frpc proxy is running, phase is the frpc working status.", []string{"client_id", "server_id", "proxy_name", "proxy_type", "phase"}, nil)
+	clientWorkerUpDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "client", "worker_up"),
+		"Whether the workerd process of a worker is running.", []string{"worker_id", "status"}, nil)
+)
+
+type clientCollector struct {
+	appInstance app.Application
+}
+
+// NewClientCollector 采集 frpc 隧道状态、本地 wireguard 与 workerd 进程状态
+func NewClientCollector(appInstance app.Application) prometheus.Collector {
+	return &clientCollector{appInstance: appInstance}
+}
+
+func (c *clientCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- clientProxyUpDesc
+	ch <- clientWorkerUpDesc
+	describeWireGuard(ch)
+}
+
+func (c *clientCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := app.NewContext(context.Background(), c.appInstance)
+
+	if ctrl := c.appInstance.GetClientController(); ctrl != nil {
+		for _, clientID := range ctrl.List() {
+			handlers := ctrl.GetByClient(clientID)
+			if handlers == nil {
+				continue
+			}
+			handlers.Range(func(serverID string, cli app.ClientHandler) bool {
+				for name := range cli.GetProxyCfgs() {
+					status, ok := cli.GetProxyStatus(name)
+					if !ok || status == nil {
+						continue
+					}
+					up := 0.0
+					if status.Phase == proxy.ProxyPhaseRunning {
+						up = 1
+					}
+					ch <- prometheus.MustNewConstMetric(clientProxyUpDesc, prometheus.GaugeValue, up, clientID, serverID, name, status.Type, status.Phase)
+				}
+				return true
+			})
+		}
+	}
+
+	if wgMgr := c.appInstance.GetWireGuardManager(); wgMgr != nil {
+		now := time.Now()
+		for _, wg := range wgMgr.GetAllServices() {
+			info, err := wg.GetWGRuntimeInfo()
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Warn("cannot get wireguard runtime info for metrics")
+				continue
+			}
+			collectWireGuard(ch, c.appInstance.GetConfig().Client.ID, info, now)
+		}
+	}
+
+	if workersMgr := c.appInstance.GetWorkersManager(); workersMgr != nil {
+		for _, workerID := range workersMgr.ListWorkerIDs() {
+			status, err := workersMgr.GetWorkerStatus(ctx, workerID)
+			if err != nil {
+				status = defs.WorkerStatus_Unknown
+			}
+			up := 0.0
+			if status == defs.WorkerStatus_Running {
+				up = 1
+			}
+			ch <- prometheus.MustNewConstMetric(clientWorkerUpDesc, prometheus.GaugeValue, up, workerID, string(status))
+		}
+	}
+}
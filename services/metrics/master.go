@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	masterClientsOnlineDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "master", "clients_online"),
+		"Number of clients and servers connected to master.", []string{"type"}, nil)
+	masterClientUpDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "master", "client_up"),
+		"Whether a client or server is connected to master.", []string{"client_id", "type"}, nil)
+	masterClientLastSeenDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "master", "client_last_seen_age_seconds"),
+		"Seconds since master last heard from a connected client.", []string{"client_id", "type"}, nil)
+
+	proxyLabels       = []string{"proxy_id", "server_id", "client_id", "proxy_name", "proxy_type"}
+	masterProxyInDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "proxy", "traffic_in_bytes_total"),
+		"Total inbound traffic of a proxy.", proxyLabels, nil)
+	masterProxyOutDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "proxy", "traffic_out_bytes_total"),
+		"Total outbound traffic of a proxy.", proxyLabels, nil)
+)
+
+// proxyStatsCacheTTL 隧道流量来自数据库，缓存一段时间避免每次抓取都查库
+const proxyStatsCacheTTL = 30 * time.Second
+
+type masterCollector struct {
+	appInstance app.Application
+
+	mu         sync.Mutex
+	proxies    []*models.ProxyStatsEntity
+	proxiesAt  time.Time
+	proxiesTTL time.Duration
+}
+
+// NewMasterCollector 采集在线客户端、隧道流量与 wireguard 运行状态
+func NewMasterCollector(appInstance app.Application) prometheus.Collector {
+	return &masterCollector{appInstance: appInstance, proxiesTTL: proxyStatsCacheTTL}
+}
+
+func (c *masterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- masterClientsOnlineDesc
+	ch <- masterClientUpDesc
+	ch <- masterClientLastSeenDesc
+	ch <- masterProxyInDesc
+	ch <- masterProxyOutDesc
+	describeWireGuard(ch)
+}
+
+func (c *masterCollector) Collect(ch chan<- prometheus.Metric) {
+	now := time.Now()
+	cliMgr := c.appInstance.GetClientsManager()
+
+	online := map[string]int{defs.CliTypeClient: 0, defs.CliTypeServer: 0}
+	for _, conn := range cliMgr.List() {
+		online[conn.CliType]++
+		ch <- prometheus.MustNewConstMetric(masterClientUpDesc, prometheus.GaugeValue, 1, conn.CliID, conn.CliType)
+		if t, ok := cliMgr.GetLastSeenAt(conn.CliID); ok {
+			ch <- prometheus.MustNewConstMetric(masterClientLastSeenDesc, prometheus.GaugeValue, now.Sub(t).Seconds(), conn.CliID, conn.CliType)
+		}
+	}
+	for cliType, count := range online {
+		ch <- prometheus.MustNewConstMetric(masterClientsOnlineDesc, prometheus.GaugeValue, float64(count), cliType)
+	}
+
+	for _, p := range c.proxyStats(now) {
+		labels := []string{strconv.Itoa(p.ProxyID), p.ServerID, p.ClientID, p.Name, p.Type}
+		ch <- prometheus.MustNewConstMetric(masterProxyInDesc, prometheus.CounterValue, float64(p.HistoryTrafficIn+p.TodayTrafficIn), labels...)
+		ch <- prometheus.MustNewConstMetric(masterProxyOutDesc, prometheus.CounterValue, float64(p.HistoryTrafficOut+p.TodayTrafficOut), labels...)
+	}
+
+	if topo := c.appInstance.GetNetworkTopologyCache(); topo != nil {
+		for _, info := range topo.ListRuntimeInfo() {
+			collectWireGuard(ch, info.GetClientId(), info, now)
+		}
+	}
+}
+
+// proxyStats 返回缓存的隧道流量，过期后重新查库，查询失败时沿用上一次的结果
+func (c *masterCollector) proxyStats(now time.Time) []*models.ProxyStatsEntity {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.proxiesAt.IsZero() && now.Sub(c.proxiesAt) < c.proxiesTTL {
+		return c.proxies
+	}
+
+	ctx := app.NewContext(context.Background(), c.appInstance)
+	proxies, err := dao.NewQuery(ctx).AdminListProxyStats()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("cannot list proxy stats for metrics")
+		return c.proxies
+	}
+	c.proxies, c.proxiesAt = proxies, now
+	return c.proxies
+}
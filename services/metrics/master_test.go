@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasterCollectorCachesProxyStats(t *testing.T) {
+	ctx := apptest.NewContext(t)
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	require.NoError(t, db.Create(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{Name: "p1"}}).Error)
+
+	c := NewMasterCollector(ctx.GetApp()).(*masterCollector)
+	now := time.Now()
+	assert.Len(t, c.proxyStats(now), 1)
+
+	require.NoError(t, db.Create(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{Name: "p2"}}).Error)
+	assert.Len(t, c.proxyStats(now.Add(proxyStatsCacheTTL/2)), 1)
+	assert.Len(t, c.proxyStats(now.Add(proxyStatsCacheTTL)), 2)
+}
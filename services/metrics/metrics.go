@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "frpp"
+
+// RPCCallDuration master 调用客户端 rpc 的耗时
+var RPCCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "master",
+	Name:      "rpc_call_duration_seconds",
+	Help:      "Latency of rpc calls from master to clients and servers.",
+	Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+}, []string{"event", "result"})
+
+// ObserveRPCCall 记录一次 rpc 调用，result 为 success 或 error
+func ObserveRPCCall(event pb.Event, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	RPCCallDuration.WithLabelValues(event.String(), result).Observe(time.Since(start).Seconds())
+}
+
+// Handler 以 prometheus 文本格式输出指标，配置了 token 时需要带上 Bearer token
+func Handler(appInstance app.Application, cs ...prometheus.Collector) gin.HandlerFunc {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	reg.MustRegister(cs...)
+	h := promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+
+	return func(c *gin.Context) {
+		if token := appInstance.GetConfig().App.MetricsToken; len(token) > 0 {
+			got := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+		}
+		h.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// wgPeerLabels 同一个 peer 可能没有 client id，用公钥保证标签唯一
+var wgPeerLabels = []string{"client_id", "interface", "peer_public_key", "peer_client_id"}
+
+var (
+	wgPeerRxDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "wireguard", "peer_rx_bytes_total"),
+		"Bytes received from a wireguard peer.", wgPeerLabels, nil)
+	wgPeerTxDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "wireguard", "peer_tx_bytes_total"),
+		"Bytes sent to a wireguard peer.", wgPeerLabels, nil)
+	wgPeerHandshakeAgeDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "wireguard", "peer_last_handshake_age_seconds"),
+		"Seconds since the last handshake with a wireguard peer.", wgPeerLabels, nil)
+	wgEndpointPingDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "wireguard", "endpoint_ping_seconds"),
+		"Ping latency to the endpoint of another wireguard node.", []string{"client_id", "interface", "to_wireguard_id"}, nil)
+	wgVirtAddrPingDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "wireguard", "virtual_ping_seconds"),
+		"Ping latency to the virtual address of a wireguard peer.", []string{"client_id", "interface", "to_address"}, nil)
+)
+
+func describeWireGuard(ch chan<- *prometheus.Desc) {
+	ch <- wgPeerRxDesc
+	ch <- wgPeerTxDesc
+	ch <- wgPeerHandshakeAgeDesc
+	ch <- wgEndpointPingDesc
+	ch <- wgVirtAddrPingDesc
+}
+
+func collectWireGuard(ch chan<- prometheus.Metric, clientID string, info *pb.WGDeviceRuntimeInfo, now time.Time) {
+	if info == nil {
+		return
+	}
+	ifce := info.GetInterfaceName()
+
+	for _, peer := range info.GetPeers() {
+		pk, peerID := peer.GetPublicKey(), peer.GetClientId()
+		ch <- prometheus.MustNewConstMetric(wgPeerRxDesc, prometheus.CounterValue, float64(peer.GetRxBytes()), clientID, ifce, pk, peerID)
+		ch <- prometheus.MustNewConstMetric(wgPeerTxDesc, prometheus.CounterValue, float64(peer.GetTxBytes()), clientID, ifce, pk, peerID)
+		// 从未握手的 peer 不输出握手时间
+		if peer.GetLastHandshakeTimeSec() > 0 {
+			last := time.Unix(int64(peer.GetLastHandshakeTimeSec()), int64(peer.GetLastHandshakeTimeNsec()))
+			ch <- prometheus.MustNewConstMetric(wgPeerHandshakeAgeDesc, prometheus.GaugeValue, now.Sub(last).Seconds(), clientID, ifce, pk, peerID)
+		}
+	}
+
+	for toID, ms := range info.GetPingMap() {
+		ch <- prometheus.MustNewConstMetric(wgEndpointPingDesc, prometheus.GaugeValue, float64(ms)/1000, clientID, ifce, strconv.FormatUint(uint64(toID), 10))
+	}
+	for addr, ms := range info.GetVirtAddrPingMap() {
+		ch <- prometheus.MustNewConstMetric(wgVirtAddrPingDesc, prometheus.GaugeValue, float64(ms)/1000, clientID, ifce, addr)
+	}
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type wgCollector struct {
+	info *pb.WGDeviceRuntimeInfo
+	now  time.Time
+}
+
+func (c *wgCollector) Describe(ch chan<- *prometheus.Desc) { describeWireGuard(ch) }
+func (c *wgCollector) Collect(ch chan<- prometheus.Metric) {
+	collectWireGuard(ch, "c1", c.info, c.now)
+}
+
+func TestCollectWireGuard(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := &wgCollector{now: now, info: &pb.WGDeviceRuntimeInfo{
+		InterfaceName: "wg0",
+		Peers: []*pb.WGPeerRuntimeInfo{
+			{PublicKey: "pk1", ClientId: "c2", RxBytes: 10, TxBytes: 20, LastHandshakeTimeSec: 990},
+			{PublicKey: "pk2"},
+		},
+		PingMap:         map[uint32]uint32{2: 15},
+		VirtAddrPingMap: map[string]uint32{"10.0.0.2": 30},
+	}}
+
+	expected := `
+# HELP frpp_wireguard_peer_last_handshake_age_seconds Seconds since the last handshake with a wireguard peer.
+# TYPE frpp_wireguard_peer_last_handshake_age_seconds gauge
+frpp_wireguard_peer_last_handshake_age_seconds{client_id="c1",interface="wg0",peer_client_id="c2",peer_public_key="pk1"} 10
+# HELP frpp_wireguard_endpoint_ping_seconds Ping latency to the endpoint of another wireguard node.
+# TYPE frpp_wireguard_endpoint_ping_seconds gauge
+frpp_wireguard_endpoint_ping_seconds{client_id="c1",interface="wg0",to_wireguard_id="2"} 0.015
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected),
+		"frpp_wireguard_peer_last_handshake_age_seconds", "frpp_wireguard_endpoint_ping_seconds"))
+	assert.Equal(t, 7, testutil.CollectAndCount(c))
+}
+
+func TestHandlerToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	appInstance := app.NewApp()
+	cfg := conf.Config{}
+	cfg.App.MetricsToken = "secret"
+	appInstance.SetConfig(cfg)
+
+	router := gin.New()
+	router.GET("/metrics", Handler(appInstance))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "go_goroutines")
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"github.com/VaalaCat/frp-panel/services/app"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var serverProxyTypes = []v1.ProxyType{
+	v1.ProxyTypeTCP,
+	v1.ProxyTypeUDP,
+	v1.ProxyTypeTCPMUX,
+	v1.ProxyTypeHTTP,
+	v1.ProxyTypeHTTPS,
+	v1.ProxyTypeSTCP,
+	v1.ProxyTypeXTCP,
+	v1.ProxyTypeSUDP,
+}
+
+var (
+	serverTrafficInDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "traffic_in_bytes_today"),
+		"Inbound traffic of frps today.", []string{"server_id"}, nil)
+	serverTrafficOutDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "traffic_out_bytes_today"),
+		"Outbound traffic of frps today.", []string{"server_id"}, nil)
+	serverConnsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "connections"),
+		"Current connections of frps.", []string{"server_id"}, nil)
+	serverClientsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "clients"),
+		"Number of frpc connected to frps.", []string{"server_id"}, nil)
+	serverProxiesDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "proxies"),
+		"Number of proxies registered on frps.", []string{"server_id", "proxy_type"}, nil)
+
+	serverProxyLabels = []string{"server_id", "proxy_name", "proxy_type"}
+	serverProxyInDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "proxy_traffic_in_bytes_today"),
+		"Inbound traffic of a proxy today, resets every day.", serverProxyLabels, nil)
+	serverProxyOutDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "proxy_traffic_out_bytes_today"),
+		"Outbound traffic of a proxy today, resets every day.", serverProxyLabels, nil)
+	serverProxyConnsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "server", "proxy_connections"),
+		"Current connections of a proxy.", serverProxyLabels, nil)
+)
+
+type serverCollector struct {
+	appInstance app.Application
+}
+
+// NewServerCollector 采集 frps 的连接数与隧道流量
+func NewServerCollector(appInstance app.Application) prometheus.Collector {
+	return &serverCollector{appInstance: appInstance}
+}
+
+func (c *serverCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- serverTrafficInDesc
+	ch <- serverTrafficOutDesc
+	ch <- serverConnsDesc
+	ch <- serverClientsDesc
+	ch <- serverProxiesDesc
+	ch <- serverProxyInDesc
+	ch <- serverProxyOutDesc
+	ch <- serverProxyConnsDesc
+}
+
+func (c *serverCollector) Collect(ch chan<- prometheus.Metric) {
+	ctrl := c.appInstance.GetServerController()
+	if ctrl == nil {
+		return
+	}
+
+	for _, serverID := range ctrl.List() {
+		srv := ctrl.Get(serverID)
+		if srv == nil {
+			continue
+		}
+
+		if stats := srv.GetMem(); stats != nil {
+			ch <- prometheus.MustNewConstMetric(serverTrafficInDesc, prometheus.GaugeValue, float64(stats.TotalTrafficIn), serverID)
+			ch <- prometheus.MustNewConstMetric(serverTrafficOutDesc, prometheus.GaugeValue, float64(stats.TotalTrafficOut), serverID)
+			ch <- prometheus.MustNewConstMetric(serverConnsDesc, prometheus.GaugeValue, float64(stats.CurConns), serverID)
+			ch <- prometheus.MustNewConstMetric(serverClientsDesc, prometheus.GaugeValue, float64(stats.ClientCounts), serverID)
+			for proxyType, count := range stats.ProxyTypeCounts {
+				ch <- prometheus.MustNewConstMetric(serverProxiesDesc, prometheus.GaugeValue, float64(count), serverID, proxyType)
+			}
+		}
+
+		for _, proxyType := range serverProxyTypes {
+			for _, p := range srv.GetProxyStatsByType(proxyType) {
+				if p == nil {
+					continue
+				}
+				ch <- prometheus.MustNewConstMetric(serverProxyInDesc, prometheus.GaugeValue, float64(p.TodayTrafficIn), serverID, p.Name, p.Type)
+				ch <- prometheus.MustNewConstMetric(serverProxyOutDesc, prometheus.GaugeValue, float64(p.TodayTrafficOut), serverID, p.Name, p.Type)
+				ch <- prometheus.MustNewConstMetric(serverProxyConnsDesc, prometheus.GaugeValue, float64(p.CurConns), serverID, p.Name, p.Type)
+			}
+		}
+	}
+}
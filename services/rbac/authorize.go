@@ -0,0 +1,181 @@
+package rbac
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type clientIDGetter interface {
+	GetClientId() string
+}
+
+type serverIDGetter interface {
+	GetServerId() string
+}
+
+// objectOwner 资源的属主信息，objIDs 为参与鉴权的资源 id，影子客户端会同时带上源客户端 id
+type objectOwner struct {
+	objIDs   []string
+	userID   int
+	tenantID int
+}
+
+func getObjectOwner(ctx *app.Context, objType defs.RBACObj, objID string) (*objectOwner, error) {
+	switch objType {
+	case defs.RBACObjClient:
+		cli, err := dao.NewQuery(ctx).AdminGetClientByClientID(objID)
+		if err != nil {
+			return nil, err
+		}
+		owner := &objectOwner{objIDs: []string{objID}, userID: cli.UserID, tenantID: cli.TenantID}
+		if len(cli.OriginClientID) > 0 && cli.OriginClientID != objID {
+			owner.objIDs = append(owner.objIDs, cli.OriginClientID)
+		}
+		return owner, nil
+	case defs.RBACObjServer:
+		srv, err := dao.NewQuery(ctx).AdminGetServerByServerID(objID)
+		if err != nil {
+			return nil, err
+		}
+		owner := &objectOwner{objIDs: []string{objID}, userID: srv.UserID, tenantID: srv.TenantID}
+		// 默认服务端不属于任何用户，和 dao 中一致视为默认管理员所有
+		if objID == defs.DefaultServerID && owner.userID == 0 {
+			owner.userID = defs.DefaultAdminUserID
+		}
+		return owner, nil
+	}
+	return nil, fmt.Errorf("unsupported object type: %s", objType)
+}
+
+// IsTenantManager 管理员与租户管理员可以管理所在租户内的用户与资源
+func IsTenantManager(userInfo models.UserInfo, tenantID int) bool {
+	if userInfo == nil || !userInfo.Valid() {
+		return false
+	}
+	return (userInfo.IsAdmin() || userInfo.IsTenantAdmin()) && userInfo.GetTenantID() == tenantID
+}
+
+// GetObjectOwner 返回资源属主的 user id 与 tenant id
+func GetObjectOwner(ctx *app.Context, objType defs.RBACObj, objID string) (userID int, tenantID int, err error) {
+	owner, err := getObjectOwner(ctx, objType, objID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return owner.userID, owner.tenantID, nil
+}
+
+// Authorize 校验用户对资源的操作权限，属主与资源所属租户的管理员直接放行，其他用户需要在资源所属租户下有 casbin 授权（共享）
+// 返回资源属主的 userInfo，后续的读写需要以属主身份进行
+func Authorize(ctx *app.Context, userInfo models.UserInfo, objType defs.RBACObj, objID string, action defs.RBACAction) (*models.UserEntity, error) {
+	if userInfo == nil || !userInfo.Valid() {
+		return nil, fmt.Errorf(defs.ErrPermissionDenied)
+	}
+
+	owner, err := getObjectOwner(ctx, objType, objID)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner.userID == userInfo.GetUserID() && owner.tenantID == userInfo.GetTenantID() {
+		return dao.NewQuery(ctx).GetUserByUserID(owner.userID)
+	}
+
+	if IsTenantManager(userInfo, owner.tenantID) {
+		return dao.NewQuery(ctx).GetUserByUserID(owner.userID)
+	}
+
+	permMgr := ctx.GetApp().GetPermManager()
+	if permMgr == nil {
+		return nil, fmt.Errorf(defs.ErrPermissionDenied)
+	}
+
+	for _, id := range owner.objIDs {
+		ok, err := permMgr.CheckPermission(userInfo.GetUserID(), objType, id, action, owner.tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return dao.NewQuery(ctx).GetUserByUserID(owner.userID)
+		}
+	}
+
+	return nil, fmt.Errorf(defs.ErrPermissionDenied)
+}
+
+// AuthorizeClientOrServer 流式日志等接口的 id 可能是客户端也可能是服务端，任一通过即可
+func AuthorizeClientOrServer(ctx *app.Context, userInfo models.UserInfo, objID string, action defs.RBACAction) (*models.UserEntity, error) {
+	owner, err := Authorize(ctx, userInfo, defs.RBACObjClient, objID, action)
+	if err == nil {
+		return owner, nil
+	}
+	if owner, srvErr := Authorize(ctx, userInfo, defs.RBACObjServer, objID, action); srvErr == nil {
+		return owner, nil
+	}
+	return nil, err
+}
+
+// ownerScope 属主的查询范围，不带角色，避免调用者借属主的身份获得管理员权限
+func ownerScope(owner *models.UserEntity) *models.UserEntity {
+	return &models.UserEntity{
+		UserID:   owner.UserID,
+		UserName: owner.UserName,
+		Email:    owner.Email,
+		Status:   owner.Status,
+		Role:     defs.UserRole_Normal,
+		TenantID: owner.TenantID,
+	}
+}
+
+// WithObjectPermission 对请求中的 client_id/server_id 做对象级鉴权。
+// 访问他人的资源时 common.GetUserInfo 仍是调用者，common.GetObjectOwnerInfo 为去掉角色的资源属主，只用于按属主查询
+func WithObjectPermission[T common.ReqType, U common.RespType](action defs.RBACAction,
+	handler func(*app.Context, *T) (*U, error)) func(*app.Context, *T) (*U, error) {
+	return func(ctx *app.Context, req *T) (*U, error) {
+		userInfo := common.GetUserInfo(ctx)
+		if userInfo == nil || !userInfo.Valid() {
+			return handler(ctx, req)
+		}
+
+		type objRef struct {
+			objType defs.RBACObj
+			objID   string
+		}
+		refs := []objRef{}
+		if r, ok := any(req).(clientIDGetter); ok && len(r.GetClientId()) > 0 {
+			refs = append(refs, objRef{defs.RBACObjClient, r.GetClientId()})
+		}
+		if r, ok := any(req).(serverIDGetter); ok && len(r.GetServerId()) > 0 {
+			refs = append(refs, objRef{defs.RBACObjServer, r.GetServerId()})
+		}
+
+		var owner *models.UserEntity
+		for _, ref := range refs {
+			o, err := Authorize(ctx, userInfo, ref.objType, ref.objID, action)
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Warnf("authorize failed, user: [%d], obj: [%s:%s], action: [%s]",
+					userInfo.GetUserID(), ref.objType, ref.objID, action)
+				return nil, err
+			}
+			if owner != nil && owner.GetUserID() != o.GetUserID() {
+				return nil, fmt.Errorf("objects in request belong to different owners")
+			}
+			owner = o
+		}
+
+		if owner != nil && owner.GetUserID() != userInfo.GetUserID() {
+			if gCtx, ok := ctx.Context.(*gin.Context); ok {
+				logger.Logger(ctx).Infof("user [%d] access shared objects of user [%d]", userInfo.GetUserID(), owner.GetUserID())
+				gCtx.Set(defs.ObjectOwnerKey, ownerScope(owner))
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
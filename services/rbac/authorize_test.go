@@ -0,0 +1,130 @@
+package rbac_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/app/apptest"
+	"github.com/VaalaCat/frp-panel/services/rbac"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAuthorizeTest(t *testing.T) (*app.Context, *models.UserEntity, *models.UserEntity) {
+	ctx := apptest.NewContext(t)
+	alice := apptest.CreateUser(t, ctx, "alice")
+	bob := apptest.CreateUser(t, ctx, "bob")
+	db := ctx.GetApp().GetDBManager().GetDefaultDB()
+	require.NoError(t, db.Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+	// 影子客户端的子客户端，共享源客户端即可访问
+	require.NoError(t, db.Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "c1.s1", UserID: alice.UserID, ConnectSecret: "x", OriginClientID: "c1",
+	}}).Error)
+	return ctx, alice, bob
+}
+
+func TestAuthorize_OwnerAndStranger(t *testing.T) {
+	ctx, alice, bob := setupAuthorizeTest(t)
+
+	owner, err := rbac.Authorize(ctx, alice, defs.RBACObjClient, "c1", defs.RBACActionUpdate)
+	require.NoError(t, err)
+	assert.Equal(t, alice.UserID, owner.UserID)
+
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+
+	_, err = rbac.Authorize(ctx, nil, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+
+	_, err = rbac.Authorize(ctx, alice, defs.RBACObjClient, "not-exist", defs.RBACActionRead)
+	assert.Error(t, err)
+}
+
+func TestAuthorize_Shared(t *testing.T) {
+	ctx, alice, bob := setupAuthorizeTest(t)
+
+	_, err := ctx.GetApp().GetPermManager().GrantUserPermission(bob.UserID, defs.RBACObjClient, "c1", defs.RBACActionRead, 0)
+	require.NoError(t, err)
+
+	// 以属主身份继续后续的读写
+	owner, err := rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	require.NoError(t, err)
+	assert.Equal(t, alice.UserID, owner.UserID)
+
+	owner, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1.s1", defs.RBACActionRead)
+	require.NoError(t, err)
+	assert.Equal(t, alice.UserID, owner.UserID)
+
+	// 只读共享不能打开终端
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionUpdate)
+	assert.Error(t, err)
+
+	_, err = ctx.GetApp().GetPermManager().RevokeUserPermission(bob.UserID, defs.RBACObjClient, "c1", defs.RBACActionRead, 0)
+	require.NoError(t, err)
+	_, err = rbac.Authorize(ctx, bob, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+}
+
+func TestAuthorize_TenantAdmin(t *testing.T) {
+	ctx, _, _ := setupAuthorizeTest(t)
+	admin := apptest.CreateUser(t, ctx, "admin", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	otherTenantAdmin := apptest.CreateUser(t, ctx, "tadmin", func(u *models.UserEntity) {
+		u.Role = defs.UserRole_TenantAdmin
+		u.TenantID = 2
+	})
+
+	_, err := rbac.Authorize(ctx, admin, defs.RBACObjClient, "c1", defs.RBACActionUpdate)
+	assert.NoError(t, err)
+	_, err = rbac.Authorize(ctx, otherTenantAdmin, defs.RBACObjClient, "c1", defs.RBACActionRead)
+	assert.Error(t, err)
+}
+
+func TestAuthorizeClientOrServer(t *testing.T) {
+	ctx, alice, bob := setupAuthorizeTest(t)
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Server{ServerEntity: &models.ServerEntity{
+		ServerID: "s1", UserID: alice.UserID, ConnectSecret: "x",
+	}}).Error)
+
+	_, err := rbac.AuthorizeClientOrServer(ctx, alice, "s1", defs.RBACActionRead)
+	assert.NoError(t, err)
+	_, err = rbac.AuthorizeClientOrServer(ctx, bob, "s1", defs.RBACActionRead)
+	assert.Error(t, err)
+}
+
+func TestWithObjectPermission_SharedByAdminKeepsCaller(t *testing.T) {
+	ctx, _, bob := setupAuthorizeTest(t)
+	admin := apptest.CreateUser(t, ctx, "admin", func(u *models.UserEntity) { u.Role = defs.UserRole_Admin })
+	require.NoError(t, ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Client{ClientEntity: &models.ClientEntity{
+		ClientID: "admin-c1", UserID: admin.UserID, ConnectSecret: "x",
+	}}).Error)
+	_, err := ctx.GetApp().GetPermManager().GrantUserPermission(bob.UserID, defs.RBACObjClient, "admin-c1", defs.RBACActionRead, 0)
+	require.NoError(t, err)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/", nil)
+	c.Set(defs.UserInfoKey, bob)
+
+	var caller, owner models.UserInfo
+	handler := rbac.WithObjectPermission(defs.RBACActionRead, func(ctx *app.Context, req *pb.GetClientRequest) (*pb.GetClientResponse, error) {
+		caller, owner = common.GetUserInfo(ctx), common.GetObjectOwnerInfo(ctx)
+		return &pb.GetClientResponse{}, nil
+	})
+	_, err = handler(app.NewContext(c, ctx.GetApp()), &pb.GetClientRequest{ClientId: lo.ToPtr("admin-c1")})
+	require.NoError(t, err)
+
+	// 调用者身份不变，属主只用于查询范围，不带管理员角色
+	assert.Equal(t, bob.UserID, caller.GetUserID())
+	assert.False(t, caller.IsAdmin())
+	assert.Equal(t, admin.UserID, owner.GetUserID())
+	assert.False(t, owner.IsAdmin())
+	assert.False(t, owner.IsTenantAdmin())
+}
@@ -80,3 +80,51 @@ func (pm *permManager) RemoveUserFromGroup(userID int, groupID string, tenantID
 
 	return pm.enforcer.RemoveGroupingPolicy(userSub, groupSub, domain)
 }
+
+// ListObjectPolicies 列出租户内某个资源上的全部授权策略，每条为 [sub, obj, act, dom]
+func (pm *permManager) ListObjectPolicies(objType defs.RBACObj, objID string, tenantID int) ([][]string, error) {
+	objSubject := identity(objType, objID)
+	domain := identity(defs.RBACDomainTenant, tenantID)
+
+	return pm.enforcer.GetFilteredPolicy(1, objSubject, "", domain)
+}
+
+// RemoveObjectPolicies 删除资源上的全部授权策略，资源删除时调用
+func (pm *permManager) RemoveObjectPolicies(objType defs.RBACObj, objID string, tenantID int) (bool, error) {
+	objSubject := identity(objType, objID)
+	domain := identity(defs.RBACDomainTenant, tenantID)
+
+	return pm.enforcer.RemoveFilteredPolicy(1, objSubject, "", domain)
+}
+
+// RemoveGroup 删除用户组在租户下的全部成员关系与授权策略
+func (pm *permManager) RemoveGroup(groupID string, tenantID int) (bool, error) {
+	groupSub := identity(defs.RBACSubjectGroup, groupID)
+	domain := identity(defs.RBACDomainTenant, tenantID)
+
+	if _, err := pm.enforcer.RemoveFilteredGroupingPolicy(1, groupSub, domain); err != nil {
+		return false, err
+	}
+	return pm.enforcer.RemoveFilteredPolicy(0, groupSub, "", "", domain)
+}
+
+// RemoveUserFromTenant 删除用户在租户下的组关系与直接授权，用户迁移租户时调用
+func (pm *permManager) RemoveUserFromTenant(userID int, tenantID int) (bool, error) {
+	userSub := identity(defs.RBACSubjectUser, userID)
+	domain := identity(defs.RBACDomainTenant, tenantID)
+
+	if _, err := pm.enforcer.RemoveFilteredGroupingPolicy(0, userSub, "", domain); err != nil {
+		return false, err
+	}
+	return pm.enforcer.RemoveFilteredPolicy(0, userSub, "", "", domain)
+}
+
+// RemoveTenant 删除租户域下的全部组关系与授权策略
+func (pm *permManager) RemoveTenant(tenantID int) (bool, error) {
+	domain := identity(defs.RBACDomainTenant, tenantID)
+
+	if _, err := pm.enforcer.RemoveFilteredGroupingPolicy(2, domain); err != nil {
+		return false, err
+	}
+	return pm.enforcer.RemoveFilteredPolicy(3, domain)
+}
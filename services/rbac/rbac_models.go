@@ -8,7 +8,7 @@ r = sub, obj, act, dom
 p = sub, obj, act, dom
 
 [role_definition]
-g = _, _, dom
+g = _, _, _
 
 [policy_effect]
 e = some(where (p.eft == allow))
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/audit"
+	"github.com/VaalaCat/frp-panel/services/metrics"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -28,7 +34,46 @@ func CallClientWrapper[R common.RespType](c *app.Context, clientID string, event
 	return proto.Unmarshal(cresp.GetData(), protoMsgRef)
 }
 
-func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Message) (*pb.ClientMessage, error) {
+// auditSkipEvents 只读的高频事件与日志流，不记录审计日志
+var auditSkipEvents = map[pb.Event]bool{
+	pb.Event_EVENT_PING:                       true,
+	pb.Event_EVENT_GET_PROXY_INFO:             true,
+	pb.Event_EVENT_GET_WORKER_STATUS:          true,
+	pb.Event_EVENT_GET_WIREGUARD_RUNTIME_INFO: true,
+	pb.Event_EVENT_START_STREAM_LOG:           true,
+	pb.Event_EVENT_STOP_STREAM_LOG:            true,
+}
+
+// userInitiated 只有用户通过 http 接口触发的调用才记录审计日志，后台任务与定时同步不记录
+func userInitiated(ctx *app.Context) bool {
+	if _, ok := ctx.Context.(*gin.Context); !ok {
+		return false
+	}
+	return common.GetUserInfo(ctx) != nil
+}
+
+func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Message) (resp *pb.ClientMessage, err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveRPCCall(event, start, err) }()
+	if !auditSkipEvents[event] && userInitiated(ctx) {
+		defer func() {
+			entry := &models.AuditLogEntity{
+				Kind:       defs.AuditKindRPC,
+				Route:      event.String(),
+				TargetID:   clientID,
+				ResultCode: int(pb.RespCode_RESP_CODE_SUCCESS),
+			}
+			if err != nil {
+				entry.ResultCode = int(pb.RespCode_RESP_CODE_INVALID)
+				entry.ResultMessage = err.Error()
+			}
+			audit.Record(ctx, entry)
+		}()
+	}
+	return callClient(ctx, clientID, event, msg)
+}
+
+func callClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Message) (*pb.ClientMessage, error) {
 	sender := ctx.GetApp().GetClientsManager().Get(clientID)
 	if sender == nil {
 		logger.Logger(ctx).Errorf("cannot get client, id: [%s]", clientID)
@@ -48,7 +93,8 @@ func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Mes
 		ClientId:  clientID,
 	}
 
-	ctx.GetApp().GetClientRecvMap().Store(req.SessionId, make(chan *pb.ClientMessage))
+	// 带缓冲，调用方超时放弃等待后迟到的响应不会阻塞接收循环
+	ctx.GetApp().GetClientRecvMap().Store(req.SessionId, make(chan *pb.ClientMessage, 1))
 	err = sender.Conn.Send(req)
 	if err != nil {
 		logger.Logger(context.Background()).WithError(err).Errorf("cannot send")
@@ -65,7 +111,13 @@ func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Mes
 		logger.Logger(ctx).Fatalf("cannot cast")
 	}
 
-	resp := <-respCh
+	var resp *pb.ClientMessage
+	select {
+	case resp = <-respCh:
+	case <-ctx.Done():
+		ctx.GetApp().GetClientRecvMap().Delete(req.SessionId)
+		return nil, fmt.Errorf("wait client response timeout, id: [%s], event: [%s]: %w", clientID, event.String(), ctx.Err())
+	}
 	if resp.Event == pb.Event_EVENT_ERROR {
 		return nil, fmt.Errorf("client return error: %s", resp.Data)
 	}
@@ -76,15 +128,16 @@ func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Mes
 }
 
 func Recv(appInstance app.Application, clientID string) chan bool {
-	done := make(chan bool)
+	done := make(chan bool, 1)
 	go func() {
 		c := context.Background()
 		log := logger.Logger(c).WithField("clientID", clientID)
 		for {
 			reciver := appInstance.GetClientsManager().Get(clientID)
 			if reciver == nil {
-				log.Errorf("cannot get client")
-				continue
+				log.Errorf("cannot get client, usually means client is kicked")
+				done <- true
+				return
 			}
 			resp, err := reciver.Conn.Recv()
 			if err == io.EOF {
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"sync"
 	"time"
 
 	"github.com/VaalaCat/frp-panel/defs"
@@ -19,9 +20,13 @@ type ClientsManager interface {
 }
 
 type ClientsManagerImpl struct {
+	// mu 保证连接的替换与移除有序，读取不加锁
+	mu          sync.Mutex
+	listeners   []func(conn *defs.Connector, connected bool)
 	senders     *utils.SyncMap[string, *defs.Connector]
 	connectTime *utils.SyncMap[string, time.Time]
 	lastSeenAt  *utils.SyncMap[string, time.Time]
+	kicked      *utils.SyncMap[string, chan struct{}]
 }
 
 // Get implements ClientsManager.
@@ -35,19 +40,94 @@ func (c *ClientsManagerImpl) Get(cliID string) *defs.Connector {
 
 // Set implements ClientsManager.
 func (c *ClientsManagerImpl) Set(cliID, clientType string, sender pb.Master_ServerSendServer) {
-	c.senders.Store(cliID, &defs.Connector{
+	conn := &defs.Connector{
 		CliID:   cliID,
 		Conn:    sender,
 		CliType: clientType,
-	})
+	}
+	c.mu.Lock()
+	c.senders.Store(cliID, conn)
 	c.connectTime.Store(cliID, time.Now())
 	c.lastSeenAt.Store(cliID, time.Now())
+	// 同一客户端重连时旧连接已被替换，通知旧的 stream 退出
+	if old, ok := c.kicked.Load(cliID); ok {
+		close(old)
+	}
+	c.kicked.Store(cliID, make(chan struct{}))
+	listeners := c.listeners
+	c.mu.Unlock()
+
+	for _, fn := range listeners {
+		fn(conn, true)
+	}
 }
 
 func (c *ClientsManagerImpl) Remove(cliID string) {
-	c.senders.Delete(cliID)
+	c.mu.Lock()
+	conn, ok := c.remove(cliID)
+	listeners := c.listeners
+	c.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	for _, fn := range listeners {
+		fn(conn, false)
+	}
+}
+
+// RemoveSender 连接断开时移除，客户端已用新连接重连时不移除
+func (c *ClientsManagerImpl) RemoveSender(cliID string, sender pb.Master_ServerSendServer) {
+	c.mu.Lock()
+	var (
+		conn *defs.Connector
+		ok   bool
+	)
+	if cur, loaded := c.senders.Load(cliID); loaded && cur.Conn == sender {
+		conn, ok = c.remove(cliID)
+	}
+	listeners := c.listeners
+	c.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	for _, fn := range listeners {
+		fn(conn, false)
+	}
+}
+
+func (c *ClientsManagerImpl) remove(cliID string) (*defs.Connector, bool) {
+	conn, ok := c.senders.LoadAndDelete(cliID)
 	c.connectTime.Delete(cliID)
 	c.lastSeenAt.Delete(cliID)
+	return conn, ok
+}
+
+// OnChange 注册客户端连接与断开的回调，回调在调用方的 goroutine 中执行，不能阻塞
+func (c *ClientsManagerImpl) OnChange(fn func(conn *defs.Connector, connected bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+// Kick 主动断开客户端连接，ServerSend 收到信号后结束 stream
+func (c *ClientsManagerImpl) Kick(cliID string) {
+	c.mu.Lock()
+	if ch, ok := c.kicked.LoadAndDelete(cliID); ok {
+		close(ch)
+	}
+	c.mu.Unlock()
+	c.Remove(cliID)
+}
+
+// Kicked 返回客户端被踢下线的信号，客户端未连接时返回 nil
+func (c *ClientsManagerImpl) Kicked(cliID string) <-chan struct{} {
+	ch, ok := c.kicked.Load(cliID)
+	if !ok {
+		return nil
+	}
+	return ch
 }
 
 func (c *ClientsManagerImpl) ClientAddr(cliID string) string {
@@ -82,10 +162,16 @@ func (c *ClientsManagerImpl) GetLastSeenAt(cliID string) (time.Time, bool) {
 	return t, true
 }
 
+// List 返回当前在线的客户端与服务端
+func (c *ClientsManagerImpl) List() []*defs.Connector {
+	return c.senders.Values()
+}
+
 func NewClientsManager() app.ClientsManager {
 	return &ClientsManagerImpl{
 		senders:     &utils.SyncMap[string, *defs.Connector]{},
 		connectTime: &utils.SyncMap[string, time.Time]{},
 		lastSeenAt:  &utils.SyncMap[string, time.Time]{},
+		kicked:      &utils.SyncMap[string, chan struct{}]{},
 	}
 }
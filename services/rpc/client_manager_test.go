@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestClientsManager_ReplaceClosesOldKicked(t *testing.T) {
+	mgr := NewClientsManager().(*ClientsManagerImpl)
+
+	mgr.Set("c1", "client", nil)
+	first := mgr.Kicked("c1")
+	assert.False(t, isClosed(first))
+
+	mgr.Set("c1", "client", nil)
+	second := mgr.Kicked("c1")
+	assert.True(t, isClosed(first))
+	assert.False(t, isClosed(second))
+
+	mgr.Kick("c1")
+	assert.True(t, isClosed(second))
+	assert.Nil(t, mgr.Kicked("c1"))
+	assert.Nil(t, mgr.Get("c1"))
+
+	// 重复踢下线不会 panic
+	mgr.Kick("c1")
+}
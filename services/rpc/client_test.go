@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserInitiated(t *testing.T) {
+	u := &models.UserEntity{UserID: 1, Status: models.STATUS_NORMAL}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/", nil)
+	assert.False(t, userInitiated(app.NewContext(c, nil)))
+
+	c.Set(defs.UserInfoKey, u)
+	assert.True(t, userInitiated(app.NewContext(c, nil)))
+
+	// 后台任务即使带上用户身份也不记录
+	assert.False(t, userInitiated(app.NewContext(common.WithUserInfo(context.Background(), u), nil)))
+}
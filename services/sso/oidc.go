@@ -0,0 +1,177 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/samber/lo"
+	"golang.org/x/oauth2"
+)
+
+// OIDCConfig 单点登录配置，claim 字段用于把 id token 中的声明映射为面板用户信息
+type OIDCConfig struct {
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	RedirectURL   string
+	Scopes        []string
+	UsernameClaim string
+	EmailClaim    string
+	GroupsClaim   string
+}
+
+// Identity 从 id token 中解析出的用户身份
+type Identity struct {
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified bool
+	Groups        []string
+}
+
+type OIDCProvider struct {
+	cfg        OIDCConfig
+	httpClient *http.Client
+	verifier   *oidc.IDTokenVerifier
+	oauth2Cfg  *oauth2.Config
+}
+
+func NewOIDCConfig(cfg conf.Config) OIDCConfig {
+	return OIDCConfig{
+		Issuer:        cfg.App.OIDC.Issuer,
+		ClientID:      cfg.App.OIDC.ClientID,
+		ClientSecret:  cfg.App.OIDC.ClientSecret,
+		RedirectURL:   conf.OIDCRedirectURL(cfg),
+		Scopes:        conf.OIDCScopes(cfg),
+		UsernameClaim: cfg.App.OIDC.UsernameClaim,
+		EmailClaim:    cfg.App.OIDC.EmailClaim,
+		GroupsClaim:   cfg.App.OIDC.GroupsClaim,
+	}
+}
+
+// NewOIDCProvider 通过 issuer 的 discovery 文档初始化，httpClient 为空时使用默认 client
+func NewOIDCProvider(ctx context.Context, cfg OIDCConfig, httpClient *http.Client) (*OIDCProvider, error) {
+	if len(cfg.Issuer) == 0 || len(cfg.ClientID) == 0 {
+		return nil, fmt.Errorf("oidc issuer or client id is empty")
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, httpClient), cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("discover oidc provider failed: %w", err)
+	}
+
+	scopes := cfg.Scopes
+	if !lo.Contains(scopes, oidc.ScopeOpenID) {
+		scopes = append([]string{oidc.ScopeOpenID}, scopes...)
+	}
+
+	return &OIDCProvider{
+		cfg:        cfg,
+		httpClient: httpClient,
+		verifier:   provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		oauth2Cfg: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+		},
+	}, nil
+}
+
+func (p *OIDCProvider) AuthCodeURL(state, nonce string) string {
+	return p.oauth2Cfg.AuthCodeURL(state, oidc.Nonce(nonce))
+}
+
+// Exchange 用授权码换取 id token，校验签名、audience 与 nonce 后解析出用户身份
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce string) (*Identity, error) {
+	ctx = oidc.ClientContext(ctx, p.httpClient)
+
+	token, err := p.oauth2Cfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("exchange code failed: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || len(rawIDToken) == 0 {
+		return nil, fmt.Errorf("no id_token in token response")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify id_token failed: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, fmt.Errorf("invalid id_token nonce")
+	}
+
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("parse id_token claims failed: %w", err)
+	}
+
+	return p.identityFromClaims(idToken.Subject, claims)
+}
+
+func (p *OIDCProvider) identityFromClaims(subject string, claims map[string]any) (*Identity, error) {
+	if len(subject) == 0 {
+		return nil, fmt.Errorf("empty subject in id_token")
+	}
+
+	ident := &Identity{
+		Subject:  subject,
+		Username: stringClaim(claims, p.cfg.UsernameClaim),
+		Email:    stringClaim(claims, p.cfg.EmailClaim),
+		Groups:   stringsClaim(claims, p.cfg.GroupsClaim),
+	}
+	if v, ok := claims["email_verified"].(bool); ok {
+		ident.EmailVerified = v
+	}
+
+	if len(ident.Username) == 0 && len(ident.Email) > 0 {
+		ident.Username = strings.Split(ident.Email, "@")[0]
+	}
+	if len(ident.Username) == 0 {
+		ident.Username = subject
+	}
+	return ident, nil
+}
+
+func stringClaim(claims map[string]any, key string) string {
+	if len(key) == 0 {
+		return ""
+	}
+	v, _ := claims[key].(string)
+	return strings.TrimSpace(v)
+}
+
+// stringsClaim 兼容数组与逗号分隔字符串两种形式的 groups 声明
+func stringsClaim(claims map[string]any, key string) []string {
+	if len(key) == 0 {
+		return nil
+	}
+
+	ret := []string{}
+	switch v := claims[key].(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && len(strings.TrimSpace(s)) > 0 {
+				ret = append(ret, strings.TrimSpace(s))
+			}
+		}
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); len(s) > 0 {
+				ret = append(ret, s)
+			}
+		}
+	}
+	return lo.Uniq(ret)
+}
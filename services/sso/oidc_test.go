@@ -0,0 +1,155 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOIDCProvider 本地 oidc provider，code 固定为 test-code，签发的 id token 带上 nonce 与自定义 claims
+type mockOIDCProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	nonce  string
+	claims map[string]any
+}
+
+func newMockOIDCProvider(t *testing.T, claims map[string]any) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockOIDCProvider{key: key, claims: claims}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/auth",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig",
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.ParseForm() != nil || r.PostForm.Get("code") != "test-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{"error": "invalid_grant"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     m.signIDToken(t),
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockOIDCProvider) signIDToken(t *testing.T) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: m.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"))
+	require.NoError(t, err)
+
+	payload := map[string]any{
+		"iss":   m.URL,
+		"aud":   "frpp",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": m.nonce,
+	}
+	for k, v := range m.claims {
+		payload[k] = v
+	}
+	raw, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	jws, err := signer.Sign(raw)
+	require.NoError(t, err)
+	token, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func newTestProvider(t *testing.T, m *mockOIDCProvider) *OIDCProvider {
+	p, err := NewOIDCProvider(context.Background(), OIDCConfig{
+		Issuer:        m.URL,
+		ClientID:      "frpp",
+		ClientSecret:  "secret",
+		RedirectURL:   "http://127.0.0.1:9000/api/v1/auth/oidc/callback",
+		Scopes:        []string{"profile", "email"},
+		UsernameClaim: "preferred_username",
+		EmailClaim:    "email",
+		GroupsClaim:   "groups",
+	}, m.Client())
+	require.NoError(t, err)
+	return p
+}
+
+func TestOIDCProviderExchange(t *testing.T) {
+	m := newMockOIDCProvider(t, map[string]any{
+		"sub":                "user-1",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+		"groups":             []string{"dev", "ops", "dev"},
+	})
+	m.nonce = "test-nonce"
+	p := newTestProvider(t, m)
+
+	authURL, err := url.Parse(p.AuthCodeURL("test-state", "test-nonce"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-state", authURL.Query().Get("state"))
+	assert.Equal(t, "test-nonce", authURL.Query().Get("nonce"))
+	assert.Contains(t, authURL.Query().Get("scope"), "openid")
+
+	ident, err := p.Exchange(context.Background(), "test-code", "test-nonce")
+	require.NoError(t, err)
+	assert.Equal(t, &Identity{
+		Subject:       "user-1",
+		Username:      "alice",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Groups:        []string{"dev", "ops"},
+	}, ident)
+
+	_, err = p.Exchange(context.Background(), "test-code", "other-nonce")
+	assert.Error(t, err)
+
+	_, err = p.Exchange(context.Background(), "bad-code", "test-nonce")
+	assert.Error(t, err)
+}
+
+func TestOIDCIdentityFallback(t *testing.T) {
+	p := &OIDCProvider{cfg: OIDCConfig{UsernameClaim: "preferred_username", EmailClaim: "email", GroupsClaim: "groups"}}
+
+	ident, err := p.identityFromClaims("sub-1", map[string]any{"email": "bob@example.com", "groups": "a, b,,a"})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", ident.Username)
+	assert.Equal(t, []string{"a", "b"}, ident.Groups)
+	assert.False(t, ident.EmailVerified)
+
+	ident, err = p.identityFromClaims("sub-2", map[string]any{})
+	require.NoError(t, err)
+	assert.Equal(t, "sub-2", ident.Username)
+
+	_, err = p.identityFromClaims("", map[string]any{})
+	assert.Error(t, err)
+}
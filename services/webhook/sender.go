@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils"
+)
+
+const (
+	HeaderEvent     = "X-Frpp-Event"
+	HeaderDelivery  = "X-Frpp-Delivery"
+	HeaderTimestamp = "X-Frpp-Timestamp"
+	HeaderSignature = "X-Frpp-Signature"
+
+	// MaxAttempts 超过后推送标记为失败，只能手动重新推送
+	MaxAttempts = 6
+	retryBase   = 30 * time.Second
+)
+
+// Request 一次推送的内容，Body 为事件 json
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID string
+	Body       []byte
+}
+
+// Sign 签名为 hex(hmac_sha256(secret, timestamp + "." + body))，接收方校验时间戳以防重放
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff 第 attempts 次失败后到下次重试的间隔，从 30s 开始翻倍
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return retryBase << (attempts - 1)
+}
+
+// Send 推送一次，返回响应状态码，非 2xx 视为失败
+// 与告警渠道共用受限的出站客户端：不访问内网地址、不跟随重定向，错误中不带响应内容
+func Send(ctx context.Context, r *Request) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return 0, err
+	}
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "frp-panel-webhook")
+	req.Header.Set(HeaderEvent, r.EventType)
+	req.Header.Set(HeaderDelivery, r.DeliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(r.Secret, ts, r.Body))
+
+	return utils.SendOutbound(req)
+}
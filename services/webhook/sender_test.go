@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"a":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=49f24e537407743fa4a0242bb63b94b9a47ee99cbbe071ccd8a22550ae411686",
+		Sign("secret", 1700000000, []byte(`{"a":1}`)))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(0))
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, 4*time.Minute, Backoff(4))
+}
+
+func TestSend(t *testing.T) {
+	utils.SetOutboundAllowPrivate(true)
+	defer utils.SetOutboundAllowPrivate(false)
+
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		assert.Equal(t, Sign("s", ts, body), r.Header.Get(HeaderSignature))
+		assert.Equal(t, "client.initialized", r.Header.Get(HeaderEvent))
+		assert.Equal(t, "d1", r.Header.Get(HeaderDelivery))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("bad"))
+	}))
+	defer srv.Close()
+
+	req := &Request{URL: srv.URL, Secret: "s", EventType: "client.initialized", DeliveryID: "d1", Body: []byte(`{}`)}
+	code, err := Send(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+
+	status = http.StatusBadGateway
+	code, err = Send(context.Background(), req)
+	assert.ErrorContains(t, err, "responded 502")
+	assert.NotContains(t, err.Error(), "bad")
+	assert.Equal(t, http.StatusBadGateway, code)
+}
+
+func TestSendRejectsPrivateTarget(t *testing.T) {
+	hit := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hit = true }))
+	defer srv.Close()
+
+	_, err := Send(context.Background(), &Request{URL: srv.URL, Secret: "s", Body: []byte(`{}`)})
+	assert.ErrorContains(t, err, "not allowed")
+	assert.False(t, hit)
+}
@@ -3,9 +3,12 @@ package wg
 import (
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/samber/lo"
 )
@@ -44,9 +47,9 @@ func (a *ACL) CanConnect(src, dst ACLEntity) bool {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
-	for _, sTag := range src.GetTags() {
-		for _, dTag := range dst.GetTags() {
-			if a.matchRule(sTag, dTag) {
+	for _, sSel := range aclSelectors(src) {
+		for _, dSel := range aclSelectors(dst) {
+			if a.matchRule(sSel, dSel) {
 				return true
 			}
 		}
@@ -54,13 +57,17 @@ func (a *ACL) CanConnect(src, dst ACLEntity) bool {
 	return false
 }
 
-func (a *ACL) matchRule(sourceTag, destTag string) bool {
+func (a *ACL) matchRule(sourceSel, destSel string) bool {
 	for _, r := range a.Acls {
-		if lo.Contains(r.Src, sourceTag) && lo.Contains(r.Dst, destTag) {
+		if aclSelectorMatch(r.Src, sourceSel) && aclSelectorMatch(r.Dst, destSel) {
 			switch r.Action {
 			case "accept":
 				return true
 			case "deny":
+				// 只限制部分协议/端口的 deny 由数据面执行，不影响节点间连通
+				if isPartialACLRule(r) {
+					continue
+				}
 				return false
 			default:
 				return true
@@ -70,6 +77,183 @@ func (a *ACL) matchRule(sourceTag, destTag string) bool {
 	return false
 }
 
+// CanRoute 判断 src 能否经由子网路由/出口节点 via 访问前缀 prefix：
+// 规则的 dst 为 via 的标签，或者为包含 prefix 的 CIDR 时命中，默认路由只按 CIDR 匹配
+func (a *ACL) CanRoute(src, via ACLEntity, prefix netip.Prefix) bool {
+	if a == nil || lo.IsNil(a) {
+		return true
+	}
+	if lo.IsNil(a.AclConfig) || len(a.AclConfig.Acls) == 0 {
+		return true
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	srcSels := aclSelectors(src)
+	for _, r := range a.Acls {
+		if !lo.ContainsBy(srcSels, func(s string) bool { return aclSelectorMatch(r.Src, s) }) {
+			continue
+		}
+		if !aclRouteMatch(r.Dst, via, prefix) {
+			continue
+		}
+		switch r.Action {
+		case "deny":
+			if isPartialACLRule(r) {
+				continue
+			}
+			return false
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func aclRouteMatch(ruleSels []string, via ACLEntity, prefix netip.Prefix) bool {
+	for _, s := range ruleSels {
+		if rp, ok := parseACLPrefix(s); ok {
+			if rp.Bits() <= prefix.Bits() && rp.Contains(prefix.Addr()) {
+				return true
+			}
+			continue
+		}
+		if prefix.Bits() > 0 && lo.Contains(via.GetTags(), s) {
+			return true
+		}
+	}
+	return false
+}
+
+type aclAddressEntity interface {
+	GetLocalAddress() string
+}
+
+// aclSelectors 实体可被规则匹配的选择子：标签，以及实现了 GetLocalAddress 时的虚拟地址
+func aclSelectors(e ACLEntity) []string {
+	sels := e.GetTags()
+	if ae, ok := e.(aclAddressEntity); ok {
+		if addr, _, err := models.ParseIPOrCIDRWithNetip(ae.GetLocalAddress()); err == nil {
+			sels = append(slices.Clone(sels), addr.String())
+		}
+	}
+	return sels
+}
+
+// aclSelectorMatch 选择子为地址时按 CIDR 包含匹配，否则按标签匹配
+func aclSelectorMatch(ruleSels []string, sel string) bool {
+	addr, err := netip.ParseAddr(sel)
+	if err != nil {
+		return lo.Contains(ruleSels, sel)
+	}
+	for _, s := range ruleSels {
+		if prefix, ok := parseACLPrefix(s); ok && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseACLPrefix(s string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+func isPartialACLRule(r *pb.AclRuleConfig) bool {
+	return aclProtocolNumber(r.GetProtocol()) != 0 || len(r.GetPorts()) > 0
+}
+
+// CompileACL 把网络 ACL 编译为节点数据面使用的规则：标签展开为节点地址，
+// 末尾追加 deny all，节点按顺序匹配，第一条命中的规则生效。ACL 为空时返回 nil，不做过滤
+// 外部设备没有标签，地址随中继节点的标签一起展开，也可以在规则中直接写设备地址
+func CompileACL(cfg *pb.AclConfig, peers []*models.WireGuard, externals []*models.WireGuardExternalPeer) *pb.AclConfig {
+	if cfg == nil || len(cfg.GetAcls()) == 0 {
+		return nil
+	}
+
+	relayExternals := make(map[uint][]string)
+	for _, ext := range externals {
+		if ext == nil || ext.WireGuardExternalPeerEntity == nil {
+			continue
+		}
+		addr, _, err := models.ParseIPOrCIDRWithNetip(ext.LocalAddress)
+		if err != nil {
+			continue
+		}
+		relayExternals[ext.RelayWireGuardID] = append(relayExternals[ext.RelayWireGuardID], netip.PrefixFrom(addr, addr.BitLen()).String())
+	}
+
+	tagAddrs := make(map[string][]string)
+	for _, p := range peers {
+		if p == nil || p.WireGuardEntity == nil {
+			continue
+		}
+		addr, _, err := models.ParseIPOrCIDRWithNetip(p.LocalAddress)
+		if err != nil {
+			continue
+		}
+		// 子网路由通告的网段随标签一起展开，默认路由不展开，访问出口需要显式的 CIDR 规则
+		prefixes := []string{netip.PrefixFrom(addr, addr.BitLen()).String()}
+		for _, r := range p.AdvertisedRoutes {
+			if rp, ok := parseACLPrefix(r); ok && rp.Bits() > 0 {
+				prefixes = append(prefixes, rp.String())
+			}
+		}
+		prefixes = append(prefixes, relayExternals[p.ID]...)
+		for _, tag := range p.Tags {
+			tagAddrs[tag] = append(tagAddrs[tag], prefixes...)
+		}
+	}
+	resolve := func(sels []string) []string {
+		ret := make([]string, 0, len(sels))
+		for _, s := range sels {
+			if prefix, ok := parseACLPrefix(s); ok {
+				ret = append(ret, prefix.String())
+				continue
+			}
+			ret = append(ret, tagAddrs[s]...)
+		}
+		return lo.Uniq(ret)
+	}
+
+	compiled := &pb.AclConfig{}
+	for _, r := range cfg.GetAcls() {
+		src, dst := resolve(r.GetSrc()), resolve(r.GetDst())
+		if len(src) == 0 || len(dst) == 0 {
+			continue
+		}
+		compiled.Acls = append(compiled.Acls, &pb.AclRuleConfig{
+			Action:   r.GetAction(),
+			Src:      src,
+			Dst:      dst,
+			Protocol: r.GetProtocol(),
+			Ports:    r.GetPorts(),
+		})
+	}
+	compiled.Acls = append(compiled.Acls, &pb.AclRuleConfig{
+		Action: "deny",
+		Src:    []string{"0.0.0.0/0", "::/0"},
+		Dst:    []string{"0.0.0.0/0", "::/0"},
+	})
+	return compiled
+}
+
+// ValidateACL 校验规则中的协议与端口
+func ValidateACL(cfg *pb.AclConfig) error {
+	for _, r := range cfg.GetAcls() {
+		if _, err := parseACLRule(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *ACL) LoadFromJSON(data []byte) error {
 	var cfg pb.AclConfig
 	if err := json.Unmarshal(data, &cfg); err != nil {
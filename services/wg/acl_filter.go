@@ -0,0 +1,314 @@
+package wg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+const (
+	aclProtoICMP  = 1
+	aclProtoTCP   = 6
+	aclProtoUDP   = 17
+	aclProtoICMP6 = 58
+
+	aclFlowTTL           = 3 * time.Minute
+	aclFlowSweepInterval = time.Minute
+	// aclFlowShards 会话表分片数，降低多核收发时的锁竞争
+	aclFlowShards = 16
+	// aclFlowMaxPerShard 每个分片的会话上限，满了先清理过期会话，仍满时淘汰任意一条
+	aclFlowMaxPerShard = 4096
+)
+
+// aclRule 解析后的数据面规则
+type aclRule struct {
+	accept bool
+	src    []netip.Prefix
+	dst    []netip.Prefix
+	proto  uint8
+	ports  [][2]uint16
+}
+
+// aclProtocolNumber 协议名转协议号，0 表示全部协议，icmp 同时匹配 icmpv6
+func aclProtocolNumber(proto string) uint8 {
+	switch strings.ToLower(strings.TrimSpace(proto)) {
+	case "tcp":
+		return aclProtoTCP
+	case "udp":
+		return aclProtoUDP
+	case "icmp", "icmpv6":
+		return aclProtoICMP
+	default:
+		return 0
+	}
+}
+
+func parseACLRule(r *pb.AclRuleConfig) (*aclRule, error) {
+	proto := strings.ToLower(strings.TrimSpace(r.GetProtocol()))
+	switch proto {
+	case "", "any", "all", "tcp", "udp", "icmp", "icmpv6":
+	default:
+		return nil, fmt.Errorf("invalid acl protocol '%s'", r.GetProtocol())
+	}
+
+	rule := &aclRule{
+		accept: strings.ToLower(r.GetAction()) != "deny",
+		proto:  aclProtocolNumber(proto),
+	}
+	if rule.proto == aclProtoICMP && len(r.GetPorts()) > 0 {
+		return nil, fmt.Errorf("icmp acl rule can not have ports")
+	}
+	for _, p := range r.GetPorts() {
+		pr, err := parseACLPortRange(p)
+		if err != nil {
+			return nil, err
+		}
+		rule.ports = append(rule.ports, pr)
+	}
+	for _, s := range r.GetSrc() {
+		if prefix, ok := parseACLPrefix(s); ok {
+			rule.src = append(rule.src, prefix)
+		}
+	}
+	for _, s := range r.GetDst() {
+		if prefix, ok := parseACLPrefix(s); ok {
+			rule.dst = append(rule.dst, prefix)
+		}
+	}
+	return rule, nil
+}
+
+// parseACLPortRange 解析 80 或 8000-9000
+func parseACLPortRange(s string) ([2]uint16, error) {
+	start, end, found := strings.Cut(strings.TrimSpace(s), "-")
+	if !found {
+		end = start
+	}
+	from, err := strconv.ParseUint(strings.TrimSpace(start), 10, 16)
+	if err != nil || from == 0 {
+		return [2]uint16{}, fmt.Errorf("invalid acl port '%s'", s)
+	}
+	to, err := strconv.ParseUint(strings.TrimSpace(end), 10, 16)
+	if err != nil || to < from {
+		return [2]uint16{}, fmt.Errorf("invalid acl port '%s'", s)
+	}
+	return [2]uint16{uint16(from), uint16(to)}, nil
+}
+
+// packetInfo 从 ip 包中解析出的五元组，hasPorts 为 false 时表示非首分片或非 tcp/udp
+type packetInfo struct {
+	src, dst netip.Addr
+	proto    uint8
+	sport    uint16
+	dport    uint16
+	hasPorts bool
+}
+
+func parsePacket(pkt []byte) (packetInfo, bool) {
+	var info packetInfo
+	if len(pkt) < 1 {
+		return info, false
+	}
+	var l4 []byte
+	switch pkt[0] >> 4 {
+	case 4:
+		if len(pkt) < 20 {
+			return info, false
+		}
+		ihl := int(pkt[0]&0x0f) * 4
+		if ihl < 20 || len(pkt) < ihl {
+			return info, false
+		}
+		info.proto = pkt[9]
+		info.src = netip.AddrFrom4([4]byte(pkt[12:16]))
+		info.dst = netip.AddrFrom4([4]byte(pkt[16:20]))
+		// 非首分片没有四层头
+		if binary.BigEndian.Uint16(pkt[6:8])&0x1fff == 0 {
+			l4 = pkt[ihl:]
+		}
+	case 6:
+		if len(pkt) < 40 {
+			return info, false
+		}
+		info.proto = pkt[6]
+		info.src = netip.AddrFrom16([16]byte(pkt[8:24]))
+		info.dst = netip.AddrFrom16([16]byte(pkt[24:40]))
+		l4 = pkt[40:]
+	default:
+		return info, false
+	}
+	if (info.proto == aclProtoTCP || info.proto == aclProtoUDP) && len(l4) >= 4 {
+		info.sport = binary.BigEndian.Uint16(l4[0:2])
+		info.dport = binary.BigEndian.Uint16(l4[2:4])
+		info.hasPorts = true
+	}
+	return info, true
+}
+
+func (r *aclRule) match(info packetInfo) bool {
+	if !prefixesContain(r.src, info.src) || !prefixesContain(r.dst, info.dst) {
+		return false
+	}
+	switch r.proto {
+	case 0:
+	case aclProtoICMP:
+		if info.proto != aclProtoICMP && info.proto != aclProtoICMP6 {
+			return false
+		}
+	default:
+		if info.proto != r.proto {
+			return false
+		}
+	}
+	if len(r.ports) == 0 {
+		return true
+	}
+	if info.proto != aclProtoTCP && info.proto != aclProtoUDP {
+		return false
+	}
+	if !info.hasPorts {
+		return false
+	}
+	for _, pr := range r.ports {
+		if info.dport >= pr[0] && info.dport <= pr[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func prefixesContain(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, p := range prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+type flowKey struct {
+	src, dst     netip.Addr
+	proto        uint8
+	sport, dport uint16
+}
+
+func (k flowKey) reverse() flowKey {
+	return flowKey{src: k.dst, dst: k.src, proto: k.proto, sport: k.dport, dport: k.sport}
+}
+
+func flowKeyOf(info packetInfo) flowKey {
+	return flowKey{src: info.src, dst: info.dst, proto: info.proto, sport: info.sport, dport: info.dport}
+}
+
+// flowShard 会话表的一个分片
+type flowShard struct {
+	mu        sync.Mutex
+	flows     map[flowKey]time.Time
+	lastSweep time.Time
+}
+
+// touch 会话未过期时刷新时间
+func (s *flowShard) touch(key flowKey, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.flows[key]
+	if !ok || now.Sub(t) >= aclFlowTTL {
+		return false
+	}
+	s.flows[key] = now
+	return true
+}
+
+func (s *flowShard) track(key flowKey, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.flows[key]; !ok && (len(s.flows) >= aclFlowMaxPerShard || now.Sub(s.lastSweep) >= aclFlowSweepInterval) {
+		for k, t := range s.flows {
+			if now.Sub(t) >= aclFlowTTL {
+				delete(s.flows, k)
+			}
+		}
+		s.lastSweep = now
+		for k := range s.flows {
+			if len(s.flows) < aclFlowMaxPerShard {
+				break
+			}
+			delete(s.flows, k)
+		}
+	}
+	s.flows[key] = now
+}
+
+// packetFilter gvisor netstack 下的用户态包过滤，只过滤从隧道进入的包。
+// 放行的入向包与本机发出的包都会记录会话，会话的回包直接放行
+type packetFilter struct {
+	rules []*aclRule
+
+	seed   maphash.Seed
+	shards [aclFlowShards]flowShard
+	now    func() time.Time
+}
+
+func newPacketFilter(cfg *pb.AclConfig) (*packetFilter, error) {
+	f := &packetFilter{
+		seed: maphash.MakeSeed(),
+		now:  time.Now,
+	}
+	for i := range f.shards {
+		f.shards[i].flows = make(map[flowKey]time.Time, 64)
+	}
+	for _, r := range cfg.GetAcls() {
+		rule, err := parseACLRule(r)
+		if err != nil {
+			return nil, err
+		}
+		f.rules = append(f.rules, rule)
+	}
+	return f, nil
+}
+
+func (f *packetFilter) shard(key flowKey) *flowShard {
+	return &f.shards[maphash.Comparable(f.seed, key)%aclFlowShards]
+}
+
+// AllowInbound 判断从隧道进入的包是否放行，无法解析的包直接丢弃
+func (f *packetFilter) AllowInbound(pkt []byte) bool {
+	info, ok := parsePacket(pkt)
+	if !ok {
+		return false
+	}
+	key := flowKeyOf(info)
+
+	now := f.now()
+	if f.shard(key.reverse()).touch(key.reverse(), now) || f.shard(key).touch(key, now) {
+		return true
+	}
+
+	for _, r := range f.rules {
+		if !r.match(info) {
+			continue
+		}
+		if r.accept {
+			f.shard(key).track(key, now)
+		}
+		return r.accept
+	}
+	return true
+}
+
+// TrackOutbound 记录发往隧道的包，使其回包可以通过过滤
+func (f *packetFilter) TrackOutbound(pkt []byte) {
+	info, ok := parsePacket(pkt)
+	if !ok {
+		return
+	}
+	key := flowKeyOf(info)
+	f.shard(key).track(key, f.now())
+}
@@ -0,0 +1,122 @@
+package wg
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func buildIPv4Packet(src, dst string, proto uint8, sport, dport uint16) []byte {
+	pkt := make([]byte, 28)
+	pkt[0] = 0x45
+	pkt[9] = proto
+	s, d := netip.MustParseAddr(src).As4(), netip.MustParseAddr(dst).As4()
+	copy(pkt[12:16], s[:])
+	copy(pkt[16:20], d[:])
+	binary.BigEndian.PutUint16(pkt[20:22], sport)
+	binary.BigEndian.PutUint16(pkt[22:24], dport)
+	return pkt
+}
+
+func TestCompileACL(t *testing.T) {
+	peers := []*models.WireGuard{
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.1/24", Tags: []string{"web"}}},
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.2/24", Tags: []string{"ops"}}},
+	}
+	assert.Nil(t, CompileACL(&pb.AclConfig{}, peers, nil))
+
+	compiled := CompileACL(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"ops", "10.0.0.100"}, Dst: []string{"web"}, Protocol: "tcp", Ports: []string{"22"}},
+		{Action: "accept", Src: []string{"missing"}, Dst: []string{"web"}},
+	}}, peers, nil)
+
+	assert.Len(t, compiled.Acls, 2)
+	assert.Equal(t, []string{"10.0.0.2/32", "10.0.0.100/32"}, compiled.Acls[0].Src)
+	assert.Equal(t, []string{"10.0.0.1/32"}, compiled.Acls[0].Dst)
+	assert.Equal(t, "deny", compiled.Acls[1].Action)
+}
+
+func TestCompileACL_ExternalPeers(t *testing.T) {
+	peers := []*models.WireGuard{
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.1/24", Tags: []string{"web"}}},
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.2/24", Tags: []string{"ops"}}},
+	}
+	for i, p := range peers {
+		p.ID = uint(i + 1)
+	}
+	ext := &models.WireGuardExternalPeer{WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+		RelayWireGuardID: 2, LocalAddress: "10.0.0.100/24",
+	}}
+
+	// 外部设备随中继节点的标签展开
+	compiled := CompileACL(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"ops"}, Dst: []string{"web"}},
+	}}, peers, []*models.WireGuardExternalPeer{ext})
+	assert.Equal(t, []string{"10.0.0.2/32", "10.0.0.100/32"}, compiled.Acls[0].Src)
+	assert.Equal(t, []string{"10.0.0.1/32"}, compiled.Acls[0].Dst)
+}
+
+func TestACLCanConnect_PartialDenyAndCIDR(t *testing.T) {
+	a := &models.WireGuard{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.1/24", Tags: []string{"a"}}}
+	b := &models.WireGuard{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.2/24", Tags: []string{"b"}}}
+
+	acl := NewACL().LoadFromPB(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "deny", Src: []string{"a"}, Dst: []string{"b"}, Protocol: "tcp", Ports: []string{"22"}},
+		{Action: "accept", Src: []string{"a"}, Dst: []string{"10.0.0.0/24"}},
+	}})
+	assert.True(t, acl.CanConnect(a, b))
+	assert.False(t, acl.CanConnect(b, a))
+}
+
+func TestPacketFilter(t *testing.T) {
+	f, err := newPacketFilter(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"10.0.0.2/32"}, Dst: []string{"10.0.0.1/32"}, Protocol: "tcp", Ports: []string{"20-22"}},
+		{Action: "deny", Src: []string{"0.0.0.0/0"}, Dst: []string{"0.0.0.0/0"}},
+	}})
+	assert.NoError(t, err)
+
+	assert.True(t, f.AllowInbound(buildIPv4Packet("10.0.0.2", "10.0.0.1", aclProtoTCP, 40000, 22)))
+	assert.False(t, f.AllowInbound(buildIPv4Packet("10.0.0.2", "10.0.0.1", aclProtoTCP, 40000, 80)))
+	assert.False(t, f.AllowInbound(buildIPv4Packet("10.0.0.2", "10.0.0.1", aclProtoUDP, 40000, 22)))
+	assert.False(t, f.AllowInbound(buildIPv4Packet("10.0.0.3", "10.0.0.1", aclProtoTCP, 40000, 22)))
+
+	// 本机发起的会话，回包放行
+	f.TrackOutbound(buildIPv4Packet("10.0.0.1", "10.0.0.3", aclProtoTCP, 50000, 443))
+	assert.True(t, f.AllowInbound(buildIPv4Packet("10.0.0.3", "10.0.0.1", aclProtoTCP, 443, 50000)))
+
+	_, err = newPacketFilter(&pb.AclConfig{Acls: []*pb.AclRuleConfig{{Protocol: "sctp"}}})
+	assert.Error(t, err)
+	_, err = newPacketFilter(&pb.AclConfig{Acls: []*pb.AclRuleConfig{{Protocol: "tcp", Ports: []string{"9000-80"}}}})
+	assert.Error(t, err)
+}
+
+func TestPacketFilter_FlowTableBounded(t *testing.T) {
+	f, err := newPacketFilter(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "deny", Src: []string{"0.0.0.0/0"}, Dst: []string{"0.0.0.0/0"}},
+	}})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	f.now = func() time.Time { return now }
+	for i := 0; i < aclFlowShards*aclFlowMaxPerShard+1000; i++ {
+		f.TrackOutbound(buildIPv4Packet("10.0.0.1", "10.0.0.3", aclProtoUDP, uint16(i%60000+1), uint16(i/60000+1)))
+	}
+	total := 0
+	for i := range f.shards {
+		assert.LessOrEqual(t, len(f.shards[i].flows), aclFlowMaxPerShard)
+		total += len(f.shards[i].flows)
+	}
+	assert.Greater(t, total, 0)
+
+	// 会话过期后回包不再放行
+	f.TrackOutbound(buildIPv4Packet("10.0.0.1", "10.0.0.4", aclProtoTCP, 50000, 443))
+	assert.True(t, f.AllowInbound(buildIPv4Packet("10.0.0.4", "10.0.0.1", aclProtoTCP, 443, 50000)))
+	now = now.Add(aclFlowTTL)
+	assert.False(t, f.AllowInbound(buildIPv4Packet("10.0.0.4", "10.0.0.1", aclProtoTCP, 443, 50000)))
+}
@@ -20,12 +20,21 @@ type firewallManager struct {
 	mu      sync.Mutex
 	logger  *logrus.Entry
 	tracked map[string]string // iface -> cidr
+	// aclTracked iface -> 是否 ipv6，记录已创建 ACL 链的接口
+	aclTracked map[string]bool
+	// subnetTracked iface -> 子网路由/出口节点的转发与 NAT 规则
+	subnetTracked map[string][]iptRule
+	// forwardRestore 是否 ipv6 -> 开启内核转发前的原值，没有接口再需要转发时恢复
+	forwardRestore map[bool]string
 }
 
 func newFirewallManager(logger *logrus.Entry) *firewallManager {
 	return &firewallManager{
-		logger:  logger,
-		tracked: make(map[string]string),
+		logger:         logger,
+		tracked:        make(map[string]string),
+		aclTracked:     make(map[string]bool),
+		subnetTracked:  make(map[string][]iptRule),
+		forwardRestore: make(map[bool]string),
 	}
 }
 
@@ -62,14 +71,23 @@ func (f *firewallManager) Cleanup(iface string) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
+	var errs error
+	if isIPv6, ok := f.aclTracked[iface]; ok {
+		errs = errors.Join(errs, f.deleteACLChain(iface, isIPv6))
+		delete(f.aclTracked, iface)
+	}
+	if _, ok := f.subnetTracked[iface]; ok {
+		errs = errors.Join(errs, f.deleteSubnetRules(iface))
+	}
+
 	cidr, ok := f.tracked[iface]
 	if !ok {
-		return nil
+		return errs
 	}
 
-	err := f.deleteRelayRules(iface, cidr)
+	errs = errors.Join(errs, f.deleteRelayRules(iface, cidr))
 	delete(f.tracked, iface)
-	return err
+	return errs
 }
 
 func (f *firewallManager) ensureRelayRules(iface, cidr string) error {
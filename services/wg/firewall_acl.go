@@ -0,0 +1,156 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+// aclChainName 每个接口独立的 ACL 链，iptables 链名最长 28 字符，接口名最长 15 字符
+func aclChainName(iface string) string {
+	return "FRPP-ACL-" + iface
+}
+
+// ApplyACLRules 用接口独立的链执行 ACL：INPUT/FORWARD 中从接口进入的包先跳转到该链，
+// 已建立的会话直接放行，放行规则 RETURN 回原链，拒绝规则 DROP。acl 为空时删除该链
+func (f *firewallManager) ApplyACLRules(iface string, isIPv6 bool, acl *pb.AclConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(acl.GetAcls()) == 0 {
+		err := f.deleteACLChain(iface, isIPv6)
+		delete(f.aclTracked, iface)
+		return err
+	}
+
+	rules, err := buildACLRuleSpecs(acl, isIPv6)
+	if err != nil {
+		return err
+	}
+
+	ipt, err := newIPT(isIPv6)
+	if err != nil {
+		return err
+	}
+	chain := aclChainName(iface)
+	if err := ipt.ClearChain("filter", chain); err != nil {
+		return errors.Join(fmt.Errorf("clear acl chain '%s' failed", chain), err)
+	}
+	for _, spec := range rules {
+		if err := ipt.Append("filter", chain, spec...); err != nil {
+			return errors.Join(fmt.Errorf("append acl rule '%s' failed", strings.Join(spec, " ")), err)
+		}
+	}
+	for _, parent := range []string{"INPUT", "FORWARD"} {
+		exists, err := ipt.Exists("filter", parent, "-i", iface, "-j", chain)
+		if err != nil {
+			return errors.Join(fmt.Errorf("check acl jump in %s failed", parent), err)
+		}
+		if exists {
+			continue
+		}
+		if err := ipt.Insert("filter", parent, 1, "-i", iface, "-j", chain); err != nil {
+			return errors.Join(fmt.Errorf("insert acl jump in %s failed", parent), err)
+		}
+	}
+
+	f.aclTracked[iface] = isIPv6
+	return nil
+}
+
+func (f *firewallManager) deleteACLChain(iface string, isIPv6 bool) error {
+	ipt, err := newIPT(isIPv6)
+	if err != nil {
+		return err
+	}
+	chain := aclChainName(iface)
+	exists, err := ipt.ChainExists("filter", chain)
+	if err != nil || !exists {
+		return err
+	}
+
+	var errs error
+	for _, parent := range []string{"INPUT", "FORWARD"} {
+		if err := ipt.DeleteIfExists("filter", parent, "-i", iface, "-j", chain); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("delete acl jump in %s failed: %w", parent, err))
+		}
+	}
+	if err := ipt.ClearAndDeleteChain("filter", chain); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("delete acl chain '%s' failed: %w", chain, err))
+	}
+	return errs
+}
+
+// buildACLRuleSpecs 把规则展开为 iptables 规则，只保留与 isIPv6 同一地址族的网段
+func buildACLRuleSpecs(acl *pb.AclConfig, isIPv6 bool) ([][]string, error) {
+	specs := [][]string{{"-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "RETURN"}}
+
+	for _, r := range acl.GetAcls() {
+		rule, err := parseACLRule(r)
+		if err != nil {
+			return nil, err
+		}
+		target := "DROP"
+		if rule.accept {
+			target = "RETURN"
+		}
+
+		var protos []string
+		switch {
+		case rule.proto == aclProtoTCP:
+			protos = []string{"tcp"}
+		case rule.proto == aclProtoUDP:
+			protos = []string{"udp"}
+		case rule.proto == aclProtoICMP && isIPv6:
+			protos = []string{"ipv6-icmp"}
+		case rule.proto == aclProtoICMP:
+			protos = []string{"icmp"}
+		case len(rule.ports) > 0:
+			protos = []string{"tcp", "udp"}
+		default:
+			protos = []string{""}
+		}
+
+		ports := []string{""}
+		if len(rule.ports) > 0 {
+			ports = ports[:0]
+			for _, pr := range rule.ports {
+				ports = append(ports, fmt.Sprintf("%d:%d", pr[0], pr[1]))
+			}
+		}
+
+		for _, src := range filterPrefixFamily(rule.src, isIPv6) {
+			for _, dst := range filterPrefixFamily(rule.dst, isIPv6) {
+				for _, proto := range protos {
+					for _, port := range ports {
+						spec := []string{"-s", src.String(), "-d", dst.String()}
+						if len(proto) > 0 {
+							spec = append(spec, "-p", proto)
+						}
+						if len(port) > 0 {
+							spec = append(spec, "--dport", port)
+						}
+						specs = append(specs, append(spec, "-j", target))
+					}
+				}
+			}
+		}
+	}
+	return specs, nil
+}
+
+func filterPrefixFamily(prefixes []netip.Prefix, isIPv6 bool) []netip.Prefix {
+	ret := make([]netip.Prefix, 0, len(prefixes))
+	for _, p := range prefixes {
+		if p.Addr().Is6() == isIPv6 {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,26 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func TestBuildACLRuleSpecs(t *testing.T) {
+	specs, err := buildACLRuleSpecs(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"10.0.0.2/32", "fd00::2/128"}, Dst: []string{"10.0.0.1/32"}, Ports: []string{"53"}},
+		{Action: "deny", Src: []string{"0.0.0.0/0"}, Dst: []string{"0.0.0.0/0"}},
+	}}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "RETURN"},
+		{"-s", "10.0.0.2/32", "-d", "10.0.0.1/32", "-p", "tcp", "--dport", "53:53", "-j", "RETURN"},
+		{"-s", "10.0.0.2/32", "-d", "10.0.0.1/32", "-p", "udp", "--dport", "53:53", "-j", "RETURN"},
+		{"-s", "0.0.0.0/0", "-d", "0.0.0.0/0", "-j", "DROP"},
+	}, specs)
+}
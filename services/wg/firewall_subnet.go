@@ -0,0 +1,178 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+// iptRule 一条 iptables 规则
+type iptRule struct {
+	isIPv6 bool
+	table  string
+	chain  string
+	spec   []string
+}
+
+func (r iptRule) key() string {
+	return fmt.Sprintf("%v|%s|%s|%s", r.isIPv6, r.table, r.chain, strings.Join(r.spec, " "))
+}
+
+// ApplySubnetRouteRules 子网路由/出口节点的转发与 NAT 规则：开启内核转发，放行接口与通告网段之间的转发，
+// 网络内地址访问通告网段时做 MASQUERADE，使对端网段无需配置回程路由。routes 为空时删除已有规则
+func (f *firewallManager) ApplySubnetRouteRules(iface, networkCIDR string, routes []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	desired, err := buildSubnetRuleSpecs(iface, networkCIDR, routes)
+	if err != nil {
+		return err
+	}
+	if len(desired) > 0 {
+		if err := f.enableIPForwardLocked(desired[0].isIPv6); err != nil {
+			return err
+		}
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, r := range desired {
+		desiredKeys[r.key()] = struct{}{}
+	}
+
+	var errs error
+	kept := make([]iptRule, 0, len(desired))
+	for _, r := range f.subnetTracked[iface] {
+		if _, ok := desiredKeys[r.key()]; ok {
+			continue
+		}
+		if err := deleteIPTRule(r); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	for _, r := range desired {
+		ipt, err := newIPT(r.isIPv6)
+		if err != nil {
+			return errors.Join(errs, err)
+		}
+		if err := ipt.AppendUnique(r.table, r.chain, r.spec...); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("append %s/%s rule '%s' failed: %w", r.table, r.chain, strings.Join(r.spec, " "), err))
+			continue
+		}
+		kept = append(kept, r)
+	}
+
+	if len(kept) == 0 {
+		delete(f.subnetTracked, iface)
+	} else {
+		f.subnetTracked[iface] = kept
+	}
+	return errors.Join(errs, f.restoreIPForwardLocked())
+}
+
+func (f *firewallManager) deleteSubnetRules(iface string) error {
+	var errs error
+	for _, r := range f.subnetTracked[iface] {
+		errs = errors.Join(errs, deleteIPTRule(r))
+	}
+	delete(f.subnetTracked, iface)
+	return errors.Join(errs, f.restoreIPForwardLocked())
+}
+
+func deleteIPTRule(r iptRule) error {
+	ipt, err := newIPT(r.isIPv6)
+	if err != nil {
+		return err
+	}
+	if err := ipt.DeleteIfExists(r.table, r.chain, r.spec...); err != nil {
+		return fmt.Errorf("delete %s/%s rule '%s' failed: %w", r.table, r.chain, strings.Join(r.spec, " "), err)
+	}
+	return nil
+}
+
+// buildSubnetRuleSpecs 每个通告网段生成：
+// - FORWARD -i iface -d route ACCEPT
+// - FORWARD route -> iface 的回程放行，出口节点只放行已建立的会话，避免公网主动访问网络内地址
+// - nat POSTROUTING -s network -d route ! -o iface MASQUERADE
+// 只保留与网络网段同一地址族的前缀
+func buildSubnetRuleSpecs(iface, networkCIDR string, routes []string) ([]iptRule, error) {
+	network, err := netip.ParsePrefix(networkCIDR)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("parse network cidr '%s' failed", networkCIDR), err)
+	}
+	isIPv6 := network.Addr().Is6()
+	networkStr := network.Masked().String()
+
+	ret := make([]iptRule, 0, len(routes)*3)
+	for _, r := range routes {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("parse advertised route '%s' failed", r), err)
+		}
+		if prefix.Addr().Is6() != isIPv6 {
+			continue
+		}
+		route := prefix.Masked().String()
+
+		back := []string{"-s", route, "-o", iface, "-j", "ACCEPT"}
+		if prefix.Bits() == 0 {
+			back = []string{"-o", iface, "-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "ACCEPT"}
+		}
+		ret = append(ret,
+			iptRule{isIPv6: isIPv6, table: "filter", chain: "FORWARD", spec: []string{"-i", iface, "-d", route, "-j", "ACCEPT"}},
+			iptRule{isIPv6: isIPv6, table: "filter", chain: "FORWARD", spec: back},
+			iptRule{isIPv6: isIPv6, table: "nat", chain: "POSTROUTING", spec: []string{"-s", networkStr, "-d", route, "!", "-o", iface, "-j", "MASQUERADE"}},
+		)
+	}
+	return ret, nil
+}
+
+// ipForwardPaths 是否 ipv6 -> 内核转发开关
+var ipForwardPaths = map[bool]string{
+	false: "/proc/sys/net/ipv4/ip_forward",
+	true:  "/proc/sys/net/ipv6/conf/all/forwarding",
+}
+
+// enableIPForwardLocked 开启内核转发，第一次由本进程开启时记录原值
+func (f *firewallManager) enableIPForwardLocked(isIPv6 bool) error {
+	p := ipForwardPaths[isIPv6]
+	b, err := os.ReadFile(p)
+	if err == nil && strings.TrimSpace(string(b)) == "1" {
+		return nil
+	}
+	if err := os.WriteFile(p, []byte("1"), 0o644); err != nil {
+		return errors.Join(fmt.Errorf("enable ip forward '%s' failed", p), err)
+	}
+	if _, ok := f.forwardRestore[isIPv6]; !ok && err == nil {
+		f.forwardRestore[isIPv6] = strings.TrimSpace(string(b))
+	}
+	return nil
+}
+
+// restoreIPForwardLocked 没有接口再通告该地址族的网段时，恢复本进程修改前的内核转发设置
+func (f *firewallManager) restoreIPForwardLocked() error {
+	var errs error
+	for isIPv6, orig := range f.forwardRestore {
+		inUse := false
+		for _, rules := range f.subnetTracked {
+			if len(rules) > 0 && rules[0].isIPv6 == isIPv6 {
+				inUse = true
+				break
+			}
+		}
+		if inUse {
+			continue
+		}
+		p := ipForwardPaths[isIPv6]
+		if err := os.WriteFile(p, []byte(orig), 0o644); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("restore ip forward '%s' failed: %w", p, err))
+			continue
+		}
+		delete(f.forwardRestore, isIPv6)
+	}
+	return errs
+}
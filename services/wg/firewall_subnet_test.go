@@ -0,0 +1,58 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSubnetRuleSpecs(t *testing.T) {
+	rules, err := buildSubnetRuleSpecs("wg0", "10.0.0.1/24", []string{"192.168.10.0/24", "0.0.0.0/0", "fd00::/64"})
+	assert.NoError(t, err)
+	assert.Equal(t, []iptRule{
+		{table: "filter", chain: "FORWARD", spec: []string{"-i", "wg0", "-d", "192.168.10.0/24", "-j", "ACCEPT"}},
+		{table: "filter", chain: "FORWARD", spec: []string{"-s", "192.168.10.0/24", "-o", "wg0", "-j", "ACCEPT"}},
+		{table: "nat", chain: "POSTROUTING", spec: []string{"-s", "10.0.0.0/24", "-d", "192.168.10.0/24", "!", "-o", "wg0", "-j", "MASQUERADE"}},
+		{table: "filter", chain: "FORWARD", spec: []string{"-i", "wg0", "-d", "0.0.0.0/0", "-j", "ACCEPT"}},
+		{table: "filter", chain: "FORWARD", spec: []string{"-o", "wg0", "-m", "conntrack", "--ctstate", "ESTABLISHED,RELATED", "-j", "ACCEPT"}},
+		{table: "nat", chain: "POSTROUTING", spec: []string{"-s", "10.0.0.0/24", "-d", "0.0.0.0/0", "!", "-o", "wg0", "-j", "MASQUERADE"}},
+	}, rules)
+}
+
+func TestIPForwardRestore(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "ip_forward")
+	assert.NoError(t, os.WriteFile(p, []byte("0\n"), 0o644))
+	old := ipForwardPaths
+	ipForwardPaths = map[bool]string{false: p}
+	defer func() { ipForwardPaths = old }()
+
+	read := func() string {
+		b, err := os.ReadFile(p)
+		assert.NoError(t, err)
+		return string(b)
+	}
+
+	f := newFirewallManager(nil)
+	assert.NoError(t, f.enableIPForwardLocked(false))
+	assert.Equal(t, "1", read())
+
+	// 仍有接口通告网段时不恢复
+	f.subnetTracked["wg0"] = []iptRule{{table: "filter", chain: "FORWARD"}}
+	assert.NoError(t, f.restoreIPForwardLocked())
+	assert.Equal(t, "1", read())
+
+	delete(f.subnetTracked, "wg0")
+	assert.NoError(t, f.restoreIPForwardLocked())
+	assert.Equal(t, "0", read())
+	assert.Empty(t, f.forwardRestore)
+
+	// 原本就开启的不做记录，也不会被关闭
+	assert.NoError(t, os.WriteFile(p, []byte("1"), 0o644))
+	assert.NoError(t, f.enableIPForwardLocked(false))
+	assert.Empty(t, f.forwardRestore)
+}
@@ -0,0 +1,305 @@
+package wg
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+const (
+	magicDNSPort       = 53
+	magicDNSTTL        = 60
+	magicDNSTimeout    = 3 * time.Second
+	magicDNSDefaultUps = "1.1.1.1:53"
+	// magicDNSMaxInflight 同时处理的查询上限，转发上游最长阻塞 magicDNSTimeout，超过时直接丢弃
+	magicDNSMaxInflight = 64
+)
+
+// magicDNS 在 WireGuard 地址上解析网络内的节点名：
+// <wireguard-name>.<network-name>.internal 与 <client-id>.<network-name>.internal，其余域名转发到上游。
+// 只使用 .internal 区域，网络名与公网域名（例如 com）重名时也不会劫持公网解析
+type magicDNS struct {
+	mu      sync.RWMutex
+	records map[string]netip.Addr // fqdn -> 虚拟 IP
+	zones   []string
+
+	upstream string
+	conn     net.PacketConn
+	inflight chan struct{}
+	logger   *logrus.Entry
+}
+
+func newMagicDNS(conn net.PacketConn, upstream string, logger *logrus.Entry) *magicDNS {
+	var self netip.Addr
+	if conn != nil {
+		if ap, err := netip.ParseAddrPort(conn.LocalAddr().String()); err == nil {
+			self = ap.Addr().Unmap()
+		}
+	}
+	if ap, err := netip.ParseAddrPort(upstream); err == nil && ap.Addr().Unmap() == self {
+		logger.Warnf("magic dns upstream '%s' is the magic dns itself, ignore it", upstream)
+		upstream = ""
+	}
+	if len(upstream) == 0 {
+		upstream = systemNameserver(self)
+	}
+	return &magicDNS{
+		records:  make(map[string]netip.Addr),
+		upstream: upstream,
+		conn:     conn,
+		inflight: make(chan struct{}, magicDNSMaxInflight),
+		logger:   logger,
+	}
+}
+
+// magicDNSLabel 把名称转成合法的 dns label
+func magicDNSLabel(s string) string {
+	b := strings.Builder{}
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+// Update 根据下发的配置重建记录，本节点自身也会加入记录
+func (d *magicDNS) Update(cfg *pb.WireGuardConfig) {
+	network := magicDNSLabel(cfg.GetNetworkName())
+	records := make(map[string]netip.Addr)
+	var zones []string
+
+	if len(network) > 0 {
+		zone := network + ".internal."
+		zones = []string{zone}
+		// 先加入 WireGuard 名称，client id 与已有名称重复时不覆盖
+		names := make(map[string]netip.Addr)
+		clientIDs := make(map[string]netip.Addr)
+		add := func(name, clientID, ip string) {
+			addr, _, err := models.ParseIPOrCIDRWithNetip(ip)
+			if err != nil {
+				return
+			}
+			if l := magicDNSLabel(name); len(l) > 0 {
+				names[l+"."+zone] = addr
+			}
+			if l := magicDNSLabel(clientID); len(l) > 0 {
+				clientIDs[l+"."+zone] = addr
+			}
+		}
+		for _, p := range cfg.GetPeers() {
+			add("", p.GetClientId(), p.GetVirtualIp())
+		}
+		for _, r := range cfg.GetDnsRecords() {
+			add(r.GetName(), r.GetClientId(), r.GetVirtualIp())
+		}
+		add(cfg.GetInterfaceName(), cfg.GetClientId(), cfg.GetLocalAddress())
+
+		for fqdn, addr := range clientIDs {
+			records[fqdn] = addr
+		}
+		for fqdn, addr := range names {
+			records[fqdn] = addr
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.records = records
+	d.zones = zones
+}
+
+// Serve 处理查询，Close 后返回
+func (d *magicDNS) Serve() {
+	d.mu.RLock()
+	conn := d.conn
+	d.mu.RUnlock()
+	if conn == nil {
+		return
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				d.logger.WithError(err).Debug("magic dns read failed, stop serving")
+			}
+			return
+		}
+		select {
+		case d.inflight <- struct{}{}:
+		default:
+			d.logger.Debug("magic dns too many inflight queries, drop")
+			continue
+		}
+		req := append([]byte(nil), buf[:n]...)
+		go func() {
+			defer func() { <-d.inflight }()
+			resp, err := d.handle(req)
+			if err != nil {
+				d.logger.WithError(err).Debug("magic dns handle query failed")
+				return
+			}
+			if _, err := conn.WriteTo(resp, addr); err != nil {
+				d.logger.WithError(err).Debug("magic dns write response failed")
+			}
+		}()
+	}
+}
+
+func (d *magicDNS) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn == nil {
+		return nil
+	}
+	err := d.conn.Close()
+	d.conn = nil
+	return err
+}
+
+// handle 网络内域名直接应答，其余转发到上游
+func (d *magicDNS) handle(req []byte) ([]byte, error) {
+	var p dnsmessage.Parser
+	hdr, err := p.Start(req)
+	if err != nil {
+		return nil, err
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ToLower(q.Name.String())
+	addr, found, managed := d.lookup(name)
+	if !managed {
+		return d.forward(req)
+	}
+
+	respHdr := dnsmessage.Header{
+		ID:                 hdr.ID,
+		Response:           true,
+		Authoritative:      true,
+		RecursionDesired:   hdr.RecursionDesired,
+		RecursionAvailable: true,
+	}
+	if !found {
+		respHdr.RCode = dnsmessage.RCodeNameError
+	}
+	b := dnsmessage.NewBuilder(make([]byte, 0, 512), respHdr)
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(q); err != nil {
+		return nil, err
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+	if found && q.Class == dnsmessage.ClassINET {
+		rh := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: magicDNSTTL}
+		switch {
+		case addr.Is4() && (q.Type == dnsmessage.TypeA || q.Type == dnsmessage.TypeALL):
+			if err := b.AResource(rh, dnsmessage.AResource{A: addr.As4()}); err != nil {
+				return nil, err
+			}
+		case addr.Is6() && (q.Type == dnsmessage.TypeAAAA || q.Type == dnsmessage.TypeALL):
+			if err := b.AAAAResource(rh, dnsmessage.AAAAResource{AAAA: addr.As16()}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b.Finish()
+}
+
+// lookup 返回记录，managed 表示域名属于网络内的区域
+func (d *magicDNS) lookup(name string) (netip.Addr, bool, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if addr, ok := d.records[name]; ok {
+		return addr, true, true
+	}
+	for _, z := range d.zones {
+		if strings.HasSuffix(name, "."+z) {
+			return netip.Addr{}, false, true
+		}
+	}
+	return netip.Addr{}, false, false
+}
+
+func (d *magicDNS) forward(req []byte) ([]byte, error) {
+	conn, err := net.DialTimeout("udp", d.upstream, magicDNSTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(magicDNSTimeout)); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(req); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// magicDNSResolvConfs 优先读取 systemd-resolved 的实际上游，/etc/resolv.conf 中通常只有 127.0.0.53
+var magicDNSResolvConfs = []string{"/run/systemd/resolve/resolv.conf", "/etc/resolv.conf"}
+
+// systemNameserver 返回系统配置中第一个可用的 nameserver。
+// 跳过回环地址与 MagicDNS 自身：127.0.0.53 等本地解析器会把网络域名再路由回本接口，形成循环
+func systemNameserver(self netip.Addr) string {
+	for _, path := range magicDNSResolvConfs {
+		if ns, ok := readNameserver(path, self); ok {
+			return ns
+		}
+	}
+	return magicDNSDefaultUps
+}
+
+func readNameserver(path string, self netip.Addr) (string, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		addr, err := netip.ParseAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		addr = addr.Unmap()
+		if addr.IsLoopback() || addr.IsUnspecified() || addr == self {
+			continue
+		}
+		return netip.AddrPortFrom(addr, magicDNSPort).String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,125 @@
+package wg
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func buildDNSQuery(t *testing.T, name string, typ dnsmessage.Type) []byte {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 1, RecursionDesired: true})
+	assert.NoError(t, b.StartQuestions())
+	assert.NoError(t, b.Question(dnsmessage.Question{Name: dnsmessage.MustNewName(name), Type: typ, Class: dnsmessage.ClassINET}))
+	msg, err := b.Finish()
+	assert.NoError(t, err)
+	return msg
+}
+
+func TestMagicDNS(t *testing.T) {
+	upstream, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer upstream.Close()
+	go func() {
+		buf := make([]byte, 512)
+		n, addr, err := upstream.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		_, _ = upstream.WriteTo(append([]byte("upstream:"), buf[:n]...), addr)
+	}()
+
+	d := newMagicDNS(nil, upstream.LocalAddr().String(), logrus.NewEntry(logrus.New()))
+	d.Update(&pb.WireGuardConfig{
+		InterfaceName: "wg0",
+		ClientId:      "self",
+		LocalAddress:  "10.0.0.1/24",
+		NetworkName:   "Home Lab",
+		Peers:         []*pb.WireGuardPeerConfig{{ClientId: "nas", VirtualIp: "10.0.0.2"}},
+		DnsRecords:    []*pb.WireGuardDNSRecord{{Name: "Phone_1", VirtualIp: "10.0.0.100"}},
+	})
+
+	answer := func(name string) (dnsmessage.RCode, []dnsmessage.Resource) {
+		resp, err := d.handle(buildDNSQuery(t, name, dnsmessage.TypeA))
+		assert.NoError(t, err)
+		var msg dnsmessage.Message
+		assert.NoError(t, msg.Unpack(resp))
+		return msg.RCode, msg.Answers
+	}
+
+	rcode, answers := answer("wg0.home-lab.internal.")
+	assert.Equal(t, dnsmessage.RCodeSuccess, rcode)
+	assert.Equal(t, [4]byte{10, 0, 0, 1}, answers[0].Body.(*dnsmessage.AResource).A)
+
+	_, answers = answer("phone-1.home-lab.internal.")
+	assert.Equal(t, [4]byte{10, 0, 0, 100}, answers[0].Body.(*dnsmessage.AResource).A)
+
+	_, answers = answer("NAS.home-lab.internal.")
+	assert.Equal(t, [4]byte{10, 0, 0, 2}, answers[0].Body.(*dnsmessage.AResource).A)
+
+	// 网络名之外的区域不由 MagicDNS 应答
+	_, _, managed := d.lookup("nas.home-lab.")
+	assert.False(t, managed)
+
+	rcode, answers = answer("unknown.home-lab.internal.")
+	assert.Equal(t, dnsmessage.RCodeNameError, rcode)
+	assert.Empty(t, answers)
+
+	resp, err := d.handle(buildDNSQuery(t, "example.com.", dnsmessage.TypeA))
+	assert.NoError(t, err)
+	assert.Equal(t, "upstream:", string(resp[:9]))
+}
+
+func TestMagicDNS_DropWhenBusy(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	d := newMagicDNS(conn, "127.0.0.2:53", logrus.NewEntry(logrus.New()))
+	defer d.Close()
+	d.Update(&pb.WireGuardConfig{InterfaceName: "wg0", LocalAddress: "10.0.0.1/24", NetworkName: "lab"})
+	go d.Serve()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	assert.NoError(t, err)
+	defer client.Close()
+	query := func() bool {
+		_, err := client.Write(buildDNSQuery(t, "wg0.lab.internal.", dnsmessage.TypeA))
+		assert.NoError(t, err)
+		assert.NoError(t, client.SetReadDeadline(time.Now().Add(200*time.Millisecond)))
+		_, err = client.Read(make([]byte, 512))
+		return err == nil
+	}
+
+	// 处理中的查询达到上限时直接丢弃，释放后恢复应答
+	for range cap(d.inflight) {
+		d.inflight <- struct{}{}
+	}
+	assert.False(t, query())
+	<-d.inflight
+	assert.True(t, query())
+}
+
+func TestSystemNameserver_SkipLoopbackAndSelf(t *testing.T) {
+	dir := t.TempDir()
+	stub := filepath.Join(dir, "stub.conf")
+	resolved := filepath.Join(dir, "resolved.conf")
+	assert.NoError(t, os.WriteFile(stub, []byte("nameserver 127.0.0.53\n"), 0o644))
+	assert.NoError(t, os.WriteFile(resolved, []byte("nameserver 10.0.0.1\nnameserver 9.9.9.9\n"), 0o644))
+
+	old := magicDNSResolvConfs
+	defer func() { magicDNSResolvConfs = old }()
+
+	magicDNSResolvConfs = []string{stub}
+	assert.Equal(t, magicDNSDefaultUps, systemNameserver(netip.Addr{}))
+
+	// 10.0.0.1 是 MagicDNS 自身的地址
+	magicDNSResolvConfs = []string{filepath.Join(dir, "missing.conf"), stub, resolved}
+	assert.Equal(t, "9.9.9.9:53", systemNameserver(netip.MustParseAddr("10.0.0.1")))
+}
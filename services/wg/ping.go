@@ -66,6 +66,19 @@ func (w *wireGuard) scheduleEndpointPings(log *logrus.Entry, ifceConfig *defs.Wi
 			continue
 		}
 
+		// NAT 映射地址 ICMP 通常由 NAT 设备应答，无法说明打洞成功，改为通过 WireGuard socket 做 STUN 探测
+		if ep.GetType() == defs.EndpointTypeSTUN {
+			if !w.natTraversalEnabled() {
+				w.storeEndpointPing(peerId, math.MaxUint32)
+				continue
+			}
+			waitGroup.Go(func() {
+				w.storeEndpointPing(peerId, w.probeSTUNEndpoint(ep))
+				log.Debugf("stun probe endpoint [%s:%d] completed, peer_id=%d", ep.GetHost(), ep.GetPort(), peerId)
+			})
+			continue
+		}
+
 		// ws endpoint 不走 ICMP ping，改为 TCP connect 探测，避免误报/不可达。
 		if endpointTypeContainsWS(ep.GetType()) {
 			tcpAddr, err := endpointTCPTarget(ep)
@@ -0,0 +1,198 @@
+package wg
+
+import (
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+const (
+	// defaultRoutePrefix 出口节点通告的默认路由
+	defaultRoutePrefix = "0.0.0.0/0"
+	// minAdvertisedRouteBits 子网路由的最短前缀，更大的网段会接管节点的大部分路由
+	minAdvertisedRouteBits = 8
+)
+
+// AdvertisedPrefixes 规范化节点通告的前缀，出口节点额外通告默认路由，非法或过大的网段直接忽略
+func AdvertisedPrefixes(routes []string, exitNode bool) []string {
+	ret := make([]string, 0, len(routes)+1)
+	for _, r := range routes {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil || prefix.Bits() < minAdvertisedRouteBits {
+			continue
+		}
+		ret = append(ret, prefix.Masked().String())
+	}
+	if exitNode {
+		ret = append(ret, defaultRoutePrefix)
+	}
+	return lo.Uniq(ret)
+}
+
+// ValidateAdvertisedRoutes 通告的网段必须是合法的 IPv4 CIDR，前缀不短于 /8，且不能与网络网段重叠，默认路由需要通过出口节点通告
+func ValidateAdvertisedRoutes(routes []string, networkCIDR string) error {
+	network, err := netip.ParsePrefix(networkCIDR)
+	if err != nil {
+		return fmt.Errorf("invalid network cidr '%s'", networkCIDR)
+	}
+	for _, r := range routes {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil || !prefix.Addr().Is4() {
+			return fmt.Errorf("invalid advertised route '%s'", r)
+		}
+		if prefix.Bits() == 0 {
+			return fmt.Errorf("advertised route '%s' is a default route, use exit node instead", r)
+		}
+		if prefix.Bits() < minAdvertisedRouteBits {
+			return fmt.Errorf("advertised route '%s' is too large, prefix must be at least /%d", r, minAdvertisedRouteBits)
+		}
+		if prefix.Masked().Overlaps(network.Masked()) {
+			return fmt.Errorf("advertised route '%s' overlaps network cidr '%s'", r, networkCIDR)
+		}
+	}
+	return nil
+}
+
+// buildNodeRoutes 返回每个节点通告的前缀
+func buildNodeRoutes(order []uint, idToPeer map[uint]*models.WireGuard) map[uint][]string {
+	out := make(map[uint][]string, len(order))
+	for _, id := range order {
+		p := idToPeer[id]
+		if p == nil || p.WireGuardEntity == nil {
+			continue
+		}
+		if routes := AdvertisedPrefixes(p.AdvertisedRoutes, p.ExitNode); len(routes) > 0 {
+			out[id] = routes
+		}
+	}
+	return out
+}
+
+// addAdvertisedRoutes 把子网路由/出口节点通告的前缀分配到各节点的直连 peer 上：
+//   - 源节点需要开启 AcceptRoutes（默认路由为 UseExitNode）且 ACL 允许才使用该前缀
+//   - 每个节点对每个前缀在允许使用的通告节点中选择最近的（距离相同时选 id 较小的），前缀挂到通往它的下一跳 peer，保证同一前缀只出现在一个 peer 上
+//   - 源节点到通告节点路径上的中间节点同样需要该前缀，用于转发以及回包的入站源地址校验，
+//     中间节点自身没有使用该前缀时，沿用第一个经由它的源节点选择的通告节点
+func addAdvertisedRoutes(
+	order []uint,
+	idToPeer map[uint]*models.WireGuard,
+	distBySrc map[uint]map[uint]float64,
+	prevBySrc map[uint]map[uint]uint,
+	allowed map[uint]map[uint]map[string]struct{},
+	policy RoutingPolicy,
+) {
+	routesByID := buildNodeRoutes(order, idToPeer)
+	if len(routesByID) == 0 {
+		return
+	}
+
+	// nearest[node][prefix] = 距离 node 最近且允许 node 使用的通告节点，自身通告的前缀不需要路由
+	nearest := make(map[uint]map[string]uint, len(order))
+	for _, src := range order {
+		for _, adv := range order {
+			if adv == src {
+				continue
+			}
+			if _, ok := prevBySrc[src][adv]; !ok {
+				continue // unreachable
+			}
+			for _, prefix := range routesByID[adv] {
+				if lo.Contains(routesByID[src], prefix) {
+					continue
+				}
+				if !wantAdvertisedRoute(idToPeer[src], idToPeer[adv], prefix, policy) {
+					continue
+				}
+				if _, ok := nearest[src]; !ok {
+					nearest[src] = make(map[string]uint, 4)
+				}
+				// order 升序遍历，距离相同时保留 id 较小的通告节点
+				if cur, ok := nearest[src][prefix]; ok && distBySrc[src][cur] <= distBySrc[src][adv] {
+					continue
+				}
+				nearest[src][prefix] = adv
+			}
+		}
+	}
+
+	// target[node][prefix] = node 转发该前缀时使用的通告节点，先放入各节点自身的选择
+	target := make(map[uint]map[string]uint, len(nearest))
+	for src, prefixes := range nearest {
+		target[src] = maps.Clone(prefixes)
+	}
+	for _, src := range order {
+		prefixes := lo.Keys(nearest[src])
+		slices.Sort(prefixes)
+		for _, prefix := range prefixes {
+			adv := nearest[src][prefix]
+			// 沿 src 的最短路树从通告节点回溯到 src，路径上的中间节点都需要安装该前缀
+			prev := prevBySrc[src]
+			for n, ok := prev[adv]; ok && n != src; n, ok = prev[n] {
+				if lo.Contains(routesByID[n], prefix) {
+					continue
+				}
+				if _, ok := target[n]; !ok {
+					target[n] = make(map[string]uint, 4)
+				}
+				if _, ok := target[n][prefix]; !ok {
+					target[n][prefix] = adv
+				}
+			}
+		}
+	}
+
+	for id, prefixes := range target {
+		for prefix, adv := range prefixes {
+			next := findNextHop(id, adv, prevBySrc[id])
+			if next == 0 {
+				continue
+			}
+			ensureAllowedSet(allowed, id, next)[prefix] = struct{}{}
+		}
+	}
+}
+
+func wantAdvertisedRoute(src, adv *models.WireGuard, prefix string, policy RoutingPolicy) bool {
+	if src == nil || adv == nil {
+		return false
+	}
+	if prefix == defaultRoutePrefix && !src.UseExitNode {
+		return false
+	}
+	if prefix != defaultRoutePrefix && !src.AcceptRoutes {
+		return false
+	}
+	if policy.ACL == nil {
+		return true
+	}
+	return policy.ACL.CanRoute(src, adv, netip.MustParsePrefix(prefix))
+}
+
+// peerRoutePrefixes 返回 peer AllowedIPs 中需要在内核添加经由接口路由的前缀，
+// 跳过默认路由、不同地址族以及网络网段内的前缀
+func peerRoutePrefixes(local netip.Prefix, peers []*pb.WireGuardPeerConfig) map[string]netip.Prefix {
+	desired := make(map[string]netip.Prefix)
+	for _, p := range peers {
+		for _, s := range p.GetAllowedIps() {
+			prefix, err := netip.ParsePrefix(s)
+			if err != nil {
+				continue
+			}
+			prefix = prefix.Masked()
+			if prefix.Bits() == 0 || prefix.Addr().Is6() != local.Addr().Is6() {
+				continue
+			}
+			if prefix.Bits() >= local.Bits() && local.Contains(prefix.Addr()) {
+				continue
+			}
+			desired[prefix.String()] = prefix
+		}
+	}
+	return desired
+}
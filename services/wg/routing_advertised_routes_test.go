@@ -0,0 +1,151 @@
+package wg
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func TestPlanAllowedIPs_AdvertisedRoutes(t *testing.T) {
+	// 1 - 2 - 3 的线性拓扑，3 通告办公室网段并作为出口节点，只有 1 开启 UseExitNode 与 AcceptRoutes
+	makePeer := func(id uint, addr string, tags []string) *models.WireGuard {
+		priv, _ := wgtypes.GeneratePrivateKey()
+		p := &models.WireGuard{
+			WireGuardEntity: &models.WireGuardEntity{
+				ClientID: addr, PrivateKey: priv.String(), LocalAddress: addr, Tags: tags,
+			},
+			AdvertisedEndpoints: []*models.Endpoint{{EndpointEntity: &models.EndpointEntity{Host: "example.com", Port: 51820}}},
+		}
+		p.ID = id
+		return p
+	}
+	peers := []*models.WireGuard{
+		makePeer(1, "10.0.0.1/24", []string{"laptop"}),
+		makePeer(2, "10.0.0.2/24", []string{"relay"}),
+		makePeer(3, "10.0.0.3/24", []string{"office"}),
+	}
+	peers[0].UseExitNode = true
+	peers[0].AcceptRoutes = true
+	peers[2].AdvertisedRoutes = []string{"192.168.10.1/24"}
+	peers[2].ExitNode = true
+
+	link := func(from, to uint) *models.WireGuardLink {
+		return &models.WireGuardLink{WireGuardLinkEntity: &models.WireGuardLinkEntity{
+			FromWireGuardID: from, ToWireGuardID: to, LatencyMs: 10, UpBandwidthMbps: 50, Active: true,
+		}}
+	}
+	links := []*models.WireGuardLink{link(1, 2), link(2, 1), link(2, 3), link(3, 2)}
+
+	allowedIPs := func(cfgs map[uint][]*pb.WireGuardPeerConfig, owner, peer uint) []string {
+		for _, pc := range cfgs[owner] {
+			if pc.GetId() == uint32(peer) {
+				return pc.GetAllowedIps()
+			}
+		}
+		return nil
+	}
+
+	policy := DefaultRoutingPolicy(nil, &fakeTopologyCache{}, nil)
+	peerCfgs, _, err := PlanAllowedIPs(peers, links, policy)
+	assert.NoError(t, err)
+
+	assert.Contains(t, allowedIPs(peerCfgs, 1, 2), "192.168.10.0/24")
+	assert.Contains(t, allowedIPs(peerCfgs, 1, 2), "0.0.0.0/0")
+	// 2 没有开启 UseExitNode，但作为 1 的中转仍需要默认路由
+	assert.Contains(t, allowedIPs(peerCfgs, 2, 3), "192.168.10.0/24")
+	assert.Contains(t, allowedIPs(peerCfgs, 2, 3), "0.0.0.0/0")
+	assert.NotContains(t, allowedIPs(peerCfgs, 2, 1), "0.0.0.0/0")
+	for _, pc := range peerCfgs[3] {
+		assert.NotContains(t, pc.GetAllowedIps(), "192.168.10.0/24")
+	}
+	// 2 未开启 AcceptRoutes，作为中转仍需要安装子网路由，默认路由不自动接管
+	relayRoutes := peerRoutePrefixes(netip.MustParsePrefix("10.0.0.0/24"), peerCfgs[2])
+	assert.Contains(t, relayRoutes, "192.168.10.0/24")
+	assert.NotContains(t, relayRoutes, "0.0.0.0/0")
+
+	// ACL 只允许 laptop 访问 office 的子网，默认路由需要显式的 CIDR 规则
+	policy = DefaultRoutingPolicy(NewACL().LoadFromPB(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"laptop", "relay", "office"}, Dst: []string{"relay"}},
+		{Action: "accept", Src: []string{"relay"}, Dst: []string{"laptop", "office"}},
+		{Action: "accept", Src: []string{"laptop"}, Dst: []string{"office"}},
+	}}), &fakeTopologyCache{}, nil)
+	peerCfgs, _, err = PlanAllowedIPs(peers, links, policy)
+	assert.NoError(t, err)
+
+	assert.Contains(t, allowedIPs(peerCfgs, 1, 2), "192.168.10.0/24")
+	assert.NotContains(t, allowedIPs(peerCfgs, 1, 2), "0.0.0.0/0")
+	assert.NotContains(t, allowedIPs(peerCfgs, 2, 3), "0.0.0.0/0")
+
+	// 未开启 AcceptRoutes 的节点不使用子网路由
+	peers[0].AcceptRoutes = false
+	peerCfgs, _, err = PlanAllowedIPs(peers, links, DefaultRoutingPolicy(nil, &fakeTopologyCache{}, nil))
+	assert.NoError(t, err)
+	assert.NotContains(t, allowedIPs(peerCfgs, 1, 2), "192.168.10.0/24")
+	assert.NotContains(t, allowedIPs(peerCfgs, 2, 3), "192.168.10.0/24")
+	assert.Contains(t, allowedIPs(peerCfgs, 1, 2), "0.0.0.0/0")
+}
+
+func TestPlanAllowedIPs_AdvertisedRoutesACLBeforeNearest(t *testing.T) {
+	// 1 同时直连 2 与 3，2 更近，2、3 通告同一网段，ACL 只允许 1 经由 3 访问
+	makePeer := func(id uint, addr string, tags []string) *models.WireGuard {
+		priv, _ := wgtypes.GeneratePrivateKey()
+		p := &models.WireGuard{
+			WireGuardEntity: &models.WireGuardEntity{
+				ClientID: addr, PrivateKey: priv.String(), LocalAddress: addr, Tags: tags,
+				AcceptRoutes: true,
+			},
+			AdvertisedEndpoints: []*models.Endpoint{{EndpointEntity: &models.EndpointEntity{Host: "example.com", Port: 51820}}},
+		}
+		p.ID = id
+		return p
+	}
+	peers := []*models.WireGuard{
+		makePeer(1, "10.0.0.1/24", []string{"laptop"}),
+		makePeer(2, "10.0.0.2/24", []string{"near"}),
+		makePeer(3, "10.0.0.3/24", []string{"far"}),
+	}
+	peers[1].AdvertisedRoutes = []string{"192.168.10.0/24"}
+	peers[2].AdvertisedRoutes = []string{"192.168.10.0/24"}
+
+	link := func(from, to uint, latency uint32) *models.WireGuardLink {
+		return &models.WireGuardLink{WireGuardLinkEntity: &models.WireGuardLinkEntity{
+			FromWireGuardID: from, ToWireGuardID: to, LatencyMs: latency, UpBandwidthMbps: 50, Active: true,
+		}}
+	}
+	links := []*models.WireGuardLink{link(1, 2, 10), link(2, 1, 10), link(1, 3, 50), link(3, 1, 50)}
+
+	policy := DefaultRoutingPolicy(NewACL().LoadFromPB(&pb.AclConfig{Acls: []*pb.AclRuleConfig{
+		{Action: "accept", Src: []string{"laptop", "near", "far"}, Dst: []string{"10.0.0.0/24"}},
+		{Action: "accept", Src: []string{"laptop"}, Dst: []string{"far"}},
+	}}), &fakeTopologyCache{}, nil)
+	peerCfgs, _, err := PlanAllowedIPs(peers, links, policy)
+	assert.NoError(t, err)
+
+	var toNear, toFar []string
+	for _, pc := range peerCfgs[1] {
+		switch pc.GetId() {
+		case 2:
+			toNear = pc.GetAllowedIps()
+		case 3:
+			toFar = pc.GetAllowedIps()
+		}
+	}
+	assert.NotContains(t, toNear, "192.168.10.0/24")
+	assert.Contains(t, toFar, "192.168.10.0/24")
+}
+
+func TestValidateAdvertisedRoutes(t *testing.T) {
+	assert.NoError(t, ValidateAdvertisedRoutes([]string{"192.168.10.0/24"}, "10.0.0.0/24"))
+	assert.Error(t, ValidateAdvertisedRoutes([]string{"10.0.0.128/25"}, "10.0.0.0/24"))
+	assert.Error(t, ValidateAdvertisedRoutes([]string{"0.0.0.0/0"}, "10.0.0.0/24"))
+	assert.Error(t, ValidateAdvertisedRoutes([]string{"192.168.10.0"}, "10.0.0.0/24"))
+	assert.Error(t, ValidateAdvertisedRoutes([]string{"0.0.0.0/1"}, "10.0.0.0/24"))
+	assert.Error(t, ValidateAdvertisedRoutes([]string{"64.0.0.0/7"}, "10.0.0.0/24"))
+	assert.NoError(t, ValidateAdvertisedRoutes([]string{"172.0.0.0/8"}, "10.0.0.0/24"))
+	assert.Equal(t, []string{"192.168.10.0/24", "0.0.0.0/0"}, AdvertisedPrefixes([]string{"192.168.10.1/24", "bad", "128.0.0.0/1"}, true))
+}
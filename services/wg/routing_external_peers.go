@@ -0,0 +1,94 @@
+package wg
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/samber/lo"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+// AttachExternalPeers 把外部设备挂到规划结果上：
+// - 中继节点增加外部设备的 peer，AllowedIPs 为外部设备的 /32
+// - 其他节点把外部设备的 /32 加到通往中继节点的下一跳 peer 上，出站选路与入站源地址校验都与中继节点本身一致
+// 中继节点不存在或其他节点没有到中继节点的路由时，对应部分直接跳过
+func AttachExternalPeers(peerCfgs map[uint][]*pb.WireGuardPeerConfig, peers []*models.WireGuard, externals []*models.WireGuardExternalPeer) error {
+	idToPeer := lo.SliceToMap(peers, func(p *models.WireGuard) (uint, *models.WireGuard) { return p.ID, p })
+
+	for _, ext := range externals {
+		if ext == nil || ext.WireGuardExternalPeerEntity == nil {
+			continue
+		}
+		relay, ok := idToPeer[ext.RelayWireGuardID]
+		if !ok {
+			continue
+		}
+		extAddr, _, err := models.ParseIPOrCIDRWithNetip(ext.LocalAddress)
+		if err != nil {
+			return errors.Join(errors.New("parse external peer address error"), err)
+		}
+		relayAddr, _, err := models.ParseIPOrCIDRWithNetip(relay.LocalAddress)
+		if err != nil {
+			return errors.Join(errors.New("parse relay address error"), err)
+		}
+		extPrefix := netip.PrefixFrom(extAddr, 32).String()
+		relayPrefix := netip.PrefixFrom(relayAddr, 32).String()
+
+		peerCfgs[relay.ID] = append(peerCfgs[relay.ID], &pb.WireGuardPeerConfig{
+			UserId:     ext.UserId,
+			TenantId:   ext.TenantId,
+			PublicKey:  ext.PublicKey,
+			AllowedIps: []string{extPrefix},
+			VirtualIp:  extAddr.String(),
+		})
+
+		for id, pcs := range peerCfgs {
+			if id == relay.ID {
+				continue
+			}
+			for _, pc := range pcs {
+				if pc != nil && lo.Contains(pc.GetAllowedIps(), relayPrefix) {
+					pc.AllowedIps = append(pc.AllowedIps, extPrefix)
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ExternalPeerConfig 外部设备的接口配置，只有中继节点一个 peer，
+// 设备经中继节点访问 ext.AllowedIPs，为空时访问整个网络
+func ExternalPeerConfig(ext *models.WireGuardExternalPeer, relay *models.WireGuard, networkCIDR string) (*pb.WireGuardConfig, error) {
+	relayPeer, err := relay.AsBasePeerConfig(firstUDPEndpoint(relay.AdvertisedEndpoints))
+	if err != nil {
+		return nil, err
+	}
+	relayPeer.AllowedIps = ext.AllowedIPs
+	if len(relayPeer.AllowedIps) == 0 {
+		relayPeer.AllowedIps = []string{networkCIDR}
+	}
+	relayPeer.PersistentKeepalive = defs.DefaultPersistentKeepalive
+
+	return &pb.WireGuardConfig{
+		Id:            uint32(ext.ID),
+		UserId:        ext.UserId,
+		TenantId:      ext.TenantId,
+		InterfaceName: ext.Name,
+		PrivateKey:    ext.PrivateKey,
+		LocalAddress:  ext.LocalAddress,
+		DnsServers:    ext.DnsServers,
+		NetworkId:     uint32(ext.NetworkID),
+		Peers:         []*pb.WireGuardPeerConfig{relayPeer},
+	}, nil
+}
+
+func firstUDPEndpoint(eps []*models.Endpoint) *models.Endpoint {
+	ep, _ := lo.Find(eps, func(e *models.Endpoint) bool {
+		return e != nil && e.EndpointEntity != nil && len(WGQuickEndpoint(e.ToPB())) > 0
+	})
+	return ep
+}
@@ -0,0 +1,73 @@
+package wg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func TestAttachExternalPeers(t *testing.T) {
+	peers := []*models.WireGuard{
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.1/24"}},
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.2/24"}},
+		{WireGuardEntity: &models.WireGuardEntity{LocalAddress: "10.0.0.3/24"}},
+	}
+	for i, p := range peers {
+		p.ID = uint(i + 1)
+	}
+
+	// 1 - 2 - 3 的线性拓扑，3 经 2 访问 1
+	peerCfgs := map[uint][]*pb.WireGuardPeerConfig{
+		1: {{Id: 2, AllowedIps: []string{"10.0.0.2/32", "10.0.0.3/32"}}},
+		2: {{Id: 1, AllowedIps: []string{"10.0.0.1/32"}}, {Id: 3, AllowedIps: []string{"10.0.0.3/32"}}},
+		3: {{Id: 2, AllowedIps: []string{"10.0.0.2/32", "10.0.0.1/32"}}},
+	}
+
+	ext := &models.WireGuardExternalPeer{WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+		RelayWireGuardID: 1, PublicKey: "ext-pub", LocalAddress: "10.0.0.100/24",
+	}}
+	assert.NoError(t, AttachExternalPeers(peerCfgs, peers, []*models.WireGuardExternalPeer{ext}))
+
+	assert.Len(t, peerCfgs[1], 2)
+	assert.Equal(t, "ext-pub", peerCfgs[1][1].PublicKey)
+	assert.Equal(t, []string{"10.0.0.100/32"}, peerCfgs[1][1].AllowedIps)
+	assert.Contains(t, peerCfgs[2][0].AllowedIps, "10.0.0.100/32")
+	assert.NotContains(t, peerCfgs[2][1].AllowedIps, "10.0.0.100/32")
+	assert.Contains(t, peerCfgs[3][0].AllowedIps, "10.0.0.100/32")
+}
+
+func TestRenderExternalPeerConfig(t *testing.T) {
+	relayKey, err := wgtypes.GeneratePrivateKey()
+	assert.NoError(t, err)
+	relay := &models.WireGuard{
+		WireGuardEntity: &models.WireGuardEntity{ClientID: "relay", PrivateKey: relayKey.String(), LocalAddress: "10.0.0.1/24"},
+		AdvertisedEndpoints: []*models.Endpoint{
+			{EndpointEntity: &models.EndpointEntity{Host: "relay.example.com", Port: 8443, Type: "ws"}},
+			{EndpointEntity: &models.EndpointEntity{Host: "relay.example.com", Port: 51820}},
+		},
+	}
+	ext := &models.WireGuardExternalPeer{WireGuardExternalPeerEntity: &models.WireGuardExternalPeerEntity{
+		Name: "phone", LocalAddress: "10.0.0.100/24", DnsServers: []string{"1.1.1.1"},
+	}}
+
+	cfg, err := ExternalPeerConfig(ext, relay, "10.0.0.0/24")
+	assert.NoError(t, err)
+	conf := RenderWGQuickConfig(cfg)
+
+	assert.Contains(t, conf, "PrivateKey = "+defs.PlaceholderPrivateKey)
+	assert.Contains(t, conf, "Address = 10.0.0.100/24\n")
+	assert.Contains(t, conf, "DNS = 1.1.1.1\n")
+	assert.Contains(t, conf, "PublicKey = "+relayKey.PublicKey().String()+"\n")
+	assert.Contains(t, conf, "AllowedIPs = 10.0.0.0/24\n")
+	assert.Contains(t, conf, "Endpoint = relay.example.com:51820\n")
+
+	qr := QRContent(conf)
+	assert.False(t, strings.Contains(qr, "#"))
+	assert.False(t, strings.Contains(qr, "\n\n"))
+}
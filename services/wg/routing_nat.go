@@ -0,0 +1,33 @@
+package wg
+
+import (
+	"net/netip"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+// natEndpoint 返回 NAT 后节点上报的映射地址，作为打洞候选边的目标端点。
+// 有 AdvertisedEndpoints 的节点直接走原有逻辑，返回 nil
+func natEndpoint(p *models.WireGuard, policy RoutingPolicy) *models.Endpoint {
+	if p == nil || len(p.AdvertisedEndpoints) > 0 || policy.NetworkTopologyCache == nil {
+		return nil
+	}
+	runtimeInfo, ok := policy.NetworkTopologyCache.GetRuntimeInfo(p.ID)
+	if !ok || runtimeInfo == nil {
+		return nil
+	}
+	ap, err := netip.ParseAddrPort(runtimeInfo.GetReflexiveEndpoint())
+	if err != nil || !ap.IsValid() || ap.Port() == 0 {
+		return nil
+	}
+	return &models.Endpoint{
+		EndpointEntity: &models.EndpointEntity{
+			Host:        ap.Addr().String(),
+			Port:        uint32(ap.Port()),
+			Type:        defs.EndpointTypeSTUN,
+			WireGuardID: p.ID,
+			ClientID:    p.ClientID,
+		},
+	}
+}
@@ -188,6 +188,7 @@ func buildNodeCIDRMap(order []uint, idToPeer map[uint]*models.WireGuard) (map[ui
 // buildAdjacency 构建“候选直连边”：
 // 1) 显式链路（管理员配置）直接加入
 // 2) 若某节点具备 endpoint，则其他节点可按 ACL 推断直连它（用于探测/候选）
+// 3) 两个节点都没有 endpoint 但都上报了 NAT 映射地址，则推断它们可打洞直连，打洞成功（有探测数据）后才参与 SPF
 func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*models.WireGuardLink, policy RoutingPolicy) map[uint][]Edge {
 	adj := make(map[uint][]Edge, len(order))
 
@@ -225,9 +226,16 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 		if !online(from) || !online(to) {
 			continue
 		}
-		// 如果两个 peer 都没有 endpoint，则不建立链路（无法直连）
+		toEndpoint := l.ToEndpoint
+		// 如果两个 peer 都没有 endpoint，则不建立链路（无法直连），除非双方都有 NAT 映射地址可以打洞
 		if len(idToPeer[from].AdvertisedEndpoints) == 0 && len(idToPeer[to].AdvertisedEndpoints) == 0 {
-			continue
+			natTo := natEndpoint(idToPeer[to], policy)
+			if natTo == nil || natEndpoint(idToPeer[from], policy) == nil {
+				continue
+			}
+			if toEndpoint == nil {
+				toEndpoint = natTo
+			}
 		}
 
 		latency := l.LatencyMs
@@ -246,7 +254,7 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 			to:         to,
 			latency:    latency,
 			upMbps:     l.UpBandwidthMbps,
-			toEndpoint: l.ToEndpoint,
+			toEndpoint: toEndpoint,
 			explicit:   true,
 		})
 	}
@@ -261,7 +269,11 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 
 	for _, to := range order {
 		peerTo := idToPeer[to]
-		if peerTo == nil || len(peerTo.AdvertisedEndpoints) == 0 {
+		if peerTo == nil {
+			continue
+		}
+		natTo := natEndpoint(peerTo, policy)
+		if len(peerTo.AdvertisedEndpoints) == 0 && natTo == nil {
 			continue
 		}
 		for _, from := range order {
@@ -275,6 +287,14 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 				continue
 			}
 
+			// NAT 节点之间只能打洞直连，双方都需要映射地址；与有 endpoint 节点之间的边由对方一侧推断
+			var natFrom *models.Endpoint
+			if natTo != nil {
+				if natFrom = natEndpoint(idToPeer[from], policy); natFrom == nil {
+					continue
+				}
+			}
+
 			latency := policy.DefaultEndpointLatencyMs
 			if policy.NetworkTopologyCache != nil {
 				if latencyMs, ok := policy.NetworkTopologyCache.GetLatencyMs(from, to); ok {
@@ -290,10 +310,11 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 				key := [2]uint{from, to}
 				if _, exists := edgeSet[key]; !exists {
 					adj[from] = append(adj[from], Edge{
-						to:       to,
-						latency:  latency,
-						upMbps:   policy.DefaultEndpointUpMbps,
-						explicit: false,
+						to:         to,
+						latency:    latency,
+						upMbps:     policy.DefaultEndpointUpMbps,
+						toEndpoint: natTo,
+						explicit:   false,
 					})
 					edgeSet[key] = struct{}{}
 				}
@@ -304,10 +325,11 @@ func buildAdjacency(order []uint, idToPeer map[uint]*models.WireGuard, links []*
 				key := [2]uint{to, from}
 				if _, exists := edgeSet[key]; !exists {
 					adj[to] = append(adj[to], Edge{
-						to:       from,
-						latency:  latency,
-						upMbps:   policy.DefaultEndpointUpMbps,
-						explicit: false,
+						to:         from,
+						latency:    latency,
+						upMbps:     policy.DefaultEndpointUpMbps,
+						toEndpoint: natFrom,
+						explicit:   false,
 					})
 					edgeSet[key] = struct{}{}
 				}
@@ -477,6 +499,9 @@ func computeAllowedIPs(
 
 	// Out/ In 聚合：owner -> peer -> set[cidr]
 	allowed := make(map[uint]map[uint]map[string]struct{}, len(order))
+	// 每个 src 的最短路结果，供子网路由/出口节点选路使用
+	distBySrc := make(map[uint]map[uint]float64, len(order))
+	prevBySrc := make(map[uint]map[uint]uint, len(order))
 
 	for _, src := range order {
 		dist := make(map[uint]float64, len(order))
@@ -514,6 +539,9 @@ func computeAllowedIPs(
 			}
 		}
 
+		distBySrc[src] = dist
+		prevBySrc[src] = prev
+
 		// 1) 出站目的集合：dstCIDR -> nextHop(src,dst)
 		for _, dst := range order {
 			if dst == src {
@@ -549,6 +577,9 @@ func computeAllowedIPs(
 		}
 	}
 
+	// 3) 子网路由与出口节点通告的前缀
+	addAdvertisedRoutes(order, idToPeer, distBySrc, prevBySrc, allowed, policy)
+
 	// 构建 PeerConfigs，并做强校验（同一节点不允许 CIDR 分配到多个 peer）
 	result := make(map[uint][]*pb.WireGuardPeerConfig, len(order))
 	finalEdges := make(map[uint][]Edge, len(order))
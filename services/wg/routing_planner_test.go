@@ -6,6 +6,7 @@ import (
 
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/samber/lo"
@@ -23,8 +24,9 @@ func (c *fakeTopologyCache) GetRuntimeInfo(id uint) (*pb.WGDeviceRuntimeInfo, bo
 	v, ok := c.rt[id]
 	return v, ok
 }
-func (c *fakeTopologyCache) SetRuntimeInfo(_ uint, _ *pb.WGDeviceRuntimeInfo) {}
-func (c *fakeTopologyCache) DeleteRuntimeInfo(_ uint)                         {}
+func (c *fakeTopologyCache) SetRuntimeInfo(_ uint, _ *pb.WGDeviceRuntimeInfo)  {}
+func (c *fakeTopologyCache) DeleteRuntimeInfo(_ uint)                          {}
+func (c *fakeTopologyCache) ListRuntimeInfo() map[uint]*pb.WGDeviceRuntimeInfo { return c.rt }
 func (c *fakeTopologyCache) GetLatencyMs(fromWGID, toWGID uint) (uint32, bool) {
 	if c == nil || c.lat == nil {
 		return 0, false
@@ -395,3 +397,46 @@ func TestBuildAdjacency_InferredEdgesAreBidirectionalWhenACLAllows(t *testing.T)
 		t.Fatalf("want inferred edges 1->2 and 2->1, got has12=%v has21=%v adj=%#v", has12, has21, adj)
 	}
 }
+
+func TestBuildAdjacency_NATPeersUseReflexiveEndpoint(t *testing.T) {
+	makePeer := func(id uint, addr string) *models.WireGuard {
+		priv, _ := wgtypes.GeneratePrivateKey()
+		p := &models.WireGuard{WireGuardEntity: &models.WireGuardEntity{
+			ClientID: addr, PrivateKey: priv.String(), LocalAddress: addr,
+		}}
+		p.ID = id
+		return p
+	}
+	a, b := makePeer(1, "10.0.0.1/24"), makePeer(2, "10.0.0.2/24")
+	idToPeer, order := buildNodeIndexSorted([]*models.WireGuard{a, b})
+
+	// 没有映射地址：两个 NAT 节点之间不建边
+	cache := &fakeTopologyCache{rt: map[uint]*pb.WGDeviceRuntimeInfo{}}
+	adj := buildAdjacency(order, idToPeer, nil, DefaultRoutingPolicy(nil, cache, nil))
+	if len(adj[1]) != 0 || len(adj[2]) != 0 {
+		t.Fatalf("want no edges without reflexive endpoints, got %#v", adj)
+	}
+
+	cache.rt[1] = &pb.WGDeviceRuntimeInfo{ReflexiveEndpoint: "198.51.100.1:40001"}
+	cache.rt[2] = &pb.WGDeviceRuntimeInfo{ReflexiveEndpoint: "203.0.113.2:40002"}
+	policy := DefaultRoutingPolicy(nil, cache, nil)
+	adj = buildAdjacency(order, idToPeer, nil, policy)
+	if len(adj[1]) != 1 || len(adj[2]) != 1 {
+		t.Fatalf("want punch candidate edges 1<->2, got %#v", adj)
+	}
+	if ep := adj[1][0].toEndpoint; ep == nil || ep.Host != "203.0.113.2" || ep.Port != 40002 || ep.Type != defs.EndpointTypeSTUN {
+		t.Fatalf("want 1->2 via reflexive endpoint of 2, got %#v", ep)
+	}
+	if ep := adj[2][0].toEndpoint; ep == nil || ep.Host != "198.51.100.1" || ep.Port != 40001 {
+		t.Fatalf("want 2->1 via reflexive endpoint of 1, got %#v", ep)
+	}
+
+	// 打洞成功前没有探测数据，不参与 SPF
+	if spf := filterAdjacencyForSPF(order, adj, policy); len(spf[1]) != 0 {
+		t.Fatalf("want candidate edges filtered before punching, got %#v", spf)
+	}
+	cache.lat = map[[2]uint]uint32{{1, 2}: 15, {2, 1}: 15}
+	if spf := filterAdjacencyForSPF(order, adj, policy); len(spf[1]) != 1 || spf[1][0].latency != 15 {
+		t.Fatalf("want punched edge in spf, got %#v", spf)
+	}
+}
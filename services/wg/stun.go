@@ -0,0 +1,147 @@
+package wg
+
+import (
+	"encoding/binary"
+	"net/netip"
+)
+
+// 只实现打洞需要的 STUN binding（RFC 5389）子集
+const (
+	stunHeaderLen   = 20
+	stunMagicCookie = 0x2112A442
+
+	stunBindingRequest = 0x0001
+	stunBindingSuccess = 0x0101
+
+	stunAttrMappedAddress    = 0x0001
+	stunAttrXORMappedAddress = 0x0020
+
+	stunFamilyIPv4 = 0x01
+	stunFamilyIPv6 = 0x02
+)
+
+type stunTxID [12]byte
+
+type stunMessage struct {
+	typ    uint16
+	txID   stunTxID
+	mapped netip.AddrPort
+}
+
+// isSTUNPacket 依据 magic cookie 与长度字段区分 STUN 与 WireGuard 报文：
+// WireGuard 报文前四个字节为小端的类型 1-4，长度字段位置恒为 0，而最短的 WireGuard 报文也有 32 字节
+func isSTUNPacket(b []byte) bool {
+	if len(b) < stunHeaderLen || b[0]&0xc0 != 0 {
+		return false
+	}
+	if binary.BigEndian.Uint32(b[4:8]) != stunMagicCookie {
+		return false
+	}
+	l := int(binary.BigEndian.Uint16(b[2:4]))
+	return l%4 == 0 && l+stunHeaderLen == len(b)
+}
+
+func newSTUNBindingRequest(txID stunTxID) []byte {
+	b := make([]byte, stunHeaderLen)
+	binary.BigEndian.PutUint16(b[0:2], stunBindingRequest)
+	binary.BigEndian.PutUint32(b[4:8], stunMagicCookie)
+	copy(b[8:20], txID[:])
+	return b
+}
+
+// newSTUNBindingSuccess 应答 binding request，XOR-MAPPED-ADDRESS 为请求方的源地址
+func newSTUNBindingSuccess(txID stunTxID, mapped netip.AddrPort) []byte {
+	addr := mapped.Addr().Unmap()
+	family, ip := byte(stunFamilyIPv4), addr.AsSlice()
+	if addr.Is6() {
+		family = stunFamilyIPv6
+	}
+
+	b := make([]byte, stunHeaderLen+4+4+len(ip))
+	binary.BigEndian.PutUint16(b[0:2], stunBindingSuccess)
+	binary.BigEndian.PutUint16(b[2:4], uint16(4+4+len(ip)))
+	binary.BigEndian.PutUint32(b[4:8], stunMagicCookie)
+	copy(b[8:20], txID[:])
+
+	attr := b[stunHeaderLen:]
+	binary.BigEndian.PutUint16(attr[0:2], stunAttrXORMappedAddress)
+	binary.BigEndian.PutUint16(attr[2:4], uint16(4+len(ip)))
+	attr[5] = family
+	binary.BigEndian.PutUint16(attr[6:8], mapped.Port()^uint16(stunMagicCookie>>16))
+	key := b[4:20] // magic cookie + transaction id
+	for i := range ip {
+		attr[8+i] = ip[i] ^ key[i]
+	}
+	return b
+}
+
+// parseSTUNMessage 解析 binding 报文，优先使用 XOR-MAPPED-ADDRESS，兼容只返回 MAPPED-ADDRESS 的旧服务器
+func parseSTUNMessage(b []byte) (stunMessage, bool) {
+	var msg stunMessage
+	if !isSTUNPacket(b) {
+		return msg, false
+	}
+	msg.typ = binary.BigEndian.Uint16(b[0:2])
+	copy(msg.txID[:], b[8:20])
+
+	var plain netip.AddrPort
+	attrs := b[stunHeaderLen:]
+	for len(attrs) >= 4 {
+		typ := binary.BigEndian.Uint16(attrs[0:2])
+		l := int(binary.BigEndian.Uint16(attrs[2:4]))
+		if len(attrs) < 4+l {
+			return msg, false
+		}
+		val := attrs[4 : 4+l]
+		switch typ {
+		case stunAttrXORMappedAddress:
+			if ap, ok := parseSTUNAddress(val, b[4:20]); ok {
+				msg.mapped = ap
+			}
+		case stunAttrMappedAddress:
+			if ap, ok := parseSTUNAddress(val, nil); ok {
+				plain = ap
+			}
+		}
+		// 属性按 4 字节对齐
+		attrs = attrs[4+(l+3)&^3:]
+	}
+	if !msg.mapped.IsValid() {
+		msg.mapped = plain
+	}
+	return msg, true
+}
+
+// parseSTUNAddress key 不为空时按 XOR-MAPPED-ADDRESS 解码
+func parseSTUNAddress(val, key []byte) (netip.AddrPort, bool) {
+	if len(val) < 4 {
+		return netip.AddrPort{}, false
+	}
+	port := binary.BigEndian.Uint16(val[2:4])
+	var ip []byte
+	switch val[1] {
+	case stunFamilyIPv4:
+		ip = append(ip, val[4:min(len(val), 8)]...)
+		if len(ip) != 4 {
+			return netip.AddrPort{}, false
+		}
+	case stunFamilyIPv6:
+		ip = append(ip, val[4:min(len(val), 20)]...)
+		if len(ip) != 16 {
+			return netip.AddrPort{}, false
+		}
+	default:
+		return netip.AddrPort{}, false
+	}
+	if key != nil {
+		port ^= uint16(stunMagicCookie >> 16)
+		for i := range ip {
+			ip[i] ^= key[i]
+		}
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return netip.AddrPort{}, false
+	}
+	return netip.AddrPortFrom(addr, port), true
+}
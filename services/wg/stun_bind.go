@@ -0,0 +1,195 @@
+package wg
+
+import (
+	"crypto/rand"
+	"errors"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.zx2c4.com/wireguard/conn"
+)
+
+const (
+	// stunTargetTTL 向其发送过 binding request 的地址在此期间内可以得到应答，打洞双方因此互相应答
+	stunTargetTTL = time.Minute
+	stunTargetMax = 1024
+	// stunPeersRefresh 收到未知地址的请求时，最多每隔这么久重新读取一次 peer 地址
+	stunPeersRefresh = 5 * time.Second
+)
+
+var errSTUNTimeout = errors.New("stun binding timeout")
+
+// stunBind 包裹 WireGuard 的 udp bind，在同一个 socket 上收发 STUN binding 报文：
+// - 应答已知 peer 的 binding request，有公网 udp endpoint 的节点因此可以充当网络内的 STUN 服务器
+// - 发起 binding request，用于探测本节点的 NAT 映射地址，以及向对端的映射地址打洞
+// 只应答 WireGuard peer 当前的地址与最近发送过请求的地址，不做公网的反射器。
+// 收到的 STUN 报文长度置 0，WireGuard 会直接跳过
+type stunBind struct {
+	conn.Bind
+
+	mu      sync.Mutex
+	pending map[stunTxID]chan netip.AddrPort
+	targets map[netip.AddrPort]time.Time
+
+	peerEndpoints func() []netip.AddrPort
+	peers         map[netip.AddrPort]struct{}
+	peersAt       time.Time
+
+	reflexive atomic.Pointer[netip.AddrPort]
+}
+
+// newSTUNBind peerEndpoints 返回 WireGuard peer 当前的 udp 地址，可以为 nil
+func newSTUNBind(inner conn.Bind, peerEndpoints func() []netip.AddrPort) *stunBind {
+	return &stunBind{
+		Bind:          inner,
+		pending:       make(map[stunTxID]chan netip.AddrPort),
+		targets:       make(map[netip.AddrPort]time.Time),
+		peerEndpoints: peerEndpoints,
+	}
+}
+
+// Open implements conn.Bind.
+func (b *stunBind) Open(port uint16) ([]conn.ReceiveFunc, uint16, error) {
+	fns, actualPort, err := b.Bind.Open(port)
+	if err != nil {
+		return nil, 0, err
+	}
+	wrapped := make([]conn.ReceiveFunc, 0, len(fns))
+	for _, fn := range fns {
+		wrapped = append(wrapped, b.wrapReceive(fn))
+	}
+	return wrapped, actualPort, nil
+}
+
+func (b *stunBind) wrapReceive(fn conn.ReceiveFunc) conn.ReceiveFunc {
+	return func(packets [][]byte, sizes []int, eps []conn.Endpoint) (int, error) {
+		n, err := fn(packets, sizes, eps)
+		for i := 0; i < n; i++ {
+			pkt := packets[i][:sizes[i]]
+			if !isSTUNPacket(pkt) {
+				continue
+			}
+			b.handle(pkt, eps[i])
+			sizes[i] = 0
+		}
+		return n, err
+	}
+}
+
+func (b *stunBind) handle(pkt []byte, ep conn.Endpoint) {
+	msg, ok := parseSTUNMessage(pkt)
+	if !ok || ep == nil {
+		return
+	}
+	switch msg.typ {
+	case stunBindingRequest:
+		from, err := netip.ParseAddrPort(ep.DstToString())
+		if err != nil || !b.known(from, time.Now()) {
+			return
+		}
+		_ = b.Bind.Send([][]byte{newSTUNBindingSuccess(msg.txID, from)}, ep)
+	case stunBindingSuccess:
+		b.mu.Lock()
+		ch, ok := b.pending[msg.txID]
+		delete(b.pending, msg.txID)
+		b.mu.Unlock()
+		if ok {
+			ch <- msg.mapped
+		}
+	}
+}
+
+// known 判断是否应答来自 from 的 binding request
+func (b *stunBind) known(from netip.AddrPort, now time.Time) bool {
+	from = unmapAddrPort(from)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if t, ok := b.targets[from]; ok && now.Sub(t) < stunTargetTTL {
+		return true
+	}
+	if _, ok := b.peers[from]; ok {
+		return true
+	}
+	if b.peerEndpoints == nil || now.Sub(b.peersAt) < stunPeersRefresh {
+		return false
+	}
+	b.peersAt = now
+	b.peers = make(map[netip.AddrPort]struct{})
+	for _, ap := range b.peerEndpoints() {
+		b.peers[unmapAddrPort(ap)] = struct{}{}
+	}
+	_, ok := b.peers[from]
+	return ok
+}
+
+// trackTargetLocked 记录发送过请求的地址，满了先清理过期的，仍满时不再记录
+func (b *stunBind) trackTargetLocked(target netip.AddrPort, now time.Time) {
+	target = unmapAddrPort(target)
+	if _, ok := b.targets[target]; !ok && len(b.targets) >= stunTargetMax {
+		for k, t := range b.targets {
+			if now.Sub(t) >= stunTargetTTL {
+				delete(b.targets, k)
+			}
+		}
+		if len(b.targets) >= stunTargetMax {
+			return
+		}
+	}
+	b.targets[target] = now
+}
+
+func unmapAddrPort(ap netip.AddrPort) netip.AddrPort {
+	return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port())
+}
+
+// Roundtrip 向 target 发送 binding request，返回对端看到的本机地址与往返时延
+func (b *stunBind) Roundtrip(target netip.AddrPort, timeout time.Duration) (netip.AddrPort, time.Duration, error) {
+	ep, err := b.Bind.ParseEndpoint(target.String())
+	if err != nil {
+		return netip.AddrPort{}, 0, err
+	}
+
+	var txID stunTxID
+	if _, err := rand.Read(txID[:]); err != nil {
+		return netip.AddrPort{}, 0, err
+	}
+	ch := make(chan netip.AddrPort, 1)
+	b.mu.Lock()
+	b.pending[txID] = ch
+	b.trackTargetLocked(target, time.Now())
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		delete(b.pending, txID)
+		b.mu.Unlock()
+	}()
+
+	start := time.Now()
+	if err := b.Bind.Send([][]byte{newSTUNBindingRequest(txID)}, ep); err != nil {
+		return netip.AddrPort{}, 0, err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case mapped := <-ch:
+		return mapped, time.Since(start), nil
+	case <-timer.C:
+		return netip.AddrPort{}, 0, errSTUNTimeout
+	}
+}
+
+// Reflexive 最近一次探测到的 NAT 映射地址
+func (b *stunBind) Reflexive() netip.AddrPort {
+	if ap := b.reflexive.Load(); ap != nil {
+		return *ap
+	}
+	return netip.AddrPort{}
+}
+
+func (b *stunBind) setReflexive(ap netip.AddrPort) {
+	b.reflexive.Store(&ap)
+}
@@ -0,0 +1,110 @@
+package wg
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/conn"
+)
+
+func TestSTUNMessage_Roundtrip(t *testing.T) {
+	txID := stunTxID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+
+	req := newSTUNBindingRequest(txID)
+	msg, ok := parseSTUNMessage(req)
+	require.True(t, ok)
+	assert.Equal(t, uint16(stunBindingRequest), msg.typ)
+	assert.Equal(t, txID, msg.txID)
+
+	for _, s := range []string{"203.0.113.7:40000", "[2001:db8::1]:51820"} {
+		mapped := netip.MustParseAddrPort(s)
+		msg, ok := parseSTUNMessage(newSTUNBindingSuccess(txID, mapped))
+		require.True(t, ok)
+		assert.Equal(t, uint16(stunBindingSuccess), msg.typ)
+		assert.Equal(t, mapped, msg.mapped)
+	}
+}
+
+func TestIsSTUNPacket_RejectWireGuard(t *testing.T) {
+	// WireGuard handshake initiation：类型 1，小端，后跟 3 字节保留位
+	pkt := make([]byte, 148)
+	pkt[0] = 1
+	assert.False(t, isSTUNPacket(pkt))
+
+	// transport data 最短 32 字节
+	pkt = make([]byte, 32)
+	pkt[0] = 4
+	assert.False(t, isSTUNPacket(pkt))
+
+	assert.True(t, isSTUNPacket(newSTUNBindingRequest(stunTxID{})))
+}
+
+func TestSTUNBind_Roundtrip(t *testing.T) {
+	open := func() (*stunBind, uint16) {
+		b := newSTUNBind(conn.NewStdNetBind(), nil)
+		fns, port, err := b.Open(0)
+		require.NoError(t, err)
+		for _, fn := range fns {
+			go func() {
+				bufs := make([][]byte, b.BatchSize())
+				for i := range bufs {
+					bufs[i] = make([]byte, 1500)
+				}
+				sizes := make([]int, len(bufs))
+				eps := make([]conn.Endpoint, len(bufs))
+				for {
+					if _, err := fn(bufs, sizes, eps); err != nil {
+						return
+					}
+				}
+			}()
+		}
+		t.Cleanup(func() { _ = b.Close() })
+		return b, port
+	}
+
+	a, portA := open()
+	b, portB := open()
+	addrA := netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), portA)
+	addrB := netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), portB)
+
+	// b 不认识 a，不应答
+	_, _, err := a.Roundtrip(addrB, 100*time.Millisecond)
+	assert.ErrorIs(t, err, errSTUNTimeout)
+
+	// 打洞双方都向对方发过请求后互相应答
+	_, _, err = b.Roundtrip(addrA, time.Second)
+	require.NoError(t, err)
+	mapped, rtt, err := a.Roundtrip(addrB, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, addrA, mapped)
+	assert.Greater(t, rtt, time.Duration(0))
+
+	_, _, err = b.Roundtrip(netip.AddrPortFrom(netip.MustParseAddr("127.0.0.1"), 1), 100*time.Millisecond)
+	assert.Error(t, err)
+}
+
+func TestSTUNBind_KnownPeers(t *testing.T) {
+	peer := netip.MustParseAddrPort("203.0.113.7:51820")
+	calls := 0
+	b := newSTUNBind(conn.NewStdNetBind(), func() []netip.AddrPort {
+		calls++
+		return []netip.AddrPort{peer}
+	})
+
+	now := time.Now()
+	assert.True(t, b.known(netip.AddrPortFrom(netip.AddrFrom16(peer.Addr().As16()), peer.Port()), now))
+	// 未知地址在刷新间隔内不会反复读取 peer 地址
+	assert.False(t, b.known(netip.MustParseAddrPort("198.51.100.1:40000"), now))
+	assert.False(t, b.known(netip.MustParseAddrPort("198.51.100.2:40000"), now.Add(time.Second)))
+	assert.Equal(t, 1, calls)
+
+	b.mu.Lock()
+	b.trackTargetLocked(netip.MustParseAddrPort("198.51.100.1:40000"), now)
+	b.mu.Unlock()
+	assert.True(t, b.known(netip.MustParseAddrPort("198.51.100.1:40000"), now.Add(time.Second)))
+	assert.False(t, b.known(netip.MustParseAddrPort("198.51.100.1:40000"), now.Add(stunTargetTTL+stunPeersRefresh)))
+}
@@ -29,6 +29,10 @@ func (c *networkTopologyCache) GetRuntimeInfo(wireguardId uint) (*pb.WGDeviceRun
 	return c.wireguardRuntimeInfoMap.Load(wireguardId)
 }
 
+func (c *networkTopologyCache) ListRuntimeInfo() map[uint]*pb.WGDeviceRuntimeInfo {
+	return c.wireguardRuntimeInfoMap.ToMap()
+}
+
 func (c *networkTopologyCache) SetRuntimeInfo(wireguardId uint, runtimeInfo *pb.WGDeviceRuntimeInfo) {
 	c.wireguardRuntimeInfoMap.Store(wireguardId, runtimeInfo)
 
@@ -0,0 +1,88 @@
+package wg
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+// RenderWGQuickConfig 渲染 wg-quick 格式的配置文件。
+// 私钥或地址为空时写入占位符，由使用者自行替换；ws 等非 udp 的 endpoint 无法在 wg-quick 中表示，对应 peer 不写 Endpoint
+func RenderWGQuickConfig(cfg *pb.WireGuardConfig) string {
+	b := &strings.Builder{}
+
+	privateKey := cfg.GetPrivateKey()
+	if len(privateKey) == 0 {
+		privateKey = defs.PlaceholderPrivateKey
+	}
+	address := cfg.GetLocalAddress()
+	if len(address) == 0 {
+		address = defs.PlaceholderPeerVPNAddressCIDR
+	}
+
+	b.WriteString("[Interface]\n")
+	if len(cfg.GetInterfaceName()) > 0 {
+		fmt.Fprintf(b, "# Name = %s\n", cfg.GetInterfaceName())
+	}
+	fmt.Fprintf(b, "PrivateKey = %s\n", privateKey)
+	fmt.Fprintf(b, "Address = %s\n", address)
+	if cfg.GetListenPort() > 0 {
+		fmt.Fprintf(b, "ListenPort = %d\n", cfg.GetListenPort())
+	}
+	if cfg.GetInterfaceMtu() > 0 {
+		fmt.Fprintf(b, "MTU = %d\n", cfg.GetInterfaceMtu())
+	}
+	if len(cfg.GetDnsServers()) > 0 {
+		fmt.Fprintf(b, "DNS = %s\n", strings.Join(cfg.GetDnsServers(), ", "))
+	}
+
+	for _, peer := range cfg.GetPeers() {
+		if peer == nil || len(peer.GetPublicKey()) == 0 {
+			continue
+		}
+		b.WriteString("\n[Peer]\n")
+		if len(peer.GetClientId()) > 0 {
+			fmt.Fprintf(b, "# Name = %s\n", peer.GetClientId())
+		}
+		fmt.Fprintf(b, "PublicKey = %s\n", peer.GetPublicKey())
+		if len(peer.GetPresharedKey()) > 0 {
+			fmt.Fprintf(b, "PresharedKey = %s\n", peer.GetPresharedKey())
+		}
+		if len(peer.GetAllowedIps()) > 0 {
+			fmt.Fprintf(b, "AllowedIPs = %s\n", strings.Join(peer.GetAllowedIps(), ", "))
+		}
+		if ep := WGQuickEndpoint(peer.GetEndpoint()); len(ep) > 0 {
+			fmt.Fprintf(b, "Endpoint = %s\n", ep)
+		}
+		if peer.GetPersistentKeepalive() > 0 {
+			fmt.Fprintf(b, "PersistentKeepalive = %d\n", peer.GetPersistentKeepalive())
+		}
+	}
+	return b.String()
+}
+
+// WGQuickEndpoint 返回 wg-quick 可用的 host:port，非 udp 的 endpoint 返回空
+func WGQuickEndpoint(ep *pb.Endpoint) string {
+	if ep == nil || len(ep.GetHost()) == 0 || ep.GetPort() == 0 ||
+		strings.Contains(strings.ToLower(ep.GetType()), "ws") {
+		return ""
+	}
+	return net.JoinHostPort(ep.GetHost(), strconv.Itoa(int(ep.GetPort())))
+}
+
+// QRContent 去掉注释与空行，缩短二维码内容
+func QRContent(conf string) string {
+	lines := make([]string, 0, 16)
+	for _, line := range strings.Split(conf, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
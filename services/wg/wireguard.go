@@ -98,6 +98,20 @@ func (w *wireGuard) Start() error {
 		return errors.Join(errors.New("apply firewall rules failed"), err)
 	}
 
+	if err := w.applySubnetRouterLocked(); err != nil {
+		log.WithError(err).Warn("apply subnet router rules failed")
+	}
+
+	if err := w.syncPeerRoutesLocked(); err != nil {
+		log.WithError(err).Warn("sync peer routes failed")
+	}
+
+	if err := w.applyACLLocked(); err != nil {
+		return errors.Join(errors.New("apply acl failed"), err)
+	}
+
+	w.startMagicDNSLocked()
+
 	log.Infof("Started service done for iface '%s'", w.ifce.GetInterfaceName())
 	w.running = true
 
@@ -123,6 +137,9 @@ func (w *wireGuard) Stop() error {
 		log.WithError(err).Warn("cleanup firewall rules failed")
 	}
 
+	w.stopMagicDNSLocked()
+	// 接口删除后其上的路由随之清除
+	w.peerRoutes = nil
 	w.cleanupWGDevice()
 	w.cleanupNetwork()
 	w.cancel()
@@ -162,7 +179,13 @@ func (w *wireGuard) AddPeer(peer *defs.WireGuardPeerConfig) error {
 
 // GenWGConfig implements WireGuard.
 func (w *wireGuard) GenWGConfig() (string, error) {
-	panic("unimplemented")
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.ifce == nil || w.ifce.WireGuardConfig == nil {
+		return "", errors.New("wireguard config is nil")
+	}
+	return RenderWGQuickConfig(w.ifce.WireGuardConfig), nil
 }
 
 // GetIfceConfig implements WireGuard.
@@ -398,6 +421,10 @@ func (w *wireGuard) PatchPeers(newPeers []*defs.WireGuardPeerConfig) (*app.WireG
 	}
 	w.ifce.Peers = newPBPeers
 
+	if err := w.syncPeerRoutesLocked(); err != nil {
+		log.WithError(err).Warn("sync peer routes failed")
+	}
+
 	// 清理 preconnectPeers 中已不存在的 peer id，避免无限增长
 	w.cleanupPreconnectPeersLocked()
 
@@ -420,6 +447,11 @@ func (w *wireGuard) GetWGRuntimeInfo() (*pb.WGDeviceRuntimeInfo, error) {
 
 	runtimeInfo.PingMap = w.endpointPingMap.Export()
 	runtimeInfo.VirtAddrPingMap = w.virtAddrPingMap.Export()
+	if w.natBind != nil {
+		if ap := w.natBind.Reflexive(); ap.IsValid() {
+			runtimeInfo.ReflexiveEndpoint = ap.String()
+		}
+	}
 
 	if w.useGvisorNet {
 		runtimeInfo.InterfaceName = w.ifce.GetInterfaceName()
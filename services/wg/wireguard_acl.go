@@ -0,0 +1,96 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+
+	"golang.zx2c4.com/wireguard/tun"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+// aclTun 在 tun 设备读写时执行 packetFilter：Write 为从隧道进入协议栈的包，Read 为发往隧道的包
+type aclTun struct {
+	tun.Device
+	filter atomic.Pointer[packetFilter]
+}
+
+func newACLTun(dev tun.Device) *aclTun {
+	return &aclTun{Device: dev}
+}
+
+func (t *aclTun) Read(bufs [][]byte, sizes []int, offset int) (int, error) {
+	n, err := t.Device.Read(bufs, sizes, offset)
+	if f := t.filter.Load(); f != nil {
+		for i := 0; i < n; i++ {
+			f.TrackOutbound(bufs[i][offset : offset+sizes[i]])
+		}
+	}
+	return n, err
+}
+
+func (t *aclTun) Write(bufs [][]byte, offset int) (int, error) {
+	f := t.filter.Load()
+	if f == nil {
+		return t.Device.Write(bufs, offset)
+	}
+	allowed := make([][]byte, 0, len(bufs))
+	for _, buf := range bufs {
+		if f.AllowInbound(buf[offset:]) {
+			allowed = append(allowed, buf)
+		}
+	}
+	if len(allowed) == 0 {
+		return len(bufs), nil
+	}
+	if _, err := t.Device.Write(allowed, offset); err != nil {
+		return 0, err
+	}
+	return len(bufs), nil
+}
+
+// UpdateACL implements WireGuard.
+func (w *wireGuard) UpdateACL(acl *pb.AclConfig) error {
+	w.Lock()
+	defer w.Unlock()
+
+	w.ifce.Acl = acl
+	if !w.running {
+		return nil
+	}
+	return w.applyACLLocked()
+}
+
+func (w *wireGuard) applyACLLocked() error {
+	acl := w.ifce.GetAcl()
+
+	if w.useGvisorNet {
+		if w.aclTun == nil {
+			return nil
+		}
+		if len(acl.GetAcls()) == 0 {
+			w.aclTun.filter.Store(nil)
+			return nil
+		}
+		f, err := newPacketFilter(acl)
+		if err != nil {
+			return errors.Join(errors.New("build packet filter failed"), err)
+		}
+		w.aclTun.filter.Store(f)
+		return nil
+	}
+
+	if w.fwManager == nil {
+		return nil
+	}
+	prefix, err := netip.ParsePrefix(w.ifce.GetLocalAddress())
+	if err != nil {
+		return errors.Join(fmt.Errorf("parse local address '%s' for acl", w.ifce.GetLocalAddress()), err)
+	}
+	return w.fwManager.ApplyACLRules(w.ifce.GetInterfaceName(), prefix.Addr().Is6(), acl)
+}
@@ -44,6 +44,9 @@ func (w *wireGuard) initWGDevice() error {
 		if err != nil {
 			return errors.Join(fmt.Errorf("create netstack TUN device '%s' (MTU %d) failed", w.ifce.GetInterfaceName(), w.ifce.GetInterfaceMtu()), err)
 		}
+		// netstack 没有 iptables，ACL 在 tun 读写时过滤
+		w.aclTun = newACLTun(w.tunDevice)
+		w.tunDevice = w.aclTun
 	} else {
 		w.tunDevice, err = tun.CreateTUN(w.ifce.GetInterfaceName(), int(w.ifce.GetInterfaceMtu()))
 		if err != nil {
@@ -104,5 +107,6 @@ func (w *wireGuard) cleanupWGDevice() {
 	}
 	w.wgDevice = nil
 	w.tunDevice = nil
+	w.aclTun = nil
 	log.Debug("Cleanup WG device complete.")
 }
@@ -0,0 +1,110 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"net"
+	"net/netip"
+	"os/exec"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+// startMagicDNSLocked 在接口地址的 53 端口启动 MagicDNS，失败只记录日志，不影响 WireGuard 本身
+func (w *wireGuard) startMagicDNSLocked() {
+	log := w.svcLogger.WithField("op", "startMagicDNS")
+
+	cfg := w.ctx.GetApp().GetConfig()
+	if !cfg.Client.Features.EnableMagicDNS || w.magicDNS != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(w.ifce.GetLocalAddress())
+	if err != nil {
+		log.WithError(err).Warnf("parse local address '%s' failed, skip magic dns", w.ifce.GetLocalAddress())
+		return
+	}
+	laddr := netip.AddrPortFrom(prefix.Addr(), magicDNSPort)
+
+	var conn net.PacketConn
+	if w.useGvisorNet {
+		conn, err = w.gvisorNet.ListenUDPAddrPort(laddr)
+	} else {
+		conn, err = net.ListenUDP("udp", net.UDPAddrFromAddrPort(laddr))
+	}
+	if err != nil {
+		log.WithError(err).Warnf("listen magic dns on '%s' failed", laddr)
+		return
+	}
+
+	w.magicDNS = newMagicDNS(conn, cfg.Client.MagicDNSUpstream, w.svcLogger.WithField("component", "magicdns"))
+	w.magicDNS.Update(w.ifce.WireGuardConfig)
+	go w.magicDNS.Serve()
+
+	log.Infof("magic dns listening on '%s', network: '%s'", laddr, w.ifce.GetNetworkName())
+
+	if !w.useGvisorNet {
+		w.configureLinkDNSLocked(prefix.Addr())
+	}
+}
+
+func (w *wireGuard) stopMagicDNSLocked() {
+	if w.magicDNS == nil {
+		return
+	}
+	if err := w.magicDNS.Close(); err != nil {
+		w.svcLogger.WithError(err).Warn("close magic dns failed")
+	}
+	w.magicDNS = nil
+}
+
+// configureLinkDNSLocked 有 systemd-resolved 时只把 ~<network>.internal 路由到本接口的 MagicDNS，
+// 本接口不作为默认路由，其余域名仍走系统原有的 dns。接口删除后 resolved 会自动清理这些配置
+func (w *wireGuard) configureLinkDNSLocked(addr netip.Addr) {
+	log := w.svcLogger.WithField("op", "configureLinkDNS")
+
+	network := magicDNSLabel(w.ifce.GetNetworkName())
+	if len(network) == 0 {
+		return
+	}
+	resolvectl, err := exec.LookPath("resolvectl")
+	if err != nil {
+		log.Debug("resolvectl not found, skip configuring link dns")
+		return
+	}
+
+	iface := w.ifce.GetInterfaceName()
+	if out, err := exec.Command(resolvectl, "dns", iface, addr.String()).CombinedOutput(); err != nil {
+		log.WithError(err).Warnf("resolvectl dns failed: %s", out)
+		return
+	}
+	if out, err := exec.Command(resolvectl, "domain", iface, "~"+network+".internal").CombinedOutput(); err != nil {
+		log.WithError(err).Warnf("resolvectl domain failed: %s", out)
+	}
+	if out, err := exec.Command(resolvectl, "default-route", iface, "false").CombinedOutput(); err != nil {
+		log.WithError(err).Warnf("resolvectl default-route failed: %s", out)
+	}
+}
+
+// UpdateMagicDNS implements WireGuard.
+func (w *wireGuard) UpdateMagicDNS(networkName string, records []*pb.WireGuardDNSRecord) error {
+	w.Lock()
+	defer w.Unlock()
+
+	renamed := w.ifce.GetNetworkName() != networkName
+	w.ifce.NetworkName = networkName
+	w.ifce.DnsRecords = records
+	if w.magicDNS == nil {
+		return nil
+	}
+	w.magicDNS.Update(w.ifce.WireGuardConfig)
+
+	// 网络改名后 resolved 上的路由域名需要跟着更新
+	if renamed && !w.useGvisorNet {
+		if prefix, err := netip.ParsePrefix(w.ifce.GetLocalAddress()); err == nil {
+			w.configureLinkDNSLocked(prefix.Addr())
+		}
+	}
+	return nil
+}
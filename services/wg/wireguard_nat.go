@@ -0,0 +1,173 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+)
+
+const (
+	stunRoundtripTimeout = 500 * time.Millisecond
+	stunDiscoverServers  = 3
+	// punchDuration 打洞持续时间，双方在此期间不断向对方映射地址发送 binding request，
+	// 先发出的报文在本端 NAT 上建立映射，对端的报文随后即可进入
+	punchDuration = 5 * time.Second
+)
+
+func (w *wireGuard) natTraversalEnabled() bool {
+	return w.natBind != nil && w.ctx.GetApp().GetConfig().Client.Features.EnableNATTraversal
+}
+
+// peerUDPEndpoints 从设备读取各 peer 当前的 udp 地址，STUN 只应答这些地址
+func (w *wireGuard) peerUDPEndpoints() []netip.AddrPort {
+	if w.wgDevice == nil {
+		return nil
+	}
+	info, err := w.wgDevice.IpcGet()
+	if err != nil {
+		return nil
+	}
+	var ret []netip.AddrPort
+	for _, line := range strings.Split(info, "\n") {
+		v, ok := strings.CutPrefix(line, "endpoint=")
+		if !ok {
+			continue
+		}
+		if ap, err := netip.ParseAddrPort(v); err == nil {
+			ret = append(ret, ap)
+		}
+	}
+	return ret
+}
+
+// discoverReflexiveEndpoint 通过 WireGuard 自身的 udp socket 向 STUN 服务器探测 NAT 映射地址，
+// 服务器为配置的 STUN_SERVERS 与网络内有公网 udp endpoint 的节点
+func (w *wireGuard) discoverReflexiveEndpoint() {
+	if !w.natTraversalEnabled() {
+		return
+	}
+	log := w.svcLogger.WithField("op", "discoverReflexiveEndpoint")
+
+	ifceConfig, err := w.GetIfceConfig()
+	if err != nil {
+		log.WithError(err).Errorf("failed to get interface config")
+		return
+	}
+
+	servers := append([]string{}, w.ctx.GetApp().GetConfig().Client.STUNServers...)
+	for _, ep := range collectEndpointPingTargets(ifceConfig) {
+		if ep == nil || endpointTypeContainsWS(ep.GetType()) || ep.GetType() == defs.EndpointTypeSTUN || ep.GetPort() == 0 {
+			continue
+		}
+		servers = append(servers, net.JoinHostPort(ep.GetHost(), fmt.Sprint(ep.GetPort())))
+	}
+
+	tried := 0
+	for _, s := range servers {
+		if tried >= stunDiscoverServers {
+			break
+		}
+		target, err := resolveUDPAddrPort(s)
+		if err != nil {
+			log.WithError(err).Debugf("resolve stun server '%s' failed", s)
+			continue
+		}
+		tried++
+		mapped, _, err := w.natBind.Roundtrip(target, stunRoundtripTimeout)
+		if err != nil || !mapped.IsValid() {
+			log.WithError(err).Debugf("stun binding to '%s' failed", target)
+			continue
+		}
+		if old := w.natBind.Reflexive(); old != mapped {
+			log.Infof("reflexive endpoint of '%s' changed: '%s' -> '%s'", w.ifce.GetInterfaceName(), old, mapped)
+		}
+		w.natBind.setReflexive(mapped)
+		return
+	}
+}
+
+// Punch implements WireGuard.
+// 并发向每个目标的映射地址打洞，结果写入 endpoint ping，随状态上报给 master 用于选路
+func (w *wireGuard) Punch(targets []*pb.WireGuardPunchTarget) []*pb.WireGuardPunchResult {
+	results := make([]*pb.WireGuardPunchResult, len(targets))
+	if !w.natTraversalEnabled() {
+		for i, t := range targets {
+			results[i] = &pb.WireGuardPunchResult{PeerId: t.PeerId, Success: lo.ToPtr(false)}
+		}
+		return results
+	}
+
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rtt, err := w.punchEndpoint(t.GetEndpoint(), punchDuration)
+			ret := &pb.WireGuardPunchResult{PeerId: t.PeerId, Success: lo.ToPtr(false)}
+			if err != nil {
+				w.svcLogger.WithError(err).Debugf("punch peer %d at '%s' failed", t.GetPeerId(), t.GetEndpoint())
+				w.storeEndpointPing(t.GetPeerId(), math.MaxUint32)
+			} else {
+				ms := uint32(max(rtt.Milliseconds(), 1))
+				w.storeEndpointPing(t.GetPeerId(), ms)
+				ret.Success, ret.RttMs = lo.ToPtr(true), lo.ToPtr(ms)
+			}
+			results[i] = ret
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// punchEndpoint 在 duration 内不断发送 binding request，直到收到对端应答
+func (w *wireGuard) punchEndpoint(endpoint string, duration time.Duration) (time.Duration, error) {
+	if w.natBind == nil {
+		return 0, errors.New("nat traversal is not available")
+	}
+	target, err := netip.ParseAddrPort(endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	deadline := time.Now().Add(duration)
+	for time.Now().Before(deadline) {
+		_, rtt, err := w.natBind.Roundtrip(target, stunRoundtripTimeout)
+		if err == nil {
+			return rtt, nil
+		}
+		if !errors.Is(err, errSTUNTimeout) {
+			return 0, err
+		}
+	}
+	return 0, errSTUNTimeout
+}
+
+// probeSTUNEndpoint 定期探测已打洞的映射地址，同时保持 NAT 映射不过期
+func (w *wireGuard) probeSTUNEndpoint(ep *pb.Endpoint) uint32 {
+	rtt, err := w.punchEndpoint(net.JoinHostPort(ep.GetHost(), fmt.Sprint(ep.GetPort())), punchDuration)
+	if err != nil {
+		return math.MaxUint32
+	}
+	return uint32(max(rtt.Milliseconds(), 1))
+}
+
+func resolveUDPAddrPort(hostport string) (netip.AddrPort, error) {
+	addr, err := net.ResolveUDPAddr("udp", hostport)
+	if err != nil {
+		return netip.AddrPort{}, err
+	}
+	ap := addr.AddrPort()
+	return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port()), nil
+}
@@ -15,6 +15,7 @@ func (w *wireGuard) reportStatusTask() {
 		case <-w.ctx.Done():
 			return
 		default:
+			w.discoverReflexiveEndpoint()
 			w.pingPeers()
 			time.Sleep(ReportInterval)
 		}
@@ -0,0 +1,110 @@
+//go:build !windows
+// +build !windows
+
+package wg
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/vishvananda/netlink"
+)
+
+// UpdateAdvertisedRoutes implements WireGuard.
+func (w *wireGuard) UpdateAdvertisedRoutes(routes []string, exitNode, acceptRoutes bool) error {
+	w.Lock()
+	defer w.Unlock()
+
+	w.ifce.AdvertisedRoutes = routes
+	w.ifce.ExitNode = exitNode
+	w.ifce.AcceptRoutes = acceptRoutes
+	if !w.running {
+		return nil
+	}
+	return errors.Join(w.applySubnetRouterLocked(), w.syncPeerRoutesLocked())
+}
+
+// applySubnetRouterLocked 通告网段需要内核转发，gvisor netstack 无法把包转发到本机网络，只提示
+func (w *wireGuard) applySubnetRouterLocked() error {
+	routes := AdvertisedPrefixes(w.ifce.GetAdvertisedRoutes(), w.ifce.GetExitNode())
+
+	if w.useGvisorNet {
+		if len(routes) > 0 {
+			w.svcLogger.Warnf("iface '%s' uses gvisor netstack, advertised routes %v can not be forwarded", w.ifce.GetInterfaceName(), routes)
+		}
+		return nil
+	}
+	if w.fwManager == nil {
+		return nil
+	}
+
+	prefix, err := netip.ParsePrefix(w.ifce.GetLocalAddress())
+	if err != nil {
+		return errors.Join(fmt.Errorf("parse local address '%s' for subnet router", w.ifce.GetLocalAddress()), err)
+	}
+	return w.fwManager.ApplySubnetRouteRules(w.ifce.GetInterfaceName(), prefix.Masked().String(), routes)
+}
+
+// syncPeerRoutesLocked 内核模式下接口地址只带来网络网段的路由，其他节点通告的网段按 peer 的 AllowedIPs 添加经由接口的路由。
+// 是否使用子网路由由 master 规划时按 AcceptRoutes 决定，未开启的节点只会作为中转收到前缀，
+// 中转节点同样需要安装路由，否则子网流量会经默认网关发出。
+// 默认路由不自动接管，避免 WireGuard 自身的流量被劫持，内核模式使用出口节点需要自行配置策略路由；
+// gvisor netstack 的默认路由本身就指向隧道，无需处理
+func (w *wireGuard) syncPeerRoutesLocked() error {
+	if w.useGvisorNet {
+		return nil
+	}
+
+	local, err := netip.ParsePrefix(w.ifce.GetLocalAddress())
+	if err != nil {
+		return errors.Join(fmt.Errorf("parse local address '%s' for routes", w.ifce.GetLocalAddress()), err)
+	}
+
+	desired := peerRoutePrefixes(local.Masked(), w.ifce.GetPeers())
+	if len(desired) == 0 && len(w.peerRoutes) == 0 {
+		return nil
+	}
+
+	link, err := netlink.LinkByName(w.ifce.GetInterfaceName())
+	if err != nil {
+		return errors.Join(fmt.Errorf("get iface '%s' via netlink", w.ifce.GetInterfaceName()), err)
+	}
+
+	var errs error
+	for s := range w.peerRoutes {
+		if _, ok := desired[s]; ok {
+			continue
+		}
+		if err := netlink.RouteDel(peerRoute(link, netip.MustParsePrefix(s))); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("delete route '%s' failed: %w", s, err))
+		}
+		delete(w.peerRoutes, s)
+	}
+	for s, prefix := range desired {
+		if _, ok := w.peerRoutes[s]; ok {
+			continue
+		}
+		if err := netlink.RouteReplace(peerRoute(link, prefix)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("add route '%s' failed: %w", s, err))
+			continue
+		}
+		if w.peerRoutes == nil {
+			w.peerRoutes = make(map[string]struct{})
+		}
+		w.peerRoutes[s] = struct{}{}
+	}
+	return errs
+}
+
+func peerRoute(link netlink.Link, prefix netip.Prefix) *netlink.Route {
+	return &netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Scope:     netlink.SCOPE_LINK,
+		Dst: &net.IPNet{
+			IP:   prefix.Addr().AsSlice(),
+			Mask: net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen()),
+		},
+	}
+}
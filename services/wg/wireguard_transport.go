@@ -20,9 +20,15 @@ func (w *wireGuard) initTransports() error {
 	log := w.svcLogger.WithField("op", "initTransports")
 
 	wsTrans := ws.NewWSBind(w.ctx)
+	var udpBind conn.Bind = conn.NewDefaultBind()
+	// 开启 NAT 穿透时 udp 包一层 STUN，用于 NAT 映射地址探测与打洞
+	if w.ctx.GetApp().GetConfig().Client.Features.EnableNATTraversal {
+		w.natBind = newSTUNBind(udpBind, w.peerUDPEndpoints)
+		udpBind = w.natBind
+	}
 	w.multiBind = multibind.NewMultiBind(
 		w.svcLogger,
-		multibind.NewTransport(conn.NewDefaultBind(), "udp"),
+		multibind.NewTransport(udpBind, "udp"),
 		multibind.NewTransport(wsTrans, "ws"),
 	)
 
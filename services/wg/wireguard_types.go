@@ -40,8 +40,14 @@ type wireGuard struct {
 	wgDevice  *device.Device
 	tunDevice tun.Device
 	multiBind *multibind.MultiBind
+	natBind   *stunBind
 	gvisorNet *netstack.Net
 	fwManager *firewallManager
+	// aclTun gvisor netstack 下包裹 tunDevice，执行用户态 ACL 过滤
+	aclTun   *aclTun
+	magicDNS *magicDNS
+	// peerRoutes 内核模式下为其他节点通告的网段添加的路由
+	peerRoutes map[string]struct{}
 
 	running      bool
 	useGvisorNet bool // if true, use gvisor netstack
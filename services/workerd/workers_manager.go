@@ -58,6 +58,10 @@ func (m *workersManager) StopAllWorkers(ctx *app.Context) {
 	}
 }
 
+func (m *workersManager) ListWorkerIDs() []string {
+	return m.workers.Keys()
+}
+
 func (m *workersManager) GetWorkerStatus(ctx *app.Context, id string) (defs.WorkerStatus, error) {
 	ok, err := utils.ProcessExistsBySelf(id)
 	if err != nil {
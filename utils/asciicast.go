@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// asciicast v2 事件类型
+const (
+	AsciicastEventOutput = "o"
+	AsciicastEventResize = "r"
+)
+
+// AsciicastHeader asciicast v2 文件的第一行
+type AsciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// AsciicastEvent asciicast v2 的事件行，格式为 [time, type, data]
+type AsciicastEvent struct {
+	Time float64
+	Type string
+	Data string
+}
+
+func (e AsciicastEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{e.Time, e.Type, e.Data})
+}
+
+func (e *AsciicastEvent) UnmarshalJSON(b []byte) error {
+	raw := []any{&e.Time, &e.Type, &e.Data}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("invalid asciicast event: %s", string(b))
+	}
+	return nil
+}
+
+// AsciicastWriter 按 asciicast v2 格式写入终端输出与窗口大小变化，可并发调用
+type AsciicastWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	start   time.Time
+	pending []byte
+	written int64
+}
+
+func NewAsciicastWriter(w io.Writer, header AsciicastHeader, start time.Time) (*AsciicastWriter, error) {
+	header.Version = 2
+	if header.Timestamp == 0 {
+		header.Timestamp = start.Unix()
+	}
+	aw := &AsciicastWriter{w: w, start: start}
+	if err := aw.writeLine(header); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// WriteOutput 写入一段终端输出，末尾不完整的 utf8 字符会留到下一次一起写入
+func (a *AsciicastWriter) WriteOutput(at time.Time, data []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	data = append(a.pending, data...)
+	cut := incompleteUTF8Tail(data)
+	a.pending = append([]byte(nil), data[len(data)-cut:]...)
+	data = data[:len(data)-cut]
+	if len(data) == 0 {
+		return nil
+	}
+	return a.writeLine(AsciicastEvent{Time: a.elapsed(at), Type: AsciicastEventOutput, Data: string(data)})
+}
+
+// WriteResize 写入窗口大小变化
+func (a *AsciicastWriter) WriteResize(at time.Time, width, height int) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.writeLine(AsciicastEvent{Time: a.elapsed(at), Type: AsciicastEventResize, Data: fmt.Sprintf("%dx%d", width, height)})
+}
+
+// Flush 写出末尾暂存的不完整字符，会话结束时调用
+func (a *AsciicastWriter) Flush(at time.Time) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.pending) == 0 {
+		return nil
+	}
+	data := a.pending
+	a.pending = nil
+	return a.writeLine(AsciicastEvent{Time: a.elapsed(at), Type: AsciicastEventOutput, Data: string(data)})
+}
+
+// Size 已写入的字节数
+func (a *AsciicastWriter) Size() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.written
+}
+
+func (a *AsciicastWriter) elapsed(at time.Time) float64 {
+	d := at.Sub(a.start)
+	if d < 0 {
+		d = 0
+	}
+	return float64(d.Microseconds()) / 1e6
+}
+
+func (a *AsciicastWriter) writeLine(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	n, err := a.w.Write(append(b, '\n'))
+	a.written += int64(n)
+	return err
+}
+
+// incompleteUTF8Tail 返回末尾未写完的 utf8 字符字节数
+func incompleteUTF8Tail(b []byte) int {
+	for i := 1; i <= utf8.UTFMax-1 && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if c < utf8.RuneSelf {
+			return 0
+		}
+		if utf8.RuneStart(c) {
+			if utf8.FullRune(b[len(b)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
+
+// AsciicastReader 逐行读取 asciicast v2 文件
+type AsciicastReader struct {
+	Header  AsciicastHeader
+	scanner *bufio.Scanner
+}
+
+func NewAsciicastReader(r io.Reader) (*AsciicastReader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("empty asciicast")
+	}
+
+	ar := &AsciicastReader{scanner: scanner}
+	if err := json.Unmarshal(scanner.Bytes(), &ar.Header); err != nil {
+		return nil, err
+	}
+	if ar.Header.Version != 2 {
+		return nil, fmt.Errorf("unsupported asciicast version: %d", ar.Header.Version)
+	}
+	return ar, nil
+}
+
+// Next 读取下一个事件，读完返回 io.EOF
+func (a *AsciicastReader) Next() (*AsciicastEvent, error) {
+	for a.scanner.Scan() {
+		if len(a.scanner.Bytes()) == 0 {
+			continue
+		}
+		e := &AsciicastEvent{}
+		if err := json.Unmarshal(a.scanner.Bytes(), e); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+	if err := a.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsciicastRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	start := time.Unix(1700000000, 0)
+
+	w, err := NewAsciicastWriter(buf, AsciicastHeader{Width: 80, Height: 24}, start)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteOutput(start.Add(500*time.Millisecond), []byte("hello\r\n")))
+	require.NoError(t, w.WriteResize(start.Add(time.Second), 120, 40))
+	require.NoError(t, w.WriteOutput(start.Add(1500*time.Millisecond), []byte("bye")))
+	assert.Equal(t, int64(buf.Len()), w.Size())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, `{"version":2,"width":80,"height":24,"timestamp":1700000000}`, lines[0])
+	assert.Equal(t, `[0.5,"o","hello\r\n"]`, lines[1])
+	assert.Equal(t, `[1,"r","120x40"]`, lines[2])
+
+	r, err := NewAsciicastReader(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 80, r.Header.Width)
+
+	events := []*AsciicastEvent{}
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, e)
+	}
+	require.Len(t, events, 3)
+	assert.Equal(t, AsciicastEvent{Time: 1, Type: AsciicastEventResize, Data: "120x40"}, *events[1])
+	assert.Equal(t, "bye", events[2].Data)
+}
+
+func TestAsciicastSplitUTF8(t *testing.T) {
+	buf := &bytes.Buffer{}
+	start := time.Now()
+	w, err := NewAsciicastWriter(buf, AsciicastHeader{Width: 80, Height: 24}, start)
+	require.NoError(t, err)
+
+	word := []byte("你好")
+	require.NoError(t, w.WriteOutput(start, word[:4]))
+	require.NoError(t, w.WriteOutput(start, word[4:]))
+
+	r, err := NewAsciicastReader(buf)
+	require.NoError(t, err)
+	var got string
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got += e.Data
+	}
+	assert.Equal(t, "你好", got)
+}
+
+func TestAsciicastFlushPending(t *testing.T) {
+	buf := &bytes.Buffer{}
+	start := time.Now()
+	w, err := NewAsciicastWriter(buf, AsciicastHeader{Width: 80, Height: 24}, start)
+	require.NoError(t, err)
+
+	word := []byte("ok你")
+	require.NoError(t, w.WriteOutput(start, word[:4]))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	// 会话结束时末尾不完整的字节也要写出
+	require.NoError(t, w.Flush(start))
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, int64(buf.Len()), w.Size())
+
+	require.NoError(t, w.Flush(start))
+	assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 3)
+}
@@ -8,3 +8,11 @@ func EncodeBase64(data string) string {
 	encodedStr := base64.StdEncoding.EncodeToString([]byte(data))
 	return encodedStr
 }
+
+func DecodeBase64(data string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
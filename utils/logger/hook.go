@@ -12,9 +12,16 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// StreamLogLine 推送给 master 的一行日志，带上包名与级别方便 master 侧过滤
+type StreamLogLine struct {
+	Msg   string
+	Pkg   string
+	Level string
+}
+
 type StreamLogHook struct {
-	ch            chan string
-	handler       func(msg string)
+	ch            chan StreamLogLine
+	handler       func(line StreamLogLine)
 	stopFunc      func()
 	streamEnabled bool
 	stdio         io.Writer
@@ -22,10 +29,10 @@ type StreamLogHook struct {
 	pkgs          map[string]bool // 只传输指定包的日志
 }
 
-func NewStreamLogHook(handler func(msg string), stopFunc func(), pkgs ...string) *StreamLogHook {
+func NewStreamLogHook(handler func(line StreamLogLine), stopFunc func(), pkgs ...string) *StreamLogHook {
 	pkgs = lo.FilterMap(pkgs, func(v string, _ int) (string, bool) { return v, len(v) > 0 })
 	return &StreamLogHook{
-		ch:            make(chan string, 4096),
+		ch:            make(chan StreamLogLine, 4096),
 		handler:       handler,
 		streamEnabled: true,
 		stdio:         bufio.NewWriter(os.Stdout),
@@ -40,10 +47,11 @@ func (s *StreamLogHook) Fire(entry *logrus.Entry) error {
 		return nil
 	}
 
+	pkgName, hasPkg := entry.Data["pkg"].(string)
+
 	// 有过滤时需要过滤
 	if len(s.pkgs) > 0 {
-		pkgName, ok := entry.Data["pkg"].(string)
-		if !ok {
+		if !hasPkg {
 			return nil
 		}
 		if _, ok := s.pkgs[pkgName]; !ok {
@@ -52,7 +60,7 @@ func (s *StreamLogHook) Fire(entry *logrus.Entry) error {
 	}
 
 	str, _ := entry.String()
-	s.ch <- str
+	s.ch <- StreamLogLine{Msg: str, Pkg: pkgName, Level: entry.Level.String()}
 	return nil
 }
 
@@ -76,11 +84,11 @@ func (s *StreamLogHook) Send() {
 		if !s.streamEnabled {
 			return
 		}
-		msg, ok := <-s.ch
+		line, ok := <-s.ch
 		if !ok {
 			return
 		}
-		s.handler(msg)
+		s.handler(line)
 	}
 }
 
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// OutboundTimeout 访问用户配置地址（告警渠道、webhook）的超时时间
+const OutboundTimeout = 10 * time.Second
+
+var (
+	outboundAllowPrivate atomic.Bool
+
+	// cgnatPrefix 运营商级 nat 地址，云厂商常用于内部服务
+	cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+	outboundClient = &http.Client{
+		Timeout: OutboundTimeout,
+		Transport: &http.Transport{
+			DialContext:         NewOutboundDialer(OutboundTimeout).DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: OutboundTimeout,
+		},
+		// 不跟随重定向，否则公网地址可以把请求转到内网
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+)
+
+// SetOutboundAllowPrivate 允许访问内网地址，只用于告警渠道和 webhook 都在内网的部署
+func SetOutboundAllowPrivate(allow bool) {
+	outboundAllowPrivate.Store(allow)
+}
+
+// IsPublicAddr 判断地址是否可以作为用户配置的外部目标，拒绝回环、内网、链路本地、组播等地址
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsUnspecified() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!cgnatPrefix.Contains(addr) &&
+		!(addr.Is4() && addr.As4()[0] == 0)
+}
+
+// NewOutboundDialer 在解析完域名、真正建立连接前检查目标 ip，避免 dns 重绑定绕过
+func NewOutboundDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if outboundAllowPrivate.Load() {
+				return nil
+			}
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicAddr(ap.Addr()) {
+				return fmt.Errorf("outbound address [%s] is not allowed", ap.Addr())
+			}
+			return nil
+		},
+	}
+}
+
+// SendOutbound 用受限的客户端发送请求，非 2xx 视为失败，不把响应内容带回给调用方
+func SendOutbound(req *http.Request) (int, error) {
+	resp, err := outboundClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%s responded %d", req.URL.Host, resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"8.8.8.8":          true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"0.1.2.3":          false,
+		"224.0.0.1":        false,
+		"::1":              false,
+		"fd00::1":          false,
+		"fe80::1":          false,
+		"::ffff:127.0.0.1": false,
+		"::ffff:8.8.8.8":   true,
+		"::":               false,
+		"ff02::1":          false,
+	} {
+		assert.Equal(t, public, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestSendOutbound(t *testing.T) {
+	hit := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = SendOutbound(req)
+	assert.ErrorContains(t, err, "not allowed")
+	assert.False(t, hit)
+
+	// 放开内网后也不跟随重定向
+	SetOutboundAllowPrivate(true)
+	defer SetOutboundAllowPrivate(false)
+	req, err = http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+	code, err := SendOutbound(req)
+	assert.True(t, hit)
+	assert.Equal(t, http.StatusFound, code)
+	assert.ErrorContains(t, err, "responded 302")
+}
@@ -0,0 +1,44 @@
+package utils
+
+import "sync"
+
+// RingBuffer 固定容量的环形缓冲区，写满后覆盖最旧的元素，可并发使用
+type RingBuffer[T any] struct {
+	mu    sync.RWMutex
+	items []T
+	next  int
+	full  bool
+}
+
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	return &RingBuffer[T]{items: make([]T, max(capacity, 1))}
+}
+
+func (r *RingBuffer[T]) Push(item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[r.next] = item
+	r.next = (r.next + 1) % len(r.items)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Snapshot 按写入顺序返回当前保存的全部元素
+func (r *RingBuffer[T]) Snapshot() []T {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.full {
+		return append([]T(nil), r.items[:r.next]...)
+	}
+	return append(append([]T(nil), r.items[r.next:]...), r.items[:r.next]...)
+}
+
+func (r *RingBuffer[T]) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.full {
+		return len(r.items)
+	}
+	return r.next
+}
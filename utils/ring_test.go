@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	assert.Empty(t, r.Snapshot())
+
+	r.Push(1)
+	r.Push(2)
+	assert.Equal(t, []int{1, 2}, r.Snapshot())
+	assert.Equal(t, 2, r.Len())
+
+	r.Push(3)
+	r.Push(4)
+	r.Push(5)
+	assert.Equal(t, []int{3, 4, 5}, r.Snapshot())
+	assert.Equal(t, 3, r.Len())
+
+	r.Push(6)
+	r.Push(7)
+	assert.Equal(t, []int{5, 6, 7}, r.Snapshot())
+}
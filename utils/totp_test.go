@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 附录 B 的 SHA1 测试向量，取低 6 位
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(secret, tt.unix/TOTPPeriod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+
+	prev, _ := TOTPCode(secret, now.Unix()/TOTPPeriod-1)
+	if counter, ok := VerifyTOTP(secret, prev, now, 1); !ok || counter != now.Unix()/TOTPPeriod-1 {
+		t.Errorf("previous period code should be accepted with skew 1")
+	}
+	if _, ok := VerifyTOTP(secret, prev, now, 0); ok {
+		t.Errorf("previous period code should be rejected without skew")
+	}
+	if _, ok := VerifyTOTP(secret, "12345", now, 1); ok {
+		t.Errorf("short code should be rejected")
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("want 10 codes, got %d", len(codes))
+	}
+	for _, c := range codes {
+		if len(c) != 11 || c[5] != '-' {
+			t.Errorf("unexpected code format: %s", c)
+		}
+		if HashRecoveryCode(c) != HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(c, "-", ""))) {
+			t.Errorf("hash should ignore case and dashes: %s", c)
+		}
+	}
+}